@@ -353,10 +353,6 @@ func (app *NibiruApp) InitKeepers(
 
 	// ---------------------------------- Nibiru Chain x/ keepers
 
-	app.SpotKeeper = spotkeeper.NewKeeper(
-		appCodec, keys[spottypes.StoreKey], app.GetSubspace(spottypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.DistrKeeper)
-
 	app.SudoKeeper = keeper.NewKeeper(
 		appCodec, keys[sudotypes.StoreKey],
 	)
@@ -367,6 +363,12 @@ func (app *NibiruApp) InitKeepers(
 		distrtypes.ModuleName,
 	)
 
+	app.SpotKeeper = spotkeeper.NewKeeper(
+		appCodec, keys[spottypes.StoreKey], app.GetSubspace(spottypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.OracleKeeper,
+		govModuleAddr,
+	)
+
 	app.EpochsKeeper = epochskeeper.NewKeeper(
 		appCodec, keys[epochstypes.StoreKey],
 	)
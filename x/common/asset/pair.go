@@ -152,6 +152,15 @@ func (pairKeyEncoder) Decode(b []byte) (int, Pair) {
 	return i, MustNewPair(s)
 }
 
+var PairValueEncoder collections.ValueEncoder[Pair] = pairValueEncoder{}
+
+type pairValueEncoder struct{}
+
+func (pairValueEncoder) Encode(value Pair) []byte    { return []byte(value) }
+func (pairValueEncoder) Decode(b []byte) Pair        { return MustNewPair(string(b)) }
+func (pairValueEncoder) Stringify(value Pair) string { return value.String() }
+func (pairValueEncoder) Name() string                { return "asset.Pair" }
+
 // MustNewPairs constructs a new asset pair set. A panic will occur if one of
 // the provided pair names is invalid.
 func MustNewPairs(pairStrings ...string) (pairs []Pair) {
@@ -178,3 +178,9 @@ func TestPairsUtils(t *testing.T) {
 		})
 	}
 }
+
+func TestInverseRoundTrip(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	require.Equal(t, asset.NewPair(denoms.NUSD, denoms.BTC), pair.Inverse())
+	require.Equal(t, pair, pair.Inverse().Inverse())
+}
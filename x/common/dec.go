@@ -187,3 +187,99 @@ func Clamp(value sdk.Dec, clampValue sdk.Dec) sdk.Dec {
 	}
 	return value
 }
+
+// InvertPrice returns the reciprocal of a positive price, which converts the
+// price of a pair into the price of its inverse, e.g. from BTC:NUSD to NUSD:BTC.
+// See asset.Pair.Inverse for the inverse pair. InvertPrice returns an error if
+// the price is nil, zero or negative.
+func InvertPrice(price sdk.Dec) (sdk.Dec, error) {
+	if price.IsNil() || !price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("cannot invert nonpositive price: %s", price)
+	}
+	return sdk.OneDec().Quo(price), nil
+}
+
+// ln2 is the natural logarithm of 2 rounded to 18 decimal places.
+var ln2 = sdk.MustNewDecFromStr("0.693147180559945309")
+
+// LnDec computes the natural logarithm of a positive decimal. The input is
+// scaled by powers of two into a mantissa m in [1, 2), whose logarithm is
+// evaluated with the series ln(m) = 2 * atanh((m - 1) / (m + 1)). Only sdk.Dec
+// arithmetic is used, so the result is deterministic across machines.
+func LnDec(dec sdk.Dec) (sdk.Dec, error) {
+	if dec.IsNil() || !dec.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("natural logarithm of non-positive number %s", dec)
+	}
+
+	two := sdk.NewDec(2)
+	exponent := int64(0)
+	mantissa := dec
+	for mantissa.GTE(two) {
+		mantissa = mantissa.Quo(two)
+		exponent++
+	}
+	for mantissa.LT(sdk.OneDec()) {
+		mantissa = mantissa.Mul(two)
+		exponent--
+	}
+
+	z := mantissa.Sub(sdk.OneDec()).Quo(mantissa.Add(sdk.OneDec()))
+	zSquared := z.Mul(z)
+	power := z
+	sum := sdk.ZeroDec()
+	for n := int64(0); ; n++ {
+		term := power.QuoInt64(2*n + 1)
+		if term.IsZero() {
+			break
+		}
+		sum = sum.Add(term)
+		power = power.Mul(zSquared)
+	}
+
+	return sum.MulInt64(2).Add(ln2.MulInt64(exponent)), nil
+}
+
+var (
+	// expDecMin is the exponent below which ExpDec rounds to zero. Any exponent
+	// below it scales the result by 2^-62 or less, under the 18 decimal places
+	// of sdk.Dec.
+	expDecMin = sdk.NewDec(-43)
+	// expDecMax is the largest exponent whose result fits in sdk.Dec, which
+	// holds values below about 2^256.
+	expDecMax = sdk.NewDec(177)
+)
+
+// ExpDec computes e raised to the power of the input decimal. The exponent is
+// split into k * ln(2) + r with |r| <= ln(2) / 2 so that the Taylor series of
+// exp(r) converges quickly, and the result is scaled back by 2^k. Exponents so
+// negative that the result rounds to zero return zero. ExpDec returns an error
+// if the result overflows sdk.Dec.
+func ExpDec(dec sdk.Dec) (sdk.Dec, error) {
+	if dec.IsNil() {
+		return sdk.Dec{}, fmt.Errorf("exponential of nil decimal")
+	}
+	if dec.LT(expDecMin) {
+		return sdk.ZeroDec(), nil
+	}
+	if dec.GT(expDecMax) {
+		return sdk.Dec{}, fmt.Errorf("exponential of %s overflows sdk.Dec", dec)
+	}
+
+	k := dec.Quo(ln2).RoundInt64()
+	remainder := dec.Sub(ln2.MulInt64(k))
+
+	sum := sdk.OneDec()
+	term := sdk.OneDec()
+	for n := int64(1); ; n++ {
+		term = term.Mul(remainder).QuoInt64(n)
+		if term.IsZero() {
+			break
+		}
+		sum = sum.Add(term)
+	}
+
+	if k >= 0 {
+		return sum.Mul(sdk.NewDec(2).Power(uint64(k))), nil
+	}
+	return sum.Quo(sdk.NewDec(2).Power(uint64(-k))), nil
+}
@@ -164,3 +164,78 @@ func TestClamp(t *testing.T) {
 		})
 	}
 }
+
+func TestLnDecAndExpDec(t *testing.T) {
+	tolerance := sdk.MustNewDecFromStr("0.000000000000001")
+
+	lnTestCases := []struct {
+		dec   sdk.Dec
+		lnDec sdk.Dec
+	}{
+		{dec: sdk.OneDec(), lnDec: sdk.ZeroDec()},
+		{dec: sdk.NewDec(2), lnDec: sdk.MustNewDecFromStr("0.693147180559945309")},
+		{dec: sdk.NewDec(10), lnDec: sdk.MustNewDecFromStr("2.302585092994045684")},
+		{dec: sdk.MustNewDecFromStr("0.5"), lnDec: sdk.MustNewDecFromStr("-0.693147180559945309")},
+		{dec: sdk.MustNewDecFromStr("8.5"), lnDec: sdk.MustNewDecFromStr("2.140066163496270770")},
+	}
+	for _, testCase := range lnTestCases {
+		tc := testCase
+		t.Run(fmt.Sprintf("ln(%s)", tc.dec), func(t *testing.T) {
+			lnDec, err := common.LnDec(tc.dec)
+			assert.NoError(t, err)
+			assert.True(t, lnDec.Sub(tc.lnDec).Abs().LTE(tolerance),
+				"expected %s, got %s", tc.lnDec, lnDec)
+		})
+	}
+
+	expTestCases := []struct {
+		dec    sdk.Dec
+		expDec sdk.Dec
+	}{
+		{dec: sdk.ZeroDec(), expDec: sdk.OneDec()},
+		{dec: sdk.OneDec(), expDec: sdk.MustNewDecFromStr("2.718281828459045235")},
+		{dec: sdk.NewDec(-3), expDec: sdk.MustNewDecFromStr("0.049787068367863943")},
+		{dec: sdk.MustNewDecFromStr("2.302585092994045684"), expDec: sdk.NewDec(10)},
+		{dec: sdk.NewDec(-40), expDec: sdk.ZeroDec()},
+		{dec: sdk.NewDec(-60), expDec: sdk.ZeroDec()},
+		{dec: sdk.NewDec(-500), expDec: sdk.ZeroDec()},
+	}
+	for _, testCase := range expTestCases {
+		tc := testCase
+		t.Run(fmt.Sprintf("exp(%s)", tc.dec), func(t *testing.T) {
+			expDec, err := common.ExpDec(tc.dec)
+			assert.NoError(t, err)
+			assert.True(t, expDec.Sub(tc.expDec).Abs().LTE(tolerance),
+				"expected %s, got %s", tc.expDec, expDec)
+		})
+	}
+
+	_, err := common.LnDec(sdk.ZeroDec())
+	assert.Error(t, err)
+	_, err = common.LnDec(sdk.NewDec(-1))
+	assert.Error(t, err)
+	_, err = common.ExpDec(sdk.NewDec(1_000))
+	assert.Error(t, err)
+	_, err = common.ExpDec(sdk.NewDec(170))
+	assert.NoError(t, err)
+}
+
+func TestInvertPrice(t *testing.T) {
+	for _, tc := range []struct {
+		price    sdk.Dec
+		expected sdk.Dec
+	}{
+		{price: sdk.NewDec(4), expected: sdk.MustNewDecFromStr("0.25")},
+		{price: sdk.MustNewDecFromStr("0.5"), expected: sdk.NewDec(2)},
+		{price: sdk.NewDec(3), expected: sdk.MustNewDecFromStr("0.333333333333333333")},
+	} {
+		inverse, err := common.InvertPrice(tc.price)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected.String(), inverse.String())
+	}
+
+	for _, price := range []sdk.Dec{sdk.ZeroDec(), sdk.NewDec(-1), {}} {
+		_, err := common.InvertPrice(price)
+		assert.Error(t, err, "price: %s", price)
+	}
+}
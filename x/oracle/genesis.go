@@ -78,6 +78,11 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 	if len(data.Rewards) != 0 {
 		keeper.RewardsID.Set(ctx, data.Rewards[len(data.Rewards)-1].Id)
 	}
+
+	for _, fp := range data.FallbackPairs {
+		keeper.FallbackPairs.Insert(ctx, fp.Pair, fp.FallbackPair)
+	}
+	keeper.MaxPriceAge.Set(ctx, data.MaxPriceAge)
 	keeper.Params.Set(ctx, data.Params)
 
 	// check if the module account exists
@@ -120,7 +125,15 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	var pairs []asset.Pair
 	pairs = append(pairs, keeper.WhitelistedPairs.Iterate(ctx, collections.Range[asset.Pair]{}).Keys()...)
 
-	return types.NewGenesisState(
+	fallbackPairs := []types.FallbackPair{}
+	for _, kv := range keeper.FallbackPairs.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		fallbackPairs = append(fallbackPairs, types.FallbackPair{
+			Pair:         kv.Key,
+			FallbackPair: kv.Value,
+		})
+	}
+
+	genesis := types.NewGenesisState(
 		params,
 		exchangeRates,
 		feederDelegations,
@@ -130,4 +143,8 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		pairs,
 		keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
 	)
+	genesis.FallbackPairs = fallbackPairs
+	genesis.MaxPriceAge = keeper.MaxPriceAge.GetOr(ctx, 0)
+
+	return genesis
 }
@@ -2,6 +2,7 @@ package oracle_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -27,7 +28,11 @@ func TestExportInitGenesis(t *testing.T) {
 		VotePeriods: 100,
 		Coins:       sdk.NewCoins(sdk.NewInt64Coin("test", 1000)),
 	})
+	input.OracleKeeper.FallbackPairs.Insert(input.Ctx, "pair1:pair2", "pair1:pair3")
+	input.OracleKeeper.MaxPriceAge.Set(input.Ctx, uint64(time.Minute))
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Len(t, genesis.FallbackPairs, 1)
+	require.Equal(t, uint64(time.Minute), genesis.MaxPriceAge)
 
 	newInput := keeper.CreateTestFixture(t)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
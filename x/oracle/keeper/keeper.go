@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -46,6 +47,14 @@ type Keeper struct {
 	WhitelistedPairs collections.KeySet[asset.Pair]
 	Rewards          collections.Map[uint64, types.Rewards]
 	RewardsID        collections.Sequence
+
+	// FallbackPairs maps a pair to the secondary pair whose exchange rate is
+	// used by GetUnderlyingPrice when the price of the primary pair is stale.
+	FallbackPairs collections.Map[asset.Pair, asset.Pair]
+	// MaxPriceAge is the maximum age in nanoseconds of the latest price
+	// snapshot of a pair for its exchange rate to be fresh. Zero disables the
+	// check.
+	MaxPriceAge collections.Item[uint64]
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -86,7 +95,9 @@ func NewKeeper(
 		Rewards: collections.NewMap(
 			storeKey, 7,
 			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Rewards](cdc)),
-		RewardsID: collections.NewSequence(storeKey, 9),
+		RewardsID:     collections.NewSequence(storeKey, 9),
+		FallbackPairs: collections.NewMap(storeKey, 12, asset.PairKeyEncoder, asset.PairValueEncoder),
+		MaxPriceAge:   collections.NewItem(storeKey, 13, collections.Uint64ValueEncoder),
 	}
 	return k
 }
@@ -183,6 +194,82 @@ func (k Keeper) GetExchangeRate(ctx sdk.Context, pair asset.Pair) (price sdk.Dec
 	return
 }
 
+// GetUnderlyingPrice returns the exchange rate of the pair if it is not stale.
+// A price is stale once it is at least ExpirationBlocks old, or when a max price
+// age is set and the latest price snapshot is older than it. When the price of
+// the pair is stale and a fallback pair is registered for it, the exchange rate
+// of the fallback pair is returned instead.
+//
+// Returns ErrStalePrice if neither price is fresh.
+func (k Keeper) GetUnderlyingPrice(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	maxPriceAge := time.Duration(k.MaxPriceAge.GetOr(ctx, 0))
+	if price, isFresh := k.getFreshExchangeRate(ctx, pair, params.ExpirationBlocks, maxPriceAge); isFresh {
+		return price, nil
+	}
+
+	fallbackPair, err := k.FallbackPairs.Get(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrStalePrice.Wrapf("pair %s", pair)
+	}
+
+	price, isFresh := k.getFreshExchangeRate(ctx, fallbackPair, params.ExpirationBlocks, maxPriceAge)
+	if !isFresh {
+		return sdk.Dec{}, types.ErrStalePrice.Wrapf(
+			"pair %s and fallback pair %s", pair, fallbackPair)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFallbackOracleUsed{
+		Pair:         pair.String(),
+		FallbackPair: fallbackPair.String(),
+		Price:        price,
+	}); err != nil {
+		ctx.Logger().Error("failed to emit EventFallbackOracleUsed", "pair", pair, "error", err)
+	}
+	return price, nil
+}
+
+// getFreshExchangeRate returns the exchange rate of the pair and whether it was
+// posted less than 'expirationBlocks' blocks ago. A nonzero 'maxPriceAge' also
+// requires the latest price snapshot of the pair to be at most that old.
+func (k Keeper) getFreshExchangeRate(
+	ctx sdk.Context, pair asset.Pair, expirationBlocks uint64, maxPriceAge time.Duration,
+) (price sdk.Dec, isFresh bool) {
+	exchangeRate, err := k.ExchangeRates.Get(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, false
+	}
+	isExpired := exchangeRate.CreatedBlock+expirationBlocks <= uint64(ctx.BlockHeight())
+	if isExpired {
+		return exchangeRate.ExchangeRate, false
+	}
+	if maxPriceAge > 0 && k.latestPriceAge(ctx, pair) > maxPriceAge {
+		return exchangeRate.ExchangeRate, false
+	}
+	return exchangeRate.ExchangeRate, true
+}
+
+// latestPriceAge returns the time since the latest price snapshot of the pair,
+// or the maximum duration if the pair has no snapshot.
+func (k Keeper) latestPriceAge(ctx sdk.Context, pair asset.Pair) time.Duration {
+	iter := k.PriceSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			EndInclusive(ctx.BlockTime()).
+			Descending(),
+	)
+	defer iter.Close()
+	if !iter.Valid() {
+		return time.Duration(math.MaxInt64)
+	}
+	return ctx.BlockTime().Sub(iter.Key().K2())
+}
+
 // SetPrice sets the price for a pair as well as the price snapshot.
 func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
 	k.ExchangeRates.Insert(ctx, pair, types.DatedPrice{ExchangeRate: price, CreatedBlock: uint64(ctx.BlockHeight())})
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
@@ -9,6 +10,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	testutilevents "github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
 func TestValidateFeeder(t *testing.T) {
@@ -53,3 +61,136 @@ func TestValidateFeeder(t *testing.T) {
 	input.StakingKeeper.SetValidator(input.Ctx, validator)
 	require.Error(t, input.OracleKeeper.ValidateFeeder(input.Ctx, sdk.AccAddress(addr1), addr))
 }
+
+func TestGetUnderlyingPrice(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	fallbackPair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+
+	testCases := []struct {
+		name            string
+		primaryBlock    int64
+		fallbackBlock   int64
+		expectedPrice   sdk.Dec
+		expectFallback  bool
+		expectedErrorIs error
+	}{
+		{
+			name:          "fresh primary price is used",
+			primaryBlock:  95,
+			fallbackBlock: 95,
+			expectedPrice: sdk.NewDec(20_000),
+		},
+		{
+			name:           "stale primary uses fresh fallback price",
+			primaryBlock:   50,
+			fallbackBlock:  95,
+			expectedPrice:  sdk.NewDec(20_001),
+			expectFallback: true,
+		},
+		{
+			name:            "both prices stale",
+			primaryBlock:    50,
+			fallbackBlock:   50,
+			expectedErrorIs: types.ErrStalePrice,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			input := CreateTestFixture(t)
+			ctx := input.Ctx.WithBlockHeight(100).WithEventManager(sdk.NewEventManager())
+
+			params, _ := input.OracleKeeper.Params.Get(ctx)
+			params.ExpirationBlocks = 10
+			input.OracleKeeper.Params.Set(ctx, params)
+
+			input.OracleKeeper.ExchangeRates.Insert(ctx, pair, types.DatedPrice{
+				ExchangeRate: sdk.NewDec(20_000), CreatedBlock: uint64(tc.primaryBlock),
+			})
+			input.OracleKeeper.ExchangeRates.Insert(ctx, fallbackPair, types.DatedPrice{
+				ExchangeRate: sdk.NewDec(20_001), CreatedBlock: uint64(tc.fallbackBlock),
+			})
+			input.OracleKeeper.FallbackPairs.Insert(ctx, pair, fallbackPair)
+
+			price, err := input.OracleKeeper.GetUnderlyingPrice(ctx, pair)
+			if tc.expectedErrorIs != nil {
+				require.ErrorIs(t, err, tc.expectedErrorIs)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice, price)
+
+			fallbackEvent := &types.EventFallbackOracleUsed{
+				Pair:         pair.String(),
+				FallbackPair: fallbackPair.String(),
+				Price:        tc.expectedPrice,
+			}
+			if tc.expectFallback {
+				testutilevents.RequireContainsTypedEvent(t, ctx, fallbackEvent)
+			} else {
+				testutilevents.RequireNotHasTypedEvent(t, ctx, fallbackEvent)
+			}
+		})
+	}
+}
+
+func TestGetUnderlyingPriceMaxAge(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	blockTime := time.UnixMilli(1_700_000_000_000)
+
+	testCases := []struct {
+		name            string
+		maxPriceAge     time.Duration
+		snapshotAge     time.Duration
+		expectedErrorIs error
+	}{
+		{
+			name:        "no max price age",
+			snapshotAge: time.Hour,
+		},
+		{
+			name:        "fresh price",
+			maxPriceAge: time.Minute,
+			snapshotAge: 30 * time.Second,
+		},
+		{
+			name:        "price exactly at the max age",
+			maxPriceAge: time.Minute,
+			snapshotAge: time.Minute,
+		},
+		{
+			name:            "stale price",
+			maxPriceAge:     time.Minute,
+			snapshotAge:     2 * time.Minute,
+			expectedErrorIs: types.ErrStalePrice,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			input := CreateTestFixture(t)
+			ctx := input.Ctx.WithBlockHeight(100).WithBlockTime(blockTime)
+
+			input.OracleKeeper.ExchangeRates.Insert(ctx, pair, types.DatedPrice{
+				ExchangeRate: sdk.NewDec(20_000), CreatedBlock: 99,
+			})
+			snapshotTime := blockTime.Add(-tc.snapshotAge)
+			input.OracleKeeper.PriceSnapshots.Insert(ctx, collections.Join(pair, snapshotTime), types.PriceSnapshot{
+				Pair:        pair,
+				Price:       sdk.NewDec(20_000),
+				TimestampMs: snapshotTime.UnixMilli(),
+			})
+			input.OracleKeeper.MaxPriceAge.Set(ctx, uint64(tc.maxPriceAge))
+
+			price, err := input.OracleKeeper.GetUnderlyingPrice(ctx, pair)
+			if tc.expectedErrorIs != nil {
+				require.ErrorIs(t, err, tc.expectedErrorIs)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDec(20_000), price)
+		})
+	}
+}
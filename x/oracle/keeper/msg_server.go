@@ -185,3 +185,29 @@ func (ms msgServer) EditOracleParams(
 	}
 	return resp, err
 }
+
+// SetFallbackPair: gRPC tx msg for registering the fallback price source of a
+// pair. [SUDO] Only callable by sudoers.
+func (ms msgServer) SetFallbackPair(
+	goCtx context.Context, msg *types.MsgSetFallbackPair,
+) (*types.MsgSetFallbackPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Stateless field validation is already performed in msg.ValidateBasic()
+	// before the current scope is reached.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	err := ms.Sudo().SetFallbackPair(ctx, msg.Pair, msg.FallbackPair, sender)
+	return &types.MsgSetFallbackPairResponse{}, err
+}
+
+// SetMaxPriceAge: gRPC tx msg for setting the maximum age of a fresh price.
+// [SUDO] Only callable by sudoers.
+func (ms msgServer) SetMaxPriceAge(
+	goCtx context.Context, msg *types.MsgSetMaxPriceAge,
+) (*types.MsgSetMaxPriceAgeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Stateless field validation is already performed in msg.ValidateBasic()
+	// before the current scope is reached.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	err := ms.Sudo().SetMaxPriceAge(ctx, msg.MaxPriceAge, sender)
+	return &types.MsgSetMaxPriceAgeResponse{}, err
+}
@@ -44,6 +44,51 @@ func (k sudoExtension) EditOracleParams(
 	return paramsAfter, paramsAfter.Validate()
 }
 
+// ------------------------------------------------------------------
+// Admin.SetFallbackPair
+
+// SetFallbackPair registers 'fallbackPair' as the secondary price source used
+// by GetUnderlyingPrice when the price of 'pair' is stale.
+func (k sudoExtension) SetFallbackPair(
+	ctx sdk.Context, pair, fallbackPair asset.Pair, sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+	if err := pair.Validate(); err != nil {
+		return err
+	}
+	if err := fallbackPair.Validate(); err != nil {
+		return err
+	}
+	if pair.Equal(fallbackPair) {
+		return fmt.Errorf("fallback pair must differ from pair %s", pair)
+	}
+
+	k.FallbackPairs.Insert(ctx, pair, fallbackPair)
+	return nil
+}
+
+// ------------------------------------------------------------------
+// Admin.SetMaxPriceAge
+
+// SetMaxPriceAge sets the maximum age of the latest price snapshot of a pair
+// for GetUnderlyingPrice to consider its price fresh. A zero age disables the
+// check.
+func (k sudoExtension) SetMaxPriceAge(
+	ctx sdk.Context, maxPriceAge time.Duration, sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+	if maxPriceAge < 0 {
+		return fmt.Errorf("max price age must not be negative: %s", maxPriceAge)
+	}
+
+	k.MaxPriceAge.Set(ctx, uint64(maxPriceAge))
+	return nil
+}
+
 // MergeOracleParams: Takes the given oracle params and merges them into the
 // existing partial params, keeping any existing values that are not set in the
 // partial.
@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
@@ -92,3 +94,42 @@ func (s *SuiteOracleSudo) TestEditOracleParams() {
 	s.Require().Error(err)
 	s.ErrorContains(err, "oracle parameter SlashWindow must be greater")
 }
+
+func (s *SuiteOracleSudo) TestSetFallbackPair() {
+	nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+	oracleMsgServer := oraclekeeper.NewMsgServerImpl(nibiru.OracleKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	fallbackPair := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	msg := oracletypes.MsgSetFallbackPair{
+		Sender:       testutil.AccAddress().String(),
+		Pair:         pair,
+		FallbackPair: fallbackPair,
+	}
+	_, err := oracleMsgServer.SetFallbackPair(goCtx, &msg)
+	s.Error(err)
+
+	msg.Sender = testapp.DefaultSudoRoot().String()
+	_, err = oracleMsgServer.SetFallbackPair(goCtx, &msg)
+	s.Require().NoError(err)
+	s.Equal(fallbackPair, nibiru.OracleKeeper.FallbackPairs.GetOr(ctx, pair, ""))
+}
+
+func (s *SuiteOracleSudo) TestSetMaxPriceAge() {
+	nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+	oracleMsgServer := oraclekeeper.NewMsgServerImpl(nibiru.OracleKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	msg := oracletypes.MsgSetMaxPriceAge{
+		Sender:      testutil.AccAddress().String(),
+		MaxPriceAge: time.Minute,
+	}
+	_, err := oracleMsgServer.SetMaxPriceAge(goCtx, &msg)
+	s.Error(err)
+
+	msg.Sender = testapp.DefaultSudoRoot().String()
+	_, err = oracleMsgServer.SetMaxPriceAge(goCtx, &msg)
+	s.Require().NoError(err)
+	s.EqualValues(time.Minute, nibiru.OracleKeeper.MaxPriceAge.GetOr(ctx, 0))
+}
@@ -36,4 +36,5 @@ var (
 	ErrNoAggregateVote        = registerError("no aggregate vote")
 	ErrUnknownPair            = registerError("unknown pair")
 	ErrNoValidTWAP            = registerError("TWA price not found")
+	ErrStalePrice             = registerError("price is stale")
 )
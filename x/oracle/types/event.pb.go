@@ -347,50 +347,109 @@ func (m *EventValidatorPerformance) GetMissCount() int64 {
 	return 0
 }
 
+// Emitted when the price of a pair is stale and the price of its fallback pair
+// is used instead
+type EventFallbackOracleUsed struct {
+	Pair         string                                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	FallbackPair string                                 `protobuf:"bytes,2,opt,name=fallback_pair,json=fallbackPair,proto3" json:"fallback_pair,omitempty"`
+	Price        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *EventFallbackOracleUsed) Reset()         { *m = EventFallbackOracleUsed{} }
+func (m *EventFallbackOracleUsed) String() string { return proto.CompactTextString(m) }
+func (*EventFallbackOracleUsed) ProtoMessage()    {}
+func (*EventFallbackOracleUsed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94ec441b793fc0ea, []int{5}
+}
+func (m *EventFallbackOracleUsed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFallbackOracleUsed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFallbackOracleUsed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFallbackOracleUsed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFallbackOracleUsed.Merge(m, src)
+}
+func (m *EventFallbackOracleUsed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFallbackOracleUsed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFallbackOracleUsed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFallbackOracleUsed proto.InternalMessageInfo
+
+func (m *EventFallbackOracleUsed) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *EventFallbackOracleUsed) GetFallbackPair() string {
+	if m != nil {
+		return m.FallbackPair
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventPriceUpdate)(nil), "nibiru.oracle.v1.EventPriceUpdate")
 	proto.RegisterType((*EventDelegateFeederConsent)(nil), "nibiru.oracle.v1.EventDelegateFeederConsent")
 	proto.RegisterType((*EventAggregateVote)(nil), "nibiru.oracle.v1.EventAggregateVote")
 	proto.RegisterType((*EventAggregatePrevote)(nil), "nibiru.oracle.v1.EventAggregatePrevote")
 	proto.RegisterType((*EventValidatorPerformance)(nil), "nibiru.oracle.v1.EventValidatorPerformance")
+	proto.RegisterType((*EventFallbackOracleUsed)(nil), "nibiru.oracle.v1.EventFallbackOracleUsed")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/event.proto", fileDescriptor_94ec441b793fc0ea) }
 
 var fileDescriptor_94ec441b793fc0ea = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x6e, 0xe8, 0x56, 0x51, 0xb7, 0x48, 0x93, 0x05, 0xa8, 0x94, 0x2e, 0xdd, 0x3a, 0x09, 0xf5,
-	0x02, 0x12, 0x0d, 0x9e, 0x80, 0xb6, 0xdb, 0xdd, 0x50, 0x15, 0xc1, 0x26, 0x71, 0x53, 0xb9, 0xc9,
-	0x99, 0x6b, 0x91, 0xd8, 0x91, 0xed, 0x26, 0xe3, 0x29, 0xe0, 0x1d, 0xb8, 0xe3, 0x49, 0x76, 0xb9,
-	0x4b, 0xc4, 0xc5, 0x40, 0xed, 0x8b, 0x20, 0x3b, 0xee, 0xf8, 0xd9, 0x05, 0xd2, 0xae, 0x72, 0xfc,
-	0x7d, 0x9f, 0xbf, 0x7c, 0xc9, 0x39, 0x07, 0xf5, 0x38, 0x9b, 0x33, 0xb9, 0x0c, 0x85, 0x24, 0x71,
-	0x0a, 0x61, 0x71, 0x18, 0x42, 0x01, 0x5c, 0x07, 0xb9, 0x14, 0x5a, 0xe0, 0x9d, 0x8a, 0x0d, 0x2a,
-	0x36, 0x28, 0x0e, 0xbb, 0xbb, 0xb7, 0xf4, 0x8e, 0xb3, 0x17, 0xba, 0x0f, 0xa9, 0xa0, 0xc2, 0x96,
-	0xa1, 0xa9, 0x1c, 0xda, 0xa3, 0x42, 0xd0, 0x14, 0x42, 0x92, 0xb3, 0x90, 0x70, 0x2e, 0x34, 0xd1,
-	0x4c, 0x70, 0x55, 0xb1, 0x83, 0x4f, 0x1e, 0xda, 0x39, 0x32, 0x2f, 0x9d, 0x4a, 0x16, 0xc3, 0xbb,
-	0x3c, 0x21, 0x1a, 0x30, 0x46, 0x5b, 0x39, 0x61, 0xb2, 0xe3, 0xed, 0x79, 0xc3, 0x66, 0x64, 0x6b,
-	0x3c, 0x41, 0xdb, 0xb9, 0x91, 0x74, 0xee, 0x19, 0x70, 0x14, 0x5c, 0x5e, 0xf7, 0x6b, 0xdf, 0xaf,
-	0xfb, 0xcf, 0x28, 0xd3, 0x8b, 0xe5, 0x3c, 0x88, 0x45, 0x16, 0xc6, 0x42, 0x65, 0x42, 0xb9, 0xc7,
-	0x0b, 0x95, 0x7c, 0x08, 0xf5, 0xc7, 0x1c, 0x54, 0x30, 0x81, 0x38, 0xaa, 0x2e, 0xe3, 0x7d, 0xd4,
-	0xd6, 0x2c, 0x03, 0xa5, 0x49, 0x96, 0xcf, 0x32, 0xd5, 0xa9, 0xef, 0x79, 0xc3, 0x7a, 0xd4, 0xba,
-	0xc1, 0x4e, 0xd4, 0x20, 0x42, 0x5d, 0x1b, 0x68, 0x02, 0x29, 0x50, 0xa2, 0xe1, 0x18, 0x20, 0x01,
-	0x39, 0x16, 0x5c, 0x01, 0xd7, 0xb8, 0x87, 0x9a, 0x05, 0x49, 0x59, 0x42, 0xb4, 0xd8, 0xe4, 0xfb,
-	0x0d, 0xe0, 0xc7, 0xa8, 0x71, 0x6e, 0xe5, 0x55, 0xca, 0xc8, 0x9d, 0x06, 0x5f, 0x3c, 0x84, 0xad,
-	0xe9, 0x6b, 0x4a, 0xa5, 0x75, 0x3d, 0x15, 0x1a, 0xee, 0x66, 0x86, 0xcf, 0x50, 0xc3, 0x7e, 0x8c,
-	0x49, 0x5f, 0x1f, 0xb6, 0x5e, 0x1e, 0x04, 0xff, 0x36, 0x2a, 0x38, 0xba, 0x88, 0x17, 0x84, 0x53,
-	0x88, 0x88, 0x86, 0xb7, 0xcb, 0x3c, 0x85, 0x51, 0xd7, 0xfc, 0xaf, 0xaf, 0x3f, 0xfa, 0xf8, 0x16,
-	0xa5, 0x22, 0x67, 0x37, 0x38, 0x41, 0x8f, 0xfe, 0x0e, 0x39, 0x95, 0x50, 0xdc, 0x39, 0xe7, 0x60,
-	0xe5, 0xa1, 0x27, 0xd6, 0xef, 0x74, 0x23, 0x9d, 0x82, 0x3c, 0x17, 0x32, 0x23, 0x3c, 0xfe, 0x9f,
-	0xe7, 0x3e, 0x6a, 0x17, 0x42, 0x33, 0x4e, 0x67, 0xb9, 0x28, 0x9d, 0x73, 0x3d, 0x6a, 0x55, 0xd8,
-	0xd4, 0x40, 0xf8, 0x00, 0x3d, 0x90, 0x50, 0x12, 0x99, 0xcc, 0x4a, 0x60, 0x74, 0xa1, 0x5d, 0x2f,
-	0xdb, 0x15, 0x78, 0x66, 0x31, 0xfc, 0x14, 0x35, 0x4b, 0xc6, 0x67, 0xb1, 0x58, 0x72, 0xdd, 0xd9,
-	0xb2, 0x82, 0xfb, 0x25, 0xe3, 0x63, 0x73, 0x36, 0x0e, 0x64, 0xae, 0x34, 0xb9, 0x11, 0x6c, 0x57,
-	0x0e, 0x0e, 0xac, 0x44, 0xbb, 0x08, 0x65, 0x4c, 0x29, 0xa7, 0x68, 0x58, 0x45, 0xd3, 0x20, 0x96,
-	0x1e, 0x1d, 0x5f, 0xae, 0x7c, 0xef, 0x6a, 0xe5, 0x7b, 0x3f, 0x57, 0xbe, 0xf7, 0x79, 0xed, 0xd7,
-	0xae, 0xd6, 0x7e, 0xed, 0xdb, 0xda, 0xaf, 0xbd, 0x7f, 0xfe, 0xc7, 0x64, 0xbe, 0xb1, 0x0d, 0x1a,
-	0x2f, 0x08, 0xe3, 0xa1, 0xdb, 0xa1, 0x8b, 0xcd, 0x16, 0xd9, 0x19, 0x9d, 0x37, 0xec, 0x3a, 0xbc,
-	0xfa, 0x15, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xd7, 0x9a, 0x89, 0x93, 0x03, 0x00, 0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x6d, 0xe8, 0x56, 0x51, 0xb7, 0x93, 0x26, 0x8b, 0x3f, 0xa5, 0x74, 0xe9, 0x96, 0x4a, 0xa8,
+	0x07, 0x48, 0x34, 0xf8, 0x04, 0xb4, 0x5d, 0x6f, 0x83, 0x2a, 0x62, 0x9b, 0xc4, 0xa5, 0x72, 0x93,
+	0x5f, 0x53, 0x6b, 0x89, 0x1d, 0xd9, 0x6e, 0x3a, 0x3e, 0x05, 0x1c, 0xb9, 0x73, 0xe3, 0x93, 0xec,
+	0xb8, 0x23, 0xe2, 0x30, 0x50, 0xfb, 0x45, 0x50, 0x1c, 0x77, 0x30, 0x86, 0x84, 0xb4, 0x9d, 0x62,
+	0xbf, 0xf7, 0xfc, 0xf2, 0xec, 0xdf, 0xef, 0x87, 0x5a, 0x8c, 0x4e, 0xa8, 0x98, 0x7b, 0x5c, 0x90,
+	0x20, 0x06, 0x2f, 0xdb, 0xf7, 0x20, 0x03, 0xa6, 0xdc, 0x54, 0x70, 0xc5, 0xf1, 0x76, 0xc1, 0xba,
+	0x05, 0xeb, 0x66, 0xfb, 0xcd, 0x9d, 0x1b, 0x7a, 0xc3, 0xe9, 0x03, 0xcd, 0x07, 0x11, 0x8f, 0xb8,
+	0x5e, 0x7a, 0xf9, 0xca, 0xa0, 0xad, 0x88, 0xf3, 0x28, 0x06, 0x8f, 0xa4, 0xd4, 0x23, 0x8c, 0x71,
+	0x45, 0x14, 0xe5, 0x4c, 0x16, 0xac, 0xf3, 0xd1, 0x42, 0xdb, 0x07, 0xf9, 0x4f, 0x47, 0x82, 0x06,
+	0x70, 0x94, 0x86, 0x44, 0x01, 0xc6, 0x68, 0x23, 0x25, 0x54, 0x34, 0xac, 0x5d, 0xab, 0x5b, 0xf5,
+	0xf5, 0x1a, 0x0f, 0xd0, 0x66, 0x9a, 0x4b, 0x1a, 0xf7, 0x72, 0xb0, 0xe7, 0x9e, 0x5f, 0xb6, 0x4b,
+	0xdf, 0x2f, 0xdb, 0xcf, 0x22, 0xaa, 0x66, 0xf3, 0x89, 0x1b, 0xf0, 0xc4, 0x0b, 0xb8, 0x4c, 0xb8,
+	0x34, 0x9f, 0x17, 0x32, 0x3c, 0xf5, 0xd4, 0x87, 0x14, 0xa4, 0x3b, 0x80, 0xc0, 0x2f, 0x0e, 0xe3,
+	0x3d, 0x54, 0x57, 0x34, 0x01, 0xa9, 0x48, 0x92, 0x8e, 0x13, 0xd9, 0x28, 0xef, 0x5a, 0xdd, 0xb2,
+	0x5f, 0xbb, 0xc2, 0x0e, 0xa5, 0xe3, 0xa3, 0xa6, 0x0e, 0x34, 0x80, 0x18, 0x22, 0xa2, 0x60, 0x08,
+	0x10, 0x82, 0xe8, 0x73, 0x26, 0x81, 0x29, 0xdc, 0x42, 0xd5, 0x8c, 0xc4, 0x34, 0x24, 0x8a, 0xaf,
+	0xf3, 0xfd, 0x06, 0xf0, 0x23, 0x54, 0x99, 0x6a, 0x79, 0x91, 0xd2, 0x37, 0x3b, 0xe7, 0x8b, 0x85,
+	0xb0, 0x36, 0x7d, 0x1d, 0x45, 0x42, 0xbb, 0x1e, 0x73, 0x05, 0xb7, 0x33, 0xc3, 0x27, 0xa8, 0xa2,
+	0x2f, 0x93, 0xa7, 0x2f, 0x77, 0x6b, 0x2f, 0x3b, 0xee, 0xdf, 0x85, 0x72, 0x0f, 0xce, 0x82, 0x19,
+	0x61, 0x11, 0xf8, 0x44, 0xc1, 0xbb, 0x79, 0x1a, 0x43, 0xaf, 0x99, 0xbf, 0xd7, 0xd7, 0x1f, 0x6d,
+	0x7c, 0x83, 0x92, 0xbe, 0xb1, 0x73, 0x0e, 0xd1, 0xc3, 0xeb, 0x21, 0x47, 0x02, 0xb2, 0x5b, 0xe7,
+	0x74, 0x96, 0x16, 0x7a, 0xa2, 0xfd, 0x8e, 0xd7, 0xd2, 0x11, 0x88, 0x29, 0x17, 0x09, 0x61, 0xc1,
+	0xff, 0x3c, 0xf7, 0x50, 0x3d, 0xe3, 0x8a, 0xb2, 0x68, 0x9c, 0xf2, 0x85, 0x71, 0x2e, 0xfb, 0xb5,
+	0x02, 0x1b, 0xe5, 0x10, 0xee, 0xa0, 0x2d, 0x01, 0x0b, 0x22, 0xc2, 0xf1, 0x02, 0x68, 0x34, 0x53,
+	0xa6, 0x96, 0xf5, 0x02, 0x3c, 0xd1, 0x18, 0x7e, 0x8a, 0xaa, 0x0b, 0xca, 0xc6, 0x01, 0x9f, 0x33,
+	0xd5, 0xd8, 0xd0, 0x82, 0xfb, 0x0b, 0xca, 0xfa, 0xf9, 0x3e, 0x77, 0x20, 0x13, 0xa9, 0xc8, 0x95,
+	0x60, 0xb3, 0x70, 0x30, 0x60, 0x21, 0xda, 0x41, 0x28, 0xa1, 0x52, 0x1a, 0x45, 0x45, 0x2b, 0xaa,
+	0x39, 0xa2, 0x69, 0xe7, 0xb3, 0x85, 0x1e, 0xeb, 0x4b, 0x0e, 0x49, 0x1c, 0x4f, 0x48, 0x70, 0xfa,
+	0x56, 0x17, 0xe1, 0x48, 0x42, 0xf8, 0xcf, 0x36, 0xee, 0xa0, 0xad, 0xa9, 0x51, 0x8e, 0x35, 0x59,
+	0xbc, 0x59, 0x7d, 0x0d, 0x8e, 0xae, 0xf5, 0x7a, 0xf9, 0x0e, 0xbd, 0xde, 0x1b, 0x9e, 0x2f, 0x6d,
+	0xeb, 0x62, 0x69, 0x5b, 0x3f, 0x97, 0xb6, 0xf5, 0x69, 0x65, 0x97, 0x2e, 0x56, 0x76, 0xe9, 0xdb,
+	0xca, 0x2e, 0xbd, 0x7f, 0xfe, 0x87, 0xd1, 0x1b, 0xdd, 0x3b, 0xfd, 0x19, 0xa1, 0xcc, 0x33, 0xe3,
+	0x7d, 0xb6, 0x1e, 0x70, 0x6d, 0x39, 0xa9, 0xe8, 0x49, 0x7d, 0xf5, 0x6b, 0x00, 0xae, 0xf3, 0x95,
+	0xaa, 0x2e, 0x04, 0x00, 0x00,
 }
 
 func (m *EventPriceUpdate) Marshal() (dAtA []byte, err error) {
@@ -618,6 +677,53 @@ func (m *EventValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFallbackOracleUsed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFallbackOracleUsed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFallbackOracleUsed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FallbackPair) > 0 {
+		i -= len(m.FallbackPair)
+		copy(dAtA[i:], m.FallbackPair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FallbackPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -732,6 +838,25 @@ func (m *EventValidatorPerformance) Size() (n int) {
 	return n
 }
 
+func (m *EventFallbackOracleUsed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FallbackPair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1426,6 +1551,154 @@ func (m *EventValidatorPerformance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFallbackOracleUsed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFallbackOracleUsed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFallbackOracleUsed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
@@ -31,7 +32,7 @@ func NewGenesisState(
 
 // DefaultGenesisState - default GenesisState
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(
+	genState := NewGenesisState(
 		DefaultParams(),
 		[]ExchangeRateTuple{},
 		[]FeederDelegation{},
@@ -40,10 +41,29 @@ func DefaultGenesisState() *GenesisState {
 		[]AggregateExchangeRateVote{},
 		[]asset.Pair{},
 		[]Rewards{})
+	genState.FallbackPairs = []FallbackPair{}
+	return genState
 }
 
 // ValidateGenesis validates the oracle genesis state
 func ValidateGenesis(data *GenesisState) error {
+	seenPairs := make(map[asset.Pair]bool)
+	for _, fp := range data.FallbackPairs {
+		if err := fp.Pair.Validate(); err != nil {
+			return err
+		}
+		if err := fp.FallbackPair.Validate(); err != nil {
+			return err
+		}
+		if fp.Pair.Equal(fp.FallbackPair) {
+			return fmt.Errorf("fallback pair must differ from pair %s", fp.Pair)
+		}
+		if seenPairs[fp.Pair] {
+			return fmt.Errorf("duplicate fallback pair for pair %s", fp.Pair)
+		}
+		seenPairs[fp.Pair] = true
+	}
+
 	return data.Params.Validate()
 }
 
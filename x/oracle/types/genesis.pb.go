@@ -35,6 +35,11 @@ type GenesisState struct {
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote                         `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	Pairs                         []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,7,rep,name=pairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pairs"`
 	Rewards                       []Rewards                                           `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards"`
+	FallbackPairs                 []FallbackPair                                      `protobuf:"bytes,9,rep,name=fallback_pairs,json=fallbackPairs,proto3" json:"fallback_pairs"`
+	// max_price_age is the maximum age in nanoseconds of the latest price
+	// snapshot of a pair for its exchange rate to be fresh. Zero disables the
+	// check.
+	MaxPriceAge uint64 `protobuf:"varint,10,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +124,20 @@ func (m *GenesisState) GetRewards() []Rewards {
 	return nil
 }
 
+func (m *GenesisState) GetFallbackPairs() []FallbackPair {
+	if m != nil {
+		return m.FallbackPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -228,51 +247,98 @@ func (m *MissCounter) GetMissCounter() uint64 {
 	return 0
 }
 
+// FallbackPair maps a pair to the secondary pair whose exchange rate is used
+// when the price of the primary pair is stale, used in oracle module's genesis
+// state
+type FallbackPair struct {
+	Pair         github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	FallbackPair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=fallback_pair,json=fallbackPair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"fallback_pair"`
+}
+
+func (m *FallbackPair) Reset()         { *m = FallbackPair{} }
+func (m *FallbackPair) String() string { return proto.CompactTextString(m) }
+func (*FallbackPair) ProtoMessage()    {}
+func (*FallbackPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d88ebb2fa2659942, []int{3}
+}
+func (m *FallbackPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FallbackPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FallbackPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FallbackPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FallbackPair.Merge(m, src)
+}
+func (m *FallbackPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *FallbackPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_FallbackPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FallbackPair proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.oracle.v1.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "nibiru.oracle.v1.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "nibiru.oracle.v1.MissCounter")
+	proto.RegisterType((*FallbackPair)(nil), "nibiru.oracle.v1.FallbackPair")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/genesis.proto", fileDescriptor_d88ebb2fa2659942) }
 
 var fileDescriptor_d88ebb2fa2659942 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6b, 0x13, 0x4d,
-	0x14, 0xc6, 0xb3, 0xfd, 0xfb, 0x76, 0xd2, 0x94, 0x74, 0x78, 0x2f, 0xd6, 0x40, 0x36, 0x31, 0x22,
-	0x04, 0x2a, 0xbb, 0xa4, 0x82, 0xd0, 0xcb, 0xa6, 0x5a, 0xbd, 0x51, 0xc3, 0x2a, 0x0a, 0x82, 0x84,
-	0xc9, 0xee, 0xc9, 0x66, 0x20, 0xbb, 0xb3, 0xcc, 0x99, 0xc4, 0x7a, 0xe1, 0x77, 0xf0, 0x73, 0xf8,
-	0x49, 0x7a, 0x59, 0xbc, 0x12, 0x2f, 0xaa, 0x24, 0x5f, 0x44, 0x76, 0x66, 0xdb, 0xc4, 0x6c, 0xab,
-	0xde, 0x85, 0xf3, 0xfc, 0xce, 0xf3, 0x9c, 0x09, 0x4f, 0x42, 0x9c, 0x84, 0x0f, 0xb8, 0x9c, 0x78,
-	0x42, 0xb2, 0x60, 0x0c, 0xde, 0xb4, 0xe3, 0x45, 0x90, 0x00, 0x72, 0x74, 0x53, 0x29, 0x94, 0xa0,
-	0x55, 0xa3, 0xbb, 0x46, 0x77, 0xa7, 0x9d, 0xda, 0xff, 0x91, 0x88, 0x84, 0x16, 0xbd, 0xec, 0x93,
-	0xe1, 0x6a, 0xf5, 0x82, 0x4f, 0xbe, 0x61, 0x64, 0x27, 0x10, 0x18, 0x0b, 0xf4, 0x06, 0x0c, 0x33,
-	0x71, 0x00, 0x8a, 0x75, 0xbc, 0x40, 0xf0, 0xc4, 0xe8, 0xad, 0xaf, 0x9b, 0x64, 0xf7, 0xa9, 0x09,
-	0x7e, 0xa5, 0x98, 0x02, 0xfa, 0x88, 0x6c, 0xa5, 0x4c, 0xb2, 0x18, 0x6d, 0xab, 0x69, 0xb5, 0xcb,
-	0x87, 0xb6, 0xbb, 0x7a, 0x88, 0xdb, 0xd3, 0x7a, 0x77, 0xe3, 0xfc, 0xb2, 0x51, 0xf2, 0x73, 0x9a,
-	0xbe, 0x25, 0x74, 0x08, 0x10, 0x82, 0xec, 0x87, 0x30, 0x86, 0x88, 0x29, 0x2e, 0x12, 0xb4, 0xd7,
-	0x9a, 0xeb, 0xed, 0xf2, 0x61, 0xab, 0xe8, 0x71, 0xaa, 0xd9, 0xc7, 0xd7, 0x68, 0xee, 0xb6, 0x3f,
-	0x5c, 0x99, 0x23, 0x1d, 0x92, 0x3d, 0x38, 0x0b, 0x46, 0x2c, 0x89, 0xa0, 0x2f, 0x99, 0x02, 0xb4,
-	0xd7, 0xb5, 0xe9, 0xbd, 0xa2, 0xe9, 0x93, 0x9c, 0xf3, 0x99, 0x82, 0xd7, 0x93, 0x74, 0x0c, 0xdd,
-	0x5a, 0xe6, 0xfa, 0xe5, 0x47, 0x83, 0x16, 0x24, 0xf4, 0x2b, 0xb0, 0x34, 0x43, 0xfa, 0x8c, 0x54,
-	0x62, 0x8e, 0xd8, 0x0f, 0xc4, 0x24, 0x51, 0x20, 0xd1, 0xde, 0xd0, 0x31, 0xf5, 0x62, 0xcc, 0x73,
-	0x8e, 0x78, 0x62, 0xa8, 0xfc, 0xec, 0xdd, 0x78, 0x31, 0x42, 0xfa, 0x89, 0x34, 0x59, 0x14, 0xc9,
-	0xec, 0x05, 0xd0, 0xff, 0xed, 0xf6, 0x7e, 0x2a, 0x61, 0x2a, 0xb2, 0x37, 0x6c, 0x6a, 0x73, 0xb7,
-	0x68, 0x7e, 0x7c, 0xb5, 0xb9, 0x7c, 0x71, 0xcf, 0xac, 0xe5, 0x69, 0x75, 0xf6, 0x07, 0x06, 0xa9,
-	0x22, 0xf5, 0xdb, 0xe2, 0x4d, 0xf6, 0x96, 0xce, 0x3e, 0xf8, 0xc7, 0xec, 0x37, 0x8b, 0xe0, 0x1a,
-	0xbb, 0x0d, 0x40, 0xfa, 0x92, 0x6c, 0xa6, 0x8c, 0x4b, 0xb4, 0xb7, 0x9b, 0xeb, 0xed, 0x9d, 0xee,
-	0x51, 0xb6, 0xf0, 0xfd, 0xb2, 0xd1, 0x89, 0xb8, 0x1a, 0x4d, 0x06, 0x6e, 0x20, 0x62, 0xef, 0x85,
-	0xce, 0x3b, 0x19, 0x31, 0x9e, 0x78, 0x79, 0x6b, 0xcf, 0xbc, 0x40, 0xc4, 0xb1, 0x48, 0x3c, 0x86,
-	0x08, 0xca, 0xed, 0x31, 0x2e, 0x7d, 0xe3, 0x43, 0x8f, 0xc8, 0xb6, 0x84, 0x0f, 0x4c, 0x86, 0x68,
-	0xff, 0xa7, 0x0f, 0xbe, 0x53, 0x3c, 0xd8, 0x37, 0x40, 0x7e, 0xde, 0x15, 0xdf, 0x1a, 0x92, 0xea,
-	0x6a, 0xbf, 0xe8, 0x7d, 0xb2, 0x97, 0xf7, 0x93, 0x85, 0xa1, 0x04, 0x34, 0xfd, 0xde, 0xf1, 0x2b,
-	0x66, 0x7a, 0x6c, 0x86, 0xf4, 0x80, 0xec, 0x4f, 0xd9, 0x98, 0x87, 0x4c, 0x89, 0x05, 0xb9, 0xa6,
-	0xc9, 0xea, 0xb5, 0x90, 0xc3, 0xad, 0xf7, 0xa4, 0xbc, 0xd4, 0x85, 0x9b, 0x77, 0xad, 0x9b, 0x77,
-	0xe9, 0x5d, 0xb2, 0xbb, 0x5c, 0x37, 0x9d, 0xb1, 0xe1, 0x97, 0x97, 0x8a, 0xd4, 0x3d, 0x3d, 0x9f,
-	0x39, 0xd6, 0xc5, 0xcc, 0xb1, 0x7e, 0xce, 0x1c, 0xeb, 0xf3, 0xdc, 0x29, 0x5d, 0xcc, 0x9d, 0xd2,
-	0xb7, 0xb9, 0x53, 0x7a, 0xf7, 0xe0, 0x6f, 0xdf, 0x6a, 0xfe, 0x6f, 0xa0, 0x3e, 0xa6, 0x80, 0x83,
-	0x2d, 0xfd, 0x53, 0x7f, 0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x56, 0x94, 0xe7, 0x7b, 0x73, 0x04,
-	0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0x86, 0xe3, 0x34, 0x4d, 0x6f, 0x27, 0x49, 0xd5, 0x8e, 0xee, 0xc2, 0x37, 0x52, 0xdc, 0xdc,
+	0x5c, 0x5d, 0x29, 0x52, 0x91, 0xad, 0x14, 0x09, 0xa9, 0xcb, 0xa6, 0x50, 0x90, 0x50, 0x21, 0x32,
+	0x08, 0x24, 0x24, 0xb0, 0x26, 0xce, 0x89, 0x6b, 0x11, 0x7b, 0xac, 0x39, 0x4e, 0x08, 0x0b, 0xde,
+	0x81, 0xe7, 0xe0, 0x1d, 0x58, 0xb1, 0xe9, 0xb2, 0x4b, 0xc4, 0xa2, 0xa0, 0xf6, 0x45, 0x90, 0x67,
+	0xa6, 0x8d, 0x5b, 0xb7, 0x80, 0xd4, 0x9d, 0x75, 0xfe, 0xff, 0x7c, 0xff, 0x19, 0xcf, 0xb1, 0x89,
+	0x15, 0x87, 0xc3, 0x50, 0x4c, 0x1d, 0x2e, 0x98, 0x3f, 0x01, 0x67, 0xd6, 0x73, 0x02, 0x88, 0x01,
+	0x43, 0xb4, 0x13, 0xc1, 0x53, 0x4e, 0xd7, 0x95, 0x6e, 0x2b, 0xdd, 0x9e, 0xf5, 0x9a, 0x7f, 0x07,
+	0x3c, 0xe0, 0x52, 0x74, 0xb2, 0x27, 0xe5, 0x6b, 0xb6, 0x0a, 0x1c, 0xdd, 0xa1, 0x64, 0xcb, 0xe7,
+	0x18, 0x71, 0x74, 0x86, 0x0c, 0x33, 0x71, 0x08, 0x29, 0xeb, 0x39, 0x3e, 0x0f, 0x63, 0xa5, 0x77,
+	0xbe, 0x54, 0x49, 0xfd, 0xa1, 0x0a, 0x7e, 0x96, 0xb2, 0x14, 0xe8, 0x3d, 0x52, 0x4d, 0x98, 0x60,
+	0x11, 0x9a, 0x46, 0xdb, 0xe8, 0xd6, 0xb6, 0x4d, 0xfb, 0xea, 0x20, 0xf6, 0x40, 0xea, 0xfd, 0xca,
+	0xd1, 0xc9, 0x66, 0xc9, 0xd5, 0x6e, 0xfa, 0x92, 0xd0, 0x31, 0xc0, 0x08, 0x84, 0x37, 0x82, 0x09,
+	0x04, 0x2c, 0x0d, 0x79, 0x8c, 0x66, 0xb9, 0xbd, 0xd4, 0xad, 0x6d, 0x77, 0x8a, 0x8c, 0x7d, 0xe9,
+	0xbd, 0x7f, 0x61, 0xd5, 0xb4, 0x8d, 0xf1, 0x95, 0x3a, 0xd2, 0x31, 0x59, 0x83, 0xb9, 0x7f, 0xc8,
+	0xe2, 0x00, 0x3c, 0xc1, 0x52, 0x40, 0x73, 0x49, 0x42, 0xff, 0x2b, 0x42, 0x1f, 0x68, 0x9f, 0xcb,
+	0x52, 0x78, 0x3e, 0x4d, 0x26, 0xd0, 0x6f, 0x66, 0xd4, 0x4f, 0xdf, 0x37, 0x69, 0x41, 0x42, 0xb7,
+	0x01, 0xb9, 0x1a, 0xd2, 0x47, 0xa4, 0x11, 0x85, 0x88, 0x9e, 0xcf, 0xa7, 0x71, 0x0a, 0x02, 0xcd,
+	0x8a, 0x8c, 0x69, 0x15, 0x63, 0x0e, 0x42, 0xc4, 0x3d, 0xe5, 0xd2, 0x63, 0xd7, 0xa3, 0x45, 0x09,
+	0xe9, 0x07, 0xd2, 0x66, 0x41, 0x20, 0xb2, 0x13, 0x80, 0x77, 0x69, 0x76, 0x2f, 0x11, 0x30, 0xe3,
+	0xd9, 0x19, 0x96, 0x25, 0xdc, 0x2e, 0xc2, 0x77, 0xcf, 0x3b, 0xf3, 0x13, 0x0f, 0x54, 0x9b, 0x4e,
+	0x6b, 0xb1, 0x5f, 0x78, 0x90, 0xa6, 0xa4, 0x75, 0x53, 0xbc, 0xca, 0xae, 0xca, 0xec, 0xad, 0x3f,
+	0xcc, 0x7e, 0xb1, 0x08, 0x6e, 0xb2, 0x9b, 0x0c, 0x48, 0x9f, 0x92, 0xe5, 0x84, 0x85, 0x02, 0xcd,
+	0x95, 0xf6, 0x52, 0x77, 0xb5, 0xbf, 0x93, 0x35, 0x7c, 0x3b, 0xd9, 0xec, 0x05, 0x61, 0x7a, 0x38,
+	0x1d, 0xda, 0x3e, 0x8f, 0x9c, 0x27, 0x32, 0x6f, 0xef, 0x90, 0x85, 0xb1, 0xa3, 0xb7, 0x76, 0xee,
+	0xf8, 0x3c, 0x8a, 0x78, 0xec, 0x30, 0x44, 0x48, 0xed, 0x01, 0x0b, 0x85, 0xab, 0x38, 0x74, 0x87,
+	0xac, 0x08, 0x78, 0xc7, 0xc4, 0x08, 0xcd, 0xbf, 0xe4, 0xc0, 0xff, 0x14, 0x07, 0x76, 0x95, 0x41,
+	0x8f, 0x77, 0xee, 0xa7, 0x8f, 0xc9, 0xda, 0x98, 0x4d, 0x26, 0x43, 0xe6, 0xbf, 0xf5, 0xd4, 0x50,
+	0xab, 0x92, 0x60, 0x5d, 0xb3, 0x87, 0xda, 0x97, 0x25, 0x6b, 0x4c, 0x63, 0x9c, 0xab, 0x21, 0xed,
+	0x90, 0x46, 0xc4, 0xe6, 0x5e, 0x22, 0x42, 0x1f, 0x3c, 0x16, 0x80, 0x49, 0xda, 0x46, 0xb7, 0xe2,
+	0xd6, 0x22, 0x36, 0x1f, 0x64, 0xb5, 0xdd, 0x00, 0x3a, 0x63, 0xb2, 0x7e, 0x75, 0xa1, 0xe9, 0xff,
+	0x64, 0x4d, 0x7f, 0x10, 0x6c, 0x34, 0x12, 0x80, 0xea, 0x83, 0x5a, 0x75, 0x1b, 0xaa, 0xba, 0xab,
+	0x8a, 0x74, 0x8b, 0x6c, 0xcc, 0xd8, 0x24, 0x1c, 0xb1, 0x94, 0x2f, 0x9c, 0x65, 0xe9, 0x5c, 0xbf,
+	0x10, 0xb4, 0xb9, 0xf3, 0x9a, 0xd4, 0x72, 0xcb, 0x77, 0x7d, 0xaf, 0x71, 0x7d, 0x2f, 0xfd, 0x97,
+	0xd4, 0xf3, 0xfb, 0x6d, 0x96, 0xf5, 0x31, 0x16, 0xbc, 0xce, 0x67, 0x83, 0xd4, 0xf3, 0x2f, 0x84,
+	0x1e, 0x90, 0x4a, 0xf6, 0xfe, 0x14, 0xf3, 0x36, 0x77, 0x2a, 0x31, 0xf4, 0x0d, 0x69, 0x5c, 0xba,
+	0x17, 0xb3, 0x7c, 0x5b, 0x6e, 0x3d, 0x7f, 0x57, 0xfd, 0xfd, 0xa3, 0x53, 0xcb, 0x38, 0x3e, 0xb5,
+	0x8c, 0x1f, 0xa7, 0x96, 0xf1, 0xf1, 0xcc, 0x2a, 0x1d, 0x9f, 0x59, 0xa5, 0xaf, 0x67, 0x56, 0xe9,
+	0xd5, 0x9d, 0xdf, 0xa1, 0xf5, 0xef, 0x33, 0x7d, 0x9f, 0x00, 0x0e, 0xab, 0xf2, 0xdf, 0x78, 0xf7,
+	0xe7, 0x00, 0xf1, 0x8f, 0xe0, 0x6c, 0xa4, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -295,6 +361,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FallbackPairs) > 0 {
+		for iNdEx := len(m.FallbackPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FallbackPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Rewards) > 0 {
 		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -478,6 +563,49 @@ func (m *MissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FallbackPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FallbackPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FallbackPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FallbackPair.Size()
+		i -= size
+		if _, err := m.FallbackPair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -539,6 +667,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FallbackPairs) > 0 {
+		for _, e := range m.FallbackPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPriceAge))
+	}
 	return n
 }
 
@@ -575,6 +712,19 @@ func (m *MissCounter) Size() (n int) {
 	return n
 }
 
+func (m *FallbackPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FallbackPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -883,6 +1033,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackPairs = append(m.FallbackPairs, FallbackPair{})
+			if err := m.FallbackPairs[len(m.FallbackPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1119,6 +1322,124 @@ func (m *MissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FallbackPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FallbackPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FallbackPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FallbackPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
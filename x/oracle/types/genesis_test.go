@@ -18,6 +18,53 @@ func TestGenesisValidation(t *testing.T) {
 	require.Error(t, types.ValidateGenesis(genState))
 }
 
+func TestGenesisValidationFallbackPairs(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		fallbackPairs []types.FallbackPair
+		wantErr       bool
+	}{
+		{
+			name:          "valid",
+			fallbackPairs: []types.FallbackPair{{Pair: "ubtc:uusd", FallbackPair: "ubtc:uusdc"}},
+		},
+		{
+			name:          "invalid pair",
+			fallbackPairs: []types.FallbackPair{{Pair: "ubtc", FallbackPair: "ubtc:uusdc"}},
+			wantErr:       true,
+		},
+		{
+			name:          "invalid fallback pair",
+			fallbackPairs: []types.FallbackPair{{Pair: "ubtc:uusd", FallbackPair: "ubtc"}},
+			wantErr:       true,
+		},
+		{
+			name:          "fallback to itself",
+			fallbackPairs: []types.FallbackPair{{Pair: "ubtc:uusd", FallbackPair: "ubtc:uusd"}},
+			wantErr:       true,
+		},
+		{
+			name: "duplicate pair",
+			fallbackPairs: []types.FallbackPair{
+				{Pair: "ubtc:uusd", FallbackPair: "ubtc:uusdc"},
+				{Pair: "ubtc:uusd", FallbackPair: "ubtc:uusdt"},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.FallbackPairs = tc.fallbackPairs
+			err := types.ValidateGenesis(genState)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestGetGenesisStateFromAppState(t *testing.T) {
 	cdc := app.MakeEncodingConfig().Marshaler
 	appState := make(map[string]json.RawMessage)
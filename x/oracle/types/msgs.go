@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -14,6 +16,8 @@ var (
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
 	_ sdk.Msg = &MsgEditOracleParams{}
+	_ sdk.Msg = &MsgSetFallbackPair{}
+	_ sdk.Msg = &MsgSetMaxPriceAge{}
 )
 
 // oracle message types
@@ -22,6 +26,8 @@ const (
 	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
 	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
 	TypeMsgEditOracleParams             = "edit_oracle_params"
+	TypeMsgSetFallbackPair              = "set_fallback_pair"
+	TypeMsgSetMaxPriceAge               = "set_max_price_age"
 )
 
 //-------------------------------------------------
@@ -220,3 +226,63 @@ func (m MsgEditOracleParams) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// ------------------------ MsgSetFallbackPair ------------------------
+
+func (m MsgSetFallbackPair) Route() string { return RouterKey }
+func (m MsgSetFallbackPair) Type() string  { return TypeMsgSetFallbackPair }
+
+func (m MsgSetFallbackPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if err := m.FallbackPair.Validate(); err != nil {
+		return err
+	}
+	if m.Pair.Equal(m.FallbackPair) {
+		return fmt.Errorf("fallback pair must differ from pair %s", m.Pair)
+	}
+	return nil
+}
+
+func (m MsgSetFallbackPair) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetFallbackPair) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ------------------------ MsgSetMaxPriceAge ------------------------
+
+func (m MsgSetMaxPriceAge) Route() string { return RouterKey }
+func (m MsgSetMaxPriceAge) Type() string  { return TypeMsgSetMaxPriceAge }
+
+func (m MsgSetMaxPriceAge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	if m.MaxPriceAge < 0 {
+		return fmt.Errorf("max price age must not be negative: %s", m.MaxPriceAge)
+	}
+	return nil
+}
+
+func (m MsgSetMaxPriceAge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMaxPriceAge) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
@@ -6,23 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -391,6 +396,184 @@ func (m *MsgEditOracleParamsResponse) GetNewParams() *Params {
 	return nil
 }
 
+// MsgSetFallbackPair: gRPC tx message for registering the secondary pair whose
+// exchange rate GetUnderlyingPrice uses when the price of pair is stale.
+// [SUDO] Only callable by sudoers.
+type MsgSetFallbackPair struct {
+	Sender       string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair         github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	FallbackPair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,opt,name=fallback_pair,json=fallbackPair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"fallback_pair"`
+}
+
+func (m *MsgSetFallbackPair) Reset()         { *m = MsgSetFallbackPair{} }
+func (m *MsgSetFallbackPair) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFallbackPair) ProtoMessage()    {}
+func (*MsgSetFallbackPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{8}
+}
+func (m *MsgSetFallbackPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFallbackPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFallbackPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFallbackPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFallbackPair.Merge(m, src)
+}
+func (m *MsgSetFallbackPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFallbackPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFallbackPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFallbackPair proto.InternalMessageInfo
+
+func (m *MsgSetFallbackPair) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgSetFallbackPairResponse defines the Msg/SetFallbackPair response type.
+type MsgSetFallbackPairResponse struct {
+}
+
+func (m *MsgSetFallbackPairResponse) Reset()         { *m = MsgSetFallbackPairResponse{} }
+func (m *MsgSetFallbackPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFallbackPairResponse) ProtoMessage()    {}
+func (*MsgSetFallbackPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{9}
+}
+func (m *MsgSetFallbackPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFallbackPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFallbackPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFallbackPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFallbackPairResponse.Merge(m, src)
+}
+func (m *MsgSetFallbackPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFallbackPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFallbackPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFallbackPairResponse proto.InternalMessageInfo
+
+// MsgSetMaxPriceAge: gRPC tx message for setting the maximum age of the latest
+// price of a pair for GetUnderlyingPrice to consider it fresh. A zero age
+// disables the check. [SUDO] Only callable by sudoers.
+type MsgSetMaxPriceAge struct {
+	Sender      string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MaxPriceAge time.Duration `protobuf:"bytes,2,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age"`
+}
+
+func (m *MsgSetMaxPriceAge) Reset()         { *m = MsgSetMaxPriceAge{} }
+func (m *MsgSetMaxPriceAge) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxPriceAge) ProtoMessage()    {}
+func (*MsgSetMaxPriceAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{10}
+}
+func (m *MsgSetMaxPriceAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxPriceAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxPriceAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxPriceAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxPriceAge.Merge(m, src)
+}
+func (m *MsgSetMaxPriceAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxPriceAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxPriceAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxPriceAge proto.InternalMessageInfo
+
+func (m *MsgSetMaxPriceAge) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetMaxPriceAge) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+// MsgSetMaxPriceAgeResponse defines the Msg/SetMaxPriceAge response type.
+type MsgSetMaxPriceAgeResponse struct {
+}
+
+func (m *MsgSetMaxPriceAgeResponse) Reset()         { *m = MsgSetMaxPriceAgeResponse{} }
+func (m *MsgSetMaxPriceAgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxPriceAgeResponse) ProtoMessage()    {}
+func (*MsgSetMaxPriceAgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{11}
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxPriceAgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxPriceAgeResponse.Merge(m, src)
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxPriceAgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxPriceAgeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevoteResponse")
@@ -400,70 +583,87 @@ func init() {
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "nibiru.oracle.v1.MsgDelegateFeedConsentResponse")
 	proto.RegisterType((*MsgEditOracleParams)(nil), "nibiru.oracle.v1.MsgEditOracleParams")
 	proto.RegisterType((*MsgEditOracleParamsResponse)(nil), "nibiru.oracle.v1.MsgEditOracleParamsResponse")
+	proto.RegisterType((*MsgSetFallbackPair)(nil), "nibiru.oracle.v1.MsgSetFallbackPair")
+	proto.RegisterType((*MsgSetFallbackPairResponse)(nil), "nibiru.oracle.v1.MsgSetFallbackPairResponse")
+	proto.RegisterType((*MsgSetMaxPriceAge)(nil), "nibiru.oracle.v1.MsgSetMaxPriceAge")
+	proto.RegisterType((*MsgSetMaxPriceAgeResponse)(nil), "nibiru.oracle.v1.MsgSetMaxPriceAgeResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/tx.proto", fileDescriptor_11e362c65eb610f4) }
 
 var fileDescriptor_11e362c65eb610f4 = []byte{
-	// 915 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xd7, 0x4d, 0xba, 0x64, 0x67, 0x49, 0x9b, 0x7a, 0xd3, 0xc8, 0xd9, 0x06, 0x3b, 0xb8,
-	0x10, 0x12, 0x89, 0xb5, 0x49, 0x90, 0x40, 0xf4, 0x04, 0x69, 0x1b, 0x09, 0x89, 0xa5, 0xc1, 0x82,
-	0x20, 0x71, 0xc0, 0xcc, 0xae, 0x5f, 0x6c, 0x2b, 0x5e, 0x8f, 0x35, 0x33, 0xcd, 0xa6, 0x57, 0xc4,
-	0x01, 0x6e, 0x48, 0x3d, 0x71, 0xcb, 0x07, 0x40, 0xe2, 0x6b, 0xf4, 0x58, 0x89, 0x0b, 0xe2, 0xb0,
-	0x42, 0x09, 0x42, 0x9c, 0x38, 0xec, 0x27, 0x40, 0x33, 0x1e, 0xbb, 0xdb, 0xcd, 0xb6, 0xcd, 0xee,
-	0x29, 0xce, 0xbc, 0xff, 0xfc, 0xde, 0xff, 0x3d, 0x7b, 0xde, 0x2c, 0x5a, 0x4d, 0xe3, 0x4e, 0x4c,
-	0x1f, 0xba, 0x84, 0xe2, 0x6e, 0x02, 0xee, 0xf1, 0xb6, 0xcb, 0x4f, 0x9c, 0x8c, 0x12, 0x4e, 0xf4,
-	0xa5, 0x3c, 0xe4, 0xe4, 0x21, 0xe7, 0x78, 0xbb, 0xb9, 0x1c, 0x92, 0x90, 0xc8, 0xa0, 0x2b, 0x9e,
-	0x72, 0x5d, 0x73, 0x2d, 0x24, 0x24, 0x4c, 0xc0, 0xc5, 0x59, 0xec, 0xe2, 0x34, 0x25, 0x1c, 0xf3,
-	0x98, 0xa4, 0x4c, 0x45, 0xdf, 0xb8, 0x90, 0x40, 0xf1, 0x64, 0xd8, 0xfe, 0x4d, 0x43, 0x56, 0x9b,
-	0x85, 0x9f, 0x84, 0x21, 0x85, 0x10, 0x73, 0xb8, 0x7f, 0xd2, 0x8d, 0x70, 0x1a, 0x82, 0x87, 0x39,
-	0xec, 0x53, 0x38, 0x26, 0x1c, 0xf4, 0xdb, 0x68, 0x3e, 0xc2, 0x2c, 0x32, 0xb4, 0x75, 0x6d, 0xb3,
-	0xb6, 0x7b, 0x7d, 0x38, 0xb0, 0xea, 0x8f, 0x70, 0x2f, 0xb9, 0x63, 0x8b, 0x55, 0xdb, 0x93, 0x41,
-	0x7d, 0x0b, 0x55, 0x0f, 0x01, 0x02, 0xa0, 0xc6, 0x15, 0x29, 0xbb, 0x31, 0x1c, 0x58, 0x8b, 0xb9,
-	0x2c, 0x5f, 0xb7, 0x3d, 0x25, 0xd0, 0x77, 0x50, 0xed, 0x18, 0x27, 0x71, 0x80, 0x39, 0xa1, 0xc6,
-	0x9c, 0x54, 0x2f, 0x0f, 0x07, 0xd6, 0x52, 0xae, 0x2e, 0x43, 0xb6, 0xf7, 0x4c, 0x76, 0x67, 0xe1,
-	0xc7, 0x53, 0xab, 0xf2, 0xef, 0xa9, 0x55, 0xb1, 0xb7, 0xd0, 0x3b, 0xaf, 0x30, 0xec, 0x01, 0xcb,
-	0x48, 0xca, 0xc0, 0xfe, 0x4f, 0x43, 0x6b, 0x2f, 0xd2, 0x1e, 0xa8, 0xca, 0x18, 0x4e, 0xf8, 0xc5,
-	0xca, 0xc4, 0xaa, 0xed, 0xc9, 0xa0, 0xfe, 0x31, 0xba, 0x06, 0x6a, 0xa3, 0x4f, 0x31, 0x07, 0xa6,
-	0x2a, 0x5c, 0x1d, 0x0e, 0xac, 0x9b, 0xb9, 0xfc, 0xf9, 0xb8, 0xed, 0x2d, 0xc2, 0x48, 0x26, 0x36,
-	0xd2, 0x9b, 0xb9, 0xa9, 0x7a, 0x33, 0x3f, 0x6d, 0x6f, 0x36, 0xd0, 0x5b, 0x2f, 0xab, 0xb7, 0x6c,
-	0xcc, 0x0f, 0x1a, 0x5a, 0x69, 0xb3, 0xf0, 0x1e, 0x24, 0x52, 0xb7, 0x07, 0x10, 0xdc, 0x15, 0x81,
-	0x94, 0xeb, 0x2e, 0x5a, 0x20, 0x19, 0x50, 0x99, 0x3f, 0x6f, 0x4b, 0x63, 0x38, 0xb0, 0xae, 0xe7,
-	0xf9, 0x8b, 0x88, 0xed, 0x95, 0x22, 0xb1, 0x21, 0x50, 0x1c, 0xd5, 0x98, 0x91, 0x0d, 0x45, 0xc4,
-	0xf6, 0x4a, 0xd1, 0x88, 0xdd, 0x75, 0x64, 0x4e, 0x76, 0x51, 0x1a, 0xfd, 0xa7, 0x8a, 0x1a, 0x6d,
-	0x16, 0xde, 0x0f, 0x62, 0xfe, 0x40, 0x7e, 0xb6, 0xfb, 0x98, 0xe2, 0x1e, 0xd3, 0x57, 0x50, 0x95,
-	0x41, 0x2a, 0x3a, 0x2a, 0x3d, 0x7a, 0xea, 0x3f, 0xfd, 0x01, 0xaa, 0x8b, 0x2f, 0xc0, 0xcf, 0x80,
-	0xc6, 0x24, 0x50, 0x7e, 0x9c, 0x27, 0x03, 0x4b, 0xfb, 0x73, 0x60, 0x6d, 0x84, 0x31, 0x8f, 0x1e,
-	0x76, 0x9c, 0x2e, 0xe9, 0xb9, 0x5d, 0xc2, 0x7a, 0x84, 0xa9, 0x3f, 0x2d, 0x16, 0x1c, 0xb9, 0xfc,
-	0x51, 0x06, 0xcc, 0xf9, 0x34, 0xe5, 0x1e, 0x12, 0x88, 0x7d, 0x49, 0xd0, 0xbf, 0x42, 0xd7, 0x24,
-	0x90, 0x47, 0x14, 0x58, 0x44, 0x92, 0x40, 0xbd, 0xc2, 0x69, 0x98, 0xf7, 0xa0, 0xeb, 0x2d, 0x0a,
-	0xca, 0x97, 0x05, 0x44, 0xf8, 0xa4, 0xd0, 0xc7, 0x34, 0xf0, 0x3b, 0x38, 0x0d, 0xd4, 0x8b, 0x9e,
-	0x96, 0x89, 0x72, 0xc4, 0x2e, 0x4e, 0x03, 0xdd, 0x46, 0xb5, 0x7e, 0x14, 0x73, 0x48, 0x62, 0xc6,
-	0x8d, 0xab, 0xeb, 0x73, 0x9b, 0xb5, 0xdd, 0x79, 0x81, 0xf3, 0x9e, 0x2d, 0x8b, 0x5a, 0x58, 0x82,
-	0x59, 0xe4, 0x1f, 0x52, 0xdc, 0x15, 0x33, 0xc2, 0xa8, 0xce, 0x56, 0x8b, 0xa4, 0xec, 0x29, 0x88,
-	0xfe, 0x05, 0x7a, 0x3d, 0xc7, 0xf6, 0xe3, 0x34, 0x20, 0x7d, 0xe3, 0xb5, 0x99, 0x9a, 0x5e, 0x97,
-	0x8c, 0xaf, 0x25, 0x42, 0xf7, 0xd1, 0x72, 0x2f, 0x4e, 0x7d, 0xf9, 0x89, 0x8b, 0x77, 0x59, 0xa0,
-	0x17, 0x66, 0xf2, 0x7b, 0xa3, 0x17, 0xa7, 0x07, 0x02, 0xb5, 0x0f, 0x54, 0x25, 0xf8, 0x0e, 0x2d,
-	0xf3, 0x3e, 0xce, 0xfc, 0x84, 0x90, 0xa3, 0x0e, 0xee, 0x1e, 0x15, 0x09, 0x6a, 0x33, 0x79, 0xd7,
-	0x05, 0xeb, 0x33, 0x85, 0x52, 0x19, 0xda, 0x08, 0xc9, 0x12, 0x08, 0x07, 0xca, 0x0c, 0x34, 0x13,
-	0xb7, 0x26, 0x8c, 0x4b, 0x80, 0xfe, 0x2d, 0x6a, 0x94, 0x07, 0xde, 0x3f, 0x04, 0x39, 0x69, 0x62,
-	0x62, 0xd4, 0x67, 0x6b, 0x48, 0x89, 0xda, 0x03, 0x31, 0x1c, 0x62, 0x62, 0x1f, 0xa0, 0x5b, 0x13,
-	0xce, 0x59, 0x71, 0x0e, 0xf5, 0x0f, 0x11, 0x4a, 0xa1, 0xef, 0x67, 0x72, 0x55, 0x9e, 0xb9, 0xfa,
-	0x8e, 0xe1, 0x8c, 0x5f, 0x50, 0x8e, 0xda, 0x55, 0x4b, 0xa1, 0x9f, 0x3f, 0xee, 0xfc, 0x74, 0x15,
-	0xcd, 0xb5, 0x59, 0xa8, 0xff, 0xaa, 0xa1, 0xb5, 0x97, 0x5e, 0x32, 0xdb, 0x17, 0x69, 0xaf, 0x18,
-	0xf3, 0xcd, 0x8f, 0xa6, 0xde, 0x52, 0xce, 0x15, 0xf3, 0xfb, 0xdf, 0xff, 0x7e, 0x7c, 0xc5, 0xb0,
-	0x57, 0xdc, 0xe7, 0xaf, 0xc7, 0x4c, 0xb9, 0x39, 0xd5, 0xd0, 0xea, 0x8b, 0xaf, 0x0d, 0xe7, 0xf2,
-	0x89, 0x85, 0xbe, 0xf9, 0xc1, 0x74, 0xfa, 0xd2, 0xe5, 0x2d, 0xe9, 0xf2, 0xa6, 0xdd, 0x18, 0x73,
-	0x29, 0x2d, 0xfe, 0xa2, 0xa1, 0xc6, 0xa4, 0x01, 0xbe, 0x39, 0x31, 0xd9, 0x04, 0x65, 0xf3, 0xbd,
-	0xcb, 0x2a, 0x4b, 0x43, 0x1b, 0xd2, 0xd0, 0xba, 0x6d, 0x8e, 0x19, 0xca, 0x2f, 0xaf, 0x56, 0x31,
-	0xe2, 0xf5, 0xc7, 0x1a, 0x5a, 0xba, 0x30, 0xb3, 0xdf, 0x9e, 0x98, 0x6e, 0x5c, 0xd6, 0x6c, 0x5d,
-	0x4a, 0x56, 0x5a, 0xda, 0x92, 0x96, 0x6e, 0xdb, 0x6f, 0x8e, 0x59, 0x82, 0x20, 0xe6, 0xad, 0xfc,
-	0xb9, 0x95, 0x7f, 0xb6, 0xbb, 0x7b, 0x4f, 0xce, 0x4c, 0xed, 0xe9, 0x99, 0xa9, 0xfd, 0x75, 0x66,
-	0x6a, 0x3f, 0x9f, 0x9b, 0x95, 0xa7, 0xe7, 0x66, 0xe5, 0x8f, 0x73, 0xb3, 0xf2, 0xcd, 0xbb, 0x23,
-	0x07, 0xe7, 0x73, 0x89, 0xb9, 0x1b, 0xe1, 0x38, 0x2d, 0x90, 0x27, 0x05, 0x54, 0x1e, 0xa1, 0x4e,
-	0x55, 0xfe, 0x74, 0x7a, 0xff, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0xca, 0xa5, 0x9a, 0xbc,
-	0x09, 0x00, 0x00,
+	// 1124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0xd2, 0x6d, 0x26, 0xdb, 0x5f, 0x6e, 0xb7, 0x72, 0xd3, 0x12, 0x97, 0xe9, 0x52,
+	0x5a, 0xb1, 0xb1, 0x69, 0x91, 0x40, 0xbb, 0x27, 0xb6, 0xdb, 0x2d, 0x42, 0x22, 0x6c, 0x30, 0x50,
+	0x24, 0x0e, 0x6b, 0x26, 0xf1, 0xc4, 0xb1, 0xea, 0x78, 0x2c, 0xcf, 0xb4, 0xc9, 0x5e, 0x11, 0x07,
+	0x0e, 0x48, 0x20, 0xed, 0x05, 0x6e, 0x3d, 0x23, 0x24, 0xfe, 0x8d, 0x1e, 0x57, 0xe2, 0x82, 0x16,
+	0x29, 0xa0, 0x16, 0x21, 0x4e, 0x1c, 0xfa, 0x17, 0xa0, 0x19, 0x8f, 0xdd, 0x6c, 0x92, 0x6e, 0xd3,
+	0x70, 0x8a, 0x33, 0xef, 0x9b, 0xef, 0xfb, 0xde, 0x8b, 0xdf, 0x7b, 0x01, 0x4b, 0x81, 0x57, 0xf5,
+	0xa2, 0x43, 0x93, 0x44, 0xa8, 0xe6, 0x63, 0xf3, 0x68, 0xcb, 0x64, 0x6d, 0x23, 0x8c, 0x08, 0x23,
+	0xea, 0x6c, 0x1c, 0x32, 0xe2, 0x90, 0x71, 0xb4, 0x55, 0x58, 0x70, 0x89, 0x4b, 0x44, 0xd0, 0xe4,
+	0x4f, 0x31, 0xae, 0xb0, 0xe2, 0x12, 0xe2, 0xfa, 0xd8, 0x44, 0xa1, 0x67, 0xa2, 0x20, 0x20, 0x0c,
+	0x31, 0x8f, 0x04, 0x54, 0x46, 0x8b, 0x32, 0x2a, 0xbe, 0x55, 0x0f, 0xeb, 0xa6, 0x73, 0x18, 0x09,
+	0x80, 0x8c, 0xbf, 0xda, 0x67, 0x40, 0xea, 0x89, 0x30, 0xfc, 0x45, 0x01, 0x7a, 0x99, 0xba, 0xf7,
+	0x5d, 0x37, 0xc2, 0x2e, 0x62, 0xf8, 0x61, 0xbb, 0xd6, 0x40, 0x81, 0x8b, 0x2d, 0xc4, 0x70, 0x25,
+	0xc2, 0x47, 0x84, 0x61, 0x75, 0x0d, 0x8c, 0x37, 0x10, 0x6d, 0x68, 0xca, 0xaa, 0xb2, 0x91, 0xdb,
+	0x99, 0x39, 0xef, 0xe8, 0xf9, 0x27, 0xa8, 0xe9, 0xdf, 0x83, 0xfc, 0x14, 0x5a, 0x22, 0xa8, 0x6e,
+	0x82, 0x89, 0x3a, 0xc6, 0x0e, 0x8e, 0xb4, 0x31, 0x01, 0x9b, 0x3b, 0xef, 0xe8, 0x53, 0x31, 0x2c,
+	0x3e, 0x87, 0x96, 0x04, 0xa8, 0xdb, 0x20, 0x77, 0x84, 0x7c, 0xcf, 0x41, 0x8c, 0x44, 0x5a, 0x56,
+	0xa0, 0x17, 0xce, 0x3b, 0xfa, 0x6c, 0x8c, 0x4e, 0x43, 0xd0, 0xba, 0x80, 0xdd, 0x9b, 0xfc, 0xe6,
+	0x58, 0xcf, 0xfc, 0x73, 0xac, 0x67, 0xe0, 0x26, 0x78, 0xe3, 0x0a, 0xc3, 0x16, 0xa6, 0x21, 0x09,
+	0x28, 0x86, 0xff, 0x2a, 0x60, 0xe5, 0x32, 0xec, 0xbe, 0xcc, 0x8c, 0x22, 0x9f, 0xf5, 0x67, 0xc6,
+	0x4f, 0xa1, 0x25, 0x82, 0xea, 0x7b, 0x60, 0x1a, 0xcb, 0x8b, 0x76, 0x84, 0x18, 0xa6, 0x32, 0xc3,
+	0xa5, 0xf3, 0x8e, 0x7e, 0x2b, 0x86, 0xbf, 0x18, 0x87, 0xd6, 0x14, 0xee, 0x52, 0xa2, 0x5d, 0xb5,
+	0xc9, 0x5e, 0xab, 0x36, 0xe3, 0xd7, 0xad, 0xcd, 0x3a, 0xb8, 0xfd, 0xb2, 0x7c, 0xd3, 0xc2, 0x7c,
+	0xad, 0x80, 0xc5, 0x32, 0x75, 0x77, 0xb1, 0x2f, 0x70, 0x7b, 0x18, 0x3b, 0x0f, 0x78, 0x20, 0x60,
+	0xaa, 0x09, 0x26, 0x49, 0x88, 0x23, 0xa1, 0x1f, 0x97, 0x65, 0xfe, 0xbc, 0xa3, 0xcf, 0xc4, 0xfa,
+	0x49, 0x04, 0x5a, 0x29, 0x88, 0x5f, 0x70, 0x24, 0x8f, 0x36, 0xd6, 0x7b, 0x21, 0x89, 0x40, 0x2b,
+	0x05, 0x75, 0xd9, 0x5d, 0x05, 0xc5, 0xc1, 0x2e, 0x52, 0xa3, 0x7f, 0x4f, 0x80, 0xf9, 0x32, 0x75,
+	0x1f, 0x3a, 0x1e, 0x7b, 0x24, 0x5e, 0xdb, 0x0a, 0x8a, 0x50, 0x93, 0xaa, 0x8b, 0x60, 0x82, 0xe2,
+	0xc0, 0xc1, 0xd2, 0xa3, 0x25, 0xbf, 0xa9, 0x8f, 0x40, 0x9e, 0xbf, 0x01, 0x76, 0x88, 0x23, 0x8f,
+	0x38, 0xd2, 0x8f, 0x71, 0xd2, 0xd1, 0x95, 0xe7, 0x1d, 0x7d, 0xdd, 0xf5, 0x58, 0xe3, 0xb0, 0x6a,
+	0xd4, 0x48, 0xd3, 0xac, 0x11, 0xda, 0x24, 0x54, 0x7e, 0x94, 0xa8, 0x73, 0x60, 0xb2, 0x27, 0x21,
+	0xa6, 0xc6, 0x07, 0x01, 0xb3, 0x00, 0xa7, 0xa8, 0x08, 0x06, 0xf5, 0x33, 0x30, 0x2d, 0x08, 0x59,
+	0x23, 0xc2, 0xb4, 0x41, 0x7c, 0x47, 0xcb, 0x5e, 0x9b, 0x73, 0x17, 0xd7, 0xac, 0x29, 0xce, 0xf2,
+	0x69, 0x42, 0xc2, 0x7d, 0x46, 0xb8, 0x85, 0x22, 0xc7, 0xae, 0xa2, 0xc0, 0xd1, 0xc6, 0x47, 0xe2,
+	0x04, 0x31, 0xc5, 0x0e, 0x0a, 0x1c, 0x15, 0x82, 0x5c, 0xab, 0xe1, 0x31, 0xec, 0x7b, 0x94, 0x69,
+	0xaf, 0xac, 0x66, 0x37, 0x72, 0x3b, 0xe3, 0x9c, 0xce, 0xba, 0x38, 0xe6, 0xb9, 0x50, 0x1f, 0xd1,
+	0x86, 0x5d, 0x8f, 0x50, 0x8d, 0x8f, 0x08, 0x6d, 0x62, 0xb4, 0x5c, 0x04, 0xcb, 0x9e, 0x24, 0x51,
+	0x3f, 0x06, 0x37, 0x63, 0xda, 0x96, 0x17, 0x38, 0xa4, 0xa5, 0xdd, 0x18, 0xa9, 0xe8, 0x79, 0xc1,
+	0xf1, 0xb9, 0xa0, 0x50, 0x6d, 0xb0, 0xd0, 0xf4, 0x02, 0x5b, 0xbc, 0xe2, 0xfc, 0xb7, 0x4c, 0xa8,
+	0x27, 0x47, 0xf2, 0x3b, 0xd7, 0xf4, 0x82, 0x7d, 0x4e, 0x55, 0xc1, 0x91, 0x14, 0xf8, 0x12, 0x2c,
+	0xb0, 0x16, 0x0a, 0x6d, 0x9f, 0x90, 0x83, 0x2a, 0xaa, 0x1d, 0x24, 0x02, 0xb9, 0x91, 0xbc, 0xab,
+	0x9c, 0xeb, 0x43, 0x49, 0x25, 0x15, 0xca, 0x00, 0x88, 0x14, 0x08, 0xc3, 0x11, 0xd5, 0xc0, 0x48,
+	0xbc, 0x39, 0x6e, 0x5c, 0x10, 0xa8, 0x8f, 0xc1, 0x7c, 0xda, 0xf0, 0x76, 0x1d, 0x8b, 0x49, 0xe3,
+	0x11, 0x2d, 0x3f, 0x5a, 0x41, 0x52, 0xaa, 0x3d, 0xcc, 0x87, 0x83, 0x47, 0xe0, 0x3e, 0x58, 0x1e,
+	0xd0, 0x67, 0x49, 0x1f, 0xaa, 0xef, 0x02, 0x10, 0xe0, 0x96, 0x1d, 0x8a, 0x53, 0xd1, 0x73, 0xf9,
+	0x6d, 0xcd, 0xe8, 0x5d, 0x60, 0x86, 0xbc, 0x95, 0x0b, 0x70, 0x2b, 0x7e, 0x84, 0xbf, 0x2b, 0x40,
+	0x2d, 0x53, 0xf7, 0x13, 0xcc, 0xf6, 0x90, 0xef, 0xf3, 0xfa, 0x54, 0x90, 0x17, 0x5d, 0xda, 0xbf,
+	0x65, 0x30, 0x1e, 0x22, 0x2f, 0xd9, 0x21, 0x77, 0x4f, 0x3a, 0x7a, 0xe6, 0x79, 0x47, 0xdf, 0xea,
+	0xca, 0xeb, 0x23, 0xa1, 0xf9, 0xa0, 0x81, 0xbc, 0xc0, 0x94, 0xab, 0xad, 0x6d, 0xd6, 0x48, 0xb3,
+	0x49, 0x02, 0x13, 0x51, 0x8a, 0x99, 0xc1, 0x05, 0x2c, 0x41, 0xa3, 0x3e, 0x06, 0x53, 0x75, 0x29,
+	0x6b, 0x0b, 0xde, 0xec, 0xff, 0xe5, 0xbd, 0x59, 0xef, 0x4a, 0x03, 0xae, 0x80, 0x42, 0x7f, 0x72,
+	0xe9, 0xf0, 0x62, 0x60, 0x2e, 0x8e, 0x96, 0x51, 0xbb, 0x12, 0x79, 0x35, 0x7c, 0xdf, 0xc5, 0x97,
+	0x66, 0xfe, 0x3e, 0x98, 0x6a, 0xa2, 0xb6, 0x1d, 0x72, 0x9c, 0x8d, 0xdc, 0x78, 0x96, 0xe6, 0xb7,
+	0x97, 0x8c, 0x78, 0xbf, 0x1b, 0xc9, 0x7e, 0x37, 0x76, 0xe5, 0x7e, 0xdf, 0x99, 0xe4, 0x59, 0xfc,
+	0xf0, 0x87, 0xae, 0x58, 0xf9, 0xe6, 0x85, 0x00, 0x5c, 0x06, 0x4b, 0x7d, 0xaa, 0x89, 0xa5, 0xed,
+	0x9f, 0x6e, 0x80, 0x6c, 0x99, 0xba, 0xea, 0xcf, 0x0a, 0x58, 0x79, 0xe9, 0xce, 0xdf, 0xea, 0xff,
+	0x71, 0xaf, 0xd8, 0xba, 0x85, 0xbb, 0xd7, 0xbe, 0x92, 0x56, 0xaa, 0xf8, 0xd5, 0xaf, 0x7f, 0x3d,
+	0x1d, 0xd3, 0xe0, 0xa2, 0xf9, 0xe2, 0xbf, 0x95, 0x50, 0xba, 0x39, 0x56, 0xc0, 0xd2, 0xe5, 0x5b,
+	0xdc, 0x18, 0x5e, 0x98, 0xe3, 0x0b, 0xef, 0x5c, 0x0f, 0x9f, 0xba, 0x5c, 0x16, 0x2e, 0x6f, 0xc1,
+	0xf9, 0x1e, 0x97, 0xc2, 0xe2, 0x8f, 0x0a, 0x98, 0x1f, 0xb4, 0x4f, 0x37, 0x06, 0x8a, 0x0d, 0x40,
+	0x16, 0xde, 0x1a, 0x16, 0x99, 0x1a, 0x5a, 0x17, 0x86, 0x56, 0x61, 0xb1, 0xc7, 0x50, 0xfc, 0x5f,
+	0xa2, 0x94, 0x6c, 0x5c, 0xf5, 0xa9, 0x02, 0x66, 0xfb, 0x56, 0xe8, 0xeb, 0x03, 0xe5, 0x7a, 0x61,
+	0x85, 0xd2, 0x50, 0xb0, 0xd4, 0xd2, 0xa6, 0xb0, 0xb4, 0x06, 0x5f, 0xeb, 0xb1, 0x84, 0x1d, 0x8f,
+	0x95, 0xe2, 0xe7, 0x52, 0x3c, 0x45, 0xd4, 0xef, 0x14, 0x30, 0xd3, 0x3b, 0x17, 0x6e, 0x0f, 0x54,
+	0xeb, 0x41, 0x15, 0xee, 0x0c, 0x83, 0x4a, 0x2d, 0x6d, 0x08, 0x4b, 0x10, 0xae, 0xf6, 0x58, 0xa2,
+	0x98, 0x95, 0x92, 0x6e, 0x2e, 0x89, 0x71, 0xf1, 0xad, 0x02, 0xa6, 0x7b, 0xda, 0x75, 0xed, 0x32,
+	0xa9, 0x2e, 0x50, 0xe1, 0xcd, 0x21, 0x40, 0x43, 0xd9, 0x69, 0xa2, 0x76, 0x49, 0x4c, 0x80, 0x12,
+	0x72, 0xf1, 0xce, 0xde, 0xc9, 0x69, 0x51, 0x79, 0x76, 0x5a, 0x54, 0xfe, 0x3c, 0x2d, 0x2a, 0xdf,
+	0x9f, 0x15, 0x33, 0xcf, 0xce, 0x8a, 0x99, 0xdf, 0xce, 0x8a, 0x99, 0x2f, 0xee, 0x5c, 0x35, 0xb8,
+	0x24, 0xa7, 0x18, 0xf9, 0xd5, 0x09, 0x31, 0x3b, 0xde, 0xfe, 0x6f, 0x00, 0x66, 0xab, 0x4d, 0x4b,
+	0x8c, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -489,6 +689,12 @@ type MsgClient interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(ctx context.Context, in *MsgEditOracleParams, opts ...grpc.CallOption) (*MsgEditOracleParamsResponse, error)
+	// SetFallbackPair: Registers the secondary pair whose price is used when the
+	// price of a pair is stale. [SUDO] Only callable by sudoers.
+	SetFallbackPair(ctx context.Context, in *MsgSetFallbackPair, opts ...grpc.CallOption) (*MsgSetFallbackPairResponse, error)
+	// SetMaxPriceAge: Sets the maximum age of a price for it to be considered
+	// fresh. [SUDO] Only callable by sudoers.
+	SetMaxPriceAge(ctx context.Context, in *MsgSetMaxPriceAge, opts ...grpc.CallOption) (*MsgSetMaxPriceAgeResponse, error)
 }
 
 type msgClient struct {
@@ -535,6 +741,24 @@ func (c *msgClient) EditOracleParams(ctx context.Context, in *MsgEditOracleParam
 	return out, nil
 }
 
+func (c *msgClient) SetFallbackPair(ctx context.Context, in *MsgSetFallbackPair, opts ...grpc.CallOption) (*MsgSetFallbackPairResponse, error) {
+	out := new(MsgSetFallbackPairResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/SetFallbackPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxPriceAge(ctx context.Context, in *MsgSetMaxPriceAge, opts ...grpc.CallOption) (*MsgSetMaxPriceAgeResponse, error) {
+	out := new(MsgSetMaxPriceAgeResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/SetMaxPriceAge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -548,6 +772,12 @@ type MsgServer interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(context.Context, *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error)
+	// SetFallbackPair: Registers the secondary pair whose price is used when the
+	// price of a pair is stale. [SUDO] Only callable by sudoers.
+	SetFallbackPair(context.Context, *MsgSetFallbackPair) (*MsgSetFallbackPairResponse, error)
+	// SetMaxPriceAge: Sets the maximum age of a price for it to be considered
+	// fresh. [SUDO] Only callable by sudoers.
+	SetMaxPriceAge(context.Context, *MsgSetMaxPriceAge) (*MsgSetMaxPriceAgeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -566,6 +796,12 @@ func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) EditOracleParams(ctx context.Context, req *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditOracleParams not implemented")
 }
+func (*UnimplementedMsgServer) SetFallbackPair(ctx context.Context, req *MsgSetFallbackPair) (*MsgSetFallbackPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFallbackPair not implemented")
+}
+func (*UnimplementedMsgServer) SetMaxPriceAge(ctx context.Context, req *MsgSetMaxPriceAge) (*MsgSetMaxPriceAgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxPriceAge not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -643,6 +879,42 @@ func _Msg_EditOracleParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFallbackPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFallbackPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFallbackPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/SetFallbackPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFallbackPair(ctx, req.(*MsgSetFallbackPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxPriceAge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxPriceAge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxPriceAge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/SetMaxPriceAge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxPriceAge(ctx, req.(*MsgSetMaxPriceAge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -663,6 +935,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditOracleParams",
 			Handler:    _Msg_EditOracleParams_Handler,
 		},
+		{
+			MethodName: "SetFallbackPair",
+			Handler:    _Msg_SetFallbackPair_Handler,
+		},
+		{
+			MethodName: "SetMaxPriceAge",
+			Handler:    _Msg_SetMaxPriceAge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/tx.proto",
@@ -1051,6 +1331,140 @@ func (m *MsgEditOracleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetFallbackPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFallbackPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFallbackPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FallbackPair.Size()
+		i -= size
+		if _, err := m.FallbackPair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFallbackPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFallbackPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFallbackPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxPriceAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxPriceAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxPriceAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxPriceAgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxPriceAgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxPriceAgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1220,11 +1634,61 @@ func (m *MsgEditOracleParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetFallbackPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FallbackPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetFallbackPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMaxPriceAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMaxPriceAgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -2338,6 +2802,371 @@ func (m *MsgEditOracleParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetFallbackPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFallbackPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFallbackPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FallbackPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFallbackPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFallbackPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFallbackPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxPriceAge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxPriceAge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxPriceAge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxPriceAgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxPriceAgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxPriceAgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
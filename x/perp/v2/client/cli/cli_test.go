@@ -1,12 +1,14 @@
 package cli_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"cosmossdk.io/errors"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
@@ -523,7 +525,7 @@ func (s *IntegrationTestSuite) TestX_AddMargin() {
 			},
 			expectFail:     false,
 			expectedMargin: sdk.NewDec(980000),
-			expectedCode:   1,
+			expectedCode:   types.ErrInvalidCollateral.ABCICode(),
 		},
 		{
 			name: "fail: position not found",
@@ -757,6 +759,70 @@ func (s *IntegrationTestSuite) TestQueryCollateralDenom() {
 		"resp: %s", resp.String())
 }
 
+func (s *IntegrationTestSuite) TestQuerySpotPrice() {
+	pair := asset.Registry.Pair(denoms.OSMO, denoms.NUSD)
+
+	markets := new(types.QueryMarketsResponse)
+	s.NoError(s.network.ExecQuery(cli.NewQueryCmd(), []string{"markets"}, markets))
+
+	out, err := clitestutil.ExecTestCLICmd(
+		s.network.Validators[0].ClientCtx,
+		cli.NewQueryCmd(),
+		[]string{"spot-price", pair.String(), "--output=json"},
+	)
+	s.Require().NoError(err)
+
+	var resp cli.SpotPriceOutput
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &resp))
+	s.Equal(pair, resp.Pair)
+	s.NotEmpty(resp.BlockHeight)
+	found := false
+	for _, ammMarket := range markets.AmmMarkets {
+		if ammMarket.Amm.Pair == pair {
+			found = true
+			s.Equal(ammMarket.Amm.InstMarkPrice().String(), resp.Price.String())
+		}
+	}
+	s.True(found)
+
+	_, err = clitestutil.ExecTestCLICmd(
+		s.network.Validators[0].ClientCtx,
+		cli.NewQueryCmd(),
+		[]string{"spot-price", "unknown:pair", "--output=json"},
+	)
+	s.Error(err)
+}
+
+func (s *IntegrationTestSuite) TestQueryTwap() {
+	pair := asset.Registry.Pair(denoms.OSMO, denoms.NUSD)
+
+	out, err := clitestutil.ExecTestCLICmd(
+		s.network.Validators[0].ClientCtx,
+		cli.NewQueryCmd(),
+		[]string{"twap", pair.String(), "1h", "--output=json"},
+	)
+	s.Require().NoError(err)
+
+	var resp cli.TwapOutput
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &resp))
+	s.Equal(pair, resp.Pair)
+	s.Equal("1h0m0s", resp.Lookback)
+	s.NotEmpty(resp.BlockHeight)
+	s.True(resp.Price.IsPositive(), "price: %s", resp.Price)
+
+	for _, args := range [][]string{
+		{"twap", "unknown:pair", "1h"},
+		{"twap", pair.String(), "an hour"},
+	} {
+		_, err = clitestutil.ExecTestCLICmd(
+			s.network.Validators[0].ClientCtx,
+			cli.NewQueryCmd(),
+			append(args, "--output=json"),
+		)
+		s.Error(err, args)
+	}
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
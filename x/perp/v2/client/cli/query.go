@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
@@ -33,6 +38,8 @@ func NewQueryCmd() *cobra.Command {
 		CmdQueryModuleAccounts(),
 		CmdQueryMarkets(),
 		CmdQueryCollateral(),
+		CmdQuerySpotPrice(),
+		CmdQueryTwap(),
 	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
@@ -219,3 +226,135 @@ func CmdQueryCollateral() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// SpotPriceOutput is the output of CmdQuerySpotPrice.
+type SpotPriceOutput struct {
+	Pair        asset.Pair `json:"pair" yaml:"pair"`
+	Price       sdk.Dec    `json:"price" yaml:"price"`
+	BlockHeight string     `json:"block_height" yaml:"block_height"`
+}
+
+// CmdQuerySpotPrice: Command for the spot price of a pair, read from the
+// reserves of its AMM.
+func CmdQuerySpotPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spot-price [token-pair]",
+		Short: "Query the spot price of a pair",
+		Long: heredoc.Doc(`
+		Query the instantaneous mark price of the AMM of a pair, along with the
+		block height it was read at.
+		`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			var header metadata.MD
+			res, err := queryClient.QueryMarkets(
+				cmd.Context(), &types.QueryMarketsRequest{}, grpc.Header(&header),
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, ammMarket := range res.AmmMarkets {
+				if ammMarket.Amm.Pair != pair {
+					continue
+				}
+				out := SpotPriceOutput{
+					Pair:  pair,
+					Price: ammMarket.Amm.InstMarkPrice(),
+				}
+				if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+					out.BlockHeight = heights[0]
+				}
+				bz, err := json.Marshal(out)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintBytes(bz)
+			}
+			return types.ErrPairNotFound.Wrapf("pair: %s", pair)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TwapOutput is the output of CmdQueryTwap.
+type TwapOutput struct {
+	Pair        asset.Pair `json:"pair" yaml:"pair"`
+	Price       sdk.Dec    `json:"price" yaml:"price"`
+	Lookback    string     `json:"lookback" yaml:"lookback"`
+	BlockHeight string     `json:"block_height" yaml:"block_height"`
+}
+
+// CmdQueryTwap: Command for the "Query/Twap" gRPC service method.
+func CmdQueryTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [token-pair] [lookback]",
+		Short: "Query the TWAP of a pair",
+		Long: heredoc.Doc(`
+		Query the time-weighted average spot price of the AMM of a pair over the
+		lookback window, e.g. "15m", along with the block height it was read at.
+		`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			lookback, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid lookback: %w", err)
+			}
+
+			var header metadata.MD
+			res, err := queryClient.QueryTwap(
+				cmd.Context(),
+				&types.QueryTwapRequest{Pair: pair, LookbackMs: lookback.Milliseconds()},
+				grpc.Header(&header),
+			)
+			if err != nil {
+				return err
+			}
+
+			out := TwapOutput{
+				Pair:     pair,
+				Price:    res.Twap,
+				Lookback: lookback.String(),
+			}
+			if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+				out.BlockHeight = heights[0]
+			}
+			bz, err := json.Marshal(out)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
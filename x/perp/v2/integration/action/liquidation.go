@@ -1,6 +1,7 @@
 package action
 
 import (
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,10 @@ type PairTraderTuple struct {
 	Pair       asset.Pair
 	Trader     sdk.AccAddress
 	Successful bool
+
+	// optional, checked against the fees of the liquidation response when set
+	LiquidatorFee *sdk.Coin
+	PerpEfFee     *sdk.Coin
 }
 
 type multiLiquidate struct {
@@ -59,6 +64,14 @@ func (m multiLiquidate) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, er
 		if response.Success != m.pairTraderTuples[i].Successful {
 			return ctx, fmt.Errorf("MultiLiquidate wrong assertion, expected %v, got %v, index %d", m.pairTraderTuples[i].Successful, response.Success, i)
 		}
+		if expected := m.pairTraderTuples[i].LiquidatorFee; expected != nil &&
+			(response.LiquidatorFee == nil || !expected.IsEqual(*response.LiquidatorFee)) {
+			return ctx, fmt.Errorf("expected liquidator fee %s, got %v, index %d", expected, response.LiquidatorFee, i)
+		}
+		if expected := m.pairTraderTuples[i].PerpEfFee; expected != nil &&
+			(response.PerpEfFee == nil || !expected.IsEqual(*response.PerpEfFee)) {
+			return ctx, fmt.Errorf("expected perp ef fee %s, got %v, index %d", expected, response.PerpEfFee, i)
+		}
 	}
 
 	return ctx, nil
@@ -71,3 +84,57 @@ func MultiLiquidate(liquidator sdk.AccAddress, shouldAllFail bool, pairTraderTup
 		shouldAllFail:    shouldAllFail,
 	}
 }
+
+type partialLiquidate struct {
+	liquidator sdk.AccAddress
+	pair       asset.Pair
+	trader     sdk.AccAddress
+	fraction   sdk.Dec
+}
+
+func (p partialLiquidate) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	_, _, err := app.PerpKeeperV2.PartialLiquidate(ctx, p.liquidator, p.pair, p.trader, p.fraction)
+	return ctx, err
+}
+
+// PartialLiquidate liquidates the given fraction of a trader's position.
+func PartialLiquidate(liquidator sdk.AccAddress, pair asset.Pair, trader sdk.AccAddress, fraction sdk.Dec) action.Action {
+	return partialLiquidate{
+		liquidator: liquidator,
+		pair:       pair,
+		trader:     trader,
+		fraction:   fraction,
+	}
+}
+
+type partialLiquidateFails struct {
+	liquidator sdk.AccAddress
+	pair       asset.Pair
+	trader     sdk.AccAddress
+	fraction   sdk.Dec
+
+	expectedErr error
+}
+
+func (p partialLiquidateFails) IsNotMandatory() {}
+
+func (p partialLiquidateFails) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	_, _, err := app.PerpKeeperV2.PartialLiquidate(ctx, p.liquidator, p.pair, p.trader, p.fraction)
+	if !errors.Is(err, p.expectedErr) {
+		return ctx, fmt.Errorf("expected error %s, got %s", p.expectedErr, err)
+	}
+
+	return ctx, nil
+}
+
+func PartialLiquidateFails(
+	liquidator sdk.AccAddress, pair asset.Pair, trader sdk.AccAddress, fraction sdk.Dec, expectedErr error,
+) action.Action {
+	return partialLiquidateFails{
+		liquidator:  liquidator,
+		pair:        pair,
+		trader:      trader,
+		fraction:    fraction,
+		expectedErr: expectedErr,
+	}
+}
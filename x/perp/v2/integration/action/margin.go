@@ -10,6 +10,7 @@ import (
 	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+	perpkeeper "github.com/NibiruChain/nibiru/x/perp/v2/keeper"
 )
 
 // AddMargin adds margin to the position
@@ -85,6 +86,110 @@ func (a addMarginFailAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Contex
 	return ctx, nil
 }
 
+// AddMarginCoin adds margin of a specific denom to the position
+func AddMarginCoin(
+	account sdk.AccAddress,
+	pair asset.Pair,
+	margin sdk.Coin,
+) action.Action {
+	return &addMarginCoinAction{
+		Account: account,
+		Pair:    pair,
+		Margin:  margin,
+	}
+}
+
+type addMarginCoinAction struct {
+	Account sdk.AccAddress
+	Pair    asset.Pair
+	Margin  sdk.Coin
+}
+
+func (a addMarginCoinAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	_, err := app.PerpKeeperV2.AddMargin(ctx, a.Pair, a.Account, a.Margin)
+	return ctx, err
+}
+
+// AddMarginCoinFail adds margin of a specific denom to the position expecting a fail
+func AddMarginCoinFail(
+	account sdk.AccAddress,
+	pair asset.Pair,
+	margin sdk.Coin,
+	err error,
+) action.Action {
+	return &addMarginCoinFailAction{
+		Account:     account,
+		Pair:        pair,
+		Margin:      margin,
+		ExpectedErr: err,
+	}
+}
+
+type addMarginCoinFailAction struct {
+	Account     sdk.AccAddress
+	Pair        asset.Pair
+	Margin      sdk.Coin
+	ExpectedErr error
+}
+
+func (a addMarginCoinFailAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	_, err := app.PerpKeeperV2.AddMargin(ctx, a.Pair, a.Account, a.Margin)
+	if !errors.Is(err, a.ExpectedErr) {
+		return ctx, fmt.Errorf("expected error %v, got %v", a.ExpectedErr, err)
+	}
+
+	return ctx, nil
+}
+
+// AddMarginBatch adds margin to several positions in one call
+func AddMarginBatch(
+	account sdk.AccAddress,
+	margins ...perpkeeper.ArgsAddMargin,
+) action.Action {
+	return &addMarginBatchAction{
+		Account: account,
+		Margins: margins,
+	}
+}
+
+type addMarginBatchAction struct {
+	Account sdk.AccAddress
+	Margins []perpkeeper.ArgsAddMargin
+}
+
+func (a addMarginBatchAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	_, err := app.PerpKeeperV2.AddMarginBatch(ctx, a.Account, a.Margins)
+	return ctx, err
+}
+
+// AddMarginBatchFail adds margin to several positions in one call expecting a fail
+func AddMarginBatchFail(
+	account sdk.AccAddress,
+	err error,
+	margins ...perpkeeper.ArgsAddMargin,
+) action.Action {
+	return &addMarginBatchFailAction{
+		Account:     account,
+		Margins:     margins,
+		ExpectedErr: err,
+	}
+}
+
+type addMarginBatchFailAction struct {
+	Account     sdk.AccAddress
+	Margins     []perpkeeper.ArgsAddMargin
+	ExpectedErr error
+}
+
+func (a addMarginBatchFailAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	_, err := app.PerpKeeperV2.AddMarginBatch(ctx, a.Account, a.Margins)
+	if !errors.Is(err, a.ExpectedErr) {
+		return ctx, fmt.Errorf("expected error %v, got %v", a.ExpectedErr, err)
+	}
+
+	return ctx, nil
+}
+
 func RemoveMargin(
 	account sdk.AccAddress,
 	pair asset.Pair,
@@ -155,3 +260,24 @@ func (a removeMarginActionFail) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Con
 
 	return ctx, nil
 }
+
+// SetFundingTopUp opts the position of the account in or out of paying its
+// funding from the account balance
+func SetFundingTopUp(account sdk.AccAddress, pair asset.Pair, enabled bool) action.Action {
+	return &setFundingTopUpAction{
+		Account: account,
+		Pair:    pair,
+		Enabled: enabled,
+	}
+}
+
+type setFundingTopUpAction struct {
+	Account sdk.AccAddress
+	Pair    asset.Pair
+	Enabled bool
+}
+
+func (a setFundingTopUpAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	app.PerpKeeperV2.SetFundingTopUp(ctx, a.Pair, a.Account, a.Enabled)
+	return ctx, nil
+}
@@ -98,6 +98,18 @@ func WithSqrtDepth(amount sdk.Dec) MarketModifier {
 	}
 }
 
+func WithBaseReserve(amount sdk.Dec) MarketModifier {
+	return func(market *types.Market, amm *types.AMM) {
+		amm.BaseReserve = amount
+	}
+}
+
+func WithQuoteReserve(amount sdk.Dec) MarketModifier {
+	return func(market *types.Market, amm *types.AMM) {
+		amm.QuoteReserve = amount
+	}
+}
+
 func WithLatestMarketCPF(amount sdk.Dec) MarketModifier {
 	return func(market *types.Market, amm *types.AMM) {
 		market.LatestCumulativePremiumFraction = amount
@@ -123,6 +135,30 @@ func WithEnabled(enabled bool) MarketModifier {
 	}
 }
 
+func WithMaintenanceMarginRatio(ratio sdk.Dec) MarketModifier {
+	return func(market *types.Market, amm *types.AMM) {
+		market.MaintenanceMarginRatio = ratio
+	}
+}
+
+func WithMaxLeverage(maxLeverage sdk.Dec) MarketModifier {
+	return func(market *types.Market, amm *types.AMM) {
+		market.MaxLeverage = maxLeverage
+	}
+}
+
+func WithExchangeFeeRatio(ratio sdk.Dec) MarketModifier {
+	return func(market *types.Market, amm *types.AMM) {
+		market.ExchangeFeeRatio = ratio
+	}
+}
+
+func WithEcosystemFundFeeRatio(ratio sdk.Dec) MarketModifier {
+	return func(market *types.Market, amm *types.AMM) {
+		market.EcosystemFundFeeRatio = ratio
+	}
+}
+
 type shiftPegMultiplier struct {
 	pair     asset.Pair
 	newValue sdk.Dec
@@ -234,3 +270,104 @@ func SetCollateral(denom string) action.Action {
 		Sender: common.NIBIRU_TEAM,
 	}
 }
+
+type addCollateralDenom struct {
+	Denom      string
+	OraclePair asset.Pair
+	Sender     string
+}
+
+func (c addCollateralDenom) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	sudoers, err := app.SudoKeeper.Sudoers.Get(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	sudoers.Root = common.NIBIRU_TEAM
+	app.SudoKeeper.Sudoers.Set(ctx, sudoers)
+
+	senderAddr, err := sdk.AccAddressFromBech32(c.Sender)
+	if err != nil {
+		return ctx, err
+	}
+	err = app.PerpKeeperV2.Sudo().AddCollateralDenom(ctx, c.Denom, c.OraclePair, senderAddr)
+	return ctx, err
+}
+
+// AddCollateralDenom whitelists a secondary collateral denom priced by the
+// oracle pair.
+func AddCollateralDenom(denom string, oraclePair asset.Pair) action.Action {
+	return addCollateralDenom{
+		Denom:      denom,
+		OraclePair: oraclePair,
+		Sender:     common.NIBIRU_TEAM,
+	}
+}
+
+type pauseMarket struct {
+	Pair asset.Pair
+}
+
+func (c pauseMarket) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	senderAddr, err := nibiruTeamAsSudoRoot(app, ctx)
+	if err != nil {
+		return ctx, err
+	}
+	err = app.PerpKeeperV2.Sudo().ChangeMarketPaused(ctx, c.Pair, true, senderAddr)
+	return ctx, err
+}
+
+// PauseMarket halts trading on the pair.
+func PauseMarket(pair asset.Pair) action.Action {
+	return pauseMarket{Pair: pair}
+}
+
+type changeFluctuationLimitRatio struct {
+	Pair  asset.Pair
+	Ratio sdk.Dec
+}
+
+func (c changeFluctuationLimitRatio) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	senderAddr, err := nibiruTeamAsSudoRoot(app, ctx)
+	if err != nil {
+		return ctx, err
+	}
+	err = app.PerpKeeperV2.Sudo().ChangeFluctuationLimitRatio(ctx, c.Pair, c.Ratio, senderAddr)
+	return ctx, err
+}
+
+// ChangeFluctuationLimitRatio sets the fluctuation limit ratio of the pair.
+func ChangeFluctuationLimitRatio(pair asset.Pair, ratio sdk.Dec) action.Action {
+	return changeFluctuationLimitRatio{Pair: pair, Ratio: ratio}
+}
+
+type changeTradeLimitRatio struct {
+	Pair  asset.Pair
+	Ratio sdk.Dec
+}
+
+func (c changeTradeLimitRatio) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	senderAddr, err := nibiruTeamAsSudoRoot(app, ctx)
+	if err != nil {
+		return ctx, err
+	}
+	err = app.PerpKeeperV2.Sudo().ChangeTradeLimitRatio(ctx, c.Pair, c.Ratio, senderAddr)
+	return ctx, err
+}
+
+// ChangeTradeLimitRatio sets the trade limit ratio of the pair.
+func ChangeTradeLimitRatio(pair asset.Pair, ratio sdk.Dec) action.Action {
+	return changeTradeLimitRatio{Pair: pair, Ratio: ratio}
+}
+
+// nibiruTeamAsSudoRoot makes the Nibiru team account the root sudoer and
+// returns its address.
+func nibiruTeamAsSudoRoot(app *app.NibiruApp, ctx sdk.Context) (sdk.AccAddress, error) {
+	sudoers, err := app.SudoKeeper.Sudoers.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sudoers.Root = common.NIBIRU_TEAM
+	app.SudoKeeper.Sudoers.Set(ctx, sudoers)
+
+	return sdk.AccAddressFromBech32(common.NIBIRU_TEAM)
+}
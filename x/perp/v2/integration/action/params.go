@@ -5,6 +5,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 )
 
 type changeLiquidationFeeRatio struct {
@@ -22,3 +23,21 @@ func ChangeLiquidationFeeRatio(liquidationFeeRatio sdk.Dec) action.Action {
 		LiquidationFeeRatio: liquidationFeeRatio,
 	}
 }
+
+type changeLiquidatorRewardRatio struct {
+	LiquidatorRewardRatio sdk.Dec
+}
+
+func (c changeLiquidatorRewardRatio) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	return ctx, app.PerpKeeperV2.Sudo().ChangeLiquidatorRewardRatio(
+		ctx, c.LiquidatorRewardRatio, testapp.DefaultSudoRoot(),
+	)
+}
+
+// ChangeLiquidatorRewardRatio sets the share of the liquidation fee paid to
+// liquidators
+func ChangeLiquidatorRewardRatio(liquidatorRewardRatio sdk.Dec) action.Action {
+	return changeLiquidatorRewardRatio{
+		LiquidatorRewardRatio: liquidatorRewardRatio,
+	}
+}
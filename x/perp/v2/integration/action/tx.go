@@ -314,3 +314,93 @@ func MsgServerMultiLiquidate(liquidator sdk.AccAddress, shouldAllFail bool, pair
 		shouldAllFail:    shouldAllFail,
 	}
 }
+
+type msgServerSetFundingTopUp struct {
+	pair          asset.Pair
+	traderAddress sdk.AccAddress
+	enabled       bool
+}
+
+func (m msgServerSetFundingTopUp) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	msgServer := keeper.NewMsgServerImpl(app.PerpKeeperV2)
+
+	_, err := msgServer.SetFundingTopUp(sdk.WrapSDKContext(ctx), &types.MsgSetFundingTopUp{
+		Sender:  m.traderAddress.String(),
+		Pair:    m.pair,
+		Enabled: m.enabled,
+	})
+
+	return ctx, err
+}
+
+func MsgServerSetFundingTopUp(
+	traderAddress sdk.AccAddress,
+	pair asset.Pair,
+	enabled bool,
+) action.Action {
+	return msgServerSetFundingTopUp{
+		pair:          pair,
+		traderAddress: traderAddress,
+		enabled:       enabled,
+	}
+}
+
+type msgServerSetMarginMode struct {
+	pair          asset.Pair
+	traderAddress sdk.AccAddress
+	mode          types.MarginMode
+}
+
+func (m msgServerSetMarginMode) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	msgServer := keeper.NewMsgServerImpl(app.PerpKeeperV2)
+
+	_, err := msgServer.SetMarginMode(sdk.WrapSDKContext(ctx), &types.MsgSetMarginMode{
+		Sender: m.traderAddress.String(),
+		Pair:   m.pair,
+		Mode:   string(m.mode),
+	})
+
+	return ctx, err
+}
+
+func MsgServerSetMarginMode(
+	traderAddress sdk.AccAddress,
+	pair asset.Pair,
+	mode types.MarginMode,
+) action.Action {
+	return msgServerSetMarginMode{
+		pair:          pair,
+		traderAddress: traderAddress,
+		mode:          mode,
+	}
+}
+
+type msgServerAddMarginBatch struct {
+	traderAddress sdk.AccAddress
+	margins       []keeper.ArgsAddMargin
+}
+
+func (m msgServerAddMarginBatch) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	msgServer := keeper.NewMsgServerImpl(app.PerpKeeperV2)
+
+	msg := &types.MsgAddMarginBatch{Sender: m.traderAddress.String()}
+	for _, margin := range m.margins {
+		msg.Margins = append(msg.Margins, types.MsgAddMarginBatch_Margin{Pair: margin.Pair, Margin: margin.Margin})
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return ctx, err
+	}
+
+	_, err := msgServer.AddMarginBatch(sdk.WrapSDKContext(ctx), msg)
+	return ctx, err
+}
+
+func MsgServerAddMarginBatch(
+	traderAddress sdk.AccAddress,
+	margins ...keeper.ArgsAddMargin,
+) action.Action {
+	return msgServerAddMarginBatch{
+		traderAddress: traderAddress,
+		margins:       margins,
+	}
+}
@@ -0,0 +1,42 @@
+package assertion
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+)
+
+type recentLiquidationsShouldBe struct {
+	Pair            asset.Pair
+	N               uint64
+	ExpectedTraders []sdk.AccAddress
+}
+
+func (r recentLiquidationsShouldBe) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	records := app.PerpKeeperV2.QueryRecentLiquidations(ctx, r.Pair, r.N)
+	if len(records) != len(r.ExpectedTraders) {
+		return ctx, fmt.Errorf("expected %d liquidations, got %d", len(r.ExpectedTraders), len(records))
+	}
+
+	for i, record := range records {
+		if record.TraderAddress != r.ExpectedTraders[i].String() {
+			return ctx, fmt.Errorf("expected liquidation %d to be of trader %s, got %s", i, r.ExpectedTraders[i], record.TraderAddress)
+		}
+	}
+
+	return ctx, nil
+}
+
+// RecentLiquidationsShouldBe checks that the 'n' most recent liquidations of
+// 'pair' are of 'expectedTraders', newest first.
+func RecentLiquidationsShouldBe(pair asset.Pair, n uint64, expectedTraders ...sdk.AccAddress) action.Action {
+	return recentLiquidationsShouldBe{
+		Pair:            pair,
+		N:               n,
+		ExpectedTraders: expectedTraders,
+	}
+}
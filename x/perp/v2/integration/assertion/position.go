@@ -60,6 +60,28 @@ func Position_PositionShouldBeEqualTo(expectedPosition types.Position) PositionC
 	}
 }
 
+// Position_PositionSizeShouldBeEqualTo checks if the position size is equal to the expected size
+func Position_PositionSizeShouldBeEqualTo(expectedSize sdk.Dec) PositionChecker {
+	return func(position types.Position) error {
+		if !position.Size_.Equal(expectedSize) {
+			return fmt.Errorf("expected position size %s, got %s", expectedSize, position.Size_)
+		}
+
+		return nil
+	}
+}
+
+// Position_PositionMarginShouldBeEqualTo checks if the position margin is equal to the expected margin
+func Position_PositionMarginShouldBeEqualTo(expectedMargin sdk.Dec) PositionChecker {
+	return func(position types.Position) error {
+		if !position.Margin.Equal(expectedMargin) {
+			return fmt.Errorf("expected position margin %s, got %s", expectedMargin, position.Margin)
+		}
+
+		return nil
+	}
+}
+
 type positionShouldNotExist struct {
 	Account sdk.AccAddress
 	Pair    asset.Pair
@@ -109,3 +131,46 @@ func PositionShouldExist(account sdk.AccAddress, pair asset.Pair, version uint64
 		Version: version,
 	}
 }
+
+type fundingRunwayShouldBe struct {
+	Account sdk.AccAddress
+	Pair    asset.Pair
+
+	ExpectedFundingPerInterval sdk.Dec
+	ExpectedIntervals          uint64
+	ExpectedFinite             bool
+}
+
+func (f fundingRunwayShouldBe) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	runway, err := app.PerpKeeperV2.QueryFundingRunway(ctx, f.Pair, f.Account)
+	if err != nil {
+		return ctx, err
+	}
+
+	if !runway.FundingPerInterval.Equal(f.ExpectedFundingPerInterval) {
+		return ctx, fmt.Errorf("expected funding per interval %s, got %s", f.ExpectedFundingPerInterval, runway.FundingPerInterval)
+	}
+	if runway.Finite != f.ExpectedFinite {
+		return ctx, fmt.Errorf("expected finite runway %t, got %t", f.ExpectedFinite, runway.Finite)
+	}
+	if runway.Intervals != f.ExpectedIntervals {
+		return ctx, fmt.Errorf("expected %d funding intervals, got %d", f.ExpectedIntervals, runway.Intervals)
+	}
+
+	return ctx, nil
+}
+
+// FundingRunwayShouldBe checks the funding runway of the position of the
+// account. 'expectedIntervals' is only checked if 'expectedFinite' is true.
+func FundingRunwayShouldBe(
+	account sdk.AccAddress, pair asset.Pair,
+	expectedFundingPerInterval sdk.Dec, expectedFinite bool, expectedIntervals uint64,
+) action.Action {
+	return fundingRunwayShouldBe{
+		Account:                    account,
+		Pair:                       pair,
+		ExpectedFundingPerInterval: expectedFundingPerInterval,
+		ExpectedIntervals:          expectedIntervals,
+		ExpectedFinite:             expectedFinite,
+	}
+}
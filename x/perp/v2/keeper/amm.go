@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
@@ -66,16 +67,126 @@ func (k Keeper) handleMarketUpdateCost(
 	return costPaid, nil
 }
 
+// clampMarkToOracleBand moves the mark price of 'pair' back to the edge of the
+// band of its max oracle spread ratio around the oracle price when it drifted
+// beyond it. This is a softer alternative to pausing the market. Only the quote
+// reserve is changed. Like swaps, see checkFluctuationLimit, the mark price is
+// kept within the pair's fluctuation limit ratio of the latest reserve
+// snapshot, so that clamping several times in a block moves the mark price no
+// more than clamping once, and a mark price far outside the band is brought
+// back over several blocks. Without a snapshot, the move is bounded relative to
+// the mark price before the clamp. It is only reached through the sudo
+// ClampMarkToOracleBand.
+//
+// The change in value of the open interest, the inventory cost, is settled
+// between the perp fund and the vault like a repeg. A positive cost is paid by
+// the perp fund, a negative one by the vault. Pairs without a max oracle spread
+// ratio, or with a mark price within the band, are left unchanged at no cost.
+func (k Keeper) clampMarkToOracleBand(ctx sdk.Context, pair asset.Pair) (cost sdkmath.Int, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return sdkmath.Int{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return sdkmath.Int{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	maxSpreadRatio, err := k.MaxOracleSpreadRatios.Get(ctx, pair)
+	if err != nil {
+		return sdk.ZeroInt(), nil
+	}
+
+	oraclePrice, err := k.OracleKeeper.GetUnderlyingPrice(ctx, market.OraclePair)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if !oraclePrice.IsPositive() {
+		return sdkmath.Int{}, fmt.Errorf("oracle price of %s must be positive, not: %s", market.OraclePair, oraclePrice)
+	}
+
+	markPrice := amm.InstMarkPrice()
+	upperBand := oraclePrice.Mul(sdk.OneDec().Add(maxSpreadRatio))
+	lowerBand := oraclePrice.Mul(sdk.OneDec().Sub(maxSpreadRatio))
+
+	var targetPrice sdk.Dec
+	switch {
+	case markPrice.GT(upperBand):
+		targetPrice = upperBand
+	case markPrice.LT(lowerBand):
+		targetPrice = lowerBand
+	default:
+		return sdk.ZeroInt(), nil
+	}
+
+	if limitRatio := k.FluctuationLimitRatios.GetOr(ctx, pair, sdk.ZeroDec()); limitRatio.IsPositive() {
+		anchorPrice := markPrice
+		iter := k.ReserveSnapshots.Iterate(
+			ctx,
+			collections.PairRange[asset.Pair, time.Time]{}.
+				Prefix(pair).
+				EndInclusive(ctx.BlockTime()).
+				Descending(),
+		)
+		if iter.Valid() && iter.Value().Amm.InstMarkPrice().IsPositive() {
+			anchorPrice = iter.Value().Amm.InstMarkPrice()
+		}
+		iter.Close()
+
+		maxMove := anchorPrice.Mul(limitRatio)
+		targetPrice = sdk.MaxDec(anchorPrice.Sub(maxMove), sdk.MinDec(anchorPrice.Add(maxMove), targetPrice))
+		// never push the mark price further away from the band
+		if markPrice.GT(upperBand) {
+			targetPrice = sdk.MinDec(targetPrice, markPrice)
+		} else {
+			targetPrice = sdk.MaxDec(targetPrice, markPrice)
+		}
+		if targetPrice.Equal(markPrice) {
+			return sdk.ZeroInt(), nil
+		}
+	}
+
+	newQuoteReserve, err := amm.QuoteReserveForMarkPrice(targetPrice)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	marketValueBefore, err := amm.GetMarketValue()
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	amm.QuoteReserve = newQuoteReserve
+	if amm.SqrtDepth, err = amm.ComputeSqrtDepth(); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	marketValueAfter, err := amm.GetMarketValue()
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	cost = marketValueAfter.Sub(marketValueBefore).Ceil().TruncateInt()
+	if _, err = k.handleMarketUpdateCost(ctx, pair, cost); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	k.SaveAMM(ctx, amm)
+	emitPriceChanged(ctx, amm)
+
+	return cost, nil
+}
+
 // GetMarket returns the market that is enabled. It is the last version of the market.
 func (k Keeper) GetMarket(ctx sdk.Context, pair asset.Pair) (types.Market, error) {
 	lastVersion, err := k.MarketLastVersion.Get(ctx, pair)
 	if err != nil {
-		return types.Market{}, fmt.Errorf("market %s not found", pair)
+		return types.Market{}, types.ErrPairNotFound.Wrapf("market %s not found", pair)
 	}
 
 	market, err := k.Markets.Get(ctx, collections.Join(pair, lastVersion.Version))
 	if err != nil {
-		return types.Market{}, fmt.Errorf("market %s not found", pair)
+		return types.Market{}, types.ErrPairNotFound.Wrapf("market %s not found", pair)
 	}
 
 	return market, nil
@@ -85,7 +196,7 @@ func (k Keeper) GetMarket(ctx sdk.Context, pair asset.Pair) (types.Market, error
 func (k Keeper) GetMarketByPairAndVersion(ctx sdk.Context, pair asset.Pair, version uint64) (types.Market, error) {
 	market, err := k.Markets.Get(ctx, collections.Join(pair, version))
 	if err != nil {
-		return types.Market{}, fmt.Errorf("market with pair %s and version %d not found", pair, version)
+		return types.Market{}, types.ErrPairNotFound.Wrapf("market with pair %s and version %d not found", pair, version)
 	}
 
 	return market, nil
@@ -100,12 +211,12 @@ func (k Keeper) SaveMarket(ctx sdk.Context, market types.Market) {
 func (k Keeper) GetAMM(ctx sdk.Context, pair asset.Pair) (types.AMM, error) {
 	lastVersion, err := k.MarketLastVersion.Get(ctx, pair)
 	if err != nil {
-		return types.AMM{}, fmt.Errorf("market %s not found", pair)
+		return types.AMM{}, types.ErrPairNotFound.Wrapf("market %s not found", pair)
 	}
 
 	amm, err := k.AMMs.Get(ctx, collections.Join(pair, lastVersion.Version))
 	if err != nil {
-		return types.AMM{}, fmt.Errorf("market %s not found", pair)
+		return types.AMM{}, types.ErrPairNotFound.Wrapf("market %s not found", pair)
 	}
 
 	return amm, nil
@@ -115,7 +226,7 @@ func (k Keeper) GetAMM(ctx sdk.Context, pair asset.Pair) (types.AMM, error) {
 func (k Keeper) GetAMMByPairAndVersion(ctx sdk.Context, pair asset.Pair, version uint64) (types.AMM, error) {
 	amm, err := k.AMMs.Get(ctx, collections.Join(pair, version))
 	if err != nil {
-		return types.AMM{}, fmt.Errorf("amm with pair %s and version %d not found", pair, version)
+		return types.AMM{}, types.ErrPairNotFound.Wrapf("amm with pair %s and version %d not found", pair, version)
 	}
 
 	return amm, nil
@@ -125,3 +236,33 @@ func (k Keeper) GetAMMByPairAndVersion(ctx sdk.Context, pair asset.Pair, version
 func (k Keeper) SaveAMM(ctx sdk.Context, amm types.AMM) {
 	k.AMMs.Insert(ctx, collections.Join(amm.Pair, amm.Version), amm)
 }
+
+// EndBlockAMMs returns the AMMs the EndBlocker processes in the current block.
+// When MaxPairsPerBlock is set, at most that many AMMs are returned, starting
+// where the previous block stopped and wrapping around, so that every AMM is
+// processed once every ceil(len(AMMs) / MaxPairsPerBlock) blocks.
+func (k Keeper) EndBlockAMMs(ctx sdk.Context) []types.AMM {
+	amms := k.AMMs.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values()
+	selected, cursor, rotated := roundRobin(amms, k.MaxPairsPerBlock.GetOr(ctx, 0), k.EndBlockAMMCursor.GetOr(ctx, 0))
+	if rotated {
+		k.EndBlockAMMCursor.Set(ctx, cursor)
+	}
+	return selected
+}
+
+// roundRobin returns at most 'maxItems' of 'items', starting at index 'cursor'
+// and wrapping around, along with the cursor the next call starts from. Every
+// item is returned, and 'rotated' is false, when 'maxItems' is zero or covers
+// all of them.
+func roundRobin[T any](items []T, maxItems uint64, cursor uint64) (selected []T, nextCursor uint64, rotated bool) {
+	if maxItems == 0 || maxItems >= uint64(len(items)) {
+		return items, cursor, false
+	}
+
+	start := cursor % uint64(len(items))
+	selected = make([]T, 0, maxItems)
+	for i := uint64(0); i < maxItems; i++ {
+		selected = append(selected, items[(start+i)%uint64(len(items))])
+	}
+	return selected, (start + maxItems) % uint64(len(items)), true
+}
@@ -481,3 +481,136 @@ func TestKeeper_GetAMMByPairAndVersion(t *testing.T) {
 	amm, err = app.PerpKeeperV2.Sudo().GetAMMByPairAndVersion(ctx, pair, 2)
 	require.ErrorContains(t, err, fmt.Sprintf("amm with pair %s and version 2 not found", pair.String()))
 }
+
+func TestPairNotFound(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.MustNewPair("luna:usdt")
+	perpKeeper := app.PerpKeeperV2
+
+	_, err := perpKeeper.GetMarket(ctx, pair)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+	_, err = perpKeeper.GetAMM(ctx, pair)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+	_, err = perpKeeper.GetMarketByPairAndVersion(ctx, pair, 1)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+	_, err = perpKeeper.GetAMMByPairAndVersion(ctx, pair, 1)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+
+	t.Log("price methods surface the same error")
+	_, err = perpKeeper.GetMarkOracleSpread(ctx, pair)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+	_, err = perpKeeper.GetIndexAdjustedMarkPrice(ctx, pair, sdk.OneDec())
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+	_, err = perpKeeper.IsOverSpreadLimit(ctx, pair)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+	_, err = perpKeeper.GetAmmPosition(ctx, pair)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+}
+
+func TestClampMarkToOracleBand(t *testing.T) {
+	// mark price of 1 with a long bias, max oracle spread ratio of 0.05
+	tests := []struct {
+		name                  string
+		oraclePrice           sdk.Dec
+		fluctuationLimitRatio sdk.Dec
+		noSpreadRatio         bool
+		snapshot              bool
+		clamps                int
+
+		expectedMarkPrice sdk.Dec
+		expectedCostSign  int
+	}{
+		{
+			name:              "mark above the band",
+			oraclePrice:       sdk.MustNewDecFromStr("0.9"),
+			expectedMarkPrice: sdk.MustNewDecFromStr("0.945"),
+			expectedCostSign:  -1,
+		},
+		{
+			name:              "mark below the band",
+			oraclePrice:       sdk.MustNewDecFromStr("1.1"),
+			expectedMarkPrice: sdk.MustNewDecFromStr("1.045"),
+			expectedCostSign:  1,
+		},
+		{
+			name:              "mark within the band",
+			oraclePrice:       sdk.MustNewDecFromStr("1.02"),
+			expectedMarkPrice: sdk.OneDec(),
+		},
+		{
+			name:                  "move bounded by the fluctuation limit",
+			oraclePrice:           sdk.MustNewDecFromStr("1.1"),
+			fluctuationLimitRatio: sdk.MustNewDecFromStr("0.02"),
+			expectedMarkPrice:     sdk.MustNewDecFromStr("1.02"),
+			expectedCostSign:      1,
+		},
+		{
+			name:                  "repeated clamps in a block are bounded by the latest snapshot",
+			oraclePrice:           sdk.MustNewDecFromStr("1.1"),
+			fluctuationLimitRatio: sdk.MustNewDecFromStr("0.02"),
+			snapshot:              true,
+			clamps:                3,
+			expectedMarkPrice:     sdk.MustNewDecFromStr("1.02"),
+			expectedCostSign:      1,
+		},
+		{
+			name:              "no max oracle spread ratio",
+			oraclePrice:       sdk.MustNewDecFromStr("1.1"),
+			noSpreadRatio:     true,
+			expectedMarkPrice: sdk.OneDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+			createTestMarket(t, app, ctx, pair, WithEnabled(true), WithTotalLong(sdk.NewDec(1e6)))
+			market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+			require.NoError(t, err)
+			amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+			require.NoError(t, err)
+			app.OracleKeeper.SetPrice(ctx, market.OraclePair, tc.oraclePrice)
+
+			fund := sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1e6))
+			require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, types.PerpFundModuleAccount, fund))
+			require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, types.VaultModuleAccount, fund))
+
+			sudoer := testapp.DefaultSudoRoot()
+			if !tc.noSpreadRatio {
+				require.NoError(t, app.PerpKeeperV2.Sudo().ChangeCloseAtOracle(
+					ctx, market.Pair, true, sdk.MustNewDecFromStr("0.05"), sudoer))
+			}
+			if !tc.fluctuationLimitRatio.IsNil() {
+				require.NoError(t, app.PerpKeeperV2.Sudo().ChangeFluctuationLimitRatio(
+					ctx, market.Pair, tc.fluctuationLimitRatio, sudoer))
+			}
+
+			if tc.snapshot {
+				app.PerpKeeperV2.SaveSnapshot(ctx, amm)
+			}
+
+			_, err = app.PerpKeeperV2.Sudo().ClampMarkToOracleBand(ctx, market.Pair, testutil.AccAddress())
+			require.Error(t, err, "only sudoers can clamp the mark price")
+
+			cost := sdk.ZeroInt()
+			for i := 0; i < tc.clamps || i == 0; i++ {
+				clampCost, err := app.PerpKeeperV2.Sudo().ClampMarkToOracleBand(ctx, market.Pair, sudoer)
+				require.NoError(t, err)
+				cost = cost.Add(clampCost)
+			}
+			require.Equal(t, tc.expectedCostSign, cost.Sign())
+
+			clamped, err := app.PerpKeeperV2.GetAMM(ctx, market.Pair)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMarkPrice.String(), clamped.InstMarkPrice().String())
+			require.Equal(t, amm.BaseReserve.String(), clamped.BaseReserve.String())
+			require.NoError(t, clamped.Validate())
+
+			// the inventory cost moved between the perp fund and the vault
+			perpFund := app.BankKeeper.GetBalance(ctx,
+				app.AccountKeeper.GetModuleAddress(types.PerpFundModuleAccount), types.TestingCollateralDenomNUSD)
+			require.Equal(t, fund[0].Amount.Sub(cost).String(), perpFund.Amount.String())
+		})
+	}
+}
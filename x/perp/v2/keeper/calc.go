@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
@@ -55,6 +59,65 @@ func (k Keeper) PositionNotionalTWAP(ctx sdk.Context,
 	)
 }
 
+// PositionNotional returns the notional value of the position, i.e. the quote
+// assets received for closing it, priced with either the spot reserves of its
+// AMM or the TWAP over the lookback window of its market.
+func (k Keeper) PositionNotional(
+	ctx sdk.Context, position types.Position, calcOption types.NotionalCalcOption,
+) (positionNotional sdk.Dec, err error) {
+	switch calcOption {
+	case types.NotionalCalcOption_SPOT:
+		amm, err := k.GetAMM(ctx, position.Pair)
+		if err != nil {
+			return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", position.Pair)
+		}
+		return PositionNotionalSpot(amm, position)
+	case types.NotionalCalcOption_TWAP:
+		market, err := k.GetMarket(ctx, position.Pair)
+		if err != nil {
+			return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", position.Pair)
+		}
+		return k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	default:
+		return sdk.Dec{}, fmt.Errorf("unknown notional calc option: %q", calcOption)
+	}
+}
+
+// GetPositionNotionalInDenom returns the spot notional value of the position
+// expressed in 'denom'. The notional is denominated in the collateral, so it is
+// converted with the oracle price of the "collateral:denom" pair or, if that
+// isn't available, with the inverse of the "denom:collateral" price.
+func (k Keeper) GetPositionNotionalInDenom(
+	ctx sdk.Context, position types.Position, denom string,
+) (notional sdk.Dec, err error) {
+	amm, err := k.GetAMM(ctx, position.Pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", position.Pair)
+	}
+
+	notional, err = PositionNotionalSpot(amm, position)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	collateral, err := k.Collateral.Get(ctx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if denom == collateral {
+		return notional, nil
+	}
+
+	oraclePair := asset.NewPair(collateral, denom)
+	if price, err := k.OracleKeeper.GetExchangeRate(ctx, oraclePair); err == nil && price.IsPositive() {
+		return notional.Mul(price), nil
+	}
+	if price, err := k.OracleKeeper.GetExchangeRate(ctx, oraclePair.Inverse()); err == nil && price.IsPositive() {
+		return notional.Quo(price), nil
+	}
+	return sdk.Dec{}, fmt.Errorf("no oracle price to convert %s to %s", collateral, denom)
+}
+
 // UnrealizedPnl calculates the unrealized profits and losses (PnL) of a position.
 func UnrealizedPnl(position types.Position, positionNotional sdk.Dec) (unrealizedPnlSigned sdk.Dec) {
 	if position.Size_.IsPositive() {
@@ -66,6 +129,381 @@ func UnrealizedPnl(position types.Position, positionNotional sdk.Dec) (unrealize
 	}
 }
 
+// PreferencePositionNotional returns whichever of 'spotNotional' and
+// 'twapNotional' gives the position the higher (MAX) or the lower (MIN)
+// unrealized PnL.
+func PreferencePositionNotional(
+	position types.Position,
+	spotNotional sdk.Dec,
+	twapNotional sdk.Dec,
+	pnlPreference types.PnLPreferenceOption,
+) sdk.Dec {
+	spotPnl := UnrealizedPnl(position, spotNotional)
+	twapPnl := UnrealizedPnl(position, twapNotional)
+	if (pnlPreference == types.PnLPreferenceOption_MAX) == spotPnl.GTE(twapPnl) {
+		return spotNotional
+	}
+	return twapNotional
+}
+
+// GetMarginRatio returns the margin ratio of the position, valued at the spot
+// or TWAP notional picked by 'pnlPreference'.
+func (k Keeper) GetMarginRatio(
+	ctx sdk.Context, position types.Position, pnlPreference types.PnLPreferenceOption,
+) (marginRatio sdk.Dec, err error) {
+	if pnlPreference != types.PnLPreferenceOption_MAX && pnlPreference != types.PnLPreferenceOption_MIN {
+		return sdk.Dec{}, fmt.Errorf("unknown pnl preference option: %q", pnlPreference)
+	}
+	market, err := k.GetMarket(ctx, position.Pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", position.Pair)
+	}
+	amm, err := k.GetAMM(ctx, position.Pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", position.Pair)
+	}
+
+	spotNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	twapNotional, err := k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	positionNotional := PreferencePositionNotional(position, spotNotional, twapNotional, pnlPreference)
+	return MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction), nil
+}
+
+// GetMarginRatioForLiquidation returns the margin ratio liquidations compare
+// against the maintenance margin ratio. It values the position at the notional
+// with the lower PnL for the trader, the most conservative of its TWAP notional
+// over the lookback window of the market and its size times the liquidation
+// price, see GetLiquidationPrice. The spot price is left out so that a
+// momentary spike alone does not make a position liquidatable.
+func (k Keeper) GetMarginRatioForLiquidation(ctx sdk.Context, position types.Position) (sdk.Dec, error) {
+	market, err := k.GetMarket(ctx, position.Pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", position.Pair)
+	}
+
+	twapNotional, err := k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	liquidationPrice, err := k.GetLiquidationPrice(ctx, market.Pair, k.liquidationTwapLookback(ctx, market))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	liquidationNotional := position.Size_.Abs().Mul(liquidationPrice)
+
+	positionNotional := PreferencePositionNotional(
+		position, twapNotional, liquidationNotional, types.PnLPreferenceOption_MIN,
+	)
+	return MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction), nil
+}
+
+// GetMarginRatioAtPrice returns the margin ratio the position would have if the
+// mark price were 'markPrice', valuing it at |size| * markPrice rather than
+// along the AMM curve. Pending funding is accounted for. This lets risk tools
+// stress test positions against hypothetical prices.
+func (k Keeper) GetMarginRatioAtPrice(
+	ctx sdk.Context, position types.Position, markPrice sdk.Dec,
+) (marginRatio sdk.Dec, err error) {
+	if markPrice.IsNil() || !markPrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("mark price must be positive, got: %s", markPrice)
+	}
+	market, err := k.GetMarket(ctx, position.Pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", position.Pair)
+	}
+
+	positionNotional := position.Size_.Abs().Mul(markPrice)
+	return MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction), nil
+}
+
+// CurrentLeverage returns the effective leverage of a trader's position at the
+// current spot price, defined as positionNotional / (margin + unrealizedPnl).
+// Unlike the leverage chosen when the position was opened, it drifts as the
+// price moves: it falls when the position is winning and rises when losing.
+func (k Keeper) CurrentLeverage(
+	ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress,
+) (leverage sdk.Dec, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	position, err := k.GetPosition(ctx, pair, market.Version, trader)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	positionNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	equity := position.Margin.Add(UnrealizedPnl(position, positionNotional))
+	if !equity.IsPositive() {
+		return sdk.Dec{}, types.ErrBadDebt.Wrapf("position equity is %s", equity)
+	}
+
+	return positionNotional.Quo(equity), nil
+}
+
+// SimulateLeverageChange returns the margin that the trader would have to add
+// to their position to bring it to 'newLeverage' at the current spot price
+// without changing its size. A negative margin delta is the margin that could
+// be released instead. It also returns the margin ratio the position would
+// have after the change.
+func (k Keeper) SimulateLeverageChange(
+	ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress, newLeverage sdk.Dec,
+) (marginDelta sdk.Dec, marginRatio sdk.Dec, err error) {
+	if newLeverage.IsNil() || !newLeverage.IsPositive() {
+		return sdk.Dec{}, sdk.Dec{}, types.ErrUserLeverageNegative
+	}
+
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	if newLeverage.GT(market.MaxLeverage) {
+		return sdk.Dec{}, sdk.Dec{}, types.ErrLeverageIsTooHigh.Wrapf(
+			"leverage %s is higher than the max leverage %s", newLeverage, market.MaxLeverage)
+	}
+
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	position, err := k.GetPosition(ctx, pair, market.Version, trader)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	positionNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	equity := position.Margin.
+		Add(UnrealizedPnl(position, positionNotional)).
+		Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+	marginDelta = positionNotional.Quo(newLeverage).Sub(equity)
+
+	position.Margin = position.Margin.Add(marginDelta)
+	marginRatio = MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction)
+
+	return marginDelta, marginRatio, nil
+}
+
+// SimulateOpenPosition returns the position a market order opening a new
+// position of 'quoteAssetAmt' margin at 'leverage' on the 'dir' side of 'pair'
+// would result in, along with its margin ratio at the spot price after the
+// trade. The exchange and ecosystem fund fees are taken from the margin at the
+// market's fee ratios, without trader discounts. No state is changed and no
+// funds are transferred. The entry price is the position's open notional over
+// its absolute size.
+func (k Keeper) SimulateOpenPosition(
+	ctx sdk.Context, pair asset.Pair, dir types.Direction, quoteAssetAmt sdkmath.Int, leverage sdk.Dec,
+) (position types.Position, marginRatio sdk.Dec, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return types.Position{}, sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	if !market.Enabled {
+		return types.Position{}, sdk.Dec{}, types.ErrMarketNotEnabled.Wrapf("pair: %s", pair)
+	}
+	if err = checkMarketOrderRequirements(market, quoteAssetAmt, leverage); err != nil {
+		return types.Position{}, sdk.Dec{}, err
+	}
+
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return types.Position{}, sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	openNotionalPreFees := leverage.MulInt(quoteAssetAmt)
+	fees := market.ExchangeFeeRatio.Mul(openNotionalPreFees).RoundInt().
+		Add(market.EcosystemFundFeeRatio.Mul(openNotionalPreFees).RoundInt())
+	openNotional := leverage.MulInt(quoteAssetAmt.Sub(fees))
+
+	updatedAMM := amm.Clone()
+	baseAssetDeltaAbs, err := updatedAMM.SwapQuoteAsset(openNotional, dir)
+	if err != nil {
+		return types.Position{}, sdk.Dec{}, err
+	}
+	size := baseAssetDeltaAbs
+	if dir == types.Direction_SHORT {
+		size = size.Neg()
+	}
+
+	position = types.Position{
+		Pair:                            pair,
+		Size_:                           size,
+		Margin:                          openNotional.Quo(leverage),
+		OpenNotional:                    openNotional,
+		LatestCumulativePremiumFraction: market.LatestCumulativePremiumFraction,
+		LastUpdatedBlockNumber:          ctx.BlockHeight(),
+	}
+
+	positionNotional, err := PositionNotionalSpot(updatedAMM, position)
+	if err != nil {
+		return types.Position{}, sdk.Dec{}, err
+	}
+	return position, MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction), nil
+}
+
+// TraderAtRiskPairs returns the pairs of enabled markets where the trader's
+// position is not yet liquidatable but its margin ratio, see
+// GetMarginRatioForLiquidation, is within 'warningBufferPct' of the maintenance
+// margin ratio, i.e. mmr <= marginRatio < mmr * (1 + warningBufferPct).
+func (k Keeper) TraderAtRiskPairs(
+	ctx sdk.Context, trader sdk.AccAddress, warningBufferPct sdk.Dec,
+) (pairs []asset.Pair, err error) {
+	if warningBufferPct.IsNil() || warningBufferPct.IsNegative() {
+		return nil, fmt.Errorf("warning buffer must be non-negative, not: %s", warningBufferPct)
+	}
+
+	markets := k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values()
+	for _, market := range markets {
+		if !market.Enabled {
+			continue
+		}
+
+		position, err := k.GetPosition(ctx, market.Pair, market.Version, trader)
+		if err != nil {
+			continue
+		}
+
+		marginRatio, err := k.GetMarginRatioForLiquidation(ctx, position)
+		if err != nil {
+			return nil, err
+		}
+
+		warningMarginRatio := market.MaintenanceMarginRatio.Mul(sdk.OneDec().Add(warningBufferPct))
+		if marginRatio.GTE(market.MaintenanceMarginRatio) && marginRatio.LT(warningMarginRatio) {
+			pairs = append(pairs, market.Pair)
+		}
+	}
+
+	return pairs, nil
+}
+
+// GetMarkOracleSpread returns the relative spread between the mark price of
+// the market's AMM and the oracle price of its underlying,
+// (markPrice - oraclePrice) / oraclePrice. The spread is positive when the
+// mark price is above the oracle price.
+func (k Keeper) GetMarkOracleSpread(ctx sdk.Context, pair asset.Pair) (spread sdk.Dec, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	oraclePrice, err := k.OracleKeeper.GetUnderlyingPrice(ctx, market.OraclePair)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !oraclePrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("oracle price of %s must be positive, not: %s", market.OraclePair, oraclePrice)
+	}
+
+	return amm.InstMarkPrice().Sub(oraclePrice).Quo(oraclePrice), nil
+}
+
+// GetIndexAdjustedMarkPrice returns a blend of the mark price of the market's
+// AMM and the oracle price of its underlying,
+// weight * markPrice + (1 - weight) * oraclePrice, with 'weight' in [0, 1].
+// A weight of one returns the mark price and a weight of zero the oracle price.
+func (k Keeper) GetIndexAdjustedMarkPrice(ctx sdk.Context, pair asset.Pair, weight sdk.Dec) (price sdk.Dec, err error) {
+	if weight.IsNil() || weight.IsNegative() || weight.GT(sdk.OneDec()) {
+		return sdk.Dec{}, fmt.Errorf("weight must be in [0, 1], not: %s", weight)
+	}
+
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	oraclePrice, err := k.OracleKeeper.GetUnderlyingPrice(ctx, market.OraclePair)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !oraclePrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("oracle price of %s must be positive, not: %s", market.OraclePair, oraclePrice)
+	}
+
+	return weight.Mul(amm.InstMarkPrice()).Add(sdk.OneDec().Sub(weight).Mul(oraclePrice)), nil
+}
+
+// GetCrossPoolIndex returns the depth-weighted median of the mark prices of
+// the AMMs of every enabled market whose base denom is 'baseDenom', e.g. of
+// BTC:NUSD and BTC:USDC for "ubtc". Each mark price is weighted by the
+// SqrtDepth of its AMM, so deeper pools weigh more and a thin pool cannot move
+// the index on its own. AMMs without a mark price or depth are left out.
+func (k Keeper) GetCrossPoolIndex(ctx sdk.Context, baseDenom string) (index sdk.Dec, err error) {
+	type weightedPrice struct {
+		price  sdk.Dec
+		weight sdk.Dec
+	}
+
+	var prices []weightedPrice
+	totalWeight := sdk.ZeroDec()
+	markets := k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values()
+	for _, market := range markets {
+		if !market.Enabled || market.Pair.BaseDenom() != baseDenom {
+			continue
+		}
+
+		amm, err := k.AMMs.Get(ctx, collections.Join(market.Pair, market.Version))
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		markPrice := amm.InstMarkPrice()
+		if !markPrice.IsPositive() || amm.SqrtDepth.IsNil() || !amm.SqrtDepth.IsPositive() {
+			continue
+		}
+		prices = append(prices, weightedPrice{price: markPrice, weight: amm.SqrtDepth})
+		totalWeight = totalWeight.Add(amm.SqrtDepth)
+	}
+	if len(prices) == 0 {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("no market with base denom %s", baseDenom)
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].price.LT(prices[j].price)
+	})
+
+	// the weighted median is the first price at which the cumulative weight
+	// reaches half of the total weight
+	cumulativeWeight := sdk.ZeroDec()
+	for _, p := range prices {
+		cumulativeWeight = cumulativeWeight.Add(p.weight)
+		if cumulativeWeight.MulInt64(2).GTE(totalWeight) {
+			return p.price, nil
+		}
+	}
+	return prices[len(prices)-1].price, nil
+}
+
 // MarginRatio Given a position and it's notional value, returns the margin ratio.
 func MarginRatio(
 	position types.Position,
@@ -96,3 +534,155 @@ func FundingPayment(position types.Position, marketLatestCumulativePremiumFracti
 		Sub(position.LatestCumulativePremiumFraction).
 		Mul(position.Size_)
 }
+
+// CalcRemainMarginWithFundingPayment returns the margin left in the position
+// after applying 'marginDelta' and the funding payment owed since the
+// position's last interaction. If the funding payment exceeds the margin, the
+// remaining margin is zero and the shortfall is returned as bad debt.
+func CalcRemainMarginWithFundingPayment(
+	position types.Position,
+	marginDelta sdk.Dec,
+	marketLatestCumulativePremiumFraction sdk.Dec,
+) (remainingMargin sdk.Dec, badDebt sdk.Dec, fundingPayment sdk.Dec) {
+	fundingPayment = FundingPayment(position, marketLatestCumulativePremiumFraction)
+	remainingMargin = position.Margin.Add(marginDelta).Sub(fundingPayment)
+
+	if remainingMargin.IsNegative() {
+		return sdk.ZeroDec(), remainingMargin.Abs(), fundingPayment
+	}
+	return remainingMargin, sdk.ZeroDec(), fundingPayment
+}
+
+// PositionSettlementPreview is the state a position would have after its
+// funding payment is settled.
+type PositionSettlementPreview struct {
+	Margin                          sdk.Dec
+	BadDebt                         sdk.Dec
+	FundingPayment                  sdk.Dec
+	LatestCumulativePremiumFraction sdk.Dec
+}
+
+// PreviewPositionSettlement returns the margin, bad debt and funding payment
+// the trader's position would have if its funding payment were settled now,
+// without changing any state.
+func (k Keeper) PreviewPositionSettlement(
+	ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress,
+) (preview PositionSettlementPreview, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return preview, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	position, err := k.GetPosition(ctx, pair, market.Version, trader)
+	if err != nil {
+		return preview, err
+	}
+
+	margin, badDebt, fundingPayment := CalcRemainMarginWithFundingPayment(
+		position, sdk.ZeroDec(), market.LatestCumulativePremiumFraction)
+
+	return PositionSettlementPreview{
+		Margin:                          margin,
+		BadDebt:                         badDebt,
+		FundingPayment:                  fundingPayment,
+		LatestCumulativePremiumFraction: market.LatestCumulativePremiumFraction,
+	}, nil
+}
+
+// RemainMarginPreview is the outcome of CalcRemainMarginWithFundingPayment for
+// a position.
+type RemainMarginPreview struct {
+	RemainingMargin sdk.Dec // margin after the margin delta and the funding payment, never negative
+	BadDebt         sdk.Dec // amount by which the margin delta and funding payment exceed the margin, never negative
+	FundingPayment  sdk.Dec // signed, paid by the trader if positive and received if negative
+}
+
+// PreviewRemainMargin returns the margin the trader's position would keep if
+// 'marginDelta', e.g. a realized PnL, were added to it and its pending funding
+// payment settled, without changing any state.
+func (k Keeper) PreviewRemainMargin(
+	ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress, marginDelta sdk.Dec,
+) (preview RemainMarginPreview, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return preview, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	position, err := k.GetPosition(ctx, pair, market.Version, trader)
+	if err != nil {
+		return preview, err
+	}
+
+	margin, badDebt, fundingPayment := CalcRemainMarginWithFundingPayment(
+		position, marginDelta, market.LatestCumulativePremiumFraction)
+
+	return RemainMarginPreview{
+		RemainingMargin: margin,
+		BadDebt:         badDebt,
+		FundingPayment:  fundingPayment,
+	}, nil
+}
+
+// AmmPosition is the net position the AMM holds as the counterparty of every
+// trader of a market, valued at the mark price.
+type AmmPosition struct {
+	Size          sdk.Dec // signed, the opposite of the traders' net open interest
+	Notional      sdk.Dec // |Size| * mark price
+	UnrealizedPnl sdk.Dec // the opposite of the traders' aggregate unrealized PnL
+}
+
+// GetAmmPosition returns the net size, notional and mark-to-market PnL of the
+// AMM's position on 'pair', which is the protocol's exposure to its traders.
+func (k Keeper) GetAmmPosition(ctx sdk.Context, pair asset.Pair) (ammPosition AmmPosition, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return ammPosition, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return ammPosition, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	// open notional of the traders' book, signed positive for longs
+	tradersOpenNotional := sdk.ZeroDec()
+	positions := k.Positions.Iterate(
+		ctx,
+		collections.PairRange[collections.Pair[asset.Pair, uint64], sdk.AccAddress]{}.
+			Prefix(collections.Join(pair, market.Version)),
+	).Values()
+	for _, position := range positions {
+		if position.Size_.IsNegative() {
+			tradersOpenNotional = tradersOpenNotional.Sub(position.OpenNotional)
+		} else {
+			tradersOpenNotional = tradersOpenNotional.Add(position.OpenNotional)
+		}
+	}
+
+	markPrice := amm.InstMarkPrice()
+	tradersNotional := amm.Bias().Mul(markPrice)
+
+	return AmmPosition{
+		Size:          amm.Bias().Neg(),
+		Notional:      tradersNotional.Abs(),
+		UnrealizedPnl: tradersOpenNotional.Sub(tradersNotional),
+	}, nil
+}
+
+// CalcImbalanceFee returns the fee, in quote assets, charged on a trade that
+// moves the AMM from 'ammBefore' to 'ammAfter'. The fee is 'imbalanceFeeRatio'
+// of the notional, at the mark price before the trade, by which the trade
+// increases the open interest imbalance |TotalLong - TotalShort|. Trades that
+// keep or reduce the imbalance pay no fee.
+func CalcImbalanceFee(ammBefore, ammAfter types.AMM, imbalanceFeeRatio sdk.Dec) sdk.Dec {
+	if imbalanceFeeRatio.IsNil() || !imbalanceFeeRatio.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	imbalanceIncrease := ammAfter.Bias().Abs().Sub(ammBefore.Bias().Abs())
+	if !imbalanceIncrease.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return imbalanceIncrease.Mul(ammBefore.InstMarkPrice()).Mul(imbalanceFeeRatio)
+}
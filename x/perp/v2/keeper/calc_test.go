@@ -4,14 +4,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/x/perp/v2/keeper"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 
@@ -138,6 +141,157 @@ func TestPositionNotionalTWAP(t *testing.T) {
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
 
+func TestPositionNotional(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithBlockTime(time.Now())
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+
+	// the price was 2 for the last 10 minutes, and only just dropped to 1
+	recentAMM := amm
+	recentAMM.PriceMultiplier = sdk.NewDec(2)
+	for timestamp, snapshotAMM := range map[time.Time]types.AMM{
+		ctx.BlockTime().Add(-10 * time.Minute): recentAMM,
+		ctx.BlockTime():                        amm,
+	} {
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, timestamp), types.ReserveSnapshot{
+			Amm:         snapshotAMM,
+			TimestampMs: timestamp.UnixMilli(),
+		})
+	}
+
+	position := types.Position{
+		TraderAddress: testutil.AccAddress().String(),
+		Pair:          pair,
+		Size_:         sdk.NewDec(100),
+	}
+
+	spotNotional, err := app.PerpKeeperV2.PositionNotional(ctx, position, types.NotionalCalcOption_SPOT)
+	require.NoError(t, err)
+	expectedSpotNotional, err := keeper.PositionNotionalSpot(amm, position)
+	require.NoError(t, err)
+	require.Equal(t, expectedSpotNotional.String(), spotNotional.String())
+
+	twapNotional, err := app.PerpKeeperV2.PositionNotional(ctx, position, types.NotionalCalcOption_TWAP)
+	require.NoError(t, err)
+	require.Equal(t, spotNotional.MulInt64(2).String(), twapNotional.String())
+
+	_, err = app.PerpKeeperV2.PositionNotional(ctx, position, "oracle")
+	require.Error(t, err)
+}
+
+func TestGetMarginRatio(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithBlockTime(time.Now())
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+
+	// spot price 1 and TWAP price 2
+	recentAMM := amm
+	recentAMM.PriceMultiplier = sdk.NewDec(2)
+	for timestamp, snapshotAMM := range map[time.Time]types.AMM{
+		ctx.BlockTime().Add(-10 * time.Minute): recentAMM,
+		ctx.BlockTime():                        amm,
+	} {
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, timestamp), types.ReserveSnapshot{
+			Amm:         snapshotAMM,
+			TimestampMs: timestamp.UnixMilli(),
+		})
+	}
+
+	for _, tc := range []struct {
+		name string
+		size sdk.Dec
+		// whether the spot notional gives the trader the higher PnL
+		spotIsMax bool
+	}{
+		{name: "long profits from the higher TWAP", size: sdk.NewDec(100), spotIsMax: false},
+		{name: "short profits from the lower spot", size: sdk.NewDec(-100), spotIsMax: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			position := types.Position{
+				TraderAddress:                   testutil.AccAddress().String(),
+				Pair:                            pair,
+				Size_:                           tc.size,
+				Margin:                          sdk.NewDec(10),
+				OpenNotional:                    sdk.NewDec(150),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			}
+			spotNotional, err := keeper.PositionNotionalSpot(amm, position)
+			require.NoError(t, err)
+			spotMarginRatio := keeper.MarginRatio(position, spotNotional, sdk.ZeroDec())
+			twapMarginRatio := keeper.MarginRatio(position, spotNotional.MulInt64(2), sdk.ZeroDec())
+
+			expectedMax, expectedMin := twapMarginRatio, spotMarginRatio
+			if tc.spotIsMax {
+				expectedMax, expectedMin = spotMarginRatio, twapMarginRatio
+			}
+
+			maxMarginRatio, err := app.PerpKeeperV2.GetMarginRatio(ctx, position, types.PnLPreferenceOption_MAX)
+			require.NoError(t, err)
+			minMarginRatio, err := app.PerpKeeperV2.GetMarginRatio(ctx, position, types.PnLPreferenceOption_MIN)
+			require.NoError(t, err)
+
+			require.Equal(t, expectedMax.String(), maxMarginRatio.String())
+			require.Equal(t, expectedMin.String(), minMarginRatio.String())
+			require.True(t, minMarginRatio.LT(maxMarginRatio))
+		})
+	}
+}
+
+func TestGetMarginRatioAtPrice(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+	require.NoError(t, err)
+
+	// a 10x long opened at a price of 1
+	position := types.Position{
+		TraderAddress:                   testutil.AccAddress().String(),
+		Pair:                            market.Pair,
+		Size_:                           sdk.NewDec(100),
+		Margin:                          sdk.NewDec(10),
+		OpenNotional:                    sdk.NewDec(100),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	}
+
+	marginRatio, err := app.PerpKeeperV2.GetMarginRatioAtPrice(ctx, position, sdk.OneDec())
+	require.NoError(t, err)
+	require.Equal(t, "0.100000000000000000", marginRatio.String())
+
+	// sweep the price down until the margin ratio falls below maintenance:
+	// (10 + 100p - 100) / 100p = 0.0625 at p = 0.96
+	step := sdk.MustNewDecFromStr("0.001")
+	price := sdk.OneDec()
+	for {
+		marginRatio, err = app.PerpKeeperV2.GetMarginRatioAtPrice(ctx, position, price)
+		require.NoError(t, err)
+		if marginRatio.LT(market.MaintenanceMarginRatio) {
+			break
+		}
+		price = price.Sub(step)
+	}
+	require.Equal(t, "0.959000000000000000", price.String())
+
+	marginRatio, err = app.PerpKeeperV2.GetMarginRatioAtPrice(ctx, position, sdk.MustNewDecFromStr("0.96"))
+	require.NoError(t, err)
+	require.Equal(t, market.MaintenanceMarginRatio.String(), marginRatio.String())
+
+	_, err = app.PerpKeeperV2.GetMarginRatioAtPrice(ctx, position, sdk.ZeroDec())
+	require.Error(t, err)
+
+	position.Pair = asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	_, err = app.PerpKeeperV2.GetMarginRatioAtPrice(ctx, position, sdk.OneDec())
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+}
+
 func TestUnrealizedPnl(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -393,3 +547,709 @@ func TestMarginRatioFail(t *testing.T) {
 	_, err = keeper.PositionNotionalSpot(types.AMM{}, types.Position{})
 	require.ErrorContains(t, err, "input base amt is nil")
 }
+
+func TestCurrentLeverage(t *testing.T) {
+	alice := testutil.AccAddress()
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	tolerance := sdk.MustNewDecFromStr("0.000001")
+
+	tests := []struct {
+		name             string
+		priceMultiplier  sdk.Dec
+		expectedLeverage sdk.Dec
+	}{
+		{
+			name:             "price unchanged, leverage at entry",
+			priceMultiplier:  sdk.OneDec(),
+			expectedLeverage: sdk.NewDec(5),
+		},
+		{
+			name:             "winning long, leverage drops",
+			priceMultiplier:  sdk.MustNewDecFromStr("1.2"),
+			expectedLeverage: sdk.NewDec(3), // 120 / (20 + 20)
+		},
+		{
+			name:             "losing long, leverage rises",
+			priceMultiplier:  sdk.MustNewDecFromStr("0.9"),
+			expectedLeverage: sdk.NewDec(9), // 90 / (20 - 10)
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			createTestMarket(t, app, ctx, pair, WithEnabled(true), WithPricePeg(tc.priceMultiplier))
+			app.PerpKeeperV2.SavePosition(ctx, pair, 1, alice, types.Position{
+				TraderAddress:                   alice.String(),
+				Pair:                            pair,
+				Size_:                           sdk.NewDec(100),
+				Margin:                          sdk.NewDec(20),
+				OpenNotional:                    sdk.NewDec(100),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			})
+
+			leverage, err := app.PerpKeeperV2.CurrentLeverage(ctx, pair, alice)
+			require.NoError(t, err)
+			require.Truef(t, leverage.Sub(tc.expectedLeverage).Abs().LTE(tolerance),
+				"expected %s, got %s", tc.expectedLeverage, leverage)
+		})
+	}
+
+	t.Run("position not found", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+		_, err := app.PerpKeeperV2.CurrentLeverage(ctx, pair, alice)
+		require.ErrorIs(t, err, types.ErrPositionNotFound)
+	})
+}
+
+func TestSimulateLeverageChange(t *testing.T) {
+	alice := testutil.AccAddress()
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	tolerance := sdk.MustNewDecFromStr("0.000001")
+
+	// position at 5x leverage: notional ~100, margin 20
+	setup := func() (*app.NibiruApp, sdk.Context) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+		app.PerpKeeperV2.SavePosition(ctx, pair, 1, alice, types.Position{
+			TraderAddress:                   alice.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(100),
+			Margin:                          sdk.NewDec(20),
+			OpenNotional:                    sdk.NewDec(100),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+		return app, ctx
+	}
+
+	tests := []struct {
+		name                string
+		newLeverage         sdk.Dec
+		expectedMarginDelta sdk.Dec
+		expectedMarginRatio sdk.Dec
+	}{
+		{
+			name:                "increase leverage, margin is released",
+			newLeverage:         sdk.NewDec(10),
+			expectedMarginDelta: sdk.NewDec(-10),
+			expectedMarginRatio: sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:                "decrease leverage, margin is required",
+			newLeverage:         sdk.NewDec(2),
+			expectedMarginDelta: sdk.NewDec(30),
+			expectedMarginRatio: sdk.MustNewDecFromStr("0.5"),
+		},
+		{
+			name:                "same leverage, nothing changes",
+			newLeverage:         sdk.NewDec(5),
+			expectedMarginDelta: sdk.ZeroDec(),
+			expectedMarginRatio: sdk.MustNewDecFromStr("0.2"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := setup()
+
+			marginDelta, marginRatio, err := app.PerpKeeperV2.SimulateLeverageChange(ctx, pair, alice, tc.newLeverage)
+			require.NoError(t, err)
+			require.Truef(t, marginDelta.Sub(tc.expectedMarginDelta).Abs().LTE(tolerance),
+				"expected margin delta %s, got %s", tc.expectedMarginDelta, marginDelta)
+			require.Truef(t, marginRatio.Sub(tc.expectedMarginRatio).Abs().LTE(tolerance),
+				"expected margin ratio %s, got %s", tc.expectedMarginRatio, marginRatio)
+		})
+	}
+
+	t.Run("leverage above max leverage", func(t *testing.T) {
+		app, ctx := setup()
+		_, _, err := app.PerpKeeperV2.SimulateLeverageChange(ctx, pair, alice, sdk.NewDec(11))
+		require.ErrorIs(t, err, types.ErrLeverageIsTooHigh)
+	})
+
+	t.Run("non-positive leverage", func(t *testing.T) {
+		app, ctx := setup()
+		_, _, err := app.PerpKeeperV2.SimulateLeverageChange(ctx, pair, alice, sdk.ZeroDec())
+		require.ErrorIs(t, err, types.ErrUserLeverageNegative)
+	})
+}
+
+func TestTraderAtRiskPairs(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairEthUsdc := asset.Registry.Pair(denoms.ETH, denoms.USDC)
+	pairAtomUsdc := asset.Registry.Pair(denoms.ATOM, denoms.USDC)
+	startTime := time.Now()
+
+	atRiskPairsShouldBe := func(warningBufferPct sdk.Dec, expectedPairs []asset.Pair) actionFn {
+		return func(app *app.NibiruApp, ctx sdk.Context) (outCtx sdk.Context, err error) {
+			pairs, err := app.PerpKeeperV2.TraderAtRiskPairs(ctx, alice, warningBufferPct)
+			require.NoError(t, err)
+			require.ElementsMatch(t, expectedPairs, pairs)
+			return ctx, nil
+		}
+	}
+
+	tc := TestCases{
+		TC("only near-maintenance positions are at risk").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				CreateCustomMarket(pairEthUsdc, WithEnabled(true)),
+				CreateCustomMarket(pairAtomUsdc, WithEnabled(true)),
+				// margin ratio ~ 0.06, already liquidatable
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+				// margin ratio ~ 0.07, at risk
+				InsertPosition(WithTrader(alice), WithPair(pairEthUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10300))),
+				// margin ratio ~ 0.1, healthy
+				InsertPosition(WithTrader(alice), WithPair(pairAtomUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10000))),
+			).
+			When(
+				MoveToNextBlock(),
+			).
+			Then(
+				// maintenance margin ratio is 0.0625
+				atRiskPairsShouldBe(sdk.MustNewDecFromStr("0.2"), []asset.Pair{pairEthUsdc}),
+				atRiskPairsShouldBe(sdk.OneDec(), []asset.Pair{pairEthUsdc, pairAtomUsdc}),
+				atRiskPairsShouldBe(sdk.ZeroDec(), nil),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestGetMarkOracleSpread(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	oraclePair := asset.NewPair(denoms.BTC, denoms.USD)
+
+	tests := []struct {
+		name           string
+		oraclePrice    sdk.Dec
+		expectedSpread sdk.Dec
+	}{
+		{
+			name:           "mark above oracle",
+			oraclePrice:    sdk.MustNewDecFromStr("0.8"),
+			expectedSpread: sdk.MustNewDecFromStr("0.25"),
+		},
+		{
+			name:           "mark below oracle",
+			oraclePrice:    sdk.MustNewDecFromStr("1.25"),
+			expectedSpread: sdk.MustNewDecFromStr("-0.2"),
+		},
+		{
+			name:           "mark equal to oracle",
+			oraclePrice:    sdk.OneDec(),
+			expectedSpread: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+			app.OracleKeeper.SetPrice(ctx, oraclePair, tc.oraclePrice)
+
+			spread, err := app.PerpKeeperV2.GetMarkOracleSpread(ctx, pair)
+			require.NoError(t, err)
+			assert.Truef(t, tc.expectedSpread.Equal(spread),
+				"expected %s, got %s", tc.expectedSpread, spread)
+		})
+	}
+
+	t.Run("zero oracle price", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+		app.OracleKeeper.SetPrice(ctx, oraclePair, sdk.ZeroDec())
+
+		_, err := app.PerpKeeperV2.GetMarkOracleSpread(ctx, pair)
+		require.ErrorContains(t, err, "must be positive")
+	})
+
+	t.Run("pair not found", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+
+		_, err := app.PerpKeeperV2.GetMarkOracleSpread(ctx, asset.NewPair(denoms.ETH, denoms.NUSD))
+		require.ErrorIs(t, err, types.ErrPairNotFound)
+	})
+}
+
+func TestGetPositionNotionalInDenom(t *testing.T) {
+	alice := testutil.AccAddress()
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	position := types.Position{
+		TraderAddress:                   alice.String(),
+		Pair:                            pair,
+		Size_:                           sdk.NewDec(1_000_000),
+		Margin:                          sdk.NewDec(100_000),
+		OpenNotional:                    sdk.NewDec(1_000_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	}
+
+	setup := func() (*app.NibiruApp, sdk.Context, sdk.Dec) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+		app.OracleKeeper.SetPrice(ctx, asset.NewPair(types.TestingCollateralDenomNUSD, denoms.USDC), sdk.MustNewDecFromStr("1.01"))
+		app.OracleKeeper.SetPrice(ctx, asset.NewPair(denoms.ETH, types.TestingCollateralDenomNUSD), sdk.NewDec(2_000))
+
+		notional, err := keeper.PositionNotionalSpot(*mock.TestAMMDefault(), position)
+		require.NoError(t, err)
+		return app, ctx, notional
+	}
+
+	t.Run("collateral denom", func(t *testing.T) {
+		app, ctx, notional := setup()
+		notionalInDenom, err := app.PerpKeeperV2.GetPositionNotionalInDenom(ctx, position, types.TestingCollateralDenomNUSD)
+		require.NoError(t, err)
+		assert.Equal(t, notional.String(), notionalInDenom.String())
+	})
+
+	t.Run("priced with the collateral as base", func(t *testing.T) {
+		app, ctx, notional := setup()
+		notionalInDenom, err := app.PerpKeeperV2.GetPositionNotionalInDenom(ctx, position, denoms.USDC)
+		require.NoError(t, err)
+		assert.Equal(t, notional.Mul(sdk.MustNewDecFromStr("1.01")).String(), notionalInDenom.String())
+	})
+
+	t.Run("priced with the collateral as quote", func(t *testing.T) {
+		app, ctx, notional := setup()
+		notionalInDenom, err := app.PerpKeeperV2.GetPositionNotionalInDenom(ctx, position, denoms.ETH)
+		require.NoError(t, err)
+		assert.Equal(t, notional.Quo(sdk.NewDec(2_000)).String(), notionalInDenom.String())
+	})
+
+	t.Run("no oracle price", func(t *testing.T) {
+		app, ctx, _ := setup()
+		_, err := app.PerpKeeperV2.GetPositionNotionalInDenom(ctx, position, denoms.ATOM)
+		require.ErrorContains(t, err, "no oracle price")
+	})
+}
+
+func TestPreviewPositionSettlement(t *testing.T) {
+	alice := testutil.AccAddress()
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	tests := []struct {
+		name                   string
+		latestCPF              sdk.Dec
+		expectedMargin         sdk.Dec
+		expectedBadDebt        sdk.Dec
+		expectedFundingPayment sdk.Dec
+	}{
+		{
+			name:                   "no funding since last interaction",
+			latestCPF:              sdk.MustNewDecFromStr("0.01"),
+			expectedMargin:         sdk.NewDec(20),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.ZeroDec(),
+		},
+		{
+			name:                   "long pays funding",
+			latestCPF:              sdk.MustNewDecFromStr("0.06"),
+			expectedMargin:         sdk.NewDec(15),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.NewDec(5),
+		},
+		{
+			name:                   "long receives funding",
+			latestCPF:              sdk.MustNewDecFromStr("-0.04"),
+			expectedMargin:         sdk.NewDec(25),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.NewDec(-5),
+		},
+		{
+			name:                   "funding payment exceeds margin",
+			latestCPF:              sdk.MustNewDecFromStr("0.31"),
+			expectedMargin:         sdk.ZeroDec(),
+			expectedBadDebt:        sdk.NewDec(10),
+			expectedFundingPayment: sdk.NewDec(30),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			createTestMarket(t, app, ctx, pair, WithEnabled(true), WithLatestMarketCPF(tc.latestCPF))
+			position := types.Position{
+				TraderAddress:                   alice.String(),
+				Pair:                            pair,
+				Size_:                           sdk.NewDec(100),
+				Margin:                          sdk.NewDec(20),
+				OpenNotional:                    sdk.NewDec(100),
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.01"),
+			}
+			app.PerpKeeperV2.SavePosition(ctx, pair, 1, alice, position)
+
+			preview, err := app.PerpKeeperV2.PreviewPositionSettlement(ctx, pair, alice)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMargin.String(), preview.Margin.String())
+			assert.Equal(t, tc.expectedBadDebt.String(), preview.BadDebt.String())
+			assert.Equal(t, tc.expectedFundingPayment.String(), preview.FundingPayment.String())
+			assert.Equal(t, tc.latestCPF.String(), preview.LatestCumulativePremiumFraction.String())
+
+			// the preview matches a direct call
+			margin, badDebt, fundingPayment := keeper.CalcRemainMarginWithFundingPayment(
+				position, sdk.ZeroDec(), tc.latestCPF)
+			assert.Equal(t, margin.String(), preview.Margin.String())
+			assert.Equal(t, badDebt.String(), preview.BadDebt.String())
+			assert.Equal(t, fundingPayment.String(), preview.FundingPayment.String())
+		})
+	}
+
+	t.Run("position not found", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+		_, err := app.PerpKeeperV2.PreviewPositionSettlement(ctx, pair, alice)
+		require.ErrorIs(t, err, types.ErrPositionNotFound)
+	})
+}
+
+func TestCalcRemainMarginWithFundingPayment(t *testing.T) {
+	// a position with 20 of margin, last updated at a cumulative premium
+	// fraction of 0.01
+	tests := []struct {
+		name        string
+		size        sdk.Dec
+		marginDelta sdk.Dec
+		latestCPF   sdk.Dec
+
+		expectedMargin         sdk.Dec
+		expectedBadDebt        sdk.Dec
+		expectedFundingPayment sdk.Dec
+	}{
+		{
+			name:                   "positive pnl",
+			size:                   sdk.NewDec(100),
+			marginDelta:            sdk.NewDec(10),
+			latestCPF:              sdk.MustNewDecFromStr("0.01"),
+			expectedMargin:         sdk.NewDec(30),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.ZeroDec(),
+		},
+		{
+			name:                   "negative pnl within the margin",
+			size:                   sdk.NewDec(100),
+			marginDelta:            sdk.NewDec(-15),
+			latestCPF:              sdk.MustNewDecFromStr("0.01"),
+			expectedMargin:         sdk.NewDec(5),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.ZeroDec(),
+		},
+		{
+			name:                   "negative pnl produces bad debt",
+			size:                   sdk.NewDec(100),
+			marginDelta:            sdk.NewDec(-25),
+			latestCPF:              sdk.MustNewDecFromStr("0.01"),
+			expectedMargin:         sdk.ZeroDec(),
+			expectedBadDebt:        sdk.NewDec(5),
+			expectedFundingPayment: sdk.ZeroDec(),
+		},
+		{
+			name:                   "zero funding",
+			size:                   sdk.NewDec(100),
+			marginDelta:            sdk.ZeroDec(),
+			latestCPF:              sdk.MustNewDecFromStr("0.01"),
+			expectedMargin:         sdk.NewDec(20),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.ZeroDec(),
+		},
+		{
+			name:                   "long pays a positive funding cost",
+			size:                   sdk.NewDec(100),
+			marginDelta:            sdk.ZeroDec(),
+			latestCPF:              sdk.MustNewDecFromStr("0.06"),
+			expectedMargin:         sdk.NewDec(15),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.NewDec(5),
+		},
+		{
+			name:                   "long receives a negative funding rebate",
+			size:                   sdk.NewDec(100),
+			marginDelta:            sdk.ZeroDec(),
+			latestCPF:              sdk.MustNewDecFromStr("-0.04"),
+			expectedMargin:         sdk.NewDec(25),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.NewDec(-5),
+		},
+		{
+			name:                   "short receives a rebate when longs pay",
+			size:                   sdk.NewDec(-100),
+			marginDelta:            sdk.ZeroDec(),
+			latestCPF:              sdk.MustNewDecFromStr("0.06"),
+			expectedMargin:         sdk.NewDec(25),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.NewDec(-5),
+		},
+		{
+			name:                   "negative pnl and funding cost together produce bad debt",
+			size:                   sdk.NewDec(100),
+			marginDelta:            sdk.NewDec(-18),
+			latestCPF:              sdk.MustNewDecFromStr("0.06"),
+			expectedMargin:         sdk.ZeroDec(),
+			expectedBadDebt:        sdk.NewDec(3),
+			expectedFundingPayment: sdk.NewDec(5),
+		},
+		{
+			name:                   "funding rebate covers a negative pnl",
+			size:                   sdk.NewDec(100),
+			marginDelta:            sdk.NewDec(-22),
+			latestCPF:              sdk.MustNewDecFromStr("-0.04"),
+			expectedMargin:         sdk.NewDec(3),
+			expectedBadDebt:        sdk.ZeroDec(),
+			expectedFundingPayment: sdk.NewDec(-5),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			alice := testutil.AccAddress()
+			pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+			createTestMarket(t, app, ctx, pair, WithEnabled(true), WithLatestMarketCPF(tc.latestCPF))
+			position := types.Position{
+				TraderAddress:                   alice.String(),
+				Pair:                            pair,
+				Size_:                           tc.size,
+				Margin:                          sdk.NewDec(20),
+				OpenNotional:                    tc.size.Abs(),
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.01"),
+			}
+			app.PerpKeeperV2.SavePosition(ctx, pair, 1, alice, position)
+
+			margin, badDebt, fundingPayment := keeper.CalcRemainMarginWithFundingPayment(
+				position, tc.marginDelta, tc.latestCPF)
+			assert.Equal(t, tc.expectedMargin.String(), margin.String())
+			assert.Equal(t, tc.expectedBadDebt.String(), badDebt.String())
+			assert.Equal(t, tc.expectedFundingPayment.String(), fundingPayment.String())
+
+			preview, err := app.PerpKeeperV2.PreviewRemainMargin(ctx, pair, alice, tc.marginDelta)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMargin.String(), preview.RemainingMargin.String())
+			assert.Equal(t, tc.expectedBadDebt.String(), preview.BadDebt.String())
+			assert.Equal(t, tc.expectedFundingPayment.String(), preview.FundingPayment.String())
+		})
+	}
+
+	t.Run("position not found", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+		_, err := app.PerpKeeperV2.PreviewRemainMargin(ctx, pair, testutil.AccAddress(), sdk.ZeroDec())
+		require.ErrorIs(t, err, types.ErrPositionNotFound)
+	})
+}
+
+func TestGetAmmPosition(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	tests := []struct {
+		name            string
+		priceMultiplier sdk.Dec
+		expectedPnl     sdk.Dec
+	}{
+		{
+			// traders: alice (150 - 140) + bob (55 - 50) = 15
+			name:            "mark price 1",
+			priceMultiplier: sdk.OneDec(),
+			expectedPnl:     sdk.NewDec(-15),
+		},
+		{
+			// traders: alice (300 - 140) + bob (55 - 100) = 115
+			name:            "mark price 2",
+			priceMultiplier: sdk.NewDec(2),
+			expectedPnl:     sdk.NewDec(-115),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			createTestMarket(t, app, ctx, pair,
+				WithEnabled(true),
+				WithPricePeg(tc.priceMultiplier),
+				WithTotalLong(sdk.NewDec(150)),
+				WithTotalShort(sdk.NewDec(50)),
+			)
+
+			// a net-long trader book of 100
+			app.PerpKeeperV2.SavePosition(ctx, pair, 1, alice, types.Position{
+				TraderAddress:                   alice.String(),
+				Pair:                            pair,
+				Size_:                           sdk.NewDec(150),
+				Margin:                          sdk.NewDec(20),
+				OpenNotional:                    sdk.NewDec(140),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			})
+			app.PerpKeeperV2.SavePosition(ctx, pair, 1, bob, types.Position{
+				TraderAddress:                   bob.String(),
+				Pair:                            pair,
+				Size_:                           sdk.NewDec(-50),
+				Margin:                          sdk.NewDec(20),
+				OpenNotional:                    sdk.NewDec(55),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			})
+
+			ammPosition, err := app.PerpKeeperV2.GetAmmPosition(ctx, pair)
+			require.NoError(t, err)
+			assert.Equal(t, sdk.NewDec(-100).String(), ammPosition.Size.String())
+			assert.Equal(t, sdk.NewDec(100).Mul(tc.priceMultiplier).String(), ammPosition.Notional.String())
+			assert.Equal(t, tc.expectedPnl.String(), ammPosition.UnrealizedPnl.String())
+		})
+	}
+
+	t.Run("market not found", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		_, err := app.PerpKeeperV2.GetAmmPosition(ctx, pair)
+		require.ErrorIs(t, err, types.ErrPairNotFound)
+	})
+}
+
+func TestCalcImbalanceFee(t *testing.T) {
+	ratio := sdk.MustNewDecFromStr("0.01")
+	newAMM := func(totalLong, totalShort int64) types.AMM {
+		return *mock.TestAMMDefault().
+			WithPriceMultiplier(sdk.NewDec(2)).
+			WithTotalLong(sdk.NewDec(totalLong)).
+			WithTotalShort(sdk.NewDec(totalShort))
+	}
+	ammBefore := newAMM(150, 50)
+
+	tests := []struct {
+		name        string
+		ammAfter    types.AMM
+		ratio       sdk.Dec
+		expectedFee sdk.Dec
+	}{
+		{
+			// |200 - 50| - |150 - 50| = 50 => 50 * 2 * 0.01
+			name:        "increases imbalance",
+			ammAfter:    newAMM(200, 50),
+			ratio:       ratio,
+			expectedFee: sdk.NewDec(1),
+		},
+		{
+			name:        "reduces imbalance by the same size",
+			ammAfter:    newAMM(150, 100),
+			ratio:       ratio,
+			expectedFee: sdk.ZeroDec(),
+		},
+		{
+			// |150 - 300| - |150 - 50| = 50
+			name:        "flips the imbalance to the other side",
+			ammAfter:    newAMM(150, 300),
+			ratio:       ratio,
+			expectedFee: sdk.NewDec(1),
+		},
+		{
+			name:        "zero ratio",
+			ammAfter:    newAMM(200, 50),
+			ratio:       sdk.ZeroDec(),
+			expectedFee: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fee := keeper.CalcImbalanceFee(ammBefore, tc.ammAfter, tc.ratio)
+			assert.Equal(t, tc.expectedFee.String(), fee.String())
+		})
+	}
+}
+
+func TestGetIndexAdjustedMarkPrice(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	oraclePair := asset.NewPair(denoms.BTC, denoms.USD)
+
+	// the mark price of the default AMM is 1
+	tests := []struct {
+		name          string
+		weight        sdk.Dec
+		expectedPrice sdk.Dec
+	}{
+		{
+			name:          "zero weight returns the oracle price",
+			weight:        sdk.ZeroDec(),
+			expectedPrice: sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name:          "unit weight returns the mark price",
+			weight:        sdk.OneDec(),
+			expectedPrice: sdk.OneDec(),
+		},
+		{
+			name:          "half weight returns the average",
+			weight:        sdk.MustNewDecFromStr("0.5"),
+			expectedPrice: sdk.MustNewDecFromStr("1.25"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+			app.OracleKeeper.SetPrice(ctx, oraclePair, sdk.MustNewDecFromStr("1.5"))
+
+			price, err := app.PerpKeeperV2.GetIndexAdjustedMarkPrice(ctx, pair, tc.weight)
+			require.NoError(t, err)
+			assert.Truef(t, tc.expectedPrice.Equal(price),
+				"expected %s, got %s", tc.expectedPrice, price)
+		})
+	}
+
+	t.Run("weight out of range", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+		app.OracleKeeper.SetPrice(ctx, oraclePair, sdk.OneDec())
+
+		for _, weight := range []sdk.Dec{sdk.NewDec(-1), sdk.MustNewDecFromStr("1.1")} {
+			_, err := app.PerpKeeperV2.GetIndexAdjustedMarkPrice(ctx, pair, weight)
+			require.ErrorContains(t, err, "weight must be in [0, 1]")
+		}
+	})
+}
+
+func TestGetCrossPoolIndex(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	createMarket := func(pair asset.Pair, enabled bool, markPrice int64, sqrtDepth sdk.Dec) {
+		createTestMarket(t, app, ctx, pair,
+			WithEnabled(enabled),
+			WithPricePeg(sdk.NewDec(markPrice)),
+			WithSqrtDepth(sqrtDepth),
+		)
+	}
+
+	// The unweighted median of 1, 2 and 3 is 2, but the deepest pool quotes 3.
+	createMarket(asset.NewPair(denoms.BTC, denoms.NUSD), true, 1, sdk.NewDec(1e12))
+	createMarket(asset.NewPair(denoms.BTC, denoms.USDC), true, 2, sdk.NewDec(1e12))
+	createMarket(asset.NewPair(denoms.BTC, denoms.USDT), true, 3, sdk.NewDec(3e12))
+	// other base denoms and disabled markets are ignored
+	createMarket(asset.NewPair(denoms.ETH, denoms.NUSD), true, 100, sdk.NewDec(9e12))
+	createMarket(asset.NewPair(denoms.BTC, denoms.USD), false, 100, sdk.NewDec(9e12))
+
+	index, err := app.PerpKeeperV2.GetCrossPoolIndex(ctx, denoms.BTC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3).String(), index.String())
+
+	index, err = app.PerpKeeperV2.GetCrossPoolIndex(ctx, denoms.ETH)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100).String(), index.String())
+
+	_, err = app.PerpKeeperV2.GetCrossPoolIndex(ctx, denoms.NIBI)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+}
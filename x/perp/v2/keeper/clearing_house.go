@@ -76,6 +76,11 @@ func (k Keeper) MarketOrder(
 	var updatedAMM *types.AMM
 	openSideMatchesPosition := sameSideLong || sameSideShort
 	if isNewPosition || openSideMatchesPosition {
+		err = k.requireOpenInterestBelowCap(ctx, amm, dir, leverage.MulInt(quoteAssetAmtMinusFees))
+		if err != nil {
+			return nil, err
+		}
+
 		updatedAMM, positionResp, err = k.increasePosition(
 			ctx,
 			market,
@@ -114,10 +119,18 @@ func (k Keeper) MarketOrder(
 		if err != nil {
 			return nil, err
 		}
+
+		if isNewPosition || openSideMatchesPosition {
+			err = k.checkMaxLeverage(ctx, market.Pair, positionResp.Position.OpenNotional, positionResp.Position.Margin)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
+	imbalanceFee := k.imbalanceFee(ctx, market.Pair, amm, *updatedAMM)
 	if err = k.afterPositionUpdate(
-		ctx, market, traderAddr, *positionResp, types.ChangeReason_MarketOrder, transferredFee, position,
+		ctx, market, traderAddr, *positionResp, types.ChangeReason_MarketOrder, transferredFee, imbalanceFee, position,
 	); err != nil {
 		return nil, err
 	}
@@ -204,6 +217,7 @@ func (k Keeper) increasePosition(
 		OpenNotional:                    currentPosition.OpenNotional.Add(increasedNotional),
 		LatestCumulativePremiumFraction: market.LatestCumulativePremiumFraction,
 		LastUpdatedBlockNumber:          ctx.BlockHeight(),
+		MarginMode:                      currentPosition.MarginMode,
 	}
 	positionResp.UnrealizedPnlAfter = UnrealizedPnl(positionResp.Position, positionResp.PositionNotional)
 
@@ -242,6 +256,32 @@ func (k Keeper) openReversePosition(
 	}
 
 	if currentPositionNotional.GT(notionalToDecreaseBy) {
+		// A residual below the dust threshold is not worth keeping in state, so
+		// the position is closed entirely instead, as in PartialClose.
+		minPositionQuote := k.MinPositionQuote.GetOr(ctx, sdk.ZeroDec())
+		if minPositionQuote.IsPositive() && currentPositionNotional.Sub(notionalToDecreaseBy).LT(minPositionQuote) {
+			// The trader's limit is on the base traded for notionalToDecreaseBy,
+			// so it is scaled to the full position before the close.
+			if baseAmtLimit.IsPositive() {
+				dir := types.Direction_LONG
+				if currentPosition.Size_.IsPositive() {
+					dir = types.Direction_SHORT
+				}
+				fullCloseBaseLimit := baseAmtLimit.Mul(currentPositionNotional).Quo(notionalToDecreaseBy)
+				if err = checkUserLimits(fullCloseBaseLimit, currentPosition.Size_.Abs(), dir); err != nil {
+					return nil, nil, err
+				}
+			}
+			return k.closePositionEntirely(
+				ctx,
+				market,
+				amm,
+				currentPosition,
+				/* quoteAssetAmountLimit */ sdk.ZeroDec(),
+				/* checkLimits */ true,
+			)
+		}
+
 		// position reduction
 		return k.decreasePosition(
 			ctx,
@@ -250,6 +290,7 @@ func (k Keeper) openReversePosition(
 			currentPosition,
 			notionalToDecreaseBy,
 			baseAmtLimit,
+			/* checkLimits */ true,
 		)
 	} else {
 		// close and reverse
@@ -281,6 +322,7 @@ func (k Keeper) openReversePosition(
 //   - currentPosition: the current position
 //   - decreasedNotional: the amount of notional the user is decreasing by
 //   - baseAmtLimit: the user-specified limit on the base reserves
+//   - checkLimits: whether the pause, trade and fluctuation limits apply, false for liquidations
 //
 // returns:
 //   - updatedAMM: the updated AMM reserves
@@ -293,6 +335,7 @@ func (k Keeper) decreasePosition(
 	currentPosition types.Position,
 	decreasedNotional sdk.Dec,
 	baseAmtLimit sdk.Dec,
+	checkLimits bool,
 ) (updatedAMM *types.AMM, positionResp *types.PositionResp, err error) {
 	if currentPosition.Size_.IsZero() {
 		return nil, nil, fmt.Errorf("current position size is zero, nothing to decrease")
@@ -320,12 +363,13 @@ func (k Keeper) decreasePosition(
 	}
 	currentUnrealizedPnl := UnrealizedPnl(currentPosition, currentPositionNotional)
 
-	updatedAMM, baseAssetDeltaAbs, err := k.SwapQuoteAsset(
+	updatedAMM, baseAssetDeltaAbs, err := k.swapQuoteAsset(
 		ctx,
 		amm,
 		dir,
 		decreasedNotional,
 		baseAmtLimit,
+		checkLimits,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -342,10 +386,10 @@ func (k Keeper) decreasePosition(
 			Quo(currentPosition.Size_.Abs()),
 	)
 
-	fundingPayment := FundingPayment(currentPosition, market.LatestCumulativePremiumFraction)
-	remainingMargin := currentPosition.Margin.Add(positionResp.RealizedPnl).Sub(fundingPayment)
+	remainingMargin, badDebt, fundingPayment := CalcRemainMarginWithFundingPayment(
+		currentPosition, positionResp.RealizedPnl, market.LatestCumulativePremiumFraction)
 
-	positionResp.BadDebt = sdk.MinDec(sdk.ZeroDec(), remainingMargin).Abs()
+	positionResp.BadDebt = badDebt
 	positionResp.FundingPayment = fundingPayment
 	positionResp.UnrealizedPnlAfter = currentUnrealizedPnl.Sub(positionResp.RealizedPnl)
 	positionResp.ExchangedNotionalValue = decreasedNotional
@@ -370,10 +414,11 @@ func (k Keeper) decreasePosition(
 		TraderAddress:                   currentPosition.TraderAddress,
 		Pair:                            currentPosition.Pair,
 		Size_:                           currentPosition.Size_.Add(positionResp.ExchangedPositionSize),
-		Margin:                          sdk.MaxDec(sdk.ZeroDec(), remainingMargin).Abs(),
+		Margin:                          remainingMargin,
 		OpenNotional:                    remainOpenNotional,
 		LatestCumulativePremiumFraction: market.LatestCumulativePremiumFraction,
 		LastUpdatedBlockNumber:          ctx.BlockHeight(),
+		MarginMode:                      currentPosition.MarginMode,
 	}
 
 	if positionResp.Position.Size_.IsZero() {
@@ -386,6 +431,62 @@ func (k Keeper) decreasePosition(
 	return updatedAMM, positionResp, nil
 }
 
+// closeNotional returns the notional value at which a position is settled when
+// it is closed entirely. This is the spot notional of the AMM, unless the
+// market settles closes at the oracle price.
+func (k Keeper) closeNotional(
+	ctx sdk.Context, market types.Market, amm types.AMM, position types.Position,
+) (sdk.Dec, error) {
+	if !k.CloseAtOracle.Has(ctx, market.Pair) {
+		return PositionNotionalSpot(amm, position)
+	}
+
+	price, err := k.oracleSettlementPrice(ctx, market, amm)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return position.Size_.Abs().Mul(price), nil
+}
+
+// oracleSettlementPrice returns the oracle price of the market, bounded to
+// within MaxOracleSpreadRatio of the mark price if the market has one.
+func (k Keeper) oracleSettlementPrice(ctx sdk.Context, market types.Market, amm types.AMM) (sdk.Dec, error) {
+	oraclePrice, err := k.OracleKeeper.GetUnderlyingPrice(ctx, market.OraclePair)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !oraclePrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("oracle price of %s must be positive, not: %s", market.OraclePair, oraclePrice)
+	}
+
+	maxSpreadRatio, err := k.MaxOracleSpreadRatios.Get(ctx, market.Pair)
+	if err != nil {
+		return oraclePrice, nil
+	}
+
+	markPrice := amm.InstMarkPrice()
+	lowerBound := markPrice.Mul(sdk.OneDec().Sub(maxSpreadRatio))
+	upperBound := markPrice.Mul(sdk.OneDec().Add(maxSpreadRatio))
+	return sdk.MinDec(sdk.MaxDec(oraclePrice, lowerBound), upperBound), nil
+}
+
+// settleOracleCloseCost settles the difference between the PnL realized at the
+// oracle price and the PnL of the swap against the AMM. The trader is paid out
+// of the vault at the oracle price while the AMM only accounts for the swap, so
+// the perp fund pays the vault when the oracle price is better for the trader
+// and receives the difference when it is worse, as repeg costs are handled.
+func (k Keeper) settleOracleCloseCost(
+	ctx sdk.Context,
+	pair asset.Pair,
+	position types.Position,
+	realizedPnl sdk.Dec,
+	exchangedNotionalValue sdk.Dec,
+) error {
+	cost := realizedPnl.Sub(UnrealizedPnl(position, exchangedNotionalValue))
+	_, err := k.handleMarketUpdateCost(ctx, pair, cost.Ceil().TruncateInt())
+	return err
+}
+
 // Closes a position and realizes PnL and funding payments.
 // Opens a position in the opposite direction if there is notional value remaining.
 // Errors out if the provided notional value is not greater than the existing position's notional value.
@@ -424,11 +525,14 @@ func (k Keeper) closeAndOpenReversePosition(
 		amm,
 		existingPosition,
 		/* quoteAssetAmountLimit */ sdk.ZeroDec(),
+		/* checkLimits */ true,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	emitReversalLeg(ctx, types.PositionReversalEvent_CLOSED, existingPosition.Pair, trader, closePositionResp)
+
 	if closePositionResp.BadDebt.IsPositive() {
 		// if there's already bad debt, then we don't allow the user to continue and just early return
 		return updatedAMM, closePositionResp, nil
@@ -473,11 +577,18 @@ func (k Keeper) closeAndOpenReversePosition(
 		)
 	}
 
+	err = k.requireOpenInterestBelowCap(ctx, *updatedAMM, dir, remainingReverseNotionalValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	newPosition := types.ZeroPosition(
 		ctx,
 		existingPosition.Pair,
 		trader,
 	)
+	// the reversed position keeps the margin mode of the closed one
+	newPosition.MarginMode = existingPosition.MarginMode
 	updatedAMM, increasePositionResp, err := k.increasePosition(
 		ctx,
 		market,
@@ -491,6 +602,7 @@ func (k Keeper) closeAndOpenReversePosition(
 	if err != nil {
 		return nil, nil, err
 	}
+	emitReversalLeg(ctx, types.PositionReversalEvent_OPENED, existingPosition.Pair, trader, increasePositionResp)
 
 	positionResp = &types.PositionResp{
 		Position:               increasePositionResp.Position,
@@ -507,6 +619,23 @@ func (k Keeper) closeAndOpenReversePosition(
 	return updatedAMM, positionResp, nil
 }
 
+// emitReversalLeg emits a PositionReversalEvent for one leg of a reversed
+// position, the close of the existing position or the open of the residual on
+// the opposite side. The PositionChangedEvent of the order nets both legs.
+func emitReversalLeg(
+	ctx sdk.Context, leg types.PositionReversalEvent_ReversalLeg, pair asset.Pair, trader sdk.AccAddress, resp *types.PositionResp,
+) {
+	_ = ctx.EventManager().EmitTypedEvent(&types.PositionReversalEvent{
+		Pair:              pair,
+		Trader:            trader.String(),
+		Leg:               leg,
+		ExchangedSize:     resp.ExchangedPositionSize,
+		ExchangedNotional: resp.ExchangedNotionalValue,
+		RealizedPnl:       resp.RealizedPnl,
+		FundingPayment:    resp.FundingPayment,
+	})
+}
+
 // checkMarketOrderRequirements checks the minimum requirements to open a position.
 //
 // - Checks that quote asset is not zero.
@@ -536,7 +665,58 @@ func checkMarketOrderRequirements(market types.Market, quoteAssetAmt sdkmath.Int
 	return nil
 }
 
-// afterPositionUpdate is called when a position has been updated.
+// checkMaxLeverage returns an error if a position with the given open notional
+// and margin would be levered beyond the max leverage of the pair's market.
+// The leverage requested by the trader is bounded by checkMarketOrderRequirements,
+// but increasing a position whose margin has been eroded can exceed it.
+func (k Keeper) checkMaxLeverage(ctx sdk.Context, pair asset.Pair, notional sdk.Dec, margin sdk.Dec) error {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return types.ErrPairNotFound.Wrapf("pair %s not found", pair)
+	}
+
+	if !margin.IsPositive() {
+		return types.ErrLeverageIsTooHigh.Wrapf("position on %s has no margin", pair)
+	}
+
+	if leverage := notional.Quo(margin); leverage.GT(market.MaxLeverage) {
+		return types.ErrLeverageIsTooHigh.Wrapf(
+			"position leverage %s is higher than the max leverage %s", leverage, market.MaxLeverage)
+	}
+
+	return nil
+}
+
+// requireOpenInterestBelowCap returns an error if opening 'additionalNotional'
+// on the 'dir' side of the market would take the notional open interest of that
+// side above the market's open interest cap. Long and short open interest are
+// valued at the mark price and capped separately. Markets without a cap, or
+// with a zero cap, are unbounded.
+func (k Keeper) requireOpenInterestBelowCap(
+	ctx sdk.Context, amm types.AMM, dir types.Direction, additionalNotional sdk.Dec,
+) error {
+	openInterestCap, err := k.OpenInterestCaps.Get(ctx, amm.Pair)
+	if err != nil || openInterestCap.IsZero() {
+		return nil
+	}
+
+	openInterest := amm.TotalLong
+	if dir == types.Direction_SHORT {
+		openInterest = amm.TotalShort
+	}
+	openInterestNotional := openInterest.Mul(amm.InstMarkPrice()).Add(additionalNotional)
+	if openInterestNotional.GT(openInterestCap) {
+		return types.ErrOpenInterestCapExceeded.Wrapf(
+			"%s open interest of %s would be %s, above the cap of %s",
+			dir, amm.Pair, openInterestNotional, openInterestCap)
+	}
+
+	return nil
+}
+
+// afterPositionUpdate is called when a position has been updated. The
+// imbalance fee is paid by the trader after the margin is settled with the
+// vault, so that a closing trader can pay it from the margin returned.
 func (k Keeper) afterPositionUpdate(
 	ctx sdk.Context,
 	market types.Market,
@@ -544,10 +724,12 @@ func (k Keeper) afterPositionUpdate(
 	positionResp types.PositionResp,
 	changeType types.ChangeReason,
 	transferredFee sdkmath.Int,
+	imbalanceFee sdkmath.Int,
 	existingPosition types.Position,
 ) (err error) {
-	// transfer trader <=> vault
-	marginToVault := positionResp.MarginToVault.RoundInt()
+	// transfer trader <=> vault, rounding toward the vault: up what it
+	// receives and down what it pays out
+	marginToVault := positionResp.MarginToVault.Ceil().TruncateInt()
 
 	collateral, err := k.Collateral.Get(ctx)
 	if errors.Is(err, collections.ErrNotFound) {
@@ -567,6 +749,8 @@ func (k Keeper) afterPositionUpdate(
 		}
 	}
 
+	k.accrueRounding(ctx, market.Pair, sdk.NewDecFromInt(marginToVault).Sub(positionResp.MarginToVault))
+
 	if !positionResp.Position.Size_.IsZero() {
 		k.SavePosition(ctx, market.Pair, market.Version, traderAddr, positionResp.Position)
 	}
@@ -592,6 +776,16 @@ func (k Keeper) afterPositionUpdate(
 		}
 	}
 
+	var imbalanceFeeCoin *sdk.Coin
+	if imbalanceFee.IsPositive() {
+		if err = k.transferImbalanceFee(ctx, traderAddr, imbalanceFee); err != nil {
+			return err
+		}
+		coin := sdk.NewCoin(collateral, imbalanceFee)
+		imbalanceFeeCoin = &coin
+	}
+
+	k.recordFundingPayment(ctx, positionResp.Position, positionResp.FundingPayment)
 	_ = ctx.EventManager().EmitTypedEvents(
 		&types.PositionChangedEvent{
 			FinalPosition:     positionResp.Position,
@@ -601,16 +795,35 @@ func (k Keeper) afterPositionUpdate(
 			BadDebt:           sdk.NewCoin(collateral, positionResp.BadDebt.RoundInt()),
 			FundingPayment:    positionResp.FundingPayment,
 			BlockHeight:       ctx.BlockHeight(),
-			MarginToUser:      marginToVault.Neg().Sub(transferredFee),
+			MarginToUser:      marginToVault.Neg().Sub(transferredFee).Sub(imbalanceFee),
 			ChangeReason:      changeType,
 			ExchangedSize:     positionResp.Position.Size_.Sub(existingPosition.Size_),
 			ExchangedNotional: positionResp.PositionNotional.Sub(existingPosition.OpenNotional),
+			ImbalanceFee:      imbalanceFeeCoin,
 		},
 	)
 
 	return nil
 }
 
+// accrueRounding adds the difference between the margin moved into the vault
+// and the margin owed to it by a trade to the rounding accrual of 'pair'.
+// Margin transfers round toward the vault, so 'rounding' is never negative.
+func (k Keeper) accrueRounding(ctx sdk.Context, pair asset.Pair, rounding sdk.Dec) {
+	if rounding.IsZero() {
+		return
+	}
+	accrual := k.RoundingAccruals.GetOr(ctx, pair, sdk.ZeroDec())
+	k.RoundingAccruals.Insert(ctx, pair, accrual.Add(rounding))
+}
+
+// QueryRoundingAccrual returns the margin the vault has gained on 'pair' from
+// rounding trader margin transfers to whole coins. Transfers always round in
+// favor of the vault, so the accrual never decreases.
+func (k Keeper) QueryRoundingAccrual(ctx sdk.Context, pair asset.Pair) sdk.Dec {
+	return k.RoundingAccruals.GetOr(ctx, pair, sdk.ZeroDec())
+}
+
 // checkMarginRatio checks if the margin ratio of the position is below the liquidation threshold.
 func (k Keeper) checkMarginRatio(ctx sdk.Context, market types.Market, amm types.AMM, position types.Position) (err error) {
 	spotNotional, err := PositionNotionalSpot(amm, position)
@@ -700,6 +913,52 @@ func (k Keeper) transferFee(
 	return feeToExchangeFeePool.Add(feeToEcosystemFund), nil
 }
 
+// returns the imbalance fee of a trade on 'pair' that moves the AMM from
+// 'ammBefore' to 'ammAfter'. Any trade that widens the open interest imbalance
+// pays it, whether it opens, increases, reduces or closes a position.
+//
+// args:
+// - ctx: the cosmos-sdk context
+// - pair: the trading pair
+// - ammBefore: the amm before the trade
+// - ammAfter: the amm after the trade
+//
+// returns:
+// - fee: the fee, zero if the pair has no imbalance fee ratio or the trade
+// doesn't widen the imbalance
+func (k Keeper) imbalanceFee(
+	ctx sdk.Context,
+	pair asset.Pair,
+	ammBefore types.AMM,
+	ammAfter types.AMM,
+) (fee sdkmath.Int) {
+	imbalanceFeeRatio := k.ImbalanceFeeRatios.GetOr(ctx, pair, sdk.ZeroDec())
+	return CalcImbalanceFee(ammBefore, ammAfter, imbalanceFeeRatio).RoundInt()
+}
+
+// transfers the imbalance fee of a trade to the ecosystem fund
+//
+// args:
+// - ctx: the cosmos-sdk context
+// - trader: the trader's address
+// - fee: the imbalance fee, see Keeper.imbalanceFee
+//
+// returns:
+// - err: error if any
+func (k Keeper) transferImbalanceFee(ctx sdk.Context, trader sdk.AccAddress, fee sdkmath.Int) (err error) {
+	collateral, err := k.Collateral.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		/* from */ trader,
+		/* to */ types.PerpFundModuleAccount,
+		/* coins */ sdk.NewCoins(sdk.NewCoin(collateral, fee)),
+	)
+}
+
 // ClosePosition closes a position entirely and transfers the remaining margin back to the user.
 // Errors if the position has bad debt.
 //
@@ -731,12 +990,13 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 		return nil, err
 	}
 
-	_, positionResp, err := k.closePositionEntirely(
+	updatedAMM, positionResp, err := k.closePositionEntirely(
 		ctx,
 		market,
 		amm,
 		position,
 		/* quoteAssetAmountLimit */ sdk.ZeroDec(),
+		/* checkLimits */ true,
 	)
 	if err != nil {
 		return nil, err
@@ -759,6 +1019,7 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 		*positionResp,
 		types.ChangeReason_ClosePosition,
 		sdk.ZeroInt(),
+		k.imbalanceFee(ctx, pair, amm, *updatedAMM),
 		position,
 	); err != nil {
 		return nil, err
@@ -776,6 +1037,7 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 //   - amm: the amm reserves
 //   - currentPosition: the existing position
 //   - quoteAssetAmountLimit: the user-specified limit on the quote asset reserves
+//   - checkLimits: whether the pause, trade and fluctuation limits apply, false for liquidations
 //
 // returns:
 //   - updatedAMM: updated AMM reserves
@@ -787,11 +1049,12 @@ func (k Keeper) closePositionEntirely(
 	amm types.AMM,
 	currentPosition types.Position,
 	quoteAssetAmountLimit sdk.Dec,
+	checkLimits bool,
 ) (updatedAMM *types.AMM, resp *types.PositionResp, err error) {
 	if currentPosition.Size_.IsZero() {
 		return nil, nil, fmt.Errorf("zero position size")
 	}
-	positionNotional, err := PositionNotionalSpot(amm, currentPosition)
+	positionNotional, err := k.closeNotional(ctx, market, amm, currentPosition)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -804,20 +1067,15 @@ func (k Keeper) closePositionEntirely(
 	resp = &types.PositionResp{
 		ExchangedPositionSize: currentPosition.Size_.Neg(),
 		PositionNotional:      sdk.ZeroDec(),
-		FundingPayment:        FundingPayment(currentPosition, market.LatestCumulativePremiumFraction),
 		RealizedPnl:           UnrealizedPnl(currentPosition, positionNotional),
 		UnrealizedPnlAfter:    sdk.ZeroDec(),
 	}
 
-	remainingMargin := currentPosition.Margin.Add(resp.RealizedPnl).Sub(resp.FundingPayment)
-
-	if remainingMargin.IsPositive() {
-		resp.BadDebt = sdk.ZeroDec()
-		resp.MarginToVault = remainingMargin.Neg()
-	} else {
-		resp.BadDebt = remainingMargin.Abs()
-		resp.MarginToVault = sdk.ZeroDec()
-	}
+	remainingMargin, badDebt, fundingPayment := CalcRemainMarginWithFundingPayment(
+		currentPosition, resp.RealizedPnl, market.LatestCumulativePremiumFraction)
+	resp.FundingPayment = fundingPayment
+	resp.BadDebt = badDebt
+	resp.MarginToVault = remainingMargin.Neg()
 
 	var dir types.Direction
 	// flipped since we are going against the current position
@@ -826,17 +1084,24 @@ func (k Keeper) closePositionEntirely(
 	} else {
 		dir = types.Direction_LONG
 	}
-	updatedAMM, exchangedNotionalValue, err := k.SwapBaseAsset(
+	updatedAMM, exchangedNotionalValue, err := k.swapBaseAsset(
 		ctx,
 		amm,
 		dir,
 		currentPosition.Size_.Abs(),
 		quoteAssetAmountLimit,
+		checkLimits,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if k.CloseAtOracle.Has(ctx, market.Pair) {
+		if err = k.settleOracleCloseCost(ctx, market.Pair, currentPosition, resp.RealizedPnl, exchangedNotionalValue); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	resp.ExchangedNotionalValue = exchangedNotionalValue
 	resp.Position = types.Position{
 		TraderAddress:                   currentPosition.TraderAddress,
@@ -902,6 +1167,18 @@ func (k Keeper) PartialClose(
 	}
 	reverseNotionalAmt = amm.QuoteReserveToAsset(reverseNotionalAmt)
 
+	// A residual below the dust threshold is not worth keeping in state, so the
+	// position is closed entirely and its remaining margin returned instead.
+	if minPositionQuote := k.MinPositionQuote.GetOr(ctx, sdk.ZeroDec()); minPositionQuote.IsPositive() {
+		positionNotional, err := PositionNotionalSpot(amm, position)
+		if err != nil {
+			return nil, err
+		}
+		if positionNotional.Sub(reverseNotionalAmt).LT(minPositionQuote) {
+			return k.ClosePosition(ctx, pair, traderAddr)
+		}
+	}
+
 	feesTransferred, err := k.transferFee(ctx, market.Pair, traderAddr, reverseNotionalAmt, market.ExchangeFeeRatio, market.EcosystemFundFeeRatio)
 	if err != nil {
 		return nil, err
@@ -909,7 +1186,7 @@ func (k Keeper) PartialClose(
 
 	reverseNotionalAmtWithoutFees := reverseNotionalAmt.Sub(feesTransferred.ToLegacyDec())
 
-	_, positionResp, err := k.decreasePosition(ctx, market, amm, position, reverseNotionalAmtWithoutFees, sdk.ZeroDec())
+	updatedAMM, positionResp, err := k.decreasePosition(ctx, market, amm, position, reverseNotionalAmtWithoutFees, sdk.ZeroDec(), true)
 	if err != nil {
 		return nil, err
 	}
@@ -931,6 +1208,7 @@ func (k Keeper) PartialClose(
 		*positionResp,
 		types.ChangeReason_PartialClose,
 		feesTransferred,
+		k.imbalanceFee(ctx, pair, amm, *updatedAMM),
 		position,
 	)
 	if err != nil {
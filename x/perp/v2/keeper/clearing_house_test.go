@@ -6,10 +6,12 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
@@ -19,6 +21,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/assertion"
+	"github.com/NibiruChain/nibiru/x/perp/v2/keeper"
 
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
@@ -1603,7 +1606,7 @@ func TestClosePosition(t *testing.T) {
 					FundingPayment:    sdk.NewDec(2),
 					TransactionFee:    sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(22)),
 					BlockHeight:       1,
-					MarginToUser:      sdk.NewInt(10976),
+					MarginToUser:      sdk.NewInt(10975),
 					ChangeReason:      types.ChangeReason_ClosePosition,
 					ExchangedNotional: sdk.MustNewDecFromStr("-10000.000000000000000000"),
 					ExchangedSize:     sdk.MustNewDecFromStr("-10000.000000000000000000"),
@@ -1650,7 +1653,7 @@ func TestClosePosition(t *testing.T) {
 					FundingPayment:    sdk.NewDec(2),
 					TransactionFee:    sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(2)),
 					BlockHeight:       1,
-					MarginToUser:      sdk.NewInt(896),
+					MarginToUser:      sdk.NewInt(895),
 					ChangeReason:      types.ChangeReason_ClosePosition,
 					ExchangedNotional: sdk.MustNewDecFromStr("-10000.000000000000000000"),
 					ExchangedSize:     sdk.MustNewDecFromStr("-10000.000000000000000000"),
@@ -1748,7 +1751,7 @@ func TestClosePosition(t *testing.T) {
 					FundingPayment:    sdk.NewDec(-2),
 					TransactionFee:    sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(20)),
 					BlockHeight:       1,
-					MarginToUser:      sdk.NewInt(9982),
+					MarginToUser:      sdk.NewInt(9981),
 					ChangeReason:      types.ChangeReason_ClosePosition,
 					ExchangedNotional: sdk.MustNewDecFromStr("-10000.000000000000000000"),
 					ExchangedSize:     sdk.MustNewDecFromStr("10000.000000000000000000"),
@@ -1795,7 +1798,7 @@ func TestClosePosition(t *testing.T) {
 					FundingPayment:    sdk.NewDec(-2),
 					TransactionFee:    sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(2)),
 					BlockHeight:       1,
-					MarginToUser:      sdk.NewInt(900),
+					MarginToUser:      sdk.NewInt(899),
 					ChangeReason:      types.ChangeReason_ClosePosition,
 					ExchangedNotional: sdk.MustNewDecFromStr("-10000.000000000000000000"),
 					ExchangedSize:     sdk.MustNewDecFromStr("10000.000000000000000000"),
@@ -1957,7 +1960,7 @@ func TestUpdateSwapInvariant(t *testing.T) {
 			).
 			Then(
 				PositionShouldNotExist(alice, pairBtcNusd, 1),
-				ModuleBalanceShouldBeEqualTo(types.VaultModuleAccount, sdk.NewCoins()),
+				ModuleBalanceShouldBeEqualTo(types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.OneInt()))),
 			),
 		TC("only short position - decreasing k").
 			Given(
@@ -1978,7 +1981,7 @@ func TestUpdateSwapInvariant(t *testing.T) {
 			).
 			Then(
 				PositionShouldNotExist(alice, pairBtcNusd, 1),
-				ModuleBalanceShouldBeEqualTo(types.VaultModuleAccount, sdk.NewCoins()),
+				ModuleBalanceShouldBeEqualTo(types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.OneInt()))),
 			),
 
 		TC("long and short position - increasing k").
@@ -2010,7 +2013,7 @@ func TestUpdateSwapInvariant(t *testing.T) {
 				PositionShouldNotExist(alice, pairBtcNusd, 1),
 				PositionShouldNotExist(bob, pairBtcNusd, 1),
 
-				ModuleBalanceShouldBeEqualTo(types.VaultModuleAccount, sdk.NewCoins()),
+				ModuleBalanceShouldBeEqualTo(types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.OneInt()))),
 				ModuleBalanceShouldBeEqualTo(types.PerpFundModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(39_960_000)))),
 			),
 		TC("long and short position - reducing k").
@@ -2042,10 +2045,686 @@ func TestUpdateSwapInvariant(t *testing.T) {
 				PositionShouldNotExist(alice, pairBtcNusd, 1),
 				PositionShouldNotExist(bob, pairBtcNusd, 1),
 
-				ModuleBalanceShouldBeEqualTo(types.VaultModuleAccount, sdk.NewCoins()),
+				ModuleBalanceShouldBeEqualTo(types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.OneInt()))),
 				ModuleBalanceShouldBeEqualTo(types.PerpFundModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(39_960_000)))),
 			),
 	}
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
+
+func TestMarketOrderImbalanceFee(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	testCases := []struct {
+		name            string
+		side            types.Direction
+		expectedBalance sdkmath.Int
+	}{
+		{
+			// the fee of 1_000 * 10 * 0.002 = 20 comes out of the margin,
+			// imbalance fee: (1_000 - 20) * 10 * 0.01 = 98
+			name:            "long increases the net long imbalance",
+			side:            types.Direction_LONG,
+			expectedBalance: sdk.NewInt(102),
+		},
+		{
+			// the fee of 20 comes out of the margin, no imbalance fee
+			name:            "short reduces the net long imbalance",
+			side:            types.Direction_SHORT,
+			expectedBalance: sdk.NewInt(200),
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			traderAddr := testutil.AccAddress()
+
+			createTestMarket(t, app, ctx, pair, WithEnabled(true), WithTotalLong(sdk.NewDec(10_000)))
+			app.PerpKeeperV2.ImbalanceFeeRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.01"))
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, traderAddr,
+				sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1_200))))
+
+			_, err := app.PerpKeeperV2.MarketOrder(
+				ctx, pair, tc.side, traderAddr, sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec(),
+			)
+			require.NoError(t, err)
+
+			balance := app.BankKeeper.GetBalance(ctx, traderAddr, types.TestingCollateralDenomNUSD)
+			require.Equal(t, tc.expectedBalance.String(), balance.Amount.String())
+		})
+	}
+}
+
+func TestCloseImbalanceFee(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	closeEntirely := func(app *app.NibiruApp, ctx sdk.Context, trader sdk.AccAddress) error {
+		_, err := app.PerpKeeperV2.ClosePosition(ctx, pair, trader)
+		return err
+	}
+	closeHalf := func(app *app.NibiruApp, ctx sdk.Context, trader sdk.AccAddress) error {
+		_, err := app.PerpKeeperV2.PartialClose(ctx, pair, trader, sdk.NewDec(5_000))
+		return err
+	}
+
+	testCases := []struct {
+		name                 string
+		totalShort           sdk.Dec
+		close                func(app *app.NibiruApp, ctx sdk.Context, trader sdk.AccAddress) error
+		expectedImbalanceFee int64
+	}{
+		{
+			// imbalance fee: 10_000 * 0.01
+			name:                 "closing a long widens the net short imbalance",
+			totalShort:           sdk.NewDec(30_000),
+			close:                closeEntirely,
+			expectedImbalanceFee: 100,
+		},
+		{
+			// imbalance fee: 5_000 * 0.01
+			name:                 "partially closing a long widens the net short imbalance",
+			totalShort:           sdk.NewDec(30_000),
+			close:                closeHalf,
+			expectedImbalanceFee: 50,
+		},
+		{
+			name:                 "closing a long reduces the net long imbalance",
+			totalShort:           sdk.ZeroDec(),
+			close:                closeEntirely,
+			expectedImbalanceFee: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		// returns the trader balance and the position changed event after the
+		// close of a 10_000 long position
+		run := func(t *testing.T, imbalanceFeeRatio sdk.Dec) (sdkmath.Int, *types.PositionChangedEvent) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			traderAddr := testutil.AccAddress()
+
+			createTestMarket(t, app, ctx, pair,
+				WithEnabled(true),
+				WithTotalLong(sdk.NewDec(10_000)),
+				WithTotalShort(tc.totalShort),
+			)
+			app.PerpKeeperV2.ImbalanceFeeRatios.Insert(ctx, pair, imbalanceFeeRatio)
+
+			require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, types.VaultModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 10_000))))
+			// partial closes pay their fees from the trader's balance
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, traderAddr,
+				sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1_000))))
+			app.PerpKeeperV2.SavePosition(ctx, pair, 1, traderAddr, types.Position{
+				TraderAddress:                   traderAddr.String(),
+				Pair:                            pair,
+				Size_:                           sdk.NewDec(10_000),
+				Margin:                          sdk.NewDec(1_000),
+				OpenNotional:                    sdk.NewDec(10_000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			})
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			require.NoError(t, tc.close(app, ctx, traderAddr))
+
+			var positionChangedEvent *types.PositionChangedEvent
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != "nibiru.perp.v2.PositionChangedEvent" {
+					continue
+				}
+				typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+				require.NoError(t, err)
+				positionChangedEvent = typedEvent.(*types.PositionChangedEvent)
+			}
+			require.NotNil(t, positionChangedEvent)
+
+			return app.BankKeeper.GetBalance(ctx, traderAddr, types.TestingCollateralDenomNUSD).Amount, positionChangedEvent
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			balanceWithoutFee, eventWithoutFee := run(t, sdk.ZeroDec())
+			balance, event := run(t, sdk.MustNewDecFromStr("0.01"))
+
+			require.Nil(t, eventWithoutFee.ImbalanceFee)
+			require.Equal(t, tc.expectedImbalanceFee, balanceWithoutFee.Sub(balance).Int64())
+			require.Equal(t, eventWithoutFee.TransactionFee, event.TransactionFee)
+			require.Equal(t,
+				eventWithoutFee.MarginToUser.SubRaw(tc.expectedImbalanceFee).String(),
+				event.MarginToUser.String(),
+			)
+			if tc.expectedImbalanceFee == 0 {
+				require.Nil(t, event.ImbalanceFee)
+			} else {
+				require.Equal(t, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, tc.expectedImbalanceFee), *event.ImbalanceFee)
+			}
+		})
+	}
+}
+
+func TestClosePositionAtOracle(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	oraclePair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+
+	testCases := []struct {
+		name          string
+		size          sdk.Dec
+		oraclePrice   sdk.Dec
+		closeAtOracle bool
+		expectedPnl   sdk.Dec
+		// the perp fund pays the vault the difference between the PnL at the
+		// oracle price and the PnL of the swap, rounded up, and receives the
+		// ecosystem fund fee of 0.001 on the margin returned to the trader
+		expectedPerpFund int64
+	}{
+		{
+			// 10_000 * 1.1 - 10_000
+			name:          "long settled at the oracle price",
+			size:          sdk.NewDec(10_000),
+			oraclePrice:   sdk.MustNewDecFromStr("1.1"),
+			closeAtOracle: true,
+			expectedPnl:   sdk.NewDec(1_000),
+
+			expectedPerpFund: 9_001,
+		},
+		{
+			// 10_000 - 10_000 * 0.9
+			name:          "short settled at the oracle price",
+			size:          sdk.NewDec(-10_000),
+			oraclePrice:   sdk.MustNewDecFromStr("0.9"),
+			closeAtOracle: true,
+			expectedPnl:   sdk.NewDec(1_000),
+
+			expectedPerpFund: 9_001,
+		},
+		{
+			// the oracle price of 1.5 is capped at 1 * (1 + 0.2)
+			name:          "oracle price bounded by the max spread from mark",
+			size:          sdk.NewDec(10_000),
+			oraclePrice:   sdk.MustNewDecFromStr("1.5"),
+			closeAtOracle: true,
+			expectedPnl:   sdk.NewDec(2_000),
+
+			expectedPerpFund: 8_002,
+		},
+		{
+			// 10_000 * 1 (minus slippage) - 10_000
+			name:          "long settled at the mark price",
+			size:          sdk.NewDec(10_000),
+			oraclePrice:   sdk.MustNewDecFromStr("1.1"),
+			closeAtOracle: false,
+			expectedPnl:   sdk.MustNewDecFromStr("-0.000099999999000000"),
+
+			expectedPerpFund: 10_001,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			traderAddr := testutil.AccAddress()
+
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+			app.OracleKeeper.SetPrice(ctx, oraclePair, tc.oraclePrice)
+			if tc.closeAtOracle {
+				app.PerpKeeperV2.CloseAtOracle.Insert(ctx, pair)
+				app.PerpKeeperV2.MaxOracleSpreadRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.2"))
+			}
+
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, traderAddr,
+				sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1_000))))
+			require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, types.VaultModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 10_000))))
+			require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, types.PerpFundModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 10_000))))
+			app.PerpKeeperV2.SavePosition(ctx, pair, 1, traderAddr, types.Position{
+				TraderAddress:                   traderAddr.String(),
+				Pair:                            pair,
+				Size_:                           tc.size,
+				Margin:                          sdk.NewDec(1_000),
+				OpenNotional:                    sdk.NewDec(10_000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			})
+
+			resp, err := app.PerpKeeperV2.ClosePosition(ctx, pair, traderAddr)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPnl.String(), resp.RealizedPnl.String())
+
+			perpFund := app.BankKeeper.GetBalance(
+				ctx, app.AccountKeeper.GetModuleAddress(types.PerpFundModuleAccount), types.TestingCollateralDenomNUSD)
+			require.EqualValues(t, tc.expectedPerpFund, perpFund.Amount.Int64())
+		})
+	}
+}
+
+func TestMarketOrderMaxLeverage(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	startBlockTime := time.Now()
+
+	// Each case increases a long with an open notional of 1000 by 100 NUSD at
+	// 10x. After the 2 NUSD fee, 98 NUSD of margin opens 980 NUSD of notional,
+	// so the position ends with an open notional of 1980 and a margin of
+	// initialMargin + 98, against a max leverage of 10.
+	increaseWithMargin := func(name string, initialMargin sdk.Dec) TestCase {
+		return TC(name).
+			Given(
+				CreateCustomMarket(pairBtcNusd, WithEnabled(true)),
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(100)))),
+				InsertPosition(
+					WithPair(pairBtcNusd),
+					WithTrader(alice),
+					WithMargin(initialMargin),
+					WithSize(sdk.NewDec(1_000)),
+					WithOpenNotional(sdk.NewDec(1_000)),
+				),
+			)
+	}
+
+	tc := TestCases{
+		increaseWithMargin("increase to just below the max leverage", sdk.NewDec(101)).
+			When(
+				MoveToNextBlock(),
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcNusd, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(199))),
+			),
+
+		increaseWithMargin("increase to exactly the max leverage", sdk.NewDec(100)).
+			When(
+				MoveToNextBlock(),
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcNusd, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(198))),
+			),
+
+		increaseWithMargin("increase to just above the max leverage", sdk.NewDec(99)).
+			When(
+				MoveToNextBlock(),
+				MarketOrderFails(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec(),
+					types.ErrLeverageIsTooHigh,
+				),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcNusd, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(99))),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestMarketOrderOpenInterestCap(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	traderAddr := testutil.AccAddress()
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true), WithTotalLong(sdk.NewDec(9_020)))
+	app.PerpKeeperV2.OpenInterestCaps.Insert(ctx, pair, sdk.NewDec(10_000))
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, traderAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1_000))))
+
+	t.Log("fill the long open interest to the cap: 9_020 + (100 - 2) * 10 = 10_000")
+	_, err := app.PerpKeeperV2.MarketOrder(
+		ctx, pair, types.Direction_LONG, traderAddr, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec(),
+	)
+	require.NoError(t, err)
+
+	t.Log("the next long is rejected")
+	_, err = app.PerpKeeperV2.MarketOrder(
+		ctx, pair, types.Direction_LONG, traderAddr, sdk.NewInt(10), sdk.OneDec(), sdk.ZeroDec(),
+	)
+	require.ErrorIs(t, err, types.ErrOpenInterestCapExceeded)
+
+	t.Log("the short side is capped separately")
+	shortTrader := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, shortTrader,
+		sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1_000))))
+	_, err = app.PerpKeeperV2.MarketOrder(
+		ctx, pair, types.Direction_SHORT, shortTrader, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec(),
+	)
+	require.NoError(t, err)
+
+	t.Log("a zero cap leaves the market uncapped")
+	app.PerpKeeperV2.OpenInterestCaps.Insert(ctx, pair, sdk.ZeroDec())
+	_, err = app.PerpKeeperV2.MarketOrder(
+		ctx, pair, types.Direction_LONG, traderAddr, sdk.NewInt(10), sdk.OneDec(), sdk.ZeroDec(),
+	)
+	require.NoError(t, err)
+}
+
+func TestQueryRoundingAccrual(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	traderAddr := testutil.AccAddress()
+	otherTraderAddr := testutil.AccAddress()
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	for _, addr := range []sdk.AccAddress{traderAddr, otherTraderAddr} {
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, addr,
+			sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 100_000))))
+	}
+	require.True(t, app.PerpKeeperV2.QueryRoundingAccrual(ctx, pair).IsZero())
+
+	// rounding of a single trade: margin moved into the vault minus margin
+	// owed to it, which rounds toward the vault
+	expectedAccrual := sdk.ZeroDec()
+	accrue := func(resp *types.PositionResp) {
+		rounding := sdk.NewDecFromInt(resp.MarginToVault.Ceil().TruncateInt()).Sub(resp.MarginToVault)
+		require.False(t, rounding.IsNegative(), "rounding %s favors the trader", rounding)
+		expectedAccrual = expectedAccrual.Add(rounding)
+	}
+
+	for i := int64(1); i <= 20; i++ {
+		dir := types.Direction_LONG
+		if i%2 == 0 {
+			dir = types.Direction_SHORT
+		}
+
+		resp, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, dir, traderAddr, sdk.NewInt(1_000+7*i), sdk.NewDec(3), sdk.ZeroDec(),
+		)
+		require.NoError(t, err)
+		accrue(resp)
+
+		// move the price so that the close realizes a fractional PnL
+		resp, err = app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_LONG, otherTraderAddr, sdk.NewInt(500), sdk.NewDec(3), sdk.ZeroDec(),
+		)
+		require.NoError(t, err)
+		accrue(resp)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Second))
+
+		resp, err = app.PerpKeeperV2.ClosePosition(ctx, pair, traderAddr)
+		require.NoError(t, err)
+		accrue(resp)
+
+		accrual := app.PerpKeeperV2.QueryRoundingAccrual(ctx, pair)
+		require.False(t, accrual.IsNegative())
+		require.Equal(t, expectedAccrual.String(), accrual.String())
+	}
+	require.True(t, expectedAccrual.IsPositive())
+
+	resp, err := keeper.NewQuerier(app.PerpKeeperV2).QueryRoundingAccrual(
+		sdk.WrapSDKContext(ctx), &types.QueryRoundingAccrualRequest{Pair: pair})
+	require.NoError(t, err)
+	require.Equal(t, expectedAccrual.String(), resp.RoundingAccrual.String())
+
+	_, err = keeper.NewQuerier(app.PerpKeeperV2).QueryRoundingAccrual(
+		sdk.WrapSDKContext(ctx), &types.QueryRoundingAccrualRequest{Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD)})
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+}
+
+func TestSimulateOpenPosition(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	for _, dir := range []types.Direction{types.Direction_LONG, types.Direction_SHORT} {
+		dir := dir
+		t.Run(dir.String(), func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			traderAddr := testutil.AccAddress()
+
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, traderAddr,
+				sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1_020))))
+
+			simulated, marginRatio, err := app.PerpKeeperV2.SimulateOpenPosition(
+				ctx, pair, dir, sdk.NewInt(1_000), sdk.NewDec(10))
+			require.NoError(t, err)
+
+			t.Log("the simulation changes no state")
+			amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+			require.NoError(t, err)
+			require.Equal(t, mock.TestAMMDefault().BaseReserve.String(), amm.BaseReserve.String())
+			require.Equal(t, mock.TestAMMDefault().QuoteReserve.String(), amm.QuoteReserve.String())
+			require.Equal(t, "1020",
+				app.BankKeeper.GetBalance(ctx, traderAddr, types.TestingCollateralDenomNUSD).Amount.String())
+
+			t.Log("the simulation matches the opened position")
+			resp, err := app.PerpKeeperV2.MarketOrder(
+				ctx, pair, dir, traderAddr, sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec())
+			require.NoError(t, err)
+			require.Equal(t, resp.Position.Size_.String(), simulated.Size_.String())
+			require.Equal(t, resp.Position.Margin.String(), simulated.Margin.String())
+			require.Equal(t, resp.Position.OpenNotional.String(), simulated.OpenNotional.String())
+
+			amm, err = app.PerpKeeperV2.GetAMM(ctx, pair)
+			require.NoError(t, err)
+			positionNotional, err := keeper.PositionNotionalSpot(amm, resp.Position)
+			require.NoError(t, err)
+			require.Equal(t,
+				keeper.MarginRatio(resp.Position, positionNotional, sdk.ZeroDec()).String(),
+				marginRatio.String(),
+			)
+		})
+	}
+
+	t.Run("leverage above the max leverage", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+		_, _, err := app.PerpKeeperV2.SimulateOpenPosition(
+			ctx, pair, types.Direction_LONG, sdk.NewInt(1_000), sdk.NewDec(11))
+		require.ErrorIs(t, err, types.ErrLeverageIsTooHigh)
+	})
+}
+
+func TestMarketOrderReversal(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	// setup opens a long with 5 NUSD of notional on a market without fees
+	setup := func(t *testing.T) (*app.NibiruApp, sdk.Context, sdk.AccAddress) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		traderAddr := testutil.AccAddress()
+
+		createTestMarket(t, app, ctx, pair,
+			WithEnabled(true),
+			WithExchangeFeeRatio(sdk.ZeroDec()),
+			WithEcosystemFundFeeRatio(sdk.ZeroDec()),
+		)
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, traderAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 100))))
+
+		_, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_LONG, traderAddr, sdk.NewInt(5), sdk.OneDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+		return app, ctx.WithEventManager(sdk.NewEventManager()), traderAddr
+	}
+	hasEvent := func(ctx sdk.Context, leg types.PositionReversalEvent_ReversalLeg) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != "nibiru.perp.v2.PositionReversalEvent" {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			if typedEvent.(*types.PositionReversalEvent).Leg == leg {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("partial close", func(t *testing.T) {
+		app, ctx, traderAddr := setup(t)
+
+		resp, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_SHORT, traderAddr, sdk.NewInt(2), sdk.OneDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+		require.True(t, resp.Position.Size_.IsPositive())
+		require.False(t, hasEvent(ctx, types.PositionReversalEvent_CLOSED))
+		require.False(t, hasEvent(ctx, types.PositionReversalEvent_OPENED))
+	})
+
+	t.Run("exact close", func(t *testing.T) {
+		app, ctx, traderAddr := setup(t)
+
+		position, err := app.PerpKeeperV2.GetPosition(ctx, pair, 1, traderAddr)
+		require.NoError(t, err)
+		amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+		require.NoError(t, err)
+		positionNotional, err := keeper.PositionNotionalSpot(amm, position)
+		require.NoError(t, err)
+
+		// a quote amount of one at a leverage of the position notional
+		// exchanges exactly the position notional
+		resp, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_SHORT, traderAddr, sdk.OneInt(), positionNotional, sdk.ZeroDec())
+		require.NoError(t, err)
+		require.True(t, resp.Position.Size_.IsZero())
+		require.True(t, hasEvent(ctx, types.PositionReversalEvent_CLOSED))
+		require.False(t, hasEvent(ctx, types.PositionReversalEvent_OPENED))
+
+		_, err = app.PerpKeeperV2.GetPosition(ctx, pair, 1, traderAddr)
+		require.ErrorIs(t, err, types.ErrPositionNotFound)
+	})
+
+	t.Run("overshoot flips the position", func(t *testing.T) {
+		app, ctx, traderAddr := setup(t)
+
+		resp, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_SHORT, traderAddr, sdk.NewInt(8), sdk.OneDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+		require.True(t, resp.Position.Size_.IsNegative())
+		require.True(t, hasEvent(ctx, types.PositionReversalEvent_CLOSED))
+		require.True(t, hasEvent(ctx, types.PositionReversalEvent_OPENED))
+
+		position, err := app.PerpKeeperV2.GetPosition(ctx, pair, 1, traderAddr)
+		require.NoError(t, err)
+		require.Equal(t, resp.Position.Size_.String(), position.Size_.String())
+		// the residual is the order notional beyond the ~5 NUSD that closed the long
+		require.True(t, position.OpenNotional.Sub(sdk.NewDec(3)).Abs().LT(sdk.NewDecWithPrec(1, 6)),
+			"open notional %s", position.OpenNotional)
+	})
+}
+
+func TestPartialCloseDust(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	// setup opens a long of size ~5 on a market without fees and sets the
+	// dust threshold to 'minPositionQuote'
+	setup := func(
+		t *testing.T, minPositionQuote sdk.Dec, marketModifiers ...MarketModifier,
+	) (*app.NibiruApp, sdk.Context, sdk.AccAddress) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		traderAddr := testutil.AccAddress()
+
+		createTestMarket(t, app, ctx, pair, append([]MarketModifier{
+			WithEnabled(true),
+			WithExchangeFeeRatio(sdk.ZeroDec()),
+			WithEcosystemFundFeeRatio(sdk.ZeroDec()),
+		}, marketModifiers...)...)
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, traderAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 100))))
+
+		require.NoError(t, app.PerpKeeperV2.Sudo().ChangeMinPositionQuote(
+			ctx, minPositionQuote, testapp.DefaultSudoRoot()))
+
+		_, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_LONG, traderAddr, sdk.NewInt(5), sdk.OneDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+		return app, ctx, traderAddr
+	}
+
+	t.Run("residual below the threshold closes the position", func(t *testing.T) {
+		app, ctx, traderAddr := setup(t, sdk.NewDec(2))
+
+		resp, err := app.PerpKeeperV2.PartialClose(ctx, pair, traderAddr, sdk.NewDec(4))
+		require.NoError(t, err)
+		require.True(t, resp.Position.Size_.IsZero())
+
+		_, err = app.PerpKeeperV2.GetPosition(ctx, pair, 1, traderAddr)
+		require.ErrorIs(t, err, types.ErrPositionNotFound)
+
+		// the dust margin is returned along with the rest of the margin
+		balance := app.BankKeeper.GetBalance(ctx, traderAddr, types.TestingCollateralDenomNUSD)
+		require.True(t, balance.Amount.GTE(sdk.NewInt(99)), "balance %s", balance)
+	})
+
+	t.Run("residual above the threshold is kept", func(t *testing.T) {
+		app, ctx, traderAddr := setup(t, sdk.NewDec(2))
+
+		resp, err := app.PerpKeeperV2.PartialClose(ctx, pair, traderAddr, sdk.NewDec(2))
+		require.NoError(t, err)
+		require.True(t, resp.Position.Size_.IsPositive())
+
+		position, err := app.PerpKeeperV2.GetPosition(ctx, pair, 1, traderAddr)
+		require.NoError(t, err)
+		require.Equal(t, resp.Position.Size_.String(), position.Size_.String())
+	})
+
+	t.Run("zero threshold keeps any residual", func(t *testing.T) {
+		app, ctx, traderAddr := setup(t, sdk.ZeroDec())
+
+		resp, err := app.PerpKeeperV2.PartialClose(ctx, pair, traderAddr, sdk.NewDec(4))
+		require.NoError(t, err)
+		require.True(t, resp.Position.Size_.IsPositive())
+	})
+
+	t.Run("market order leaving a residual below the threshold closes the position", func(t *testing.T) {
+		app, ctx, traderAddr := setup(t, sdk.NewDec(2))
+
+		resp, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_SHORT, traderAddr, sdk.NewInt(4), sdk.OneDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+		require.True(t, resp.Position.Size_.IsZero())
+
+		_, err = app.PerpKeeperV2.GetPosition(ctx, pair, 1, traderAddr)
+		require.ErrorIs(t, err, types.ErrPositionNotFound)
+
+		balance := app.BankKeeper.GetBalance(ctx, traderAddr, types.TestingCollateralDenomNUSD)
+		require.True(t, balance.Amount.GTE(sdk.NewInt(99)), "balance %s", balance)
+	})
+
+	t.Run("market order leaving a residual above the threshold keeps it", func(t *testing.T) {
+		app, ctx, traderAddr := setup(t, sdk.NewDec(2))
+
+		resp, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_SHORT, traderAddr, sdk.NewInt(2), sdk.OneDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+		require.True(t, resp.Position.Size_.IsPositive())
+
+		position, err := app.PerpKeeperV2.GetPosition(ctx, pair, 1, traderAddr)
+		require.NoError(t, err)
+		require.Equal(t, resp.Position.Size_.String(), position.Size_.String())
+	})
+
+	t.Run("market order whose dust close breaks the base limit is rejected", func(t *testing.T) {
+		// With reserves of 100, the long is ~4.7619 in size and selling it
+		// back returns 5. Reducing it by 4 sells ~3.7718, within a limit of
+		// 3.78, but the limit scaled to the full close is 3.78 * 5 / 4 = 4.725.
+		baseAmtLimit := sdk.MustNewDecFromStr("3.78")
+
+		app, ctx, traderAddr := setup(t, sdk.ZeroDec(), WithSqrtDepth(sdk.NewDec(100)))
+		resp, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_SHORT, traderAddr, sdk.NewInt(4), sdk.OneDec(), baseAmtLimit)
+		require.NoError(t, err)
+		require.True(t, resp.Position.Size_.IsPositive())
+
+		app, ctx, traderAddr = setup(t, sdk.NewDec(2), WithSqrtDepth(sdk.NewDec(100)))
+		positionBefore, err := app.PerpKeeperV2.GetPosition(ctx, pair, 1, traderAddr)
+		require.NoError(t, err)
+
+		_, err = app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_SHORT, traderAddr, sdk.NewInt(4), sdk.OneDec(), baseAmtLimit)
+		require.ErrorIs(t, err, types.ErrAssetFailsUserLimit)
+
+		position, err := app.PerpKeeperV2.GetPosition(ctx, pair, 1, traderAddr)
+		require.NoError(t, err)
+		require.Equal(t, positionBefore.Size_.String(), position.Size_.String())
+	})
+
+	t.Run("negative threshold is rejected", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		err := app.PerpKeeperV2.Sudo().ChangeMinPositionQuote(
+			ctx, sdk.NewDec(-1), testapp.DefaultSudoRoot())
+		require.Error(t, err)
+	})
+}
@@ -1 +1,22 @@
 package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+
+	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
+)
+
+// createTestMarket runs CreateCustomMarket against an app and context for
+// tests that call the keeper directly instead of going through a TestSuite.
+func createTestMarket(
+	t *testing.T, app *app.NibiruApp, ctx sdk.Context, pair asset.Pair, marketModifiers ...MarketModifier,
+) {
+	_, err := CreateCustomMarket(pair, marketModifiers...).Do(app, ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+// SetFundingTopUp opts the position of 'trader' in 'pair' in or out of funding
+// top-ups. While opted in, the funding payments owed by the position are paid
+// from the trader's collateral balance before they reduce the position margin
+// when it is liquidated. Traders opt in with MsgSetFundingTopUp.
+func (k Keeper) SetFundingTopUp(ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress, enabled bool) {
+	key := collections.Join(pair, trader)
+	if enabled {
+		k.FundingTopUps.Insert(ctx, key)
+		return
+	}
+	k.FundingTopUps.Delete(ctx, key)
+}
+
+/*
+topUpFunding pays the funding payment owed by an opted-in position from the
+trader's collateral balance instead of its margin. The payment is rounded up to
+a whole amount of collateral, and the rounding difference is credited to the
+margin. Nothing happens if the position is not opted in, owes no funding, or if
+the trader's balance does not cover the payment, in which case funding is
+taken from the margin as usual. Callers run it in a cached context that is only
+written if the liquidation that follows succeeds, so a top-up alone never
+debits the trader.
+
+args:
+  - ctx: cosmos-sdk context
+  - market: the market of the position
+  - amm: the amm of the market
+  - position: the position, updated in place when topped up
+
+ret:
+  - err: error
+*/
+func (k Keeper) topUpFunding(
+	ctx sdk.Context, market types.Market, amm types.AMM, position *types.Position,
+) (err error) {
+	traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return err
+	}
+	if !k.FundingTopUps.Has(ctx, collections.Join(market.Pair, traderAddr)) {
+		return nil
+	}
+
+	fundingPayment := FundingPayment(*position, market.LatestCumulativePremiumFraction)
+	if !fundingPayment.IsPositive() {
+		return nil
+	}
+
+	collateral, err := k.Collateral.Get(ctx)
+	if err != nil {
+		return err
+	}
+	topUp := sdk.NewCoin(collateral, fundingPayment.Ceil().TruncateInt())
+	if k.BankKeeper.GetBalance(ctx, traderAddr, collateral).IsLT(topUp) {
+		return nil
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx, traderAddr, types.VaultModuleAccount, sdk.NewCoins(topUp),
+	); err != nil {
+		return err
+	}
+
+	position.Margin = position.Margin.Add(sdk.NewDecFromInt(topUp.Amount)).Sub(fundingPayment)
+	position.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
+	position.LastUpdatedBlockNumber = ctx.BlockHeight()
+	k.SavePosition(ctx, market.Pair, market.Version, traderAddr, *position)
+
+	positionNotional, err := PositionNotionalSpot(amm, *position)
+	if err != nil {
+		return err
+	}
+
+	k.recordFundingPayment(ctx, *position, fundingPayment)
+	return ctx.EventManager().EmitTypedEvent(
+		&types.PositionChangedEvent{
+			FinalPosition:    *position,
+			PositionNotional: positionNotional,
+			TransactionFee:   sdk.NewCoin(collateral, sdk.ZeroInt()),
+			RealizedPnl:      sdk.ZeroDec(),
+			BadDebt:          sdk.NewCoin(collateral, sdk.ZeroInt()),
+			FundingPayment:   fundingPayment,
+			BlockHeight:      ctx.BlockHeight(),
+			MarginToUser:     topUp.Amount.Neg(),
+			ChangeReason:     types.ChangeReason_FundingTopUp,
+		},
+	)
+}
+
+// FundingRunway is an estimate of how long a position can pay funding before
+// its margin ratio falls to the maintenance margin ratio.
+type FundingRunway struct {
+	// Funding paid by the position per interval at the current funding rate.
+	// Negative if the position receives funding.
+	FundingPerInterval sdk.Dec
+	// Number of whole funding intervals the position can pay for, capped at
+	// math.MaxUint64. Only set if Finite is true.
+	Intervals uint64
+	// False if the position does not pay funding, in which case funding alone
+	// never brings it to the maintenance margin ratio.
+	Finite bool
+}
+
+/*
+QueryFundingRunway estimates how many funding intervals it takes for the
+funding payments of a position to bring its margin ratio down to the
+maintenance margin ratio, assuming the mark price and the funding rate stay
+unchanged. Funding accrued but not yet realized by the position is accounted
+for.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the position
+  - trader: the owner of the position
+
+ret:
+  - runway: the estimate
+  - err: error
+*/
+func (k Keeper) QueryFundingRunway(
+	ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress,
+) (runway FundingRunway, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return FundingRunway{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return FundingRunway{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	position, err := k.GetPosition(ctx, pair, market.Version, trader)
+	if err != nil {
+		return FundingRunway{}, err
+	}
+
+	premiumFraction, _, _, err := k.calcPremiumFraction(ctx, market)
+	if err != nil {
+		return FundingRunway{}, err
+	}
+	runway.FundingPerInterval = premiumFraction.Mul(position.Size_)
+	if !runway.FundingPerInterval.IsPositive() {
+		return runway, nil
+	}
+	runway.Finite = true
+
+	positionNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return FundingRunway{}, err
+	}
+	remainingMargin := position.Margin.
+		Add(UnrealizedPnl(position, positionNotional)).
+		Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+	buffer := remainingMargin.Sub(positionNotional.Mul(market.MaintenanceMarginRatio))
+	if !buffer.IsPositive() {
+		return runway, nil
+	}
+
+	// a tiny funding rate can leave more intervals than fit in a uint64
+	intervals := buffer.Quo(runway.FundingPerInterval).TruncateInt()
+	if !intervals.IsUint64() {
+		runway.Intervals = math.MaxUint64
+		return runway, nil
+	}
+	runway.Intervals = intervals.Uint64()
+	return runway, nil
+}
+
+// RecentFundingSettlementsCapacity is the number of funding settlements kept
+// per pair in the funding history. Older settlements are overwritten.
+const RecentFundingSettlementsCapacity uint64 = 100
+
+// recordFundingSettlement appends a funding settlement to the history of its
+// pair, dropping the oldest one once the history holds
+// RecentFundingSettlementsCapacity entries.
+func (k Keeper) recordFundingSettlement(
+	ctx sdk.Context, pair asset.Pair, premiumFraction sdk.Dec, cumulativePremiumFraction sdk.Dec,
+) {
+	sequence := k.FundingSettlementSequences.GetOr(ctx, pair, 0)
+	k.FundingHistory.Insert(ctx, collections.Join(pair, sequence), types.FundingSettlement{
+		Pair:                      pair,
+		PremiumFraction:           premiumFraction,
+		CumulativePremiumFraction: cumulativePremiumFraction,
+		BlockHeight:               ctx.BlockHeight(),
+		TimestampMs:               ctx.BlockTime().UnixMilli(),
+	})
+	if sequence >= RecentFundingSettlementsCapacity {
+		_ = k.FundingHistory.Delete(ctx, collections.Join(pair, sequence-RecentFundingSettlementsCapacity))
+	}
+	k.FundingSettlementSequences.Insert(ctx, pair, sequence+1)
+}
+
+// RecentFundingPaymentsCapacity is the number of funding payments kept per
+// position in the funding payment history. Older payments are dropped.
+const RecentFundingPaymentsCapacity = 100
+
+// recordFundingPayment appends the funding payment realized by 'position' to
+// the funding payment history of its trader, dropping the oldest one once the
+// history holds RecentFundingPaymentsCapacity entries. 'position' is the
+// position after the payment, its cumulative premium fraction is the one the
+// payment brought it up to. Payments realized in the same block are summed.
+func (k Keeper) recordFundingPayment(ctx sdk.Context, position types.Position, fundingPayment sdk.Dec) {
+	if fundingPayment.IsNil() || fundingPayment.IsZero() {
+		return
+	}
+	trader, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return
+	}
+
+	traderKey := collections.Join(position.Pair, trader)
+	key := collections.Join(traderKey, uint64(ctx.BlockHeight()))
+	payment := k.FundingPayments.GetOr(ctx, key, types.AppliedFundingPayment{Payment: sdk.ZeroDec()})
+	k.FundingPayments.Insert(ctx, key, types.AppliedFundingPayment{
+		Pair:                      position.Pair,
+		Trader:                    position.TraderAddress,
+		Payment:                   payment.Payment.Add(fundingPayment),
+		CumulativePremiumFraction: position.LatestCumulativePremiumFraction,
+		BlockHeight:               ctx.BlockHeight(),
+		TimestampMs:               ctx.BlockTime().UnixMilli(),
+	})
+
+	keys := k.FundingPayments.Iterate(
+		ctx, collections.PairRange[collections.Pair[asset.Pair, sdk.AccAddress], uint64]{}.Prefix(traderKey),
+	).Keys()
+	for i := 0; i < len(keys)-RecentFundingPaymentsCapacity; i++ {
+		_ = k.FundingPayments.Delete(ctx, keys[i])
+	}
+}
+
+// GetFundingPayments returns the funding payments the position of 'trader' in
+// 'pair' realized, oldest first. Each payment records the cumulative premium
+// fraction of the market it brought the position up to. Only the last
+// RecentFundingPaymentsCapacity payments of a position are kept.
+func (k Keeper) GetFundingPayments(
+	ctx sdk.Context, trader sdk.AccAddress, pair asset.Pair,
+) (payments []types.AppliedFundingPayment, err error) {
+	if _, err = k.GetMarket(ctx, pair); err != nil {
+		return nil, err
+	}
+
+	return k.FundingPayments.Iterate(
+		ctx, collections.PairRange[collections.Pair[asset.Pair, sdk.AccAddress], uint64]{}.Prefix(collections.Join(pair, trader)),
+	).Values(), nil
+}
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/NibiruChain/collections"
 
@@ -191,6 +194,254 @@ func (q queryServer) QueryMarkets(
 	return &types.QueryMarketsResponse{AmmMarkets: ammMarkets}, nil
 }
 
+func (q queryServer) QueryAllMarkPrices(
+	goCtx context.Context, req *types.QueryAllMarkPricesRequest,
+) (*types.QueryAllMarkPricesResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prices, err := q.k.AllMarkPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAllMarkPricesResponse{MarkPrices: prices}, nil
+}
+
+// AllMarkPrices returns the instantaneous mark price of the AMM of every
+// enabled market, ordered by pair. An AMM without reserves has a zero mark
+// price rather than failing the whole batch.
+func (k Keeper) AllMarkPrices(ctx sdk.Context) (prices []types.PairMarkPrice, err error) {
+	markets := k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values()
+	for _, market := range markets {
+		if !market.Enabled {
+			continue
+		}
+
+		amm, err := k.AMMs.Get(ctx, collections.Join(market.Pair, market.Version))
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, types.PairMarkPrice{
+			Pair:      market.Pair,
+			MarkPrice: amm.InstMarkPrice(),
+		})
+	}
+	return prices, nil
+}
+
+func (q queryServer) QueryMarketConfig(
+	goCtx context.Context, req *types.QueryMarketConfigRequest,
+) (*types.QueryMarketConfigResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, err := q.k.QueryMarketConfig(ctx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarketConfigResponse{Config: config}, nil
+}
+
+// QueryMarketConfig returns the configuration of the current market of 'pair',
+// including the per-pair limits kept outside of the market, and the reserves
+// and mark price of its AMM.
+func (k Keeper) QueryMarketConfig(ctx sdk.Context, pair asset.Pair) (config types.MarketConfig, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return config, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return config, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	return types.MarketConfig{
+		Market:                market,
+		Amm:                   amm,
+		MarkPrice:             amm.InstMarkPrice(),
+		TradeLimitRatio:       k.TradeLimitRatios.GetOr(ctx, pair, sdk.ZeroDec()),
+		TradeLimitRatioLong:   k.TradeLimitRatio(ctx, pair, types.Direction_LONG),
+		TradeLimitRatioShort:  k.TradeLimitRatio(ctx, pair, types.Direction_SHORT),
+		FluctuationLimitRatio: k.FluctuationLimitRatios.GetOr(ctx, pair, sdk.ZeroDec()),
+		MaxOracleSpreadRatio:  k.MaxOracleSpreadRatios.GetOr(ctx, pair, sdk.ZeroDec()),
+	}, nil
+}
+
+func (q queryServer) QueryMarkIndexDivergence(
+	goCtx context.Context, req *types.QueryMarkIndexDivergenceRequest,
+) (*types.QueryMarkIndexDivergenceResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	series, err := q.k.GetMarkIndexDivergence(ctx, req.Pair, time.Duration(req.LookbackMs)*time.Millisecond)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarkIndexDivergenceResponse{Series: series}, nil
+}
+
+// GetMarkIndexDivergence returns the divergence of the mark price from the
+// index price for every reserve snapshot of 'pair' taken in the last
+// 'lookback', oldest first. The oracle keeps no price history, so the current
+// oracle price of the market is the index price of every point.
+func (k Keeper) GetMarkIndexDivergence(
+	ctx sdk.Context, pair asset.Pair, lookback time.Duration,
+) (series []types.MarkIndexDivergence, err error) {
+	if lookback < 0 {
+		return nil, fmt.Errorf("lookback must not be negative: %s", lookback)
+	}
+
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+	indexPrice, err := k.OracleKeeper.GetUnderlyingPrice(ctx, market.OraclePair)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			StartInclusive(ctx.BlockTime().Add(-lookback)).
+			EndInclusive(ctx.BlockTime()),
+	).Values()
+	for _, snapshot := range snapshots {
+		markPrice := snapshot.Amm.InstMarkPrice()
+		series = append(series, types.MarkIndexDivergence{
+			TimestampMs: snapshot.TimestampMs,
+			MarkPrice:   markPrice,
+			Divergence:  markPrice.Sub(indexPrice),
+		})
+	}
+	return series, nil
+}
+
+func (q queryServer) QueryMarketsPage(
+	goCtx context.Context, req *types.QueryMarketsPageRequest,
+) (*types.QueryMarketsPageResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ammMarkets, pageRes, err := q.k.MarketsPage(ctx, req.Pagination, req.Versioned)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarketsPageResponse{
+		AmmMarkets: ammMarkets,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MarketsPage returns a page of the markets and their AMMs. Markets are
+// ordered by pair string and then by version, so the order is deterministic
+// and a page key stays valid as markets are added. Disabled markets are left
+// out unless 'versioned' is set, as in QueryMarkets.
+func (k Keeper) MarketsPage(
+	ctx sdk.Context, pageReq *sdkquery.PageRequest, versioned bool,
+) (ammMarkets []types.AmmMarket, pageRes *sdkquery.PageResponse, err error) {
+	pagination, _, err := common.ParsePagination(pageReq)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	store := storeprefix.NewStore(ctx.KVStore(k.storeKey), NamespaceMarkets.Prefix())
+	pageRes, err = sdkquery.FilteredPaginate(store, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		market := new(types.Market)
+		if err := k.cdc.Unmarshal(value, market); err != nil {
+			return false, grpcstatus.Error(grpccodes.Internal, err.Error())
+		}
+		// disabled markets are not returned
+		if !versioned && !market.Enabled {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+
+		amm, err := k.AMMs.Get(ctx, collections.Join(market.Pair, market.Version))
+		if err != nil {
+			return false, err
+		}
+		ammMarkets = append(ammMarkets, types.AmmMarket{
+			Amm:    amm,
+			Market: *market,
+		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ammMarkets, pageRes, nil
+}
+
+func (q queryServer) QueryReserveSnapshots(
+	goCtx context.Context, req *types.QueryReserveSnapshotsRequest,
+) (*types.QueryReserveSnapshotsResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	snapshots, pageRes, err := q.k.ReserveSnapshotsPage(ctx, req.Pair, req.FromMs, req.ToMs, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryReserveSnapshotsResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ReserveSnapshotsPage returns a page of the reserve snapshots of 'pair' taken
+// between 'fromMs' and 'toMs' (inclusive), oldest first, so that the TWAP can
+// be recomputed off-chain.
+func (k Keeper) ReserveSnapshotsPage(
+	ctx sdk.Context, pair asset.Pair, fromMs int64, toMs int64, pageReq *sdkquery.PageRequest,
+) (snapshots []types.ReserveSnapshot, pageRes *sdkquery.PageResponse, err error) {
+	if fromMs > toMs {
+		return nil, nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "time range start %d is after its end %d", fromMs, toMs)
+	}
+
+	pagination, _, err := common.ParsePagination(pageReq)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	// snapshots are keyed by pair and then by time, so a pair's snapshots share
+	// a prefix and are iterated in time order
+	pairPrefix := append(NamespaceReserveSnapshots.Prefix(), asset.PairKeyEncoder.Encode(pair)...)
+	store := storeprefix.NewStore(ctx.KVStore(k.storeKey), pairPrefix)
+	pageRes, err = sdkquery.FilteredPaginate(store, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		snapshot := new(types.ReserveSnapshot)
+		if err := k.cdc.Unmarshal(value, snapshot); err != nil {
+			return false, grpcstatus.Error(grpccodes.Internal, err.Error())
+		}
+		if snapshot.TimestampMs < fromMs || snapshot.TimestampMs > toMs {
+			return false, nil
+		}
+		if accumulate {
+			snapshots = append(snapshots, *snapshot)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return snapshots, pageRes, nil
+}
+
 func (q queryServer) QueryCollateral(
 	goCtx context.Context, req *types.QueryCollateralRequest,
 ) (*types.QueryCollateralResponse, error) {
@@ -200,3 +451,197 @@ func (q queryServer) QueryCollateral(
 		CollateralDenom: denom,
 	}, nil
 }
+
+func (q queryServer) QueryUncoveredBadDebts(
+	goCtx context.Context, req *types.QueryUncoveredBadDebtsRequest,
+) (*types.QueryUncoveredBadDebtsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QueryUncoveredBadDebtsResponse{}
+	for _, kv := range q.k.UncoveredBadDebts.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		resp.BadDebts = append(resp.BadDebts, types.UncoveredBadDebt{
+			Pair:   kv.Key,
+			Amount: kv.Value,
+		})
+	}
+	return resp, nil
+}
+
+func (q queryServer) QueryFundingPayments(
+	goCtx context.Context, req *types.QueryFundingPaymentsRequest,
+) (*types.QueryFundingPaymentsResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	payments, err := q.k.GetFundingPayments(ctx, traderAddr, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFundingPaymentsResponse{FundingPayments: payments}, nil
+}
+
+func (q queryServer) QueryPositionSettlementPreview(
+	goCtx context.Context, req *types.QueryPositionSettlementPreviewRequest,
+) (*types.QueryPositionSettlementPreviewResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	preview, err := q.k.PreviewPositionSettlement(ctx, req.Pair, traderAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPositionSettlementPreviewResponse{
+		Margin:                          preview.Margin,
+		BadDebt:                         preview.BadDebt,
+		FundingPayment:                  preview.FundingPayment,
+		LatestCumulativePremiumFraction: preview.LatestCumulativePremiumFraction,
+	}, nil
+}
+
+func (q queryServer) QueryTraderPositions(
+	goCtx context.Context, req *types.QueryTraderPositionsRequest,
+) (*types.QueryTraderPositionsResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	positions, pageRes, err := q.k.TraderPositionsPage(ctx, traderAddr, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTraderPositionsResponse{
+		Positions:  positions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TraderPositionsPage returns a page of the positions of 'trader' on every pair
+// and market version, in the same order as GetTraderPositions. The pages are
+// taken over the market versions, and the trader's position in each is a
+// single lookup, so the positions of other traders are never read.
+func (k Keeper) TraderPositionsPage(
+	ctx sdk.Context, trader sdk.AccAddress, pageReq *sdkquery.PageRequest,
+) (positions []types.Position, pageRes *sdkquery.PageResponse, err error) {
+	pagination, _, err := common.ParsePagination(pageReq)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	store := storeprefix.NewStore(ctx.KVStore(k.storeKey), NamespaceMarkets.Prefix())
+	pageRes, err = sdkquery.FilteredPaginate(store, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		market := new(types.Market)
+		if err := k.cdc.Unmarshal(value, market); err != nil {
+			return false, grpcstatus.Error(grpccodes.Internal, err.Error())
+		}
+		position, err := k.Positions.Get(ctx, collections.Join(collections.Join(market.Pair, market.Version), trader))
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		if accumulate {
+			positions = append(positions, position)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return positions, pageRes, nil
+}
+
+func (q queryServer) QueryRoundingAccrual(
+	goCtx context.Context, req *types.QueryRoundingAccrualRequest,
+) (*types.QueryRoundingAccrualResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := q.k.GetMarket(ctx, req.Pair); err != nil {
+		return nil, types.ErrPairNotFound.Wrapf("pair: %s", req.Pair)
+	}
+	return &types.QueryRoundingAccrualResponse{
+		RoundingAccrual: q.k.QueryRoundingAccrual(ctx, req.Pair),
+	}, nil
+}
+
+func (q queryServer) QueryPositionNotional(
+	goCtx context.Context, req *types.QueryPositionNotionalRequest,
+) (*types.QueryPositionNotionalResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, err
+	}
+
+	calcOption := types.NotionalCalcOption(req.CalcOption)
+	if calcOption == "" {
+		calcOption = types.NotionalCalcOption_SPOT
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market, err := q.k.GetMarket(ctx, req.Pair)
+	if err != nil {
+		return nil, types.ErrPairNotFound.Wrapf("pair: %s", req.Pair)
+	}
+
+	position, err := q.k.GetPosition(ctx, req.Pair, market.Version, traderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	notional, err := q.k.PositionNotional(ctx, position, calcOption)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPositionNotionalResponse{PositionNotional: notional}, nil
+}
+
+func (q queryServer) QueryTwap(
+	goCtx context.Context, req *types.QueryTwapRequest,
+) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+	if req.LookbackMs < 0 {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "lookback must not be negative: %dms", req.LookbackMs)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := q.k.GetMarket(ctx, req.Pair); err != nil {
+		return nil, types.ErrPairNotFound.Wrapf("pair: %s", req.Pair)
+	}
+
+	twap, err := q.k.CalcTwap(
+		ctx,
+		req.Pair,
+		types.TwapCalcOption_SPOT,
+		types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(),
+		time.Duration(req.LookbackMs)*time.Millisecond,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTwapResponse{Twap: twap}, nil
+}
@@ -2,16 +2,22 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
+	"github.com/NibiruChain/nibiru/x/perp/v2/keeper"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
@@ -454,6 +460,61 @@ func TestQueryPositionStore(t *testing.T) {
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
 
+func TestMarketsPage(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	createMarket := func(base string, enabled bool) {
+		createTestMarket(t, app, ctx, asset.NewPair(base, denoms.NUSD), WithEnabled(enabled))
+	}
+	pairsOf := func(ammMarkets []types.AmmMarket) (pairs []string) {
+		for _, ammMarket := range ammMarkets {
+			pairs = append(pairs, ammMarket.Market.Pair.String())
+		}
+		return pairs
+	}
+
+	createMarket("ccc", true)
+	createMarket("aaa", true)
+	createMarket("eee", true)
+	createMarket("ddd", false)
+
+	firstPage, pageRes, err := app.PerpKeeperV2.MarketsPage(ctx, &sdkquery.PageRequest{Limit: 2}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"aaa:unusd", "ccc:unusd"}, pairsOf(firstPage))
+	require.NotNil(t, pageRes.NextKey)
+
+	t.Log("the same request returns the same page")
+	samePage, _, err := app.PerpKeeperV2.MarketsPage(ctx, &sdkquery.PageRequest{Limit: 2}, false)
+	require.NoError(t, err)
+	require.Equal(t, pairsOf(firstPage), pairsOf(samePage))
+
+	t.Log("a market added before the page key does not shift the next page")
+	createMarket("bbb", true)
+	secondPage, pageRes, err := app.PerpKeeperV2.MarketsPage(ctx, &sdkquery.PageRequest{Key: pageRes.NextKey, Limit: 2}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"eee:unusd"}, pairsOf(secondPage))
+	require.Nil(t, pageRes.NextKey)
+
+	t.Log("versioned requests include disabled markets")
+	allMarkets, _, err := app.PerpKeeperV2.MarketsPage(ctx, &sdkquery.PageRequest{Limit: 10}, true)
+	require.NoError(t, err)
+	require.Equal(t,
+		[]string{"aaa:unusd", "bbb:unusd", "ccc:unusd", "ddd:unusd", "eee:unusd"},
+		pairsOf(allMarkets),
+	)
+
+	_, _, err = app.PerpKeeperV2.MarketsPage(ctx, &sdkquery.PageRequest{Key: []byte("key"), Offset: 1}, false)
+	require.Error(t, err)
+
+	t.Log("the gRPC query serves the same pages")
+	resp, err := keeper.NewQuerier(app.PerpKeeperV2).QueryMarketsPage(sdk.WrapSDKContext(ctx), &types.QueryMarketsPageRequest{
+		Pagination: &sdkquery.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"aaa:unusd", "bbb:unusd"}, pairsOf(resp.AmmMarkets))
+	require.NotNil(t, resp.Pagination.NextKey)
+}
+
 func TestQueryCollateral(t *testing.T) {
 	tc := TestCases{
 		TC("state starts as expected with the mock NUSD denomination").
@@ -486,3 +547,393 @@ func TestQueryCollateral(t *testing.T) {
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
+
+func TestReserveSnapshotsPage(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	saveSnapshot := func(pair asset.Pair, timestampMs int64) {
+		amm := *mock.TestAMMDefault().WithPair(pair)
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, time.UnixMilli(timestampMs)), types.ReserveSnapshot{
+			Amm:         amm,
+			TimestampMs: timestampMs,
+		})
+	}
+	timestampsOf := func(snapshots []types.ReserveSnapshot) (timestamps []int64) {
+		for _, snapshot := range snapshots {
+			require.Equal(t, pairBtc, snapshot.Amm.Pair)
+			timestamps = append(timestamps, snapshot.TimestampMs)
+		}
+		return timestamps
+	}
+
+	for _, timestampMs := range []int64{5_000, 1_000, 3_000, 2_000, 4_000} {
+		saveSnapshot(pairBtc, timestampMs)
+	}
+	saveSnapshot(pairEth, 3_000)
+
+	firstPage, pageRes, err := app.PerpKeeperV2.ReserveSnapshotsPage(ctx, pairBtc, 2_000, 4_000, &sdkquery.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{2_000, 3_000}, timestampsOf(firstPage))
+	require.NotNil(t, pageRes.NextKey)
+
+	secondPage, pageRes, err := app.PerpKeeperV2.ReserveSnapshotsPage(ctx, pairBtc, 2_000, 4_000, &sdkquery.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{4_000}, timestampsOf(secondPage))
+
+	t.Log("a nil page request returns the whole range")
+	allSnapshots, _, err := app.PerpKeeperV2.ReserveSnapshotsPage(ctx, pairBtc, 0, 10_000, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1_000, 2_000, 3_000, 4_000, 5_000}, timestampsOf(allSnapshots))
+
+	_, _, err = app.PerpKeeperV2.ReserveSnapshotsPage(ctx, pairBtc, 4_000, 2_000, nil)
+	require.Error(t, err)
+
+	t.Log("the gRPC query serves the same pages")
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	resp, err := queryServer.QueryReserveSnapshots(sdk.WrapSDKContext(ctx), &types.QueryReserveSnapshotsRequest{
+		Pair:       pairBtc,
+		FromMs:     2_000,
+		ToMs:       4_000,
+		Pagination: &sdkquery.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, firstPage, resp.Snapshots)
+	require.NotNil(t, resp.Pagination.NextKey)
+
+	_, err = queryServer.QueryReserveSnapshots(sdk.WrapSDKContext(ctx), &types.QueryReserveSnapshotsRequest{
+		Pair:   pairBtc,
+		FromMs: 4_000,
+		ToMs:   2_000,
+	})
+	require.Error(t, err)
+}
+
+func TestGetMarkIndexDivergence(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithBlockTime(time.UnixMilli(10_000))
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+	require.NoError(t, err)
+	app.OracleKeeper.SetPrice(ctx, market.OraclePair, sdk.MustNewDecFromStr("2.5"))
+
+	// the mark price of each snapshot is its timestamp in seconds, on top of
+	// the snapshot at a mark price of 1 taken when the market was created
+	for _, timestampMs := range []int64{1_000, 2_000, 3_000, 4_000, 5_000} {
+		amm := *mock.TestAMMDefault().WithPriceMultiplier(sdk.NewDec(timestampMs / 1_000))
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(market.Pair, time.UnixMilli(timestampMs)), types.ReserveSnapshot{
+			Amm:         amm,
+			TimestampMs: timestampMs,
+		})
+	}
+
+	series, err := app.PerpKeeperV2.GetMarkIndexDivergence(ctx, market.Pair, 7*time.Second)
+	require.NoError(t, err)
+	require.Len(t, series, 4)
+	for i, expected := range []struct {
+		timestampMs int64
+		divergence  string
+	}{
+		{timestampMs: 3_000, divergence: "0.500000000000000000"},
+		{timestampMs: 4_000, divergence: "1.500000000000000000"},
+		{timestampMs: 5_000, divergence: "2.500000000000000000"},
+		{timestampMs: 10_000, divergence: "-1.500000000000000000"},
+	} {
+		require.Equal(t, expected.timestampMs, series[i].TimestampMs)
+		require.Equal(t, expected.divergence, series[i].Divergence.String())
+	}
+
+	_, err = app.PerpKeeperV2.GetMarkIndexDivergence(ctx, market.Pair, -time.Second)
+	require.Error(t, err)
+
+	_, err = app.PerpKeeperV2.GetMarkIndexDivergence(ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD), time.Hour)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+
+	resp, err := keeper.NewQuerier(app.PerpKeeperV2).QueryMarkIndexDivergence(sdk.WrapSDKContext(ctx), &types.QueryMarkIndexDivergenceRequest{
+		Pair:       market.Pair,
+		LookbackMs: 7_000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, series, resp.Series)
+}
+
+func TestTraderPositions(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	savePosition := func(pair asset.Pair, version uint64, trader sdk.AccAddress) {
+		app.PerpKeeperV2.SavePosition(ctx, pair, version, trader, types.Position{
+			TraderAddress:                   trader.String(),
+			Pair:                            pair,
+			Size_:                           sdk.OneDec(),
+			Margin:                          sdk.OneDec(),
+			OpenNotional:                    sdk.OneDec(),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+	}
+	pairsOf := func(positions []types.Position) (pairs []asset.Pair) {
+		for _, position := range positions {
+			require.Equal(t, alice.String(), position.TraderAddress)
+			pairs = append(pairs, position.Pair)
+		}
+		return pairs
+	}
+
+	// positions are listed per market version
+	createTestMarket(t, app, ctx, pairBtc, WithEnabled(true))
+	createTestMarket(t, app, ctx, pairEth, WithEnabled(true))
+	createTestMarket(t, app, ctx, pairEth, WithEnabled(true), WithVersion(2))
+
+	savePosition(pairEth, 1, alice)
+	savePosition(pairBtc, 1, alice)
+	savePosition(pairBtc, 1, bob)
+	savePosition(pairEth, 2, bob)
+
+	positions, err := app.PerpKeeperV2.GetTraderPositions(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, []asset.Pair{pairBtc, pairEth}, pairsOf(positions))
+
+	firstPage, pageRes, err := app.PerpKeeperV2.TraderPositionsPage(ctx, alice, &sdkquery.PageRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, []asset.Pair{pairBtc}, pairsOf(firstPage))
+	require.NotNil(t, pageRes.NextKey)
+
+	secondPage, _, err := app.PerpKeeperV2.TraderPositionsPage(ctx, alice, &sdkquery.PageRequest{Key: pageRes.NextKey, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, []asset.Pair{pairEth}, pairsOf(secondPage))
+
+	resp, err := keeper.NewQuerier(app.PerpKeeperV2).QueryTraderPositions(sdk.WrapSDKContext(ctx), &types.QueryTraderPositionsRequest{
+		Trader:     alice.String(),
+		Pagination: &sdkquery.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, firstPage, resp.Positions)
+	require.Equal(t, pageRes.NextKey, resp.Pagination.NextKey)
+
+	t.Log("a trader without positions has none")
+	positions, err = app.PerpKeeperV2.GetTraderPositions(ctx, testutil.AccAddress())
+	require.NoError(t, err)
+	require.Empty(t, positions)
+}
+
+func TestAllMarkPrices(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	createMarket := func(base string, marketModifiers ...MarketModifier) {
+		createTestMarket(t, app, ctx, asset.NewPair(base, denoms.NUSD), marketModifiers...)
+	}
+
+	createMarket("aaa", WithEnabled(true), WithPricePeg(sdk.NewDec(2)))
+	createMarket("bbb", WithEnabled(true), WithQuoteReserve(sdk.NewDec(3e12)))
+	createMarket("ccc", WithEnabled(true), WithBaseReserve(sdk.ZeroDec()))
+	createMarket("ddd", WithEnabled(false))
+
+	prices, err := app.PerpKeeperV2.AllMarkPrices(ctx)
+	require.NoError(t, err)
+	require.Len(t, prices, 3)
+	for i, expected := range []struct {
+		pair      string
+		markPrice sdk.Dec
+	}{
+		{pair: "aaa:unusd", markPrice: sdk.NewDec(2)},
+		{pair: "bbb:unusd", markPrice: sdk.NewDec(3)},
+		{pair: "ccc:unusd", markPrice: sdk.ZeroDec()},
+	} {
+		require.Equal(t, expected.pair, prices[i].Pair.String())
+		require.Equal(t, expected.markPrice.String(), prices[i].MarkPrice.String())
+	}
+
+	resp, err := keeper.NewQuerier(app.PerpKeeperV2).QueryAllMarkPrices(
+		sdk.WrapSDKContext(ctx), &types.QueryAllMarkPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, prices, resp.MarkPrices)
+}
+
+func TestQueryMarketConfig(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	createTestMarket(t, app, ctx, pair,
+		WithEnabled(true),
+		WithMaintenanceMarginRatio(sdk.MustNewDecFromStr("0.04")),
+		WithMaxLeverage(sdk.NewDec(20)),
+		WithExchangeFeeRatio(sdk.MustNewDecFromStr("0.002")),
+		WithEcosystemFundFeeRatio(sdk.MustNewDecFromStr("0.0005")),
+		WithSqrtDepth(sdk.NewDec(1e6)),
+		WithPricePeg(sdk.NewDec(3)),
+	)
+
+	sudoer := testapp.DefaultSudoRoot()
+	require.NoError(t, app.PerpKeeperV2.Sudo().ChangeTradeLimitRatio(
+		ctx, pair, sdk.MustNewDecFromStr("0.1"), sudoer))
+
+	config, err := app.PerpKeeperV2.QueryMarketConfig(ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, "0.040000000000000000", config.Market.MaintenanceMarginRatio.String())
+	require.Equal(t, "20.000000000000000000", config.Market.MaxLeverage.String())
+	require.Equal(t, "0.002000000000000000", config.Market.ExchangeFeeRatio.String())
+	require.Equal(t, "0.000500000000000000", config.Market.EcosystemFundFeeRatio.String())
+	require.Equal(t, sdk.NewDec(1e6).String(), config.Amm.BaseReserve.String())
+	require.Equal(t, sdk.NewDec(1e6).String(), config.Amm.QuoteReserve.String())
+	require.Equal(t, "3.000000000000000000", config.MarkPrice.String())
+	require.Equal(t, "0.100000000000000000", config.TradeLimitRatio.String())
+	require.True(t, config.FluctuationLimitRatio.IsZero())
+	require.True(t, config.MaxOracleSpreadRatio.IsZero())
+
+	_, err = app.PerpKeeperV2.QueryMarketConfig(ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD))
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+
+	resp, err := keeper.NewQuerier(app.PerpKeeperV2).QueryMarketConfig(
+		sdk.WrapSDKContext(ctx), &types.QueryMarketConfigRequest{Pair: pair})
+	require.NoError(t, err)
+	require.Equal(t, config, resp.Config)
+}
+
+func TestQueryPositionSettlementPreview(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	alice := testutil.AccAddress()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	createTestMarket(t, app, ctx, pair, WithEnabled(true), WithLatestMarketCPF(sdk.MustNewDecFromStr("0.06")))
+	market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+	require.NoError(t, err)
+	position := types.Position{
+		TraderAddress:                   alice.String(),
+		Pair:                            market.Pair,
+		Size_:                           sdk.NewDec(100),
+		Margin:                          sdk.NewDec(20),
+		OpenNotional:                    sdk.NewDec(100),
+		LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.01"),
+	}
+	app.PerpKeeperV2.SavePosition(ctx, market.Pair, market.Version, alice, position)
+
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	resp, err := queryServer.QueryPositionSettlementPreview(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryPositionSettlementPreviewRequest{Pair: market.Pair, Trader: alice.String()},
+	)
+	require.NoError(t, err)
+
+	margin, badDebt, fundingPayment := keeper.CalcRemainMarginWithFundingPayment(
+		position, sdk.ZeroDec(), market.LatestCumulativePremiumFraction)
+	require.Equal(t, margin.String(), resp.Margin.String())
+	require.Equal(t, badDebt.String(), resp.BadDebt.String())
+	require.Equal(t, fundingPayment.String(), resp.FundingPayment.String())
+	require.Equal(t, "0.060000000000000000", resp.LatestCumulativePremiumFraction.String())
+
+	_, err = queryServer.QueryPositionSettlementPreview(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryPositionSettlementPreviewRequest{Pair: market.Pair, Trader: testutil.AccAddress().String()},
+	)
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+}
+
+func TestQueryPositionNotional(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	alice := testutil.AccAddress()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+	require.NoError(t, err)
+	amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+	snapshotAmm := amm
+	snapshotAmm.PriceMultiplier = sdk.NewDec(2)
+	timestampMs := ctx.BlockTime().Add(-time.Second).UnixMilli()
+	app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(market.Pair, time.UnixMilli(timestampMs)), types.ReserveSnapshot{
+		Amm:         snapshotAmm,
+		TimestampMs: timestampMs,
+	})
+	position := types.Position{
+		TraderAddress:                   alice.String(),
+		Pair:                            market.Pair,
+		Size_:                           sdk.NewDec(100),
+		Margin:                          sdk.NewDec(20),
+		OpenNotional:                    sdk.NewDec(100),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	}
+	app.PerpKeeperV2.SavePosition(ctx, market.Pair, market.Version, alice, position)
+
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	spotNotional, err := keeper.PositionNotionalSpot(amm, position)
+	require.NoError(t, err)
+	twapNotional, err := app.PerpKeeperV2.PositionNotional(ctx, position, types.NotionalCalcOption_TWAP)
+	require.NoError(t, err)
+	require.False(t, spotNotional.Equal(twapNotional))
+
+	for _, tc := range []struct {
+		calcOption       string
+		expectedNotional sdk.Dec
+	}{
+		{calcOption: "", expectedNotional: spotNotional},
+		{calcOption: "spot", expectedNotional: spotNotional},
+		{calcOption: "twap", expectedNotional: twapNotional},
+	} {
+		resp, err := queryServer.QueryPositionNotional(
+			sdk.WrapSDKContext(ctx),
+			&types.QueryPositionNotionalRequest{Pair: market.Pair, Trader: alice.String(), CalcOption: tc.calcOption},
+		)
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedNotional.String(), resp.PositionNotional.String(), tc.calcOption)
+	}
+
+	_, err = queryServer.QueryPositionNotional(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryPositionNotionalRequest{Pair: market.Pair, Trader: alice.String(), CalcOption: "mark"},
+	)
+	require.Error(t, err)
+
+	_, err = queryServer.QueryPositionNotional(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryPositionNotionalRequest{Pair: market.Pair, Trader: testutil.AccAddress().String()},
+	)
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+
+	_, err = queryServer.QueryPositionNotional(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryPositionNotionalRequest{Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD), Trader: alice.String()},
+	)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+}
+
+func TestQueryTwap(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+	require.NoError(t, err)
+	amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+	for i, priceMultiplier := range []int64{2, 4} {
+		snapshotAmm := amm
+		snapshotAmm.PriceMultiplier = sdk.NewDec(priceMultiplier)
+		timestampMs := ctx.BlockTime().Add(time.Duration(i-2) * time.Minute).UnixMilli()
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(market.Pair, time.UnixMilli(timestampMs)), types.ReserveSnapshot{
+			Amm:         snapshotAmm,
+			TimestampMs: timestampMs,
+		})
+	}
+
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	resp, err := queryServer.QueryTwap(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryTwapRequest{Pair: market.Pair, LookbackMs: (2 * time.Minute).Milliseconds()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "3.000000000000000000", resp.Twap.String())
+
+	_, err = queryServer.QueryTwap(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryTwapRequest{Pair: market.Pair, LookbackMs: -1},
+	)
+	require.Error(t, err)
+
+	_, err = queryServer.QueryTwap(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryTwapRequest{Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD), LookbackMs: 1},
+	)
+	require.ErrorIs(t, err, types.ErrPairNotFound)
+}
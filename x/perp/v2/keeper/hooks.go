@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/NibiruChain/collections"
@@ -18,51 +19,145 @@ func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ uint64) {
 
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, number uint64) {
 	k.maybeUpdateDnREpoch(ctx, epochIdentifier, number)
+	if k.FundingRateIntervalMs.GetOr(ctx, 0) > 0 {
+		// funding is settled by the EndBlocker, see EndBlockFunding
+		return
+	}
 	for _, market := range k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values() {
 		if !market.Enabled || epochIdentifier != market.FundingRateEpochId {
-			return
+			continue
 		}
 
-		indexTwap, err := k.OracleKeeper.GetExchangeRateTwap(ctx, market.OraclePair)
-		if err != nil {
-			ctx.Logger().Error("failed to fetch twap index price", "market.OraclePair", market.OraclePair, "market.Pair", market.Pair, "error", err)
+		if _, err := k.SettleFunding(ctx, market.Pair); err != nil {
+			ctx.Logger().Error("failed to settle funding", "market.Pair", market.Pair, "error", err)
 			continue
 		}
-		if indexTwap.IsZero() {
-			ctx.Logger().Error("index price is zero", "market.OraclePair", market.OraclePair, "market.Pair", market.Pair)
+	}
+}
+
+// SettleFunding computes the premium fraction of the market for one funding
+// interval, (markTwap - indexTwap) / indexTwap clamped to the max funding rate,
+// scaled by the index price and the number of funding intervals per day. It
+// adds it to the market's cumulative premium fraction, records the settlement
+// in the funding history of the pair and emits a FundingRateChangedEvent.
+//
+// The funding interval is FundingRateIntervalMs if set, else the duration of
+// the market's funding rate epoch.
+func (k Keeper) SettleFunding(ctx sdk.Context, pair asset.Pair) (premiumFraction sdk.Dec, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	if !market.Enabled {
+		return sdk.Dec{}, types.ErrMarketNotEnabled.Wrapf("pair: %s", pair)
+	}
+
+	premiumFraction, markTwap, indexTwap, err := k.calcPremiumFraction(ctx, market)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	market.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction.Add(premiumFraction)
+	k.SaveMarket(ctx, market)
+	k.recordFundingSettlement(ctx, market.Pair, premiumFraction, market.LatestCumulativePremiumFraction)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.FundingRateChangedEvent{
+		Pair:                      market.Pair,
+		MarkPriceTwap:             markTwap,
+		IndexPriceTwap:            indexTwap,
+		PremiumFraction:           premiumFraction,
+		CumulativePremiumFraction: market.LatestCumulativePremiumFraction,
+	})
+	return premiumFraction, nil
+}
+
+// calcPremiumFraction returns the premium fraction of the market for one
+// funding interval at the current mark and index TWAPs, along with the TWAPs.
+func (k Keeper) calcPremiumFraction(
+	ctx sdk.Context, market types.Market,
+) (premiumFraction sdk.Dec, markTwap sdk.Dec, indexTwap sdk.Dec, err error) {
+	indexTwap, err = k.OracleKeeper.GetExchangeRateTwap(ctx, market.OraclePair)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("failed to fetch twap index price of %s: %w", market.OraclePair, err)
+	}
+	if indexTwap.IsZero() {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("index price of %s is zero", market.OraclePair)
+	}
+
+	markTwap, err = k.CalcTwap(ctx, market.Pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), market.TwapLookbackWindow)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("failed to fetch twap mark price: %w", err)
+	}
+	if markTwap.IsZero() {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("mark price is zero")
+	}
+
+	interval, err := k.fundingInterval(ctx, market)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+	intervalsPerDay := (24 * time.Hour) / interval
+	// See https://www.notion.so/nibiru/Funding-Payments-5032d0f8ed164096808354296d43e1fa for an explanation of these terms.
+	clampedDivergence := common.Clamp(markTwap.Sub(indexTwap).Quo(indexTwap), market.MaxFundingRate)
+	premiumFraction = clampedDivergence.Mul(indexTwap).QuoInt64(int64(intervalsPerDay))
+
+	return premiumFraction, markTwap, indexTwap, nil
+}
+
+// fundingInterval returns the duration of one funding interval of 'market':
+// FundingRateIntervalMs if set, else the duration of its funding rate epoch.
+func (k Keeper) fundingInterval(ctx sdk.Context, market types.Market) (time.Duration, error) {
+	if intervalMs := k.FundingRateIntervalMs.GetOr(ctx, 0); intervalMs > 0 {
+		return time.Duration(intervalMs) * time.Millisecond, nil
+	}
+
+	epochInfo, err := k.EpochKeeper.GetEpochInfo(ctx, market.FundingRateEpochId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch epoch info of %s: %w", market.FundingRateEpochId, err)
+	}
+	return epochInfo.Duration, nil
+}
+
+// EndBlockFunding settles funding of every enabled market whose funding
+// interval elapsed, when FundingRateIntervalMs is set. The first interval of a
+// market starts at the first block it is seen in, and later intervals start on
+// the boundaries that follow, so that funding is settled exactly once when a
+// boundary is crossed. A block crossing several boundaries settles a single
+// interval. A market whose settlement fails is retried in the next block.
+// Like EndBlockAMMs, at most MaxPairsPerBlock markets are looked at per block,
+// rotating through them with their own cursor.
+func (k Keeper) EndBlockFunding(ctx sdk.Context) {
+	intervalMs := k.FundingRateIntervalMs.GetOr(ctx, 0)
+	if intervalMs == 0 {
+		return
+	}
+
+	markets := k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values()
+	markets, cursor, rotated := roundRobin(markets, k.MaxPairsPerBlock.GetOr(ctx, 0), k.EndBlockFundingCursor.GetOr(ctx, 0))
+	if rotated {
+		k.EndBlockFundingCursor.Set(ctx, cursor)
+	}
+
+	nowMs := uint64(ctx.BlockTime().UnixMilli())
+	for _, market := range markets {
+		if !market.Enabled {
 			continue
 		}
 
-		markTwap, err := k.CalcTwap(ctx, market.Pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), market.TwapLookbackWindow)
+		startMs, err := k.LastFundingSettlementsMs.Get(ctx, market.Pair)
 		if err != nil {
-			ctx.Logger().Error("failed to fetch twap mark price", "market.Pair", market.Pair, "error", err)
+			k.LastFundingSettlementsMs.Insert(ctx, market.Pair, nowMs)
 			continue
 		}
-		if markTwap.IsZero() {
-			ctx.Logger().Error("mark price is zero", "market.Pair", market.Pair)
+		if nowMs < startMs+intervalMs {
 			continue
 		}
 
-		epochInfo, err := k.EpochKeeper.GetEpochInfo(ctx, epochIdentifier)
-		if err != nil {
-			ctx.Logger().Error("failed to fetch epoch info", "epochIdentifier", epochIdentifier, "error", err)
+		if _, err := k.SettleFunding(ctx, market.Pair); err != nil {
+			k.Logger(ctx).Error("failed to settle funding", "market.Pair", market.Pair, "error", err)
 			continue
 		}
-		intervalsPerDay := (24 * time.Hour) / epochInfo.Duration
-		// See https://www.notion.so/nibiru/Funding-Payments-5032d0f8ed164096808354296d43e1fa for an explanation of these terms.
-		clampedDivergence := common.Clamp(markTwap.Sub(indexTwap).Quo(indexTwap), market.MaxFundingRate)
-		premiumFraction := clampedDivergence.Mul(indexTwap).QuoInt64(int64(intervalsPerDay))
-
-		market.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction.Add(premiumFraction)
-		k.SaveMarket(ctx, market)
-
-		_ = ctx.EventManager().EmitTypedEvent(&types.FundingRateChangedEvent{
-			Pair:                      market.Pair,
-			MarkPriceTwap:             markTwap,
-			IndexPriceTwap:            indexTwap,
-			PremiumFraction:           premiumFraction,
-			CumulativePremiumFraction: market.LatestCumulativePremiumFraction,
-		})
+		k.LastFundingSettlementsMs.Insert(ctx, market.Pair, nowMs-(nowMs-startMs)%intervalMs)
 	}
 }
 
@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"fmt"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +21,8 @@ import (
 	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/assertion"
+	"github.com/NibiruChain/nibiru/x/perp/v2/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
 func TestAfterEpochEnd(t *testing.T) {
@@ -155,6 +160,274 @@ func TestAfterEpochEnd(t *testing.T) {
 			Then(
 				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.ZeroDec())),
 			),
+
+		TC("closed market does not stop the next markets from settling").
+			Given(
+				CreateCustomMarket(pairBtcUsd, WithEnabled(true)),
+				CloseMarket(pairBtcUsd, adminUser),
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("5.8")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				MoveToNextBlockWithDuration(30 * time.Minute),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("-0.099999999999999999"))),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestSettleFunding(t *testing.T) {
+	pairBtcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	settleFundingShouldBe := func(expectedPremiumFraction sdk.Dec) actionFn {
+		return func(app *app.NibiruApp, ctx sdk.Context) (outCtx sdk.Context, err error) {
+			premiumFraction, err := app.PerpKeeperV2.SettleFunding(ctx, pairBtcUsdc)
+			if err != nil {
+				return ctx, err
+			}
+			if !premiumFraction.Equal(expectedPremiumFraction) {
+				return ctx, fmt.Errorf("expected premium fraction %s, got %s", expectedPremiumFraction, premiumFraction)
+			}
+			return ctx, nil
+		}
+	}
+
+	settleFundingShouldFail := func() actionFn {
+		return func(app *app.NibiruApp, ctx sdk.Context) (outCtx sdk.Context, err error) {
+			if _, err := app.PerpKeeperV2.SettleFunding(ctx, pairBtcUsdc); err == nil {
+				return ctx, fmt.Errorf("expected settling funding to fail")
+			}
+			return ctx, nil
+		}
+	}
+
+	tc := TestCases{
+		TC("mark above index, longs pay shorts").
+			Given(
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime, sdk.MustNewDecFromStr("0.52")),
+			).
+			When(
+				settleFundingShouldBe(sdk.MustNewDecFromStr("0.01")),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("0.01"))),
+			),
+
+		TC("mark below index, shorts pay longs").
+			Given(
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime, sdk.MustNewDecFromStr("5.8")),
+			).
+			When(
+				settleFundingShouldBe(sdk.MustNewDecFromStr("-0.099999999999999999")),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("-0.099999999999999999"))),
+			),
+
+		TC("premium fractions accumulate").
+			Given(
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime, sdk.MustNewDecFromStr("0.52")),
+			).
+			When(
+				settleFundingShouldBe(sdk.MustNewDecFromStr("0.01")),
+				settleFundingShouldBe(sdk.MustNewDecFromStr("0.01")),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("0.02"))),
+			),
+
+		TC("missing index price").
+			Given(
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				settleFundingShouldFail(),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.ZeroDec())),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestGetFundingPayments(t *testing.T) {
+	pairBtcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	alice := testutil.AccAddress()
+	startTime := time.Now()
+
+	settleFunding := actionFn(func(app *app.NibiruApp, ctx sdk.Context) (outCtx sdk.Context, err error) {
+		_, err = app.PerpKeeperV2.SettleFunding(ctx, pairBtcUsdc)
+		return ctx, err
+	})
+	fundingPaymentsShouldBe := func(expected ...types.AppliedFundingPayment) actionFn {
+		return func(app *app.NibiruApp, ctx sdk.Context) (outCtx sdk.Context, err error) {
+			resp, err := keeper.NewQuerier(app.PerpKeeperV2).QueryFundingPayments(
+				sdk.WrapSDKContext(ctx),
+				&types.QueryFundingPaymentsRequest{Pair: pairBtcUsdc, Trader: alice.String()},
+			)
+			if err != nil {
+				return ctx, err
+			}
+			if len(resp.FundingPayments) != len(expected) {
+				return ctx, fmt.Errorf("expected %d funding payments, got %v", len(expected), resp.FundingPayments)
+			}
+			for i, payment := range resp.FundingPayments {
+				if !payment.Payment.Equal(expected[i].Payment) ||
+					!payment.CumulativePremiumFraction.Equal(expected[i].CumulativePremiumFraction) ||
+					payment.BlockHeight != expected[i].BlockHeight {
+					return ctx, fmt.Errorf("expected funding payment %v, got %v", expected[i], payment)
+				}
+			}
+			return ctx, nil
+		}
+	}
+
+	tc := TestCases{
+		TC("funding payments realized after each settlement are returned").
+			Given(
+				SetBlockTime(startTime),
+				SetBlockNumber(1),
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime, sdk.MustNewDecFromStr("0.52")),
+				settleFunding,
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.OneDec()),
+					WithMargin(sdk.OneDec()),
+					WithOpenNotional(sdk.OneDec()),
+					WithLatestCumulativePremiumFraction(sdk.MustNewDecFromStr("0.01")),
+					WithLastUpdatedBlockNumber(1),
+				),
+				FundAccount(alice, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 10))),
+			).
+			When(
+				SetBlockNumber(2),
+				settleFunding,
+				AddMargin(alice, pairBtcUsdc, sdk.OneInt()),
+				SetBlockNumber(3),
+				settleFunding,
+				AddMargin(alice, pairBtcUsdc, sdk.OneInt()),
+			).
+			Then(
+				fundingPaymentsShouldBe(
+					types.AppliedFundingPayment{
+						Payment:                   sdk.MustNewDecFromStr("0.01"),
+						CumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"),
+						BlockHeight:               2,
+					},
+					types.AppliedFundingPayment{
+						Payment:                   sdk.MustNewDecFromStr("0.01"),
+						CumulativePremiumFraction: sdk.MustNewDecFromStr("0.03"),
+						BlockHeight:               3,
+					},
+				),
+			),
+
+		TC("pending funding is not a payment").
+			Given(
+				SetBlockTime(startTime),
+				SetBlockNumber(1),
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime, sdk.MustNewDecFromStr("0.52")),
+				settleFunding,
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.OneDec()),
+					WithLatestCumulativePremiumFraction(sdk.MustNewDecFromStr("0.01")),
+					WithLastUpdatedBlockNumber(1),
+				),
+			).
+			When(
+				SetBlockNumber(2),
+				settleFunding,
+			).
+			Then(
+				fundingPaymentsShouldBe(),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestQueryFundingRunway(t *testing.T) {
+	pairBtcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	alice := testutil.AccAddress()
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("long pays funding").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("0.52")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(1_000)), WithMargin(sdk.NewDec(100)), WithOpenNotional(sdk.NewDec(1_000))),
+			).
+			When(
+				MoveToNextBlockWithDuration(30 * time.Minute),
+			).
+			Then(
+				// premium fraction of 0.01 per interval, 10 already accrued by the position:
+				// (100 - 10 - 0.0625 * 1_000) / (0.01 * 1_000) = 2.75
+				FundingRunwayShouldBe(alice, pairBtcUsdc, sdk.NewDec(10), true, 2),
+			),
+
+		TC("short receives funding").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("0.52")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(-1_000)), WithMargin(sdk.NewDec(100)), WithOpenNotional(sdk.NewDec(1_000))),
+			).
+			When(
+				MoveToNextBlockWithDuration(30 * time.Minute),
+			).
+			Then(
+				FundingRunwayShouldBe(alice, pairBtcUsdc, sdk.NewDec(-10), false, 0),
+			),
+
+		TC("tiny funding rate caps the runway").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				SetBlockTime(startTime),
+				// premium fraction of 48e-18 / 48 = 1e-18 per interval
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("0.999999999999999952")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(1)), WithMargin(sdk.NewDec(100)), WithOpenNotional(sdk.NewDec(1))),
+			).
+			When(
+				MoveToNextBlockWithDuration(30 * time.Minute),
+			).
+			Then(
+				// about 99.9375 / 1e-18 intervals, more than fit in a uint64
+				FundingRunwayShouldBe(alice, pairBtcUsdc, sdk.MustNewDecFromStr("0.000000000000000001"), true, math.MaxUint64),
+			),
 	}
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
@@ -32,9 +32,14 @@ type Keeper struct {
 	Markets           collections.Map[collections.Pair[asset.Pair, uint64], types.Market]
 	AMMs              collections.Map[collections.Pair[asset.Pair, uint64], types.AMM]
 	Collateral        collections.Item[string]
+	CollateralDenoms  collections.Map[string, asset.Pair] // maps a secondary collateral denom to the oracle pair pricing it in the primary collateral
 
 	Positions              collections.Map[collections.Pair[collections.Pair[asset.Pair, uint64], sdk.AccAddress], types.Position]
 	ReserveSnapshots       collections.Map[collections.Pair[asset.Pair, time.Time], types.ReserveSnapshot]
+	MinSnapshotIntervalMs  collections.Item[uint64]                                                    // Minimum time between two reserve snapshots of a pair. Zero snapshots every block.
+	SnapshotRetentionMs    collections.Item[uint64]                                                    // How long reserve snapshots are kept before being pruned. Zero disables pruning.
+	FundingTopUps          collections.KeySet[collections.Pair[asset.Pair, sdk.AccAddress]]            // Positions whose funding payments are paid from the trader's balance before their margin.
+	ImbalanceFeeRatios     collections.Map[asset.Pair, math.LegacyDec]                                 // maps a pair to the fee ratio charged on the open interest imbalance a trade adds
 	DnREpoch               collections.Item[uint64]                                                    // Keeps track of the current DnR epoch.
 	DnREpochName           collections.Item[string]                                                    // Keeps track of the current DnR epoch identifier, provided by x/epoch.
 	GlobalVolumes          collections.Map[uint64, math.Int]                                           // Keeps track of global volumes for each epoch.
@@ -42,6 +47,43 @@ type Keeper struct {
 	GlobalDiscounts        collections.Map[math.Int, math.LegacyDec]                                   // maps a volume level to a discount
 	TraderDiscounts        collections.Map[collections.Pair[sdk.AccAddress, math.Int], math.LegacyDec] // maps a user and volume level to a discount, supersedes global discounts
 	EpochRebateAllocations collections.Map[uint64, types.DNRAllocation]                                // maps an epoch to a string representing the allocation of rebates for that epoch
+
+	LiquidationSequences collections.Map[asset.Pair, uint64]                                            // maps a pair to the number of liquidations recorded for it
+	LiquidationHistory   collections.Map[collections.Pair[asset.Pair, uint64], types.LiquidationRecord] // the last RecentLiquidationsCapacity liquidations of a pair, by sequence
+
+	CloseAtOracle         collections.KeySet[asset.Pair]              // pairs whose positions are closed at the oracle price instead of the mark price
+	MaxOracleSpreadRatios collections.Map[asset.Pair, math.LegacyDec] // maps a pair to the max relative spread from mark of the oracle price it closes at
+	SpreadLimitedSwaps    collections.KeySet[asset.Pair]              // pairs whose swaps are rejected while the mark price is beyond their max oracle spread ratio
+
+	MaxPairsPerBlock  collections.Item[uint64] // Maximum number of AMMs the EndBlocker processes per block. Zero processes all of them.
+	EndBlockAMMCursor collections.Item[uint64] // Index of the AMM the next EndBlocker starts processing from.
+
+	EndBlockFundingCursor collections.Item[uint64] // Index of the market the next EndBlocker starts settling funding from.
+
+	OpenInterestCaps collections.Map[asset.Pair, math.LegacyDec] // maps a pair to the max notional open interest of each side of its market
+	RoundingAccruals collections.Map[asset.Pair, math.LegacyDec] // maps a pair to the net margin the vault gained from rounding margin transfers to whole coins
+
+	FluctuationLimitRatios collections.Map[asset.Pair, math.LegacyDec] // maps a pair to the max relative move of its mark price from the latest reserve snapshot a swap may cause
+	TradeLimitRatios       collections.Map[asset.Pair, math.LegacyDec] // maps a pair to the max share of either reserve a single swap may trade
+	LongTradeLimitRatios   collections.Map[asset.Pair, math.LegacyDec] // maps a pair to the trade limit ratio of long swaps, overriding TradeLimitRatios
+	ShortTradeLimitRatios  collections.Map[asset.Pair, math.LegacyDec] // maps a pair to the trade limit ratio of short swaps, overriding TradeLimitRatios
+
+	UncoveredBadDebts collections.Map[asset.Pair, math.Int] // maps a pair to the bad debt the perp fund had no funds to cover
+
+	LiquidationTwapLookbackMs collections.Item[uint64]         // TWAP window used to value positions for liquidation. Zero uses the TWAP lookback window of the market.
+	LiquidatorRewardRatio     collections.Item[math.LegacyDec] // Share of the liquidation fee paid to the liquidator, the rest goes to the perp fund. Unset pays DefaultLiquidatorRewardRatio().
+
+	FundingRateIntervalMs    collections.Item[uint64]            // Interval at which the EndBlocker settles funding. Zero settles funding at the end of each market's funding rate epoch.
+	LastFundingSettlementsMs collections.Map[asset.Pair, uint64] // maps a pair to the start of its current funding interval, in unix milliseconds
+
+	FundingSettlementSequences collections.Map[asset.Pair, uint64]                                            // maps a pair to the number of funding settlements recorded for it
+	FundingHistory             collections.Map[collections.Pair[asset.Pair, uint64], types.FundingSettlement] // the last RecentFundingSettlementsCapacity funding settlements of a pair, by sequence
+
+	FundingPayments collections.Map[collections.Pair[collections.Pair[asset.Pair, sdk.AccAddress], uint64], types.AppliedFundingPayment] // the last RecentFundingPaymentsCapacity funding payments realized by a position, by block height
+
+	PausedMarkets collections.KeySet[asset.Pair] // pairs whose trading is halted. Queries, liquidations and admin settlements are unaffected.
+
+	MinPositionQuote collections.Item[math.LegacyDec] // Minimum notional a partially closed or reduced position may keep. Smaller residuals are closed entirely.
 }
 
 // NewKeeper Creates a new x/perp Keeper instance.
@@ -130,6 +172,141 @@ func NewKeeper(
 			storeKey, NamespaceDnrEpochName,
 			common.StringValueEncoder,
 		),
+		MinSnapshotIntervalMs: collections.NewItem(
+			storeKey, NamespaceMinSnapshotIntervalMs,
+			collections.Uint64ValueEncoder,
+		),
+		CollateralDenoms: collections.NewMap(
+			storeKey, NamespaceCollateralDenoms,
+			collections.StringKeyEncoder,
+			asset.PairValueEncoder,
+		),
+		SnapshotRetentionMs: collections.NewItem(
+			storeKey, NamespaceSnapshotRetentionMs,
+			collections.Uint64ValueEncoder,
+		),
+		FundingTopUps: collections.NewKeySet(
+			storeKey, NamespaceFundingTopUps,
+			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.AccAddressKeyEncoder),
+		),
+		ImbalanceFeeRatios: collections.NewMap(
+			storeKey, NamespaceImbalanceFeeRatios,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
+		LiquidationSequences: collections.NewMap(
+			storeKey, NamespaceLiquidationSequences,
+			asset.PairKeyEncoder,
+			collections.Uint64ValueEncoder,
+		),
+		LiquidationHistory: collections.NewMap(
+			storeKey, NamespaceLiquidationHistory,
+			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[types.LiquidationRecord](cdc),
+		),
+		CloseAtOracle: collections.NewKeySet(
+			storeKey, NamespaceCloseAtOracle,
+			asset.PairKeyEncoder,
+		),
+		MaxOracleSpreadRatios: collections.NewMap(
+			storeKey, NamespaceMaxOracleSpreadRatios,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
+		SpreadLimitedSwaps: collections.NewKeySet(
+			storeKey, NamespaceSpreadLimitedSwaps,
+			asset.PairKeyEncoder,
+		),
+		MaxPairsPerBlock: collections.NewItem(
+			storeKey, NamespaceMaxPairsPerBlock,
+			collections.Uint64ValueEncoder,
+		),
+		EndBlockAMMCursor: collections.NewItem(
+			storeKey, NamespaceEndBlockAMMCursor,
+			collections.Uint64ValueEncoder,
+		),
+		OpenInterestCaps: collections.NewMap(
+			storeKey, NamespaceOpenInterestCaps,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
+		RoundingAccruals: collections.NewMap(
+			storeKey, NamespaceRoundingAccruals,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
+		FluctuationLimitRatios: collections.NewMap(
+			storeKey, NamespaceFluctuationLimitRatios,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
+		TradeLimitRatios: collections.NewMap(
+			storeKey, NamespaceTradeLimitRatios,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
+		UncoveredBadDebts: collections.NewMap(
+			storeKey, NamespaceUncoveredBadDebts,
+			asset.PairKeyEncoder,
+			collections.IntValueEncoder,
+		),
+		LiquidationTwapLookbackMs: collections.NewItem(
+			storeKey, NamespaceLiquidationTwapLookbackMs,
+			collections.Uint64ValueEncoder,
+		),
+		FundingRateIntervalMs: collections.NewItem(
+			storeKey, NamespaceFundingRateIntervalMs,
+			collections.Uint64ValueEncoder,
+		),
+		LastFundingSettlementsMs: collections.NewMap(
+			storeKey, NamespaceLastFundingSettlementsMs,
+			asset.PairKeyEncoder,
+			collections.Uint64ValueEncoder,
+		),
+		FundingSettlementSequences: collections.NewMap(
+			storeKey, NamespaceFundingSettlementSequences,
+			asset.PairKeyEncoder,
+			collections.Uint64ValueEncoder,
+		),
+		FundingHistory: collections.NewMap(
+			storeKey, NamespaceFundingHistory,
+			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[types.FundingSettlement](cdc),
+		),
+		PausedMarkets: collections.NewKeySet(
+			storeKey, NamespacePausedMarkets,
+			asset.PairKeyEncoder,
+		),
+		MinPositionQuote: collections.NewItem(
+			storeKey, NamespaceMinPositionQuote,
+			collections.DecValueEncoder,
+		),
+		LiquidatorRewardRatio: collections.NewItem(
+			storeKey, NamespaceLiquidatorRewardRatio,
+			collections.DecValueEncoder,
+		),
+		LongTradeLimitRatios: collections.NewMap(
+			storeKey, NamespaceLongTradeLimitRatios,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
+		ShortTradeLimitRatios: collections.NewMap(
+			storeKey, NamespaceShortTradeLimitRatios,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
+		EndBlockFundingCursor: collections.NewItem(
+			storeKey, NamespaceEndBlockFundingCursor,
+			collections.Uint64ValueEncoder,
+		),
+		FundingPayments: collections.NewMap(
+			storeKey, NamespaceFundingPayments,
+			collections.PairKeyEncoder(
+				collections.PairKeyEncoder(asset.PairKeyEncoder, collections.AccAddressKeyEncoder),
+				collections.Uint64KeyEncoder,
+			),
+			collections.ProtoValueEncoder[types.AppliedFundingPayment](cdc),
+		),
 	}
 }
 
@@ -147,6 +324,35 @@ const (
 	NamespaceMarketLastVersion
 	NamespaceCollateral
 	NamespaceDnrEpochName
+	NamespaceCollateralDenoms
+	NamespaceMinSnapshotIntervalMs
+	NamespaceSnapshotRetentionMs
+	NamespaceFundingTopUps
+	NamespaceImbalanceFeeRatios
+	NamespaceLiquidationSequences
+	NamespaceLiquidationHistory
+	NamespaceCloseAtOracle
+	NamespaceMaxOracleSpreadRatios
+	NamespaceMaxPairsPerBlock
+	NamespaceEndBlockAMMCursor
+	NamespaceOpenInterestCaps
+	NamespaceRoundingAccruals
+	NamespaceFluctuationLimitRatios
+	NamespaceTradeLimitRatios
+	NamespaceUncoveredBadDebts
+	NamespaceLiquidationTwapLookbackMs
+	NamespaceSpreadLimitedSwaps
+	NamespaceFundingRateIntervalMs
+	NamespaceLastFundingSettlementsMs
+	NamespaceFundingSettlementSequences
+	NamespaceFundingHistory
+	NamespacePausedMarkets
+	NamespaceMinPositionQuote
+	NamespaceLongTradeLimitRatios
+	NamespaceShortTradeLimitRatios
+	NamespaceLiquidatorRewardRatio
+	NamespaceFundingPayments
+	NamespaceEndBlockFundingCursor
 )
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
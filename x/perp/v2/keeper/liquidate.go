@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,10 +25,19 @@ func (k Keeper) MultiLiquidate(
 	var allFailed bool = true
 
 	for reqIdx, req := range liquidationRequests {
+		// Each liquidation runs in its own cached context so that a failed
+		// one leaves no partial state changes behind.
+		cacheCtx, writeCache := ctx.CacheContext()
 		traderAddr, errAccAddress := sdk.AccAddressFromBech32(req.Trader)
 		liquidatorFee, perpEfFee, err := k.liquidate(
-			ctx, liquidator, req.Pair, traderAddr,
+			cacheCtx, liquidator, req.Pair, traderAddr,
 		)
+		if errAccAddress == nil && err == nil {
+			writeCache()
+		} else {
+			// keep the LiquidationFailedEvent of the failed liquidation
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
 
 		switch {
 		case errAccAddress != nil:
@@ -189,24 +200,17 @@ func (k Keeper) liquidate(
 		return
 	}
 
-	spotNotional, err := PositionNotionalSpot(amm, position)
-	if err != nil {
+	// The funding top-up and the liquidation only take effect if the
+	// liquidation succeeds.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err = k.topUpFunding(cacheCtx, market, amm, &position); err != nil {
 		return
 	}
-	twapNotional, err := k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+
+	marginRatio, err := k.GetMarginRatioForLiquidation(cacheCtx, position)
 	if err != nil {
 		return
 	}
-
-	// give the user the preferred position notional
-	var preferredPositionNotional sdk.Dec
-	if position.Size_.IsPositive() {
-		preferredPositionNotional = sdk.MaxDec(spotNotional, twapNotional)
-	} else {
-		preferredPositionNotional = sdk.MinDec(spotNotional, twapNotional)
-	}
-
-	marginRatio := MarginRatio(position, preferredPositionNotional, market.LatestCumulativePremiumFraction)
 	if marginRatio.GTE(market.MaintenanceMarginRatio) {
 		eventLiqFailed := &types.LiquidationFailedEvent{
 			Pair:       pair,
@@ -219,19 +223,197 @@ func (k Keeper) liquidate(
 		return
 	}
 
+	spotNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return
+	}
 	spotMarginRatio := MarginRatio(position, spotNotional, market.LatestCumulativePremiumFraction)
 	if spotMarginRatio.GTE(market.LiquidationFeeRatio) {
-		liquidatorFee, ecosystemFundFee, err = k.executePartialLiquidation(ctx, market, amm, liquidator, &position)
+		liquidatorFee, ecosystemFundFee, err = k.executePartialLiquidation(
+			cacheCtx, market, amm, liquidator, &position, market.PartialLiquidationRatio)
 	} else {
-		liquidatorFee, ecosystemFundFee, err = k.executeFullLiquidation(ctx, market, amm, liquidator, &position)
+		liquidatorFee, ecosystemFundFee, err = k.executeFullLiquidation(cacheCtx, market, amm, liquidator, &position)
 	}
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	writeCache()
 	return liquidatorFee, ecosystemFundFee, nil
 }
 
+/*
+PartialLiquidate liquidates only a fraction of an underwater position. The
+fraction of the position size is closed, realizing a proportional PnL and
+funding payment, and the liquidation fee is taken from the margin left in the
+position. A fraction of one fully liquidates the position.
+
+args:
+  - ctx: cosmos-sdk context
+  - liquidator: the liquidator who is executing the liquidation
+  - pair: the asset pair
+  - trader: the trader who owns the position being liquidated
+  - fraction: the fraction of the position size to liquidate, in (0, 1]
+
+returns:
+  - liquidatorFee: the amount of coins given to the liquidator
+  - remainingMargin: the margin left in the position after the liquidation
+  - err: error
+*/
+func (k Keeper) PartialLiquidate(
+	ctx sdk.Context,
+	liquidator sdk.AccAddress,
+	pair asset.Pair,
+	trader sdk.AccAddress,
+	fraction sdk.Dec,
+) (liquidatorFee sdk.Coin, remainingMargin sdk.Dec, err error) {
+	if fraction.IsNil() || !fraction.IsPositive() || fraction.GT(sdk.OneDec()) {
+		return sdk.Coin{}, sdk.Dec{}, types.ErrInvalidLiquidationFraction.Wrapf("fraction: %s", fraction)
+	}
+
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	position, err := k.GetPosition(ctx, pair, market.Version, trader)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	// The funding top-up and the liquidation only take effect if the
+	// liquidation succeeds.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err = k.topUpFunding(cacheCtx, market, amm, &position); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	marginRatio, err := k.GetMarginRatioForLiquidation(cacheCtx, position)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+	if marginRatio.GTE(market.MaintenanceMarginRatio) {
+		return sdk.Coin{}, sdk.Dec{}, types.ErrPositionHealthy
+	}
+
+	if fraction.Equal(sdk.OneDec()) {
+		liquidatorFee, _, err = k.executeFullLiquidation(cacheCtx, market, amm, liquidator, &position)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, err
+		}
+		writeCache()
+		return liquidatorFee, sdk.ZeroDec(), nil
+	}
+
+	liquidatorFee, _, err = k.executePartialLiquidation(cacheCtx, market, amm, liquidator, &position, fraction)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	position, err = k.GetPosition(cacheCtx, pair, market.Version, trader)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	writeCache()
+	return liquidatorFee, position.Margin, nil
+}
+
+// GetLiquidationPrice returns the TWAP of the mark price of 'pair' over the
+// last 'lookback'. Liquidations value positions over the same window, see
+// liquidationTwapLookback, so that a momentary spike of the spot price alone
+// does not make a position liquidatable.
+func (k Keeper) GetLiquidationPrice(
+	ctx sdk.Context, pair asset.Pair, lookback time.Duration,
+) (price sdk.Dec, err error) {
+	return k.CalcTwap(
+		ctx, pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), lookback,
+	)
+}
+
+// GetLiquidationPriceForPosition returns the mark price at which the margin
+// ratio of 'position', see GetMarginRatioAtPrice, equals the maintenance margin
+// ratio of its market, accounting for its pending funding payment. Solving
+// (margin + unrealizedPnl - fundingPayment) / (|size| * price) = mmr gives:
+//
+//	long:  price = (openNotional - margin + fundingPayment) / (size * (1 - mmr))
+//	short: price = (openNotional + margin - fundingPayment) / (|size| * (1 + mmr))
+//
+// The returned price is zero when no positive price solves the equation: a
+// long whose margin covers its open notional is never liquidated by a price
+// drop, and a short owing more funding than its margin and open notional is
+// under maintenance at any price.
+func (k Keeper) GetLiquidationPriceForPosition(
+	ctx sdk.Context, position types.Position,
+) (liquidationPrice sdk.Dec, err error) {
+	if position.Size_.IsNil() || position.Size_.IsZero() {
+		return sdk.Dec{}, fmt.Errorf("position size must not be zero")
+	}
+	market, err := k.GetMarket(ctx, position.Pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", position.Pair)
+	}
+
+	fundingPayment := FundingPayment(position, market.LatestCumulativePremiumFraction)
+	mmr := market.MaintenanceMarginRatio
+
+	var numerator, denominator sdk.Dec
+	if position.Size_.IsPositive() {
+		numerator = position.OpenNotional.Sub(position.Margin).Add(fundingPayment)
+		denominator = position.Size_.Mul(sdk.OneDec().Sub(mmr))
+	} else {
+		numerator = position.OpenNotional.Add(position.Margin).Sub(fundingPayment)
+		denominator = position.Size_.Abs().Mul(sdk.OneDec().Add(mmr))
+	}
+
+	if !numerator.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+	return numerator.Quo(denominator), nil
+}
+
+// liquidationTwapLookback returns the TWAP window used to value positions of
+// 'market' for liquidation: LiquidationTwapLookbackMs if set, else the TWAP
+// lookback window of the market.
+func (k Keeper) liquidationTwapLookback(ctx sdk.Context, market types.Market) time.Duration {
+	if lookbackMs := k.LiquidationTwapLookbackMs.GetOr(ctx, 0); lookbackMs > 0 {
+		return time.Duration(lookbackMs) * time.Millisecond
+	}
+	return market.TwapLookbackWindow
+}
+
+// IsLiquidatable returns whether the position of 'trader' in 'pair' can be
+// liquidated, i.e. whether its margin ratio is below the maintenance margin
+// ratio of the market, along with that margin ratio. The margin ratio is the
+// one used by liquidations, see GetMarginRatioForLiquidation. Positions of
+// zero size are never liquidatable.
+func (k Keeper) IsLiquidatable(
+	ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress,
+) (liquidatable bool, marginRatio sdk.Dec, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return false, sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	position, err := k.GetPosition(ctx, pair, market.Version, trader)
+	if err != nil {
+		return false, sdk.Dec{}, err
+	}
+	if position.Size_.IsZero() {
+		return false, sdk.ZeroDec(), nil
+	}
+
+	marginRatio, err = k.GetMarginRatioForLiquidation(ctx, position)
+	if err != nil {
+		return false, sdk.Dec{}, err
+	}
+	return marginRatio.LT(market.MaintenanceMarginRatio), marginRatio, nil
+}
+
 /*
 executeFullLiquidation Fully liquidates a position. It is assumed that the margin ratio has already been
 checked prior to calling this method.
@@ -254,6 +436,7 @@ func (k Keeper) executeFullLiquidation(
 		amm,
 		/* currentPosition */ *position,
 		/* quoteAssetAmountLimit */ sdk.ZeroDec(),
+		/* checkLimits */ false,
 	)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
@@ -261,9 +444,11 @@ func (k Keeper) executeFullLiquidation(
 
 	remainMargin := positionResp.MarginToVault.Abs()
 
-	liquidatorFeeAmount := market.LiquidationFeeRatio.
-		Mul(positionResp.ExchangedNotionalValue).
-		QuoInt64(2)
+	// the perp fund receives all of the margin left after the liquidator's
+	// share, so its share of the fee is not needed here
+	liquidatorFeeAmount, _ := k.splitLiquidationFee(
+		ctx, market.LiquidationFeeRatio.Mul(positionResp.ExchangedNotionalValue),
+	)
 	totalBadDebt := positionResp.BadDebt
 
 	if liquidatorFeeAmount.GT(remainMargin) {
@@ -310,6 +495,7 @@ func (k Keeper) executeFullLiquidation(
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	k.recordFundingPayment(ctx, positionResp.Position, positionResp.FundingPayment)
 	_ = ctx.EventManager().EmitTypedEvent(&types.PositionLiquidatedEvent{
 		PositionChangedEvent: types.PositionChangedEvent{
 			FinalPosition:    positionResp.Position,
@@ -327,12 +513,18 @@ func (k Keeper) executeFullLiquidation(
 		FeeToEcosystemFund: sdk.NewCoin(collateral, ecosystemFundFeeAmount.RoundInt()),
 	})
 
+	k.recordLiquidation(
+		ctx, liquidator, *positionResp,
+		sdk.NewCoin(collateral, totalBadDebt.RoundInt()), types.ChangeReason_FullLiquidation,
+	)
+
 	return liquidatorfee, ecosystemFundFee, err
 }
 
-// executePartialLiquidation partially liquidates a position
+// executePartialLiquidation liquidates the given fraction of a position's size
 func (k Keeper) executePartialLiquidation(
 	ctx sdk.Context, market types.Market, amm types.AMM, liquidator sdk.AccAddress, position *types.Position,
+	fraction sdk.Dec,
 ) (liquidatorFee sdk.Coin, ecosystemFundFee sdk.Coin, err error) {
 	traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
 	if err != nil {
@@ -346,7 +538,7 @@ func (k Keeper) executePartialLiquidation(
 		dir = types.Direction_LONG
 	}
 
-	quoteReserveDelta, err := amm.GetQuoteReserveAmt(position.Size_.Mul(market.PartialLiquidationRatio), dir)
+	quoteReserveDelta, err := amm.GetQuoteReserveAmt(position.Size_.Mul(fraction), dir)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
@@ -359,6 +551,7 @@ func (k Keeper) executePartialLiquidation(
 		/* currentPosition */ *position,
 		/* quoteAssetAmount */ quoteAssetDelta,
 		/* baseAmtLimit */ sdk.ZeroDec(),
+		/* checkLimits */ false,
 	)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
@@ -370,22 +563,22 @@ func (k Keeper) executePartialLiquidation(
 	k.SavePosition(ctx, positionResp.Position.Pair, market.Version, traderAddr, positionResp.Position)
 
 	// Compute splits for the liquidation fee
-	feeToLiquidator := liquidationFeeAmount.QuoInt64(2)
-	feeToPerpEcosystemFund := liquidationFeeAmount.Sub(feeToLiquidator)
+	feeToLiquidator, feeToPerpEcosystemFund := k.splitLiquidationFee(ctx, liquidationFeeAmount)
 
 	collateral, err := k.Collateral.Get(ctx)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	err = k.distributeLiquidateRewards(ctx, market, liquidator,
-		sdk.NewCoin(collateral, feeToPerpEcosystemFund.RoundInt()),
-		sdk.NewCoin(collateral, feeToLiquidator.RoundInt()),
-	)
+	liquidatorFee = sdk.NewCoin(collateral, feeToLiquidator.RoundInt())
+	ecosystemFundFee = sdk.NewCoin(collateral, feeToPerpEcosystemFund.RoundInt())
+
+	err = k.distributeLiquidateRewards(ctx, market, liquidator, liquidatorFee, ecosystemFundFee)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	k.recordFundingPayment(ctx, positionResp.Position, positionResp.FundingPayment)
 	_ = ctx.EventManager().EmitTypedEvent(&types.PositionLiquidatedEvent{
 		PositionChangedEvent: types.PositionChangedEvent{
 			FinalPosition:    positionResp.Position,
@@ -399,13 +592,39 @@ func (k Keeper) executePartialLiquidation(
 			ChangeReason:     types.ChangeReason_PartialLiquidation,
 		},
 		LiquidatorAddress:  liquidator.String(),
-		FeeToLiquidator:    sdk.NewCoin(collateral, feeToLiquidator.RoundInt()),
-		FeeToEcosystemFund: sdk.NewCoin(collateral, feeToPerpEcosystemFund.RoundInt()),
+		FeeToLiquidator:    liquidatorFee,
+		FeeToEcosystemFund: ecosystemFundFee,
 	})
 
+	k.recordLiquidation(
+		ctx, liquidator, *positionResp,
+		sdk.NewCoin(collateral, positionResp.BadDebt.RoundInt()), types.ChangeReason_PartialLiquidation,
+	)
+
 	return liquidatorFee, ecosystemFundFee, err
 }
 
+// DefaultLiquidatorRewardRatio returns the share of the liquidation fee paid to
+// the liquidator while LiquidatorRewardRatio is unset.
+func DefaultLiquidatorRewardRatio() sdk.Dec {
+	return sdk.MustNewDecFromStr("0.5")
+}
+
+// splitLiquidationFee splits 'liquidationFee' into the LiquidatorRewardRatio
+// share paid to the liquidator and the rest. The two parts always sum to
+// 'liquidationFee'.
+//
+// Partial liquidations pay the rest to the perp fund. Full liquidations only
+// use the liquidator's share: as before, the perp fund receives all of the
+// margin left after it, which includes its own share of the fee.
+func (k Keeper) splitLiquidationFee(
+	ctx sdk.Context, liquidationFee sdk.Dec,
+) (feeToLiquidator sdk.Dec, feeToPerpFund sdk.Dec) {
+	rewardRatio := k.LiquidatorRewardRatio.GetOr(ctx, DefaultLiquidatorRewardRatio())
+	feeToLiquidator = liquidationFee.Mul(rewardRatio)
+	return feeToLiquidator, liquidationFee.Sub(feeToLiquidator)
+}
+
 func (k Keeper) distributeLiquidateRewards(
 	ctx sdk.Context, market types.Market, liquidator sdk.AccAddress, liquidatorFee sdk.Coin, ecosystemFundFee sdk.Coin,
 ) (err error) {
@@ -435,3 +654,111 @@ func (k Keeper) distributeLiquidateRewards(
 
 	return nil
 }
+
+// RecentLiquidationsCapacity is the number of liquidations kept per pair in the
+// liquidation history. Older liquidations are overwritten.
+const RecentLiquidationsCapacity uint64 = 100
+
+// recordLiquidation appends a liquidation to the history of its pair, dropping
+// the oldest one once the history holds RecentLiquidationsCapacity entries.
+func (k Keeper) recordLiquidation(
+	ctx sdk.Context,
+	liquidator sdk.AccAddress,
+	positionResp types.PositionResp,
+	badDebt sdk.Coin,
+	reason types.ChangeReason,
+) {
+	pair := positionResp.Position.Pair
+
+	price := sdk.ZeroDec()
+	if !positionResp.ExchangedPositionSize.IsZero() {
+		price = positionResp.ExchangedNotionalValue.Abs().Quo(positionResp.ExchangedPositionSize.Abs())
+	}
+
+	sequence := k.LiquidationSequences.GetOr(ctx, pair, 0)
+	k.LiquidationHistory.Insert(ctx, collections.Join(pair, sequence), types.LiquidationRecord{
+		Pair:              pair,
+		TraderAddress:     positionResp.Position.TraderAddress,
+		LiquidatorAddress: liquidator.String(),
+		LiquidatedSize:    positionResp.ExchangedPositionSize.Neg(),
+		Price:             price,
+		BadDebt:           badDebt,
+		BlockHeight:       ctx.BlockHeight(),
+		TimestampMs:       ctx.BlockTime().UnixMilli(),
+		Reason:            reason,
+	})
+	if sequence >= RecentLiquidationsCapacity {
+		_ = k.LiquidationHistory.Delete(ctx, collections.Join(pair, sequence-RecentLiquidationsCapacity))
+	}
+	k.LiquidationSequences.Insert(ctx, pair, sequence+1)
+}
+
+// QueryRecentLiquidations returns up to 'n' of the most recent liquidations of
+// 'pair', newest first.
+func (k Keeper) QueryRecentLiquidations(ctx sdk.Context, pair asset.Pair, n uint64) []types.LiquidationRecord {
+	iter := k.LiquidationHistory.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, uint64]{}.Prefix(pair).Descending(),
+	)
+	defer iter.Close()
+
+	var records []types.LiquidationRecord
+	for ; iter.Valid() && uint64(len(records)) < n; iter.Next() {
+		records = append(records, iter.Value())
+	}
+	return records
+}
+
+// QueryHistoricalMaxDrawdown replays the mark prices of the reserve snapshots
+// of 'pair' taken between 'fromMs' and 'toMs' (inclusive) against the current
+// positions of the market. At each snapshot, every position whose margin,
+// unrealized PnL and pending funding payment leave it underwater is counted as
+// liquidated with that shortfall as bad debt. It returns the largest total bad
+// debt over the replayed snapshots: the most the perp ecosystem fund would have
+// had to cover. This is a read-only simulation.
+func (k Keeper) QueryHistoricalMaxDrawdown(
+	ctx sdk.Context, pair asset.Pair, fromMs int64, toMs int64,
+) (maxDrawdown sdk.Dec, err error) {
+	if fromMs > toMs {
+		return sdk.Dec{}, fmt.Errorf("replay window start %d is after its end %d", fromMs, toMs)
+	}
+
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	positions := k.Positions.Iterate(
+		ctx,
+		collections.PairRange[collections.Pair[asset.Pair, uint64], sdk.AccAddress]{}.
+			Prefix(collections.Join(pair, market.Version)),
+	).Values()
+
+	snapshots := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			StartInclusive(time.UnixMilli(fromMs)).
+			EndInclusive(time.UnixMilli(toMs)),
+	).Values()
+
+	maxDrawdown = sdk.ZeroDec()
+	for _, snapshot := range snapshots {
+		markPrice := snapshot.Amm.InstMarkPrice()
+
+		drawdown := sdk.ZeroDec()
+		for _, position := range positions {
+			positionNotional := position.Size_.Abs().Mul(markPrice)
+			remainingMargin := position.Margin.
+				Add(UnrealizedPnl(position, positionNotional)).
+				Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+			if remainingMargin.IsNegative() {
+				drawdown = drawdown.Add(remainingMargin.Abs())
+			}
+		}
+
+		maxDrawdown = sdk.MaxDec(maxDrawdown, drawdown)
+	}
+
+	return maxDrawdown, nil
+}
@@ -1,17 +1,26 @@
 package keeper_test
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/assertion"
 
@@ -64,6 +73,96 @@ func TestMultiLiquidate(t *testing.T) {
 				),
 			),
 
+		TC("full liquidation of a paused market").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10600))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				PauseMarket(pairBtcUsdc),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: alice, Successful: true},
+				),
+			).
+			Then(
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(600)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(150)),
+				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(250)),
+				PositionShouldNotExist(alice, pairBtcUsdc, 1),
+			),
+
+		TC("full liquidation beyond the fluctuation limit").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10600))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				// closing 10_000 of 1e12 base reserves moves the mark price by ~2e-8
+				ChangeFluctuationLimitRatio(pairBtcUsdc, sdk.MustNewDecFromStr("0.000000000001")),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: alice, Successful: true},
+				),
+			).
+			Then(
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(600)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(150)),
+				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(250)),
+				PositionShouldNotExist(alice, pairBtcUsdc, 1),
+			),
+
+		TC("partial liquidation beyond the trade limit").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				// closing 5_000 of 1e12 base reserves trades 5e-9 of the reserves
+				ChangeTradeLimitRatio(pairBtcUsdc, sdk.MustNewDecFromStr("0.000000000001")),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: alice, Successful: true},
+				),
+			).
+			Then(
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(750)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(125)),
+				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(125)),
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_PositionSizeShouldBeEqualTo(sdk.NewDec(5000))),
+			),
+
+		TC("partial liquidation of a paused market").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				PauseMarket(pairBtcUsdc),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: alice, Successful: true},
+				),
+			).
+			Then(
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(750)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(125)),
+				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(125)),
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_PositionSizeShouldBeEqualTo(sdk.NewDec(5000))),
+			),
+
 		TC("full liquidation").
 			Given(
 				SetBlockNumber(1),
@@ -171,6 +270,35 @@ func TestMultiLiquidate(t *testing.T) {
 				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.ZeroInt()),
 				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(250)),
 				PositionShouldNotExist(alice, pairBtcUsdc, 1),
+				uncoveredBadDebtIs(pairBtcUsdc, sdk.ZeroInt()),
+			),
+
+		TC("records bad debt the perp fund can't cover").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10800))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				FundModule(types.PerpFundModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 20))),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: alice, Successful: true},
+				),
+			).
+			Then(
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(770)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.ZeroInt()),
+				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(250)),
+				PositionShouldNotExist(alice, pairBtcUsdc, 1),
+				uncoveredBadDebtIs(pairBtcUsdc, sdk.NewInt(30)),
+				typedEventEmitted(&types.BadDebtRealizedEvent{
+					Pair:      pairBtcUsdc,
+					Covered:   sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 20),
+					Uncovered: sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 30),
+				}),
 			),
 
 		TC("uses prepaid bad debt").
@@ -312,6 +440,365 @@ func TestMultiLiquidate(t *testing.T) {
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
 
+// typedEventEmitted checks that 'expected' was emitted as a typed event.
+func typedEventEmitted(expected proto.Message) actionFn {
+	return func(app *app.NibiruApp, ctx sdk.Context) (outCtx sdk.Context, err error) {
+		expectedEvent, err := sdk.TypedEventToEvent(expected)
+		if err != nil {
+			return ctx, err
+		}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == expectedEvent.Type && reflect.DeepEqual(event.Attributes, expectedEvent.Attributes) {
+				return ctx, nil
+			}
+		}
+		return ctx, fmt.Errorf("event not emitted: %s", sdk.StringifyEvents([]abci.Event{abci.Event(expectedEvent)}))
+	}
+}
+
+func uncoveredBadDebtIs(pair asset.Pair, expected sdkmath.Int) actionFn {
+	return func(app *app.NibiruApp, ctx sdk.Context) (outCtx sdk.Context, err error) {
+		uncovered := app.PerpKeeperV2.UncoveredBadDebts.GetOr(ctx, pair, sdk.ZeroInt())
+		if !uncovered.Equal(expected) {
+			return ctx, fmt.Errorf("expected uncovered bad debt %s, got %s", expected, uncovered)
+		}
+		return ctx, nil
+	}
+}
+
+func TestPartialLiquidate(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairEthUsdc := asset.Registry.Pair(denoms.ETH, denoms.USDC)
+
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("liquidate 10% of the position").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+			).
+			When(
+				MoveToNextBlock(),
+				PartialLiquidate(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("0.1")),
+			).
+			Then(
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(950)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(25)),
+				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(25)),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(9000)),
+				),
+			),
+
+		TC("position still below maintenance margin after partial liquidation").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+			).
+			When(
+				MoveToNextBlock(),
+				PartialLiquidate(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("0.1")),
+			).
+			Then(
+				// margin ratio ~ (910 - 360) / 9000 < 0.0625, so the position can be liquidated again
+				PartialLiquidate(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("0.1")),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(8100)),
+				),
+			),
+
+		TC("liquidating the whole position closes it").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+			).
+			When(
+				MoveToNextBlock(),
+				PartialLiquidate(liquidator, pairBtcUsdc, alice, sdk.OneDec()),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc, 1),
+			),
+
+		TC("invalid fractions").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+			).
+			When(
+				MoveToNextBlock(),
+			).
+			Then(
+				PartialLiquidateFails(liquidator, pairBtcUsdc, alice, sdk.ZeroDec(), types.ErrInvalidLiquidationFraction),
+				PartialLiquidateFails(liquidator, pairBtcUsdc, alice, sdk.NewDec(-1), types.ErrInvalidLiquidationFraction),
+				PartialLiquidateFails(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("1.5"), types.ErrInvalidLiquidationFraction),
+			),
+
+		TC("healthy position and missing market").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10000))),
+			).
+			When(
+				MoveToNextBlock(),
+			).
+			Then(
+				PartialLiquidateFails(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("0.5"), types.ErrPositionHealthy),
+				PartialLiquidateFails(liquidator, pairEthUsdc, alice, sdk.MustNewDecFromStr("0.5"), types.ErrPairNotFound),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestLiquidatorRewardRatio(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("partial liquidation pays 80% of the fee to the liquidator").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				ChangeLiquidatorRewardRatio(sdk.MustNewDecFromStr("0.8")),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: alice, Successful: true},
+				),
+			).
+			Then(
+				// the 250 fee is split 200 / 50 instead of 125 / 125
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(750)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(50)),
+				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(200)),
+			),
+
+		TC("full liquidation pays 60% of the fee to the liquidator").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10600))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				ChangeLiquidatorRewardRatio(sdk.MustNewDecFromStr("0.6")),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: alice, Successful: true},
+				),
+			).
+			Then(
+				// the liquidator gets 300 of the 500 fee, the rest of the 400
+				// remaining margin goes to the perp fund
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(600)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(100)),
+				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(300)),
+				PositionShouldNotExist(alice, pairBtcUsdc, 1),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+
+	t.Run("ratio outside of [0, 1] is rejected", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		sudoer := testapp.DefaultSudoRoot()
+		require.Error(t, app.PerpKeeperV2.Sudo().ChangeLiquidatorRewardRatio(ctx, sdk.MustNewDecFromStr("1.1"), sudoer))
+		require.Error(t, app.PerpKeeperV2.Sudo().ChangeLiquidatorRewardRatio(ctx, sdk.NewDec(-1), sudoer))
+		require.NoError(t, app.PerpKeeperV2.Sudo().ChangeLiquidatorRewardRatio(ctx, sdk.OneDec(), sudoer))
+	})
+}
+
+// TestPartialLiquidationPayouts pins two fixes to partial liquidations:
+//   - the liquidator is paid its share of the fee and the perp fund the rest,
+//     where the vault used to pay each of them the other's share
+//   - MultiLiquidate responses report the fees paid, which used to be empty
+func TestPartialLiquidationPayouts(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	startTime := time.Now()
+
+	liquidatorFee := sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 200)
+	perpEfFee := sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 50)
+
+	tc := TestCases{
+		TC("liquidator and perp fund are paid their own shares").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				ChangeLiquidatorRewardRatio(sdk.MustNewDecFromStr("0.8")),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{
+						Pair:          pairBtcUsdc,
+						Trader:        alice,
+						Successful:    true,
+						LiquidatorFee: &liquidatorFee,
+						PerpEfFee:     &perpEfFee,
+					},
+				),
+			).
+			Then(
+				// previously the liquidator received 50 and the perp fund 200
+				BalanceEqual(liquidator, types.TestingCollateralDenomNUSD, sdk.NewInt(200)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(50)),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestFundingTopUp(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	startTime := time.Now()
+
+	// A funding payment of 10000 * 0.05 = 500 takes the margin ratio of the
+	// position from 0.1 to (1000 - 500) / 10000 = 0.05, below the maintenance
+	// margin ratio of 0.0625.
+	tc := TestCases{
+		TC("funding paid from margin makes the position liquidatable").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithLatestMarketCPF(sdk.MustNewDecFromStr("0.05"))),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10000))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				FundAccount(alice, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 500))),
+			).
+			When(
+				MoveToNextBlock(),
+				PartialLiquidate(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("0.1")),
+			).
+			Then(
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(500)),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(9000)),
+				),
+			),
+
+		TC("funding topped up from the trader's balance keeps the position healthy").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithLatestMarketCPF(sdk.MustNewDecFromStr("0.05"))),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10000))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				FundAccount(alice, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 500))),
+				SetFundingTopUp(alice, pairBtcUsdc, true),
+			).
+			When(
+				MoveToNextBlock(),
+				PartialLiquidateFails(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("0.1"), types.ErrPositionHealthy),
+			).
+			Then(
+				// the failed liquidation does not debit the top-up
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(500)),
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(1000)),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+					Position_PositionMarginShouldBeEqualTo(sdk.NewDec(1000)),
+				),
+			),
+
+		TC("the top-up is debited when the liquidation succeeds").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithLatestMarketCPF(sdk.MustNewDecFromStr("0.06"))),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(500)), WithOpenNotional(sdk.NewDec(10000))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				FundAccount(alice, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 600))),
+				MsgServerSetFundingTopUp(alice, pairBtcUsdc, true),
+			).
+			When(
+				MoveToNextBlock(),
+				PartialLiquidate(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("0.1")),
+			).
+			Then(
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.ZeroInt()),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(9000)),
+				),
+			),
+
+		TC("insufficient balance falls back to paying funding from margin").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithLatestMarketCPF(sdk.MustNewDecFromStr("0.05"))),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10000))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				FundAccount(alice, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 400))),
+				SetFundingTopUp(alice, pairBtcUsdc, true),
+			).
+			When(
+				MoveToNextBlock(),
+				PartialLiquidate(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("0.1")),
+			).
+			Then(
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(400)),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(9000)),
+				),
+			),
+
+		TC("opting out pays funding from margin again").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithLatestMarketCPF(sdk.MustNewDecFromStr("0.05"))),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10000))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				FundAccount(alice, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 500))),
+				SetFundingTopUp(alice, pairBtcUsdc, true),
+				SetFundingTopUp(alice, pairBtcUsdc, false),
+			).
+			When(
+				MoveToNextBlock(),
+				PartialLiquidate(liquidator, pairBtcUsdc, alice, sdk.MustNewDecFromStr("0.1")),
+			).
+			Then(
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(500)),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
 func TestPrettyLiquidateResponse(t *testing.T) {
 	type TestCase struct {
 		name        string
@@ -402,3 +889,478 @@ func TestPrettyLiquidateResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestRecentLiquidations(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	carol := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("liquidations are returned newest first").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10600))),
+				InsertPosition(WithTrader(bob), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10600))),
+				InsertPosition(WithTrader(carol), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10600))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 3000))),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: alice, Successful: true},
+				),
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: bob, Successful: true},
+				),
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcUsdc, Trader: carol, Successful: true},
+				),
+			).
+			Then(
+				RecentLiquidationsShouldBe(pairBtcUsdc, 2, carol, bob),
+				RecentLiquidationsShouldBe(pairBtcUsdc, 10, carol, bob, alice),
+			),
+
+		TC("no liquidations").
+			Given(
+				CreateCustomMarket(pairBtcUsdc),
+			).
+			When().
+			Then(
+				RecentLiquidationsShouldBe(pairBtcUsdc, 10),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestQueryHistoricalMaxDrawdown(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+	// alice is long and bob is short, both 10x levered at a price of 1
+	for trader, size := range map[string]sdk.Dec{alice.String(): sdk.NewDec(100), bob.String(): sdk.NewDec(-100)} {
+		app.PerpKeeperV2.SavePosition(ctx, pair, 1, sdk.MustAccAddressFromBech32(trader), types.Position{
+			TraderAddress:                   trader,
+			Pair:                            pair,
+			Size_:                           size,
+			Margin:                          sdk.NewDec(10),
+			OpenNotional:                    sdk.NewDec(100),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+	}
+
+	// synthetic price history with a sharp drawdown to 0.7 and a rally to 1.25
+	for i, price := range []string{"1", "0.95", "0.7", "0.85", "1.25"} {
+		amm := *mock.TestAMMDefault()
+		amm.PriceMultiplier = sdk.MustNewDecFromStr(price)
+		timestamp := time.UnixMilli(int64(i+1) * 1_000)
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, timestamp), types.ReserveSnapshot{
+			Amm:         amm,
+			TimestampMs: timestamp.UnixMilli(),
+		})
+	}
+
+	testCases := []struct {
+		name                string
+		fromMs              int64
+		toMs                int64
+		expectedMaxDrawdown sdk.Dec
+	}{
+		{
+			// at 0.7, alice has 10 + (70 - 100) = -20 of margin left
+			name:                "whole history",
+			fromMs:              0,
+			toMs:                10_000,
+			expectedMaxDrawdown: sdk.NewDec(20),
+		},
+		{
+			// at 1.25, bob has 10 + (100 - 125) = -15 of margin left
+			name:                "after the drawdown",
+			fromMs:              4_000,
+			toMs:                5_000,
+			expectedMaxDrawdown: sdk.NewDec(15),
+		},
+		{
+			name:                "no position goes underwater",
+			fromMs:              1_000,
+			toMs:                2_000,
+			expectedMaxDrawdown: sdk.ZeroDec(),
+		},
+		{
+			name:                "no snapshots in the window",
+			fromMs:              6_000,
+			toMs:                7_000,
+			expectedMaxDrawdown: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			maxDrawdown, err := app.PerpKeeperV2.QueryHistoricalMaxDrawdown(ctx, pair, tc.fromMs, tc.toMs)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMaxDrawdown.String(), maxDrawdown.String())
+		})
+	}
+
+	_, err := app.PerpKeeperV2.QueryHistoricalMaxDrawdown(ctx, pair, 2_000, 1_000)
+	require.Error(t, err)
+}
+
+func TestIsLiquidatable(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	testCases := []struct {
+		name                 string
+		position             *types.Position
+		expectedLiquidatable bool
+		expectErr            bool
+	}{
+		{
+			// margin ratio: 10 / 100 = 0.1 >= 0.0625
+			name: "healthy position",
+			position: &types.Position{
+				Size_:        sdk.NewDec(100),
+				Margin:       sdk.NewDec(10),
+				OpenNotional: sdk.NewDec(100),
+			},
+			expectedLiquidatable: false,
+		},
+		{
+			// margin ratio: (10 + 100 - 108) / 100 = 0.02 < 0.0625
+			name: "underwater position",
+			position: &types.Position{
+				Size_:        sdk.NewDec(100),
+				Margin:       sdk.NewDec(10),
+				OpenNotional: sdk.NewDec(108),
+			},
+			expectedLiquidatable: true,
+		},
+		{
+			name: "zero size position",
+			position: &types.Position{
+				Size_:        sdk.ZeroDec(),
+				Margin:       sdk.ZeroDec(),
+				OpenNotional: sdk.ZeroDec(),
+			},
+			expectedLiquidatable: false,
+		},
+		{
+			name:      "missing position",
+			position:  nil,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			trader := testutil.AccAddress()
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+			if tc.position != nil {
+				tc.position.TraderAddress = trader.String()
+				tc.position.Pair = pair
+				tc.position.LatestCumulativePremiumFraction = sdk.ZeroDec()
+				app.PerpKeeperV2.SavePosition(ctx, pair, 1, trader, *tc.position)
+			}
+
+			liquidatable, marginRatio, err := app.PerpKeeperV2.IsLiquidatable(ctx, pair, trader)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedLiquidatable, liquidatable, "margin ratio: %s", marginRatio)
+		})
+	}
+}
+
+func TestLiquidationTwap(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	setup := func(t *testing.T, spikeDuration time.Duration) (*app.NibiruApp, sdk.Context, sdk.AccAddress) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		trader := testutil.AccAddress()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true), WithPricePeg(sdk.MustNewDecFromStr("0.9")))
+		spikedAmm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+		require.NoError(t, err)
+		healthyAmm := spikedAmm
+		healthyAmm.PriceMultiplier = sdk.OneDec()
+
+		healthyTime := ctx.BlockTime().Add(-10 * time.Minute)
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, healthyTime), types.ReserveSnapshot{
+			Amm:         healthyAmm,
+			TimestampMs: healthyTime.UnixMilli(),
+		})
+		spikeTime := ctx.BlockTime().Add(-spikeDuration)
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, spikeTime), types.ReserveSnapshot{
+			Amm:         spikedAmm,
+			TimestampMs: spikeTime.UnixMilli(),
+		})
+
+		// margin ratio at the spiked spot price: (10 + 90 - 100) / 90 = 0 < 0.0625
+		app.PerpKeeperV2.SavePosition(ctx, pair, 1, trader, types.Position{
+			TraderAddress:                   trader.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(100),
+			Margin:                          sdk.NewDec(10),
+			OpenNotional:                    sdk.NewDec(100),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+		return app, ctx, trader
+	}
+
+	t.Run("momentary spike does not liquidate while the twap is healthy", func(t *testing.T) {
+		app, ctx, trader := setup(t, 0)
+
+		liquidatable, marginRatio, err := app.PerpKeeperV2.IsLiquidatable(ctx, pair, trader)
+		require.NoError(t, err)
+		require.False(t, liquidatable, "margin ratio: %s", marginRatio)
+
+		price, err := app.PerpKeeperV2.GetLiquidationPrice(ctx, pair, time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, sdk.OneDec(), price)
+	})
+
+	t.Run("spike shorter than the liquidation lookback does not liquidate", func(t *testing.T) {
+		app, ctx, trader := setup(t, 30*time.Second)
+		require.NoError(t, app.PerpKeeperV2.Sudo().ChangeLiquidationTwapLookbackMs(
+			ctx, uint64(5*time.Minute/time.Millisecond), testapp.DefaultSudoRoot()))
+
+		liquidatable, marginRatio, err := app.PerpKeeperV2.IsLiquidatable(ctx, pair, trader)
+		require.NoError(t, err)
+		require.False(t, liquidatable, "margin ratio: %s", marginRatio)
+
+		// (0.9 * 30s + 1 * 270s) / 300s
+		price, err := app.PerpKeeperV2.GetLiquidationPrice(ctx, pair, 5*time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr("0.99"), price)
+	})
+
+	t.Run("move sustained over the liquidation lookback liquidates", func(t *testing.T) {
+		app, ctx, trader := setup(t, 30*time.Second)
+		require.NoError(t, app.PerpKeeperV2.Sudo().ChangeLiquidationTwapLookbackMs(
+			ctx, uint64(20*time.Second/time.Millisecond), testapp.DefaultSudoRoot()))
+
+		liquidatable, marginRatio, err := app.PerpKeeperV2.IsLiquidatable(ctx, pair, trader)
+		require.NoError(t, err)
+		require.True(t, liquidatable, "margin ratio: %s", marginRatio)
+
+		price, err := app.PerpKeeperV2.GetLiquidationPrice(ctx, pair, 20*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr("0.9"), price)
+	})
+
+	t.Run("lookback longer than the snapshot retention is rejected", func(t *testing.T) {
+		app, ctx, _ := setup(t, 0)
+		app.PerpKeeperV2.SnapshotRetentionMs.Set(ctx, uint64(time.Hour/time.Millisecond))
+
+		require.Error(t, app.PerpKeeperV2.Sudo().ChangeLiquidationTwapLookbackMs(
+			ctx, uint64(2*time.Hour/time.Millisecond), testapp.DefaultSudoRoot()))
+		require.Error(t, app.PerpKeeperV2.Sudo().ChangeLiquidationTwapLookbackMs(
+			ctx, uint64(time.Minute/time.Millisecond), testutil.AccAddress()))
+	})
+}
+
+func TestLiquidateAtMarginRatioForLiquidation(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	trader := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+
+	// The price dropped from 1 to 0.9 a minute ago. The TWAP over the 30 minute
+	// window of the market is still ~0.997 while the liquidation price over a
+	// 20 second lookback is already 0.9.
+	createTestMarket(t, app, ctx, pair, WithEnabled(true), WithPricePeg(sdk.MustNewDecFromStr("0.9")))
+	droppedAmm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+	healthyAmm := droppedAmm
+	healthyAmm.PriceMultiplier = sdk.OneDec()
+	for timestamp, snapshotAmm := range map[time.Time]types.AMM{
+		ctx.BlockTime().Add(-30 * time.Minute): healthyAmm,
+		ctx.BlockTime().Add(-time.Minute):      droppedAmm,
+	} {
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, timestamp), types.ReserveSnapshot{
+			Amm:         snapshotAmm,
+			TimestampMs: timestamp.UnixMilli(),
+		})
+	}
+	require.NoError(t, app.PerpKeeperV2.Sudo().ChangeLiquidationTwapLookbackMs(
+		ctx, uint64(20*time.Second/time.Millisecond), testapp.DefaultSudoRoot()))
+
+	position := types.Position{
+		TraderAddress:                   trader.String(),
+		Pair:                            pair,
+		Size_:                           sdk.NewDec(100),
+		Margin:                          sdk.NewDec(10),
+		OpenNotional:                    sdk.NewDec(100),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	}
+	app.PerpKeeperV2.SavePosition(ctx, pair, 1, trader, position)
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 10))))
+
+	// the margin ratio at the MAX PnL preference is above maintenance
+	market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+	require.NoError(t, err)
+	twapNotional, err := app.PerpKeeperV2.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	require.NoError(t, err)
+	maxMarginRatio := keeper.MarginRatio(position, twapNotional, sdk.ZeroDec())
+	require.True(t, maxMarginRatio.GTE(market.MaintenanceMarginRatio), "margin ratio: %s", maxMarginRatio)
+
+	// while the one at the MIN PnL preference, used by liquidations, is below
+	marginRatio, err := app.PerpKeeperV2.GetMarginRatioForLiquidation(ctx, position)
+	require.NoError(t, err)
+	require.True(t, marginRatio.LT(market.MaintenanceMarginRatio), "margin ratio: %s", marginRatio)
+
+	liquidatable, isLiquidatableRatio, err := app.PerpKeeperV2.IsLiquidatable(ctx, pair, trader)
+	require.NoError(t, err)
+	require.True(t, liquidatable)
+	require.Equal(t, marginRatio.String(), isLiquidatableRatio.String())
+
+	resps, err := app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*types.MsgMultiLiquidate_Liquidation{
+		{Pair: pair, Trader: trader.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, resps[0].Success, resps[0].Error)
+
+	_, err = app.PerpKeeperV2.GetPosition(ctx, pair, 1, trader)
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+}
+
+func TestGetLiquidationPriceForPosition(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	// maintenance margin ratio of 0.0625, positions last updated at a
+	// cumulative premium fraction of zero
+	tests := []struct {
+		name          string
+		latestCPF     sdk.Dec
+		size          sdk.Dec
+		margin        sdk.Dec
+		openNotional  sdk.Dec
+		expectedPrice sdk.Dec
+	}{
+		{
+			// (100 - 10) / (100 * 0.9375)
+			name:          "long",
+			latestCPF:     sdk.ZeroDec(),
+			size:          sdk.NewDec(100),
+			margin:        sdk.NewDec(10),
+			openNotional:  sdk.NewDec(100),
+			expectedPrice: sdk.MustNewDecFromStr("0.96"),
+		},
+		{
+			// (100 - 11 + 1) / (100 * 0.9375)
+			name:          "long paying funding",
+			latestCPF:     sdk.MustNewDecFromStr("0.01"),
+			size:          sdk.NewDec(100),
+			margin:        sdk.NewDec(11),
+			openNotional:  sdk.NewDec(100),
+			expectedPrice: sdk.MustNewDecFromStr("0.96"),
+		},
+		{
+			// (100 + 6.25) / (100 * 1.0625)
+			name:          "short",
+			latestCPF:     sdk.ZeroDec(),
+			size:          sdk.NewDec(-100),
+			margin:        sdk.MustNewDecFromStr("6.25"),
+			openNotional:  sdk.NewDec(100),
+			expectedPrice: sdk.OneDec(),
+		},
+		{
+			// (100 + 5.25 + 1) / (100 * 1.0625)
+			name:          "short receiving funding",
+			latestCPF:     sdk.MustNewDecFromStr("0.01"),
+			size:          sdk.NewDec(-100),
+			margin:        sdk.MustNewDecFromStr("5.25"),
+			openNotional:  sdk.NewDec(100),
+			expectedPrice: sdk.OneDec(),
+		},
+		{
+			name:          "long backed by its whole notional",
+			latestCPF:     sdk.ZeroDec(),
+			size:          sdk.NewDec(100),
+			margin:        sdk.NewDec(100),
+			openNotional:  sdk.NewDec(100),
+			expectedPrice: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			createTestMarket(t, app, ctx, pair, WithEnabled(true), WithLatestMarketCPF(tc.latestCPF))
+			market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+			require.NoError(t, err)
+			position := types.Position{
+				TraderAddress:                   testutil.AccAddress().String(),
+				Pair:                            pair,
+				Size_:                           tc.size,
+				Margin:                          tc.margin,
+				OpenNotional:                    tc.openNotional,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			}
+
+			price, err := app.PerpKeeperV2.GetLiquidationPriceForPosition(ctx, position)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice.String(), price.String())
+			if price.IsZero() {
+				return
+			}
+
+			marginRatio, err := app.PerpKeeperV2.GetMarginRatioAtPrice(ctx, position, price)
+			require.NoError(t, err)
+			require.Equal(t, market.MaintenanceMarginRatio.String(), marginRatio.String())
+		})
+	}
+
+	t.Run("inexact price is within rounding of maintenance", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+		market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+		require.NoError(t, err)
+		for _, size := range []sdk.Dec{sdk.NewDec(37), sdk.NewDec(-37)} {
+			position := types.Position{
+				TraderAddress:                   testutil.AccAddress().String(),
+				Pair:                            pair,
+				Size_:                           size,
+				Margin:                          sdk.NewDec(7),
+				OpenNotional:                    sdk.NewDec(53),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			}
+
+			price, err := app.PerpKeeperV2.GetLiquidationPriceForPosition(ctx, position)
+			require.NoError(t, err)
+			marginRatio, err := app.PerpKeeperV2.GetMarginRatioAtPrice(ctx, position, price)
+			require.NoError(t, err)
+			require.True(t, marginRatio.Sub(market.MaintenanceMarginRatio).Abs().LT(sdk.NewDecWithPrec(1, 15)),
+				"margin ratio %s at price %s", marginRatio, price)
+		}
+	})
+
+	t.Run("zero size", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+		_, err := app.PerpKeeperV2.GetLiquidationPriceForPosition(ctx, types.Position{
+			Pair:  pair,
+			Size_: sdk.ZeroDec(),
+		})
+		require.Error(t, err)
+	})
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
@@ -24,56 +26,203 @@ import (
 func (k Keeper) AddMargin(
 	ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress, marginToAdd sdk.Coin,
 ) (res *types.MsgAddMarginResponse, err error) {
-	collateral, err := k.Collateral.Get(ctx)
+	market, amm, position, marginValue, err := k.validateAddMargin(ctx, pair, traderAddr, marginToAdd)
 	if err != nil {
 		return nil, err
 	}
 
-	market, err := k.GetMarket(ctx, pair)
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		/* from */ traderAddr,
+		/* to */ types.VaultModuleAccount,
+		/* amount */ sdk.NewCoins(marginToAdd),
+	); err != nil {
+		return nil, err
+	}
+
+	return k.applyAddMargin(ctx, market, amm, traderAddr, position, marginValue)
+}
+
+// ArgsAddMargin is the margin to add to the position of a single pair in
+// AddMarginBatch.
+type ArgsAddMargin struct {
+	Pair   asset.Pair
+	Margin sdk.Coin
+}
+
+// AddMarginBatch adds margin to several of a trader's positions at once. The
+// total margin is transferred from the trader in a single bank send and then
+// distributed to each position. Either all positions are topped up or, if any
+// of them is invalid, none are.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - traderAddr: the trader's address
+//   - margins: the margin to add for each pair. Pairs must be unique.
+//
+// ret:
+//   - resps: the response for each position, in the order of 'margins'
+//   - err: error if any
+func (k Keeper) AddMarginBatch(
+	ctx sdk.Context, traderAddr sdk.AccAddress, margins []ArgsAddMargin,
+) (resps []*types.MsgAddMarginResponse, err error) {
+	if len(margins) == 0 {
+		return nil, fmt.Errorf("no margins to add")
+	}
+
+	// All state changes go through the cached context, which is only written
+	// once every position has been topped up.
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	markets := make([]types.Market, len(margins))
+	amms := make([]types.AMM, len(margins))
+	positions := make([]types.Position, len(margins))
+	marginValues := make([]sdk.Dec, len(margins))
+	seenPairs := make(map[asset.Pair]bool, len(margins))
+	var totalMargin sdk.Coins
+	for i, margin := range margins {
+		if seenPairs[margin.Pair] {
+			return nil, fmt.Errorf("duplicate pair in batch: %s", margin.Pair)
+		}
+		seenPairs[margin.Pair] = true
+
+		if !margin.Margin.Amount.IsPositive() {
+			return nil, fmt.Errorf("margin must be positive, not: %v", margin.Margin.Amount.String())
+		}
+
+		markets[i], amms[i], positions[i], marginValues[i], err = k.validateAddMargin(
+			cacheCtx, margin.Pair, traderAddr, margin.Margin)
+		if err != nil {
+			return nil, err
+		}
+		totalMargin = totalMargin.Add(margin.Margin)
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(
+		cacheCtx,
+		/* from */ traderAddr,
+		/* to */ types.VaultModuleAccount,
+		/* amount */ totalMargin,
+	); err != nil {
+		return nil, err
+	}
+
+	for i := range margins {
+		resp, err := k.applyAddMargin(cacheCtx, markets[i], amms[i], traderAddr, positions[i], marginValues[i])
+		if err != nil {
+			return nil, err
+		}
+		resps = append(resps, resp)
+	}
+
+	writeCache()
+	return resps, nil
+}
+
+// validateAddMargin checks that margin can be added to the trader's position
+// and returns the market, amm and position it would be added to, along with
+// the value of the margin in whole units of the primary collateral.
+//
+// Margin in a secondary collateral denom (see Keeper.CollateralDenoms) is
+// valued with the oracle price at deposit time, rounded down to whole units of
+// the primary collateral, and is rejected if it is worth less than one unit.
+// The deposited coin itself is kept in the vault.
+func (k Keeper) validateAddMargin(
+	ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress, marginToAdd sdk.Coin,
+) (market types.Market, amm types.AMM, position types.Position, marginValue sdk.Dec, err error) {
+	if err = k.requireMarketActive(ctx, pair); err != nil {
+		return market, amm, position, marginValue, err
+	}
+
+	market, err = k.GetMarket(ctx, pair)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", types.ErrPairNotFound, pair)
+		return market, amm, position, marginValue, fmt.Errorf("%w: %s", types.ErrPairNotFound, pair)
 	}
-	amm, err := k.GetAMM(ctx, pair)
+	amm, err = k.GetAMM(ctx, pair)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", types.ErrPairNotFound, pair)
+		return market, amm, position, marginValue, fmt.Errorf("%w: %s", types.ErrPairNotFound, pair)
 	}
 
-	if marginToAdd.Denom != collateral {
-		return nil, fmt.Errorf("invalid margin denom: %s", marginToAdd.Denom)
+	marginValue, err = k.CollateralValue(ctx, marginToAdd)
+	if err != nil {
+		return market, amm, position, marginValue, err
+	}
+	marginValue = marginValue.TruncateDec()
+	if !marginValue.IsPositive() {
+		return market, amm, position, marginValue, types.ErrInvalidCollateral.Wrapf(
+			"margin of %s is worth less than one unit of the primary collateral", marginToAdd)
 	}
 
-	position, err := k.GetPosition(ctx, pair, market.Version, traderAddr)
+	position, err = k.GetPosition(ctx, pair, market.Version, traderAddr)
 	if err != nil {
-		return nil, err
+		return market, amm, position, marginValue, err
 	}
 
 	fundingPayment := FundingPayment(position, market.LatestCumulativePremiumFraction)
-	remainingMargin := position.Margin.Add(sdk.NewDecFromInt(marginToAdd.Amount)).Sub(fundingPayment)
+	remainingMargin := position.Margin.Add(marginValue).Sub(fundingPayment)
 
 	if remainingMargin.IsNegative() {
-		return nil, types.ErrBadDebt.Wrapf("applying funding payment would result in negative remaining margin: %s", remainingMargin)
+		return market, amm, position, marginValue, types.ErrBadDebt.Wrapf("applying funding payment would result in negative remaining margin: %s", remainingMargin)
 	}
 
-	if err = k.BankKeeper.SendCoinsFromAccountToModule(
-		ctx,
-		/* from */ traderAddr,
-		/* to */ types.VaultModuleAccount,
-		/* amount */ sdk.NewCoins(marginToAdd),
-	); err != nil {
+	return market, amm, position, marginValue, nil
+}
+
+// CollateralValue returns the value of 'coin' in units of the primary
+// collateral. The primary collateral is valued at par, while whitelisted
+// secondary collateral is valued at the current oracle price of its pair,
+// which must not be stale. Any other denom is rejected.
+func (k Keeper) CollateralValue(ctx sdk.Context, coin sdk.Coin) (sdk.Dec, error) {
+	collateral, err := k.Collateral.Get(ctx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if coin.Denom == collateral {
+		return sdk.NewDecFromInt(coin.Amount), nil
+	}
+
+	oraclePair, err := k.CollateralDenoms.Get(ctx, coin.Denom)
+	if err != nil {
+		return sdk.Dec{}, types.ErrInvalidCollateral.Wrapf(
+			"invalid margin denom: %s is neither %s nor a whitelisted collateral denom", coin.Denom, collateral)
+	}
+	price, err := k.OracleKeeper.GetUnderlyingPrice(ctx, oraclePair)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to get price of collateral %s: %w", coin.Denom, err)
+	}
+	if !price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("invalid price of collateral %s: %s", coin.Denom, price)
+	}
+	return price.MulInt(coin.Amount), nil
+}
+
+// applyAddMargin applies the funding payment to the position and adds the
+// margin to it. The margin is expected to be in the vault already and
+// 'marginValue' is its value in units of the primary collateral.
+func (k Keeper) applyAddMargin(
+	ctx sdk.Context, market types.Market, amm types.AMM, traderAddr sdk.AccAddress,
+	position types.Position, marginValue sdk.Dec,
+) (res *types.MsgAddMarginResponse, err error) {
+	collateral, err := k.Collateral.Get(ctx)
+	if err != nil {
 		return nil, err
 	}
 
+	fundingPayment := FundingPayment(position, market.LatestCumulativePremiumFraction)
+	remainingMargin := position.Margin.Add(marginValue).Sub(fundingPayment)
+
 	// apply funding payment and add margin
 	position.Margin = remainingMargin
 	position.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
 	position.LastUpdatedBlockNumber = ctx.BlockHeight()
-	k.SavePosition(ctx, pair, market.Version, traderAddr, position)
+	k.SavePosition(ctx, market.Pair, market.Version, traderAddr, position)
 
 	positionNotional, err := PositionNotionalSpot(amm, position)
 	if err != nil {
 		return nil, err
 	}
 
+	k.recordFundingPayment(ctx, position, fundingPayment)
 	return &types.MsgAddMarginResponse{
 			FundingPayment: fundingPayment,
 			Position:       &position,
@@ -86,7 +235,7 @@ func (k Keeper) AddMargin(
 				BadDebt:          sdk.NewCoin(collateral, sdk.ZeroInt()), // always zero when adding margin
 				FundingPayment:   fundingPayment,
 				BlockHeight:      ctx.BlockHeight(),
-				MarginToUser:     marginToAdd.Amount.Neg(),
+				MarginToUser:     marginValue.TruncateInt().Neg(),
 				ChangeReason:     types.ChangeReason_AddMargin,
 			},
 		)
@@ -108,7 +257,9 @@ args:
 
 ret:
   - marginOut: the amount of margin removed
-  - fundingPayment: the funding payment that was applied with this position interaction
+  - fundingPayment: the funding payment that was applied with this position interaction.
+    Negative if the position received funding, in which case the rebate is credited to
+    the margin before the margin is removed and can be withdrawn as well.
   - err: error if any
 */
 func (k Keeper) RemoveMargin(
@@ -118,6 +269,9 @@ func (k Keeper) RemoveMargin(
 	if err != nil {
 		return nil, err
 	}
+	if err = k.requireMarketActive(ctx, pair); err != nil {
+		return nil, err
+	}
 
 	// fetch objects from state
 	market, err := k.GetMarket(ctx, pair)
@@ -149,9 +303,15 @@ func (k Keeper) RemoveMargin(
 	}
 	minPositionNotional := sdk.MinDec(spotNotional, twapNotional)
 
-	// account for funding payment
-	fundingPayment := FundingPayment(position, market.LatestCumulativePremiumFraction)
-	remainingMargin := position.Margin.Sub(fundingPayment)
+	// account for funding payment, a negative payment is a rebate that adds to the margin
+	marginAfterFunding, badDebt, fundingPayment := CalcRemainMarginWithFundingPayment(
+		position, sdk.ZeroDec(), market.LatestCumulativePremiumFraction)
+	if badDebt.IsPositive() {
+		return nil, types.ErrBadDebt.Wrapf(
+			"applying funding payment would result in negative remaining margin: %s", badDebt.Neg(),
+		)
+	}
+	remainingMargin := marginAfterFunding
 
 	// account for negative PnL
 	unrealizedPnl := UnrealizedPnl(position, minPositionNotional)
@@ -159,14 +319,35 @@ func (k Keeper) RemoveMargin(
 		remainingMargin = remainingMargin.Add(unrealizedPnl)
 	}
 
-	if remainingMargin.LT(sdk.NewDecFromInt(marginToRemove.Amount)) {
+	// in cross margin mode, the margin is drawn from the free collateral of the
+	// trader's other cross margin positions first, and this position covers the rest
+	marginFromPosition := sdk.NewDecFromInt(marginToRemove.Amount)
+	var crossPositions []types.Position
+	if k.GetMarginMode(ctx, pair, traderAddr) == types.MarginMode_CROSS {
+		freeCollateral, err := k.calcFreeCollateral(ctx, pair, traderAddr)
+		if err != nil {
+			return nil, err
+		}
+		if freeCollateral.LT(marginFromPosition) {
+			return nil, types.ErrBadDebt.Wrapf(
+				"not enough cross margin free collateral to remove margin; freeCollateral %s, marginToRemove %s", freeCollateral, marginToRemove,
+			)
+		}
+
+		crossPositions, marginFromPosition, err = k.drawCrossMargin(ctx, pair, traderAddr, marginFromPosition)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if remainingMargin.LT(marginFromPosition) {
 		return nil, types.ErrBadDebt.Wrapf(
 			"not enough free collateral to remove margin; remainingMargin %s, marginToRemove %s", remainingMargin, marginToRemove,
 		)
 	}
 
 	// apply funding payment and remove margin
-	position.Margin = position.Margin.Sub(fundingPayment).Sub(sdk.NewDecFromInt(marginToRemove.Amount))
+	position.Margin = marginAfterFunding.Sub(marginFromPosition)
 	position.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
 	position.LastUpdatedBlockNumber = ctx.BlockHeight()
 
@@ -180,6 +361,37 @@ func (k Keeper) RemoveMargin(
 	}
 	k.SavePosition(ctx, pair, market.Version, traderAddr, position)
 
+	for _, crossPosition := range crossPositions {
+		crossMarket, err := k.GetMarket(ctx, crossPosition.Pair)
+		if err != nil {
+			return nil, err
+		}
+		crossAMM, err := k.GetAMM(ctx, crossPosition.Pair)
+		if err != nil {
+			return nil, err
+		}
+		crossNotional, err := PositionNotionalSpot(crossAMM, crossPosition)
+		if err != nil {
+			return nil, err
+		}
+
+		k.SavePosition(ctx, crossPosition.Pair, crossMarket.Version, traderAddr, crossPosition)
+		_ = ctx.EventManager().EmitTypedEvent(
+			&types.PositionChangedEvent{
+				FinalPosition:    crossPosition,
+				PositionNotional: crossNotional,
+				TransactionFee:   sdk.NewCoin(collateral, sdk.ZeroInt()),
+				RealizedPnl:      sdk.ZeroDec(),
+				BadDebt:          sdk.NewCoin(collateral, sdk.ZeroInt()),
+				FundingPayment:   sdk.ZeroDec(),
+				BlockHeight:      ctx.BlockHeight(),
+				MarginToUser:     sdk.ZeroInt(), // paid out with the position margin is removed from
+				ChangeReason:     types.ChangeReason_RemoveMargin,
+			},
+		)
+	}
+
+	k.recordFundingPayment(ctx, position, fundingPayment)
 	return &types.MsgRemoveMarginResponse{
 			FundingPayment: fundingPayment,
 			Position:       &position,
@@ -197,3 +409,199 @@ func (k Keeper) RemoveMargin(
 			},
 		)
 }
+
+// SetMarginMode sets the margin mode of the position of 'trader' in 'pair'.
+// Positions are isolated unless set to cross margin with MsgSetMarginMode.
+func (k Keeper) SetMarginMode(ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress, mode types.MarginMode) error {
+	if err := mode.Validate(); err != nil {
+		return err
+	}
+
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	position, err := k.GetPosition(ctx, pair, market.Version, trader)
+	if err != nil {
+		return err
+	}
+
+	position.MarginMode = mode
+	k.SavePosition(ctx, pair, market.Version, trader, position)
+	return nil
+}
+
+// GetMarginMode returns the margin mode of the position of 'trader' in 'pair'.
+// Positions without a margin mode are isolated.
+func (k Keeper) GetMarginMode(ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress) types.MarginMode {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return types.MarginMode_ISOLATED
+	}
+	position, err := k.GetPosition(ctx, pair, market.Version, trader)
+	if err != nil || position.MarginMode != types.MarginMode_CROSS {
+		return types.MarginMode_ISOLATED
+	}
+	return types.MarginMode_CROSS
+}
+
+// calcFreeCollateral returns the margin the trader can remove from their
+// position in 'pair'. For an isolated position, it is the free collateral of
+// that position. For a cross margin position, it also includes the free
+// collateral the trader's other cross margin positions have above their
+// initial margin ratio.
+func (k Keeper) calcFreeCollateral(ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress) (sdk.Dec, error) {
+	pairs := []asset.Pair{pair}
+	if k.GetMarginMode(ctx, pair, traderAddr) == types.MarginMode_CROSS {
+		pairs = k.crossMarginPairs(ctx, traderAddr)
+	}
+
+	freeCollateral := sdk.ZeroDec()
+	for _, p := range pairs {
+		// margin is not drawn from positions of paused markets
+		if p != pair && k.PausedMarkets.Has(ctx, p) {
+			continue
+		}
+
+		market, err := k.GetMarket(ctx, p)
+		if err != nil {
+			return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", p)
+		}
+		amm, err := k.GetAMM(ctx, p)
+		if err != nil {
+			return sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", p)
+		}
+		position, err := k.GetPosition(ctx, p, market.Version, traderAddr)
+		if errors.Is(err, types.ErrPositionNotFound) && p != pair {
+			continue
+		} else if err != nil {
+			return sdk.Dec{}, err
+		}
+
+		marginRatio := market.MaintenanceMarginRatio
+		if p != pair {
+			marginRatio = initialMarginRatio(market)
+		}
+		positionFreeCollateral, err := k.positionFreeCollateral(ctx, market, amm, position, marginRatio)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		freeCollateral = freeCollateral.Add(positionFreeCollateral)
+	}
+	return freeCollateral, nil
+}
+
+// positionFreeCollateral returns the margin that can be removed from 'position'
+// without realizing its unrealized profits or taking it below 'marginRatio'.
+// With the maintenance margin ratio, it follows the same rules as RemoveMargin
+// and checkMarginRatio.
+func (k Keeper) positionFreeCollateral(
+	ctx sdk.Context, market types.Market, amm types.AMM, position types.Position, marginRatio sdk.Dec,
+) (sdk.Dec, error) {
+	spotNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	twapNotional, err := k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	marginAfterFunding := position.Margin.Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+
+	// unrealized losses reduce the free collateral, unrealized profits don't add to it
+	freeCollateral := marginAfterFunding.Add(
+		sdk.MinDec(sdk.ZeroDec(), UnrealizedPnl(position, sdk.MinDec(spotNotional, twapNotional))),
+	)
+
+	var preferredPositionNotional sdk.Dec
+	if position.Size_.IsPositive() {
+		preferredPositionNotional = sdk.MaxDec(spotNotional, twapNotional)
+	} else {
+		preferredPositionNotional = sdk.MinDec(spotNotional, twapNotional)
+	}
+	aboveMarginRatio := marginAfterFunding.
+		Add(UnrealizedPnl(position, preferredPositionNotional)).
+		Sub(marginRatio.Mul(preferredPositionNotional))
+
+	return sdk.MaxDec(sdk.ZeroDec(), sdk.MinDec(freeCollateral, aboveMarginRatio)), nil
+}
+
+// initialMarginRatio returns the margin ratio a position opened at the max
+// leverage of 'market' starts with. Cross margin positions only lend the
+// collateral they have above it, so that a draw never leaves them close to
+// liquidation.
+func initialMarginRatio(market types.Market) sdk.Dec {
+	if !market.MaxLeverage.IsPositive() {
+		return sdk.OneDec()
+	}
+	return sdk.OneDec().Quo(market.MaxLeverage)
+}
+
+// crossMarginPairs returns the pairs of the trader's cross margin positions, in
+// pair order.
+func (k Keeper) crossMarginPairs(ctx sdk.Context, traderAddr sdk.AccAddress) (pairs []asset.Pair) {
+	markets := k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values()
+	for _, market := range markets {
+		lastVersion, err := k.MarketLastVersion.Get(ctx, market.Pair)
+		if err != nil || lastVersion.Version != market.Version {
+			continue
+		}
+
+		position, err := k.GetPosition(ctx, market.Pair, market.Version, traderAddr)
+		if err == nil && position.MarginMode == types.MarginMode_CROSS {
+			pairs = append(pairs, market.Pair)
+		}
+	}
+	return pairs
+}
+
+// drawCrossMargin removes up to 'amount' of margin from the free collateral of
+// the trader's cross margin positions other than the one in 'pair', in pair
+// order. Positions of paused markets are skipped, and each position keeps at
+// least its initial margin ratio. It returns the updated positions without
+// saving them, and the part of 'amount' they could not cover.
+func (k Keeper) drawCrossMargin(
+	ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress, amount sdk.Dec,
+) (positions []types.Position, remaining sdk.Dec, err error) {
+	remaining = amount
+	for _, p := range k.crossMarginPairs(ctx, traderAddr) {
+		if !remaining.IsPositive() {
+			break
+		}
+		if p == pair || k.PausedMarkets.Has(ctx, p) {
+			continue
+		}
+
+		market, err := k.GetMarket(ctx, p)
+		if err != nil {
+			return nil, sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", p)
+		}
+		amm, err := k.GetAMM(ctx, p)
+		if err != nil {
+			return nil, sdk.Dec{}, types.ErrPairNotFound.Wrapf("pair: %s", p)
+		}
+		position, err := k.GetPosition(ctx, p, market.Version, traderAddr)
+		if errors.Is(err, types.ErrPositionNotFound) {
+			continue
+		} else if err != nil {
+			return nil, sdk.Dec{}, err
+		}
+
+		freeCollateral, err := k.positionFreeCollateral(ctx, market, amm, position, initialMarginRatio(market))
+		if err != nil {
+			return nil, sdk.Dec{}, err
+		}
+		drawn := sdk.MinDec(remaining, freeCollateral)
+		if !drawn.IsPositive() {
+			continue
+		}
+
+		position.Margin = position.Margin.Sub(drawn)
+		position.LastUpdatedBlockNumber = ctx.BlockHeight()
+		positions = append(positions, position)
+		remaining = remaining.Sub(drawn)
+	}
+
+	return positions, remaining, nil
+}
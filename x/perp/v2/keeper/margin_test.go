@@ -5,16 +5,22 @@ import (
 	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/assertion"
+	"github.com/NibiruChain/nibiru/x/perp/v2/keeper"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
@@ -151,6 +157,207 @@ func TestAddMargin(t *testing.T) {
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
 
+func TestAddMarginBatch(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairEthUsdc := asset.Registry.Pair(denoms.ETH, denoms.USDC)
+	pairAtomUsdc := asset.Registry.Pair(denoms.ATOM, denoms.USDC)
+	startBlockTime := time.Now()
+
+	marginCoin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, amount)
+	}
+
+	tc := TestCases{
+		TC("add margin to three positions").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				CreateCustomMarket(pairEthUsdc, WithEnabled(true)),
+				CreateCustomMarket(pairAtomUsdc, WithEnabled(true)),
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(marginCoin(3600))),
+				MarketOrder(alice, pairBtcUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				MarketOrder(alice, pairEthUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				MarketOrder(alice, pairAtomUsdc, types.Direction_SHORT, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			When(
+				MoveToNextBlock(),
+				AddMarginBatch(alice,
+					keeper.ArgsAddMargin{Pair: pairBtcUsdc, Margin: marginCoin(100)},
+					keeper.ArgsAddMargin{Pair: pairEthUsdc, Margin: marginCoin(200)},
+					keeper.ArgsAddMargin{Pair: pairAtomUsdc, Margin: marginCoin(300)},
+				),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(1080))),
+				PositionShouldBeEqual(alice, pairEthUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(1180))),
+				PositionShouldBeEqual(alice, pairAtomUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(1280))),
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.ZeroInt()),
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(3540)),
+			),
+
+		TC("invalid pair fails the whole batch").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				CreateCustomMarket(pairEthUsdc, WithEnabled(true)),
+				CreateCustomMarket(pairAtomUsdc, WithEnabled(true)),
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(marginCoin(2600))),
+				MarketOrder(alice, pairBtcUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				MarketOrder(alice, pairEthUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			When(
+				MoveToNextBlock(),
+				AddMarginBatchFail(alice, types.ErrPairNotFound,
+					keeper.ArgsAddMargin{Pair: pairBtcUsdc, Margin: marginCoin(100)},
+					keeper.ArgsAddMargin{Pair: asset.MustNewPair("luna:usdt"), Margin: marginCoin(100)},
+				),
+				AddMarginBatchFail(alice, types.ErrPositionNotFound,
+					keeper.ArgsAddMargin{Pair: pairEthUsdc, Margin: marginCoin(100)},
+					keeper.ArgsAddMargin{Pair: pairAtomUsdc, Margin: marginCoin(100)},
+				),
+				AddMarginBatchFail(alice, sdkerrors.ErrInsufficientFunds,
+					keeper.ArgsAddMargin{Pair: pairBtcUsdc, Margin: marginCoin(400)},
+					keeper.ArgsAddMargin{Pair: pairEthUsdc, Margin: marginCoin(400)},
+				),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(980))),
+				PositionShouldBeEqual(alice, pairEthUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(980))),
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(600)),
+			),
+
+		TC("msg server adds margin to two positions").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				CreateCustomMarket(pairEthUsdc, WithEnabled(true)),
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(marginCoin(2300))),
+				MarketOrder(alice, pairBtcUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				MarketOrder(alice, pairEthUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			When(
+				MoveToNextBlock(),
+				MsgServerAddMarginBatch(alice,
+					keeper.ArgsAddMargin{Pair: pairBtcUsdc, Margin: marginCoin(100)},
+					keeper.ArgsAddMargin{Pair: pairEthUsdc, Margin: marginCoin(200)},
+				),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(1080))),
+				PositionShouldBeEqual(alice, pairEthUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(1180))),
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.ZeroInt()),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestAddMarginSecondaryCollateral(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairUsdtNusd := asset.NewPair(denoms.USDT, types.TestingCollateralDenomNUSD)
+	startBlockTime := time.Now()
+
+	tc := TestCases{
+		TC("whitelisted denom is valued at the oracle price").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				AddCollateralDenom(denoms.USDT, pairUsdtNusd),
+				SetOraclePrice(pairUsdtNusd, sdk.MustNewDecFromStr("0.98")),
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(
+					sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000),
+					sdk.NewInt64Coin(denoms.USDT, 1001),
+				)),
+				MarketOrder(alice, pairBtcUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			When(
+				MoveToNextBlock(),
+				// 1001 * 0.98 = 980.98, rounded down to 980
+				AddMarginCoin(alice, pairBtcUsdc, sdk.NewInt64Coin(denoms.USDT, 1001)),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(1960))),
+				BalanceEqual(alice, denoms.USDT, sdk.ZeroInt()),
+				ModuleBalanceEqual(types.VaultModuleAccount, denoms.USDT, sdk.NewInt(1001)),
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(980)),
+			),
+
+		TC("whitelisted denom worth less than one unit is rejected").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				AddCollateralDenom(denoms.USDT, pairUsdtNusd),
+				SetOraclePrice(pairUsdtNusd, sdk.MustNewDecFromStr("0.98")),
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(
+					sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000),
+					sdk.NewInt64Coin(denoms.USDT, 1000),
+				)),
+				MarketOrder(alice, pairBtcUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			When(
+				MoveToNextBlock(),
+				// 1 * 0.98 = 0.98, rounded down to 0
+				AddMarginCoinFail(alice, pairBtcUsdc, sdk.NewInt64Coin(denoms.USDT, 1), types.ErrInvalidCollateral),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(980))),
+				BalanceEqual(alice, denoms.USDT, sdk.NewInt(1000)),
+			),
+
+		TC("whitelisted denom with a stale oracle price is rejected").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				AddCollateralDenom(denoms.USDT, pairUsdtNusd),
+				SetBlockNumber(1),
+				SetOraclePrice(pairUsdtNusd, sdk.MustNewDecFromStr("0.98")),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(
+					sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000),
+					sdk.NewInt64Coin(denoms.USDT, 1000),
+				)),
+				MarketOrder(alice, pairBtcUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			When(
+				// past the default expiration of 900 blocks
+				SetBlockNumber(1_000),
+				AddMarginCoinFail(alice, pairBtcUsdc, sdk.NewInt64Coin(denoms.USDT, 1000), oracletypes.ErrStalePrice),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(980))),
+				BalanceEqual(alice, denoms.USDT, sdk.NewInt(1000)),
+			),
+
+		TC("non-whitelisted denom is rejected").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(
+					sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000),
+					sdk.NewInt64Coin(denoms.USDT, 1000),
+				)),
+				MarketOrder(alice, pairBtcUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			When(
+				MoveToNextBlock(),
+				AddMarginCoinFail(alice, pairBtcUsdc, sdk.NewInt64Coin(denoms.USDT, 1000), types.ErrInvalidCollateral),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_PositionMarginShouldBeEqualTo(sdk.NewDec(980))),
+				BalanceEqual(alice, denoms.USDT, sdk.NewInt(1000)),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
 func TestRemoveMargin(t *testing.T) {
 	alice := testutil.AccAddress()
 	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
@@ -310,3 +517,188 @@ func TestRemoveMargin(t *testing.T) {
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
+
+func TestRemoveMarginCrossMode(t *testing.T) {
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	// Both positions are 100 long at a price of 1 with a maintenance margin
+	// ratio of 0.0625 and a max leverage of 10. The BTC position has ~3.75 of
+	// free collateral for itself and the ETH position lends ~40, what it has
+	// above the initial margin ratio of 0.1.
+	setup := func(t *testing.T, crossPairs ...asset.Pair) (*app.NibiruApp, sdk.Context, sdk.AccAddress) {
+		nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+		trader := testutil.AccAddress()
+
+		for pair, margin := range map[asset.Pair]sdk.Dec{pairBtc: sdk.NewDec(10), pairEth: sdk.NewDec(50)} {
+			createTestMarket(t, nibiru, ctx, pair, WithEnabled(true))
+			nibiru.PerpKeeperV2.SavePosition(ctx, pair, 1, trader, types.Position{
+				TraderAddress:                   trader.String(),
+				Pair:                            pair,
+				Size_:                           sdk.NewDec(100),
+				Margin:                          margin,
+				OpenNotional:                    sdk.NewDec(100),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			})
+		}
+		msgServer := keeper.NewMsgServerImpl(nibiru.PerpKeeperV2)
+		for _, pair := range crossPairs {
+			_, err := msgServer.SetMarginMode(sdk.WrapSDKContext(ctx), &types.MsgSetMarginMode{
+				Sender: trader.String(),
+				Pair:   pair,
+				Mode:   string(types.MarginMode_CROSS),
+			})
+			require.NoError(t, err)
+		}
+		require.NoError(t, testapp.FundModuleAccount(nibiru.BankKeeper, ctx, types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 60))))
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+		return nibiru, ctx, trader
+	}
+
+	t.Run("isolated position can't remove more than its own free collateral", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t)
+		_, err := nibiru.PerpKeeperV2.RemoveMargin(ctx, pairBtc, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 20))
+		require.ErrorIs(t, err, types.ErrBadDebt)
+	})
+
+	t.Run("margin mode is kept on the position", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t, pairBtc)
+		btcPosition, err := nibiru.PerpKeeperV2.GetPosition(ctx, pairBtc, 1, trader)
+		require.NoError(t, err)
+		require.Equal(t, types.MarginMode_CROSS, btcPosition.MarginMode)
+
+		err = nibiru.PerpKeeperV2.SetMarginMode(ctx, pairBtc, testutil.AccAddress(), types.MarginMode_CROSS)
+		require.ErrorIs(t, err, types.ErrPositionNotFound)
+	})
+
+	t.Run("cross position removes margin backed by another cross position", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t, pairBtc, pairEth)
+		require.Equal(t, types.MarginMode_CROSS, nibiru.PerpKeeperV2.GetMarginMode(ctx, pairBtc, trader))
+
+		_, err := nibiru.PerpKeeperV2.RemoveMargin(ctx, pairBtc, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 20))
+		require.NoError(t, err)
+
+		btcPosition, err := nibiru.PerpKeeperV2.GetPosition(ctx, pairBtc, 1, trader)
+		require.NoError(t, err)
+		ethPosition, err := nibiru.PerpKeeperV2.GetPosition(ctx, pairEth, 1, trader)
+		require.NoError(t, err)
+
+		// the surplus of the ETH position covers the whole removal
+		require.Equal(t, sdk.NewDec(10).String(), btcPosition.Margin.String())
+		require.Equal(t, sdk.NewDec(30).String(), ethPosition.Margin.String())
+
+		balance := nibiru.BankKeeper.GetBalance(ctx, trader, types.TestingCollateralDenomNUSD)
+		require.Equal(t, sdk.NewInt(20).String(), balance.Amount.String())
+	})
+
+	t.Run("cross position covers what the other positions can't", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t, pairBtc, pairEth)
+		_, err := nibiru.PerpKeeperV2.RemoveMargin(ctx, pairBtc, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 42))
+		require.NoError(t, err)
+
+		btcPosition, err := nibiru.PerpKeeperV2.GetPosition(ctx, pairBtc, 1, trader)
+		require.NoError(t, err)
+		ethPosition, err := nibiru.PerpKeeperV2.GetPosition(ctx, pairEth, 1, trader)
+		require.NoError(t, err)
+		require.True(t, btcPosition.Margin.LT(sdk.NewDec(10)), btcPosition.Margin.String())
+		require.Equal(t, sdk.NewDec(18).String(), btcPosition.Margin.Add(ethPosition.Margin).String())
+
+		// the ETH position is only drawn down to its initial margin ratio
+		require.True(t, ethPosition.Margin.GTE(sdk.NewDec(10)), ethPosition.Margin.String())
+	})
+
+	t.Run("other cross positions don't lend below their initial margin ratio", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t, pairBtc, pairEth)
+		// ~3.75 + ~40 of free collateral, less than the ~46.25 the positions
+		// have above their maintenance margin ratio
+		_, err := nibiru.PerpKeeperV2.RemoveMargin(ctx, pairBtc, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 45))
+		require.ErrorIs(t, err, types.ErrBadDebt)
+	})
+
+	t.Run("isolated again after switching back", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t, pairBtc, pairEth)
+		_, err := keeper.NewMsgServerImpl(nibiru.PerpKeeperV2).SetMarginMode(sdk.WrapSDKContext(ctx), &types.MsgSetMarginMode{
+			Sender: trader.String(),
+			Pair:   pairBtc,
+			Mode:   string(types.MarginMode_ISOLATED),
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.MarginMode_ISOLATED, nibiru.PerpKeeperV2.GetMarginMode(ctx, pairBtc, trader))
+
+		_, err = nibiru.PerpKeeperV2.RemoveMargin(ctx, pairBtc, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 20))
+		require.ErrorIs(t, err, types.ErrBadDebt)
+	})
+
+	t.Run("cross position can't remove more than the cross free collateral", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t, pairBtc, pairEth)
+		_, err := nibiru.PerpKeeperV2.RemoveMargin(ctx, pairBtc, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 50))
+		require.ErrorIs(t, err, types.ErrBadDebt)
+	})
+
+	t.Run("cross positions of paused markets don't back cross positions", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t, pairBtc, pairEth)
+		nibiru.PerpKeeperV2.PausedMarkets.Insert(ctx, pairEth)
+
+		_, err := nibiru.PerpKeeperV2.RemoveMargin(ctx, pairBtc, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 20))
+		require.ErrorIs(t, err, types.ErrBadDebt)
+
+		ethPosition, err := nibiru.PerpKeeperV2.GetPosition(ctx, pairEth, 1, trader)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(50).String(), ethPosition.Margin.String())
+	})
+
+	t.Run("isolated positions don't back cross positions", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t, pairBtc)
+		_, err := nibiru.PerpKeeperV2.RemoveMargin(ctx, pairBtc, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 20))
+		require.ErrorIs(t, err, types.ErrBadDebt)
+	})
+}
+
+func TestRemoveMarginFundingRebate(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	// The position is 100 long at a price of 1 with 10 of margin, so it can only
+	// remove ~3.75 before reaching the maintenance margin ratio of 0.0625.
+	setup := func(t *testing.T, cumulativePremiumFraction sdk.Dec) (*app.NibiruApp, sdk.Context, sdk.AccAddress) {
+		nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+		trader := testutil.AccAddress()
+
+		createTestMarket(t, nibiru, ctx, pair, WithEnabled(true), WithLatestMarketCPF(cumulativePremiumFraction))
+		nibiru.PerpKeeperV2.SavePosition(ctx, pair, 1, trader, types.Position{
+			TraderAddress:                   trader.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(100),
+			Margin:                          sdk.NewDec(10),
+			OpenNotional:                    sdk.NewDec(100),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+		require.NoError(t, testapp.FundModuleAccount(nibiru.BankKeeper, ctx, types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 20))))
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+		return nibiru, ctx, trader
+	}
+
+	t.Run("no funding", func(t *testing.T) {
+		nibiru, ctx, trader := setup(t, sdk.ZeroDec())
+		_, err := nibiru.PerpKeeperV2.RemoveMargin(ctx, pair, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 8))
+		require.Error(t, err)
+	})
+
+	t.Run("funding rebate can be removed", func(t *testing.T) {
+		// funding payment: (-0.05 - 0) * 100 = -5
+		nibiru, ctx, trader := setup(t, sdk.MustNewDecFromStr("-0.05"))
+		res, err := nibiru.PerpKeeperV2.RemoveMargin(ctx, pair, trader, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 8))
+		require.NoError(t, err)
+
+		require.Equal(t, sdk.NewDec(-5).String(), res.FundingPayment.String())
+		// 10 + 5 - 8
+		require.Equal(t, sdk.NewDec(7).String(), res.Position.Margin.String())
+		require.Equal(t, sdk.MustNewDecFromStr("-0.05").String(), res.Position.LatestCumulativePremiumFraction.String())
+
+		balance := nibiru.BankKeeper.GetBalance(ctx, trader, types.TestingCollateralDenomNUSD)
+		require.Equal(t, sdk.NewInt(8).String(), balance.Amount.String())
+	})
+}
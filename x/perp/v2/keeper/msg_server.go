@@ -35,6 +35,27 @@ func (m msgServer) AddMargin(ctx context.Context, msg *types.MsgAddMargin,
 	return m.k.AddMargin(sdk.UnwrapSDKContext(ctx), msg.Pair, traderAddr, msg.Margin)
 }
 
+func (m msgServer) AddMarginBatch(ctx context.Context, msg *types.MsgAddMarginBatch,
+) (*types.MsgAddMarginBatchResponse, error) {
+	// These fields should have already been validated by MsgAddMarginBatch.ValidateBasic() prior to being sent to the msgServer.
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	margins := make([]ArgsAddMargin, len(msg.Margins))
+	for i, margin := range msg.Margins {
+		margins[i] = ArgsAddMargin{Pair: margin.Pair, Margin: margin.Margin}
+	}
+	resps, err := m.k.AddMarginBatch(sdk.UnwrapSDKContext(ctx), traderAddr, margins)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.MsgAddMarginBatchResponse{}
+	for _, resp := range resps {
+		response.Responses = append(response.Responses, *resp)
+	}
+	return response, nil
+}
+
 func (m msgServer) MarketOrder(goCtx context.Context, req *types.MsgMarketOrder,
 ) (response *types.MsgMarketOrderResponse, err error) {
 	traderAddr := sdk.MustAccAddressFromBech32(req.Sender)
@@ -239,3 +260,271 @@ func (m msgServer) CloseMarket(ctx context.Context, msg *types.MsgCloseMarket) (
 	err := m.k.Sudo().CloseMarket(sdk.UnwrapSDKContext(ctx), msg.Pair, sender)
 	return &types.MsgCloseMarketResponse{}, err
 }
+
+// ChangeMinSnapshotIntervalMs: gRPC tx msg for changing the minimum time
+// between two reserve snapshots of a pair. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeMinSnapshotIntervalMs(
+	goCtx context.Context, msg *types.MsgChangeMinSnapshotIntervalMs,
+) (*types.MsgChangeMinSnapshotIntervalMsResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeMinSnapshotIntervalMs(ctx, msg.IntervalMs, sender)
+	return &types.MsgChangeMinSnapshotIntervalMsResponse{}, err
+}
+
+// ChangeSnapshotRetentionMs: gRPC tx msg for changing how long reserve
+// snapshots are kept before being pruned. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeSnapshotRetentionMs(
+	goCtx context.Context, msg *types.MsgChangeSnapshotRetentionMs,
+) (*types.MsgChangeSnapshotRetentionMsResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeSnapshotRetentionMs(ctx, msg.RetentionMs, sender)
+	return &types.MsgChangeSnapshotRetentionMsResponse{}, err
+}
+
+// ChangeLiquidationTwapLookbackMs: gRPC tx msg for changing the TWAP window
+// used to value positions for liquidation. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeLiquidationTwapLookbackMs(
+	goCtx context.Context, msg *types.MsgChangeLiquidationTwapLookbackMs,
+) (*types.MsgChangeLiquidationTwapLookbackMsResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeLiquidationTwapLookbackMs(ctx, msg.LookbackMs, sender)
+	return &types.MsgChangeLiquidationTwapLookbackMsResponse{}, err
+}
+
+// ChangeLiquidatorRewardRatio: gRPC tx msg for changing the share of the
+// liquidation fee paid to liquidators. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeLiquidatorRewardRatio(
+	goCtx context.Context, msg *types.MsgChangeLiquidatorRewardRatio,
+) (*types.MsgChangeLiquidatorRewardRatioResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeLiquidatorRewardRatio(ctx, msg.LiquidatorRewardRatio, sender)
+	return &types.MsgChangeLiquidatorRewardRatioResponse{}, err
+}
+
+// ChangeFundingRateIntervalMs: gRPC tx msg for changing the interval at
+// which funding is settled. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeFundingRateIntervalMs(
+	goCtx context.Context, msg *types.MsgChangeFundingRateIntervalMs,
+) (*types.MsgChangeFundingRateIntervalMsResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeFundingRateIntervalMs(ctx, msg.IntervalMs, sender)
+	return &types.MsgChangeFundingRateIntervalMsResponse{}, err
+}
+
+// ChangeMaxPairsPerBlock: gRPC tx msg for changing the maximum number of
+// pairs the EndBlocker processes per block. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeMaxPairsPerBlock(
+	goCtx context.Context, msg *types.MsgChangeMaxPairsPerBlock,
+) (*types.MsgChangeMaxPairsPerBlockResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeMaxPairsPerBlock(ctx, msg.MaxPairs, sender)
+	return &types.MsgChangeMaxPairsPerBlockResponse{}, err
+}
+
+// ChangeImbalanceFeeRatio: gRPC tx msg for changing the fee ratio charged on
+// the open interest imbalance of a market. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeImbalanceFeeRatio(
+	goCtx context.Context, msg *types.MsgChangeImbalanceFeeRatio,
+) (*types.MsgChangeImbalanceFeeRatioResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeImbalanceFeeRatio(ctx, msg.Pair, msg.ImbalanceFeeRatio, sender)
+	return &types.MsgChangeImbalanceFeeRatioResponse{}, err
+}
+
+// ChangeOpenInterestCap: gRPC tx msg for changing the max notional open
+// interest of each side of a market. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeOpenInterestCap(
+	goCtx context.Context, msg *types.MsgChangeOpenInterestCap,
+) (*types.MsgChangeOpenInterestCapResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeOpenInterestCap(ctx, msg.Pair, msg.OpenInterestCap, sender)
+	return &types.MsgChangeOpenInterestCapResponse{}, err
+}
+
+// ChangeFluctuationLimitRatio: gRPC tx msg for changing the max move of the
+// mark price a swap may cause. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeFluctuationLimitRatio(
+	goCtx context.Context, msg *types.MsgChangeFluctuationLimitRatio,
+) (*types.MsgChangeFluctuationLimitRatioResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeFluctuationLimitRatio(ctx, msg.Pair, msg.FluctuationLimitRatio, sender)
+	return &types.MsgChangeFluctuationLimitRatioResponse{}, err
+}
+
+// ChangeTradeLimitRatio: gRPC tx msg for changing the max share of a reserve
+// a single swap may trade. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeTradeLimitRatio(
+	goCtx context.Context, msg *types.MsgChangeTradeLimitRatio,
+) (*types.MsgChangeTradeLimitRatioResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeTradeLimitRatio(ctx, msg.Pair, msg.TradeLimitRatio, sender)
+	return &types.MsgChangeTradeLimitRatioResponse{}, err
+}
+
+// ChangeSideTradeLimitRatios: gRPC tx msg for changing the trade limit
+// ratios of long and short swaps of a market. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeSideTradeLimitRatios(
+	goCtx context.Context, msg *types.MsgChangeSideTradeLimitRatios,
+) (*types.MsgChangeSideTradeLimitRatiosResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var longTradeLimitRatio, shortTradeLimitRatio sdk.Dec
+	if msg.LongTradeLimitRatio != nil {
+		longTradeLimitRatio = *msg.LongTradeLimitRatio
+	}
+	if msg.ShortTradeLimitRatio != nil {
+		shortTradeLimitRatio = *msg.ShortTradeLimitRatio
+	}
+	err := m.k.Sudo().ChangeSideTradeLimitRatios(ctx, msg.Pair, longTradeLimitRatio, shortTradeLimitRatio, sender)
+	return &types.MsgChangeSideTradeLimitRatiosResponse{}, err
+}
+
+// ChangeCloseAtOracle: gRPC tx msg for setting whether positions of a market
+// are closed at the oracle price. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeCloseAtOracle(
+	goCtx context.Context, msg *types.MsgChangeCloseAtOracle,
+) (*types.MsgChangeCloseAtOracleResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeCloseAtOracle(ctx, msg.Pair, msg.CloseAtOracle, msg.MaxOracleSpreadRatio, sender)
+	return &types.MsgChangeCloseAtOracleResponse{}, err
+}
+
+// ChangeMaxOracleSpreadRatio: gRPC tx msg for changing the max spread of the
+// oracle price from the mark price of a market. [SUDO] Only callable by
+// sudoers.
+func (m msgServer) ChangeMaxOracleSpreadRatio(
+	goCtx context.Context, msg *types.MsgChangeMaxOracleSpreadRatio,
+) (*types.MsgChangeMaxOracleSpreadRatioResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeMaxOracleSpreadRatio(ctx, msg.Pair, msg.MaxOracleSpreadRatio, sender)
+	return &types.MsgChangeMaxOracleSpreadRatioResponse{}, err
+}
+
+// ChangeSpreadLimitedSwaps: gRPC tx msg for setting whether swaps of a
+// market are rejected while its mark price is over its oracle spread.
+// [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeSpreadLimitedSwaps(
+	goCtx context.Context, msg *types.MsgChangeSpreadLimitedSwaps,
+) (*types.MsgChangeSpreadLimitedSwapsResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeSpreadLimitedSwaps(ctx, msg.Pair, msg.SpreadLimited, sender)
+	return &types.MsgChangeSpreadLimitedSwapsResponse{}, err
+}
+
+// ChangeMarketPaused: gRPC tx msg for halting or resuming trading on a
+// market. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeMarketPaused(
+	goCtx context.Context, msg *types.MsgChangeMarketPaused,
+) (*types.MsgChangeMarketPausedResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeMarketPaused(ctx, msg.Pair, msg.Paused, sender)
+	return &types.MsgChangeMarketPausedResponse{}, err
+}
+
+// ChangeMinPositionQuote: gRPC tx msg for changing the minimum notional a
+// partially closed position may keep. [SUDO] Only callable by sudoers.
+func (m msgServer) ChangeMinPositionQuote(
+	goCtx context.Context, msg *types.MsgChangeMinPositionQuote,
+) (*types.MsgChangeMinPositionQuoteResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().ChangeMinPositionQuote(ctx, msg.MinPositionQuote, sender)
+	return &types.MsgChangeMinPositionQuoteResponse{}, err
+}
+
+// AddCollateralDenom: gRPC tx msg for whitelisting a secondary collateral
+// denom. [SUDO] Only callable by sudoers.
+func (m msgServer) AddCollateralDenom(
+	goCtx context.Context, msg *types.MsgAddCollateralDenom,
+) (*types.MsgAddCollateralDenomResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().AddCollateralDenom(ctx, msg.Denom, msg.OraclePair, sender)
+	return &types.MsgAddCollateralDenomResponse{}, err
+}
+
+// RemoveCollateralDenom: gRPC tx msg for removing a secondary collateral
+// denom from the whitelist. [SUDO] Only callable by sudoers.
+func (m msgServer) RemoveCollateralDenom(
+	goCtx context.Context, msg *types.MsgRemoveCollateralDenom,
+) (*types.MsgRemoveCollateralDenomResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().RemoveCollateralDenom(ctx, msg.Denom, sender)
+	return &types.MsgRemoveCollateralDenomResponse{}, err
+}
+
+// SetFundingTopUp opts the sender's position in or out of funding top-ups.
+func (m msgServer) SetFundingTopUp(
+	goCtx context.Context, msg *types.MsgSetFundingTopUp,
+) (*types.MsgSetFundingTopUpResponse, error) {
+	// These fields should have already been validated by MsgSetFundingTopUp.ValidateBasic() prior to being sent to the msgServer.
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := m.k.GetMarket(ctx, msg.Pair); err != nil {
+		return nil, types.ErrPairNotFound.Wrapf("pair: %s", msg.Pair)
+	}
+	m.k.SetFundingTopUp(ctx, msg.Pair, traderAddr, msg.Enabled)
+	return &types.MsgSetFundingTopUpResponse{}, nil
+}
+
+// SetMarginMode sets the margin mode of the sender's position.
+func (m msgServer) SetMarginMode(
+	goCtx context.Context, msg *types.MsgSetMarginMode,
+) (*types.MsgSetMarginModeResponse, error) {
+	// These fields should have already been validated by MsgSetMarginMode.ValidateBasic() prior to being sent to the msgServer.
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := m.k.GetMarket(ctx, msg.Pair); err != nil {
+		return nil, types.ErrPairNotFound.Wrapf("pair: %s", msg.Pair)
+	}
+	if err := m.k.SetMarginMode(ctx, msg.Pair, traderAddr, types.MarginMode(msg.Mode)); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetMarginModeResponse{}, nil
+}
+
+// ClampMarkToOracleBand: gRPC tx msg for moving the mark price of a market
+// back toward the band of its max oracle spread ratio around the oracle
+// price. [SUDO] Only callable by sudoers.
+func (m msgServer) ClampMarkToOracleBand(
+	goCtx context.Context, msg *types.MsgClampMarkToOracleBand,
+) (*types.MsgClampMarkToOracleBandResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cost, err := m.k.Sudo().ClampMarkToOracleBand(ctx, msg.Pair, sender)
+	return &types.MsgClampMarkToOracleBandResponse{Cost: cost}, err
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
@@ -31,3 +33,21 @@ func (k Keeper) DeletePosition(ctx sdk.Context, pair asset.Pair, version uint64,
 func (k Keeper) SavePosition(ctx sdk.Context, pair asset.Pair, version uint64, account sdk.AccAddress, position types.Position) {
 	k.Positions.Insert(ctx, collections.Join(collections.Join(position.Pair, version), account), position)
 }
+
+// GetTraderPositions returns the positions of 'trader' on every pair and
+// market version, ordered by pair and then by version. Positions are keyed by
+// market first, so they are looked up once per market version rather than by
+// scanning the positions of every trader.
+func (k Keeper) GetTraderPositions(ctx sdk.Context, trader sdk.AccAddress) ([]types.Position, error) {
+	var positions []types.Position
+	for _, marketKey := range k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Keys() {
+		position, err := k.Positions.Get(ctx, collections.Join(marketKey, trader))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
@@ -49,6 +49,7 @@ func (k Keeper) SettlePosition(ctx sdk.Context, pair asset.Pair, version uint64,
 		*positionResp,
 		types.ChangeReason_Settlement,
 		sdk.ZeroInt(),
+		sdk.ZeroInt(),
 		position,
 	); err != nil {
 		return nil, err
@@ -65,20 +66,15 @@ func (k Keeper) settlePosition(ctx sdk.Context, market types.Market, amm types.A
 	resp = &types.PositionResp{
 		ExchangedPositionSize: position.Size_.Neg(),
 		PositionNotional:      sdk.ZeroDec(),
-		FundingPayment:        FundingPayment(position, market.LatestCumulativePremiumFraction),
 		RealizedPnl:           UnrealizedPnl(position, positionNotional),
 		UnrealizedPnlAfter:    sdk.ZeroDec(),
 	}
 
-	remainingMargin := position.Margin.Add(resp.RealizedPnl).Sub(resp.FundingPayment)
-
-	if remainingMargin.IsPositive() {
-		resp.BadDebt = sdk.ZeroDec()
-		resp.MarginToVault = remainingMargin.Neg()
-	} else {
-		resp.BadDebt = remainingMargin.Abs()
-		resp.MarginToVault = sdk.ZeroDec()
-	}
+	remainingMargin, badDebt, fundingPayment := CalcRemainMarginWithFundingPayment(
+		position, resp.RealizedPnl, market.LatestCumulativePremiumFraction)
+	resp.FundingPayment = fundingPayment
+	resp.BadDebt = badDebt
+	resp.MarginToVault = remainingMargin.Neg()
 
 	var dir types.Direction
 	// flipped since we are going against the current position
@@ -87,12 +83,13 @@ func (k Keeper) settlePosition(ctx sdk.Context, market types.Market, amm types.A
 	} else {
 		dir = types.Direction_LONG
 	}
-	updatedAMM, exchangedNotionalValue, err := k.SwapBaseAsset(
+	updatedAMM, exchangedNotionalValue, err := k.swapBaseAsset(
 		ctx,
 		amm,
 		dir,
 		position.Size_.Abs(),
 		sdk.ZeroDec(),
+		/* checkLimits */ false,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -120,7 +120,7 @@ func TestSettlePosition(t *testing.T) {
 			PositionShouldNotExist(bob, pairBtcUsdc, 1),
 			SetBlockNumber(2),
 			BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(5)),
-			BalanceEqual(bob, types.TestingCollateralDenomNUSD, sdk.NewInt(1095)),
+			BalanceEqual(bob, types.TestingCollateralDenomNUSD, sdk.NewInt(1094)),
 		),
 
 		TC("Error: can't settle on enabled market").When(
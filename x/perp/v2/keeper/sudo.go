@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
@@ -83,7 +85,7 @@ func (k sudoExtension) CreateMarket(
 	pair := args.Pair
 	market, err := k.GetMarket(ctx, pair)
 	if err == nil && market.Enabled {
-		return fmt.Errorf("market %s already exists and it is enabled", pair)
+		return types.ErrMarketAlreadyExists.Wrapf("pair: %s", pair)
 	}
 
 	// init market
@@ -186,6 +188,457 @@ func (k sudoExtension) UnsafeChangeCollateralDenom(
 	return nil
 }
 
+// ChangeMinSnapshotIntervalMs Updates the minimum time between two reserve
+// snapshots of a pair. Zero stores a snapshot every block.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeMinSnapshotIntervalMs(
+	ctx sdk.Context,
+	intervalMs uint64,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+	k.MinSnapshotIntervalMs.Set(ctx, intervalMs)
+	return nil
+}
+
+// ChangeSnapshotRetentionMs Updates how long reserve snapshots are kept before
+// the EndBlocker prunes them. Zero disables pruning. A non-zero retention must
+// be at least the TWAP lookback window of every market.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeSnapshotRetentionMs(
+	ctx sdk.Context,
+	retentionMs uint64,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if retentionMs > 0 {
+		markets := k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values()
+		for _, market := range markets {
+			if int64(retentionMs) < market.TwapLookbackWindow.Milliseconds() {
+				return fmt.Errorf(
+					"snapshot retention of %dms is shorter than the twap lookback window of %s: %s",
+					retentionMs, market.Pair, market.TwapLookbackWindow,
+				)
+			}
+		}
+	}
+
+	k.SnapshotRetentionMs.Set(ctx, retentionMs)
+	return nil
+}
+
+// ChangeLiquidationTwapLookbackMs Updates the TWAP window used to value
+// positions when deciding whether they can be liquidated. A short window keeps
+// a single-block price spike from liquidating a position while still tracking
+// sustained moves. Zero uses the TWAP lookback window of each market. A non-zero
+// lookback must not exceed the snapshot retention, if any.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeLiquidationTwapLookbackMs(
+	ctx sdk.Context,
+	lookbackMs uint64,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	retentionMs := k.SnapshotRetentionMs.GetOr(ctx, 0)
+	if retentionMs > 0 && lookbackMs > retentionMs {
+		return fmt.Errorf(
+			"liquidation twap lookback of %dms is longer than the snapshot retention of %dms",
+			lookbackMs, retentionMs,
+		)
+	}
+
+	k.LiquidationTwapLookbackMs.Set(ctx, lookbackMs)
+	return nil
+}
+
+// ChangeLiquidatorRewardRatio Updates the share of the liquidation fee paid to
+// the liquidator of a position. The rest of the fee goes to the perp fund.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeLiquidatorRewardRatio(
+	ctx sdk.Context,
+	liquidatorRewardRatio sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if liquidatorRewardRatio.IsNil() || liquidatorRewardRatio.IsNegative() || liquidatorRewardRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquidator reward ratio must be in [0, 1], got: %s", liquidatorRewardRatio)
+	}
+
+	k.LiquidatorRewardRatio.Set(ctx, liquidatorRewardRatio)
+	return nil
+}
+
+// ChangeFundingRateIntervalMs Updates the interval at which the EndBlocker
+// settles funding of every market. Zero settles funding at the end of each
+// market's funding rate epoch instead. A non-zero interval must not exceed a
+// day, since funding rates are expressed per day.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeFundingRateIntervalMs(
+	ctx sdk.Context,
+	intervalMs uint64,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if intervalMs > uint64((24 * time.Hour).Milliseconds()) {
+		return fmt.Errorf("funding rate interval of %dms is longer than a day", intervalMs)
+	}
+
+	k.FundingRateIntervalMs.Set(ctx, intervalMs)
+	return nil
+}
+
+// ChangeMaxPairsPerBlock Updates the maximum number of AMMs the EndBlocker
+// snapshots per block. Pairs are rotated through across blocks. Zero processes
+// every AMM each block.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeMaxPairsPerBlock(
+	ctx sdk.Context,
+	maxPairs uint64,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+	k.MaxPairsPerBlock.Set(ctx, maxPairs)
+	return nil
+}
+
+// ChangeImbalanceFeeRatio Updates the fee ratio charged on the open interest
+// imbalance that trades on 'pair' add, including closes. Zero disables the fee.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeImbalanceFeeRatio(
+	ctx sdk.Context,
+	pair asset.Pair,
+	imbalanceFeeRatio sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if _, err := k.GetMarket(ctx, pair); err != nil {
+		return err
+	}
+	if imbalanceFeeRatio.IsNil() || imbalanceFeeRatio.IsNegative() || imbalanceFeeRatio.GTE(sdk.OneDec()) {
+		return fmt.Errorf("imbalance fee ratio must be in [0, 1), got: %s", imbalanceFeeRatio)
+	}
+
+	k.ImbalanceFeeRatios.Insert(ctx, pair, imbalanceFeeRatio)
+	return nil
+}
+
+// ChangeOpenInterestCap Updates the max notional open interest of each side of
+// the market of 'pair'. Zero leaves the market uncapped.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeOpenInterestCap(
+	ctx sdk.Context,
+	pair asset.Pair,
+	openInterestCap sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if _, err := k.GetMarket(ctx, pair); err != nil {
+		return err
+	}
+	if openInterestCap.IsNil() || openInterestCap.IsNegative() {
+		return fmt.Errorf("open interest cap must be non-negative, got: %s", openInterestCap)
+	}
+
+	k.OpenInterestCaps.Insert(ctx, pair, openInterestCap)
+	return nil
+}
+
+// ChangeFluctuationLimitRatio Updates the max relative move of the mark price
+// of 'pair' from its latest reserve snapshot that a swap may cause. Zero
+// disables the limit.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeFluctuationLimitRatio(
+	ctx sdk.Context,
+	pair asset.Pair,
+	fluctuationLimitRatio sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if _, err := k.GetMarket(ctx, pair); err != nil {
+		return err
+	}
+	if fluctuationLimitRatio.IsNil() || fluctuationLimitRatio.IsNegative() || fluctuationLimitRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("fluctuation limit ratio must be in [0, 1], got: %s", fluctuationLimitRatio)
+	}
+
+	k.FluctuationLimitRatios.Insert(ctx, pair, fluctuationLimitRatio)
+	return nil
+}
+
+// ChangeTradeLimitRatio Updates the max share of the base or quote reserve of
+// 'pair' that a single swap may trade. Zero disables the limit.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeTradeLimitRatio(
+	ctx sdk.Context,
+	pair asset.Pair,
+	tradeLimitRatio sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if _, err := k.GetMarket(ctx, pair); err != nil {
+		return err
+	}
+	if tradeLimitRatio.IsNil() || tradeLimitRatio.IsNegative() || tradeLimitRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("trade limit ratio must be in [0, 1], got: %s", tradeLimitRatio)
+	}
+
+	k.TradeLimitRatios.Insert(ctx, pair, tradeLimitRatio)
+	return nil
+}
+
+// ChangeSideTradeLimitRatios Updates the trade limit ratios of long and short
+// swaps of 'pair', so that one side can be capped tighter than the other. A
+// nil ratio removes the override of its side, which then uses the symmetric
+// trade limit ratio. Zero disables the limit of its side.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeSideTradeLimitRatios(
+	ctx sdk.Context,
+	pair asset.Pair,
+	longTradeLimitRatio sdk.Dec,
+	shortTradeLimitRatio sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if _, err := k.GetMarket(ctx, pair); err != nil {
+		return err
+	}
+	for _, ratio := range []sdk.Dec{longTradeLimitRatio, shortTradeLimitRatio} {
+		if !ratio.IsNil() && (ratio.IsNegative() || ratio.GT(sdk.OneDec())) {
+			return fmt.Errorf("trade limit ratio must be in [0, 1], got: %s", ratio)
+		}
+	}
+
+	if longTradeLimitRatio.IsNil() {
+		_ = k.LongTradeLimitRatios.Delete(ctx, pair)
+	} else {
+		k.LongTradeLimitRatios.Insert(ctx, pair, longTradeLimitRatio)
+	}
+	if shortTradeLimitRatio.IsNil() {
+		_ = k.ShortTradeLimitRatios.Delete(ctx, pair)
+	} else {
+		k.ShortTradeLimitRatios.Insert(ctx, pair, shortTradeLimitRatio)
+	}
+	return nil
+}
+
+// ChangeCloseAtOracle Sets whether positions of 'pair' are closed at the oracle
+// price instead of the mark price. Turning it on also sets the max oracle
+// spread ratio, which bounds the oracle price to within 'maxOracleSpreadRatio'
+// of the mark price. Turning it off leaves the max oracle spread ratio, and
+// the swaps limited by it, in place.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeCloseAtOracle(
+	ctx sdk.Context,
+	pair asset.Pair,
+	closeAtOracle bool,
+	maxOracleSpreadRatio sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if _, err := k.GetMarket(ctx, pair); err != nil {
+		return err
+	}
+
+	if !closeAtOracle {
+		k.CloseAtOracle.Delete(ctx, pair)
+		return nil
+	}
+
+	if maxOracleSpreadRatio.IsNil() || !maxOracleSpreadRatio.IsPositive() || maxOracleSpreadRatio.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max oracle spread ratio must be in (0, 1), got: %s", maxOracleSpreadRatio)
+	}
+
+	k.CloseAtOracle.Insert(ctx, pair)
+	k.MaxOracleSpreadRatios.Insert(ctx, pair, maxOracleSpreadRatio)
+	return nil
+}
+
+// ChangeMaxOracleSpreadRatio Sets the max relative spread of the oracle price
+// from the mark price of 'pair'. It bounds the oracle price positions are
+// closed at, and the mark price of spread limited swaps. A nil or zero ratio
+// removes it, unless swaps of the pair are spread limited.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeMaxOracleSpreadRatio(
+	ctx sdk.Context,
+	pair asset.Pair,
+	maxOracleSpreadRatio sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if _, err := k.GetMarket(ctx, pair); err != nil {
+		return err
+	}
+
+	if maxOracleSpreadRatio.IsNil() || maxOracleSpreadRatio.IsZero() {
+		if k.SpreadLimitedSwaps.Has(ctx, pair) {
+			return fmt.Errorf("swaps of pair %s are spread limited and need a max oracle spread ratio", pair)
+		}
+		_ = k.MaxOracleSpreadRatios.Delete(ctx, pair)
+		return nil
+	}
+
+	if maxOracleSpreadRatio.IsNegative() || maxOracleSpreadRatio.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max oracle spread ratio must be in (0, 1), got: %s", maxOracleSpreadRatio)
+	}
+	k.MaxOracleSpreadRatios.Insert(ctx, pair, maxOracleSpreadRatio)
+	return nil
+}
+
+// ChangeSpreadLimitedSwaps Sets whether swaps of 'pair' are rejected while its
+// mark price is further than its max oracle spread ratio from the oracle
+// price, see IsOverSpreadLimit. The pair must have a max oracle spread ratio,
+// see ChangeMaxOracleSpreadRatio.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeSpreadLimitedSwaps(
+	ctx sdk.Context,
+	pair asset.Pair,
+	spreadLimited bool,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if !spreadLimited {
+		k.SpreadLimitedSwaps.Delete(ctx, pair)
+		return nil
+	}
+
+	if _, err := k.MaxOracleSpreadRatios.Get(ctx, pair); err != nil {
+		return fmt.Errorf("pair %s has no max oracle spread ratio", pair)
+	}
+	k.SpreadLimitedSwaps.Insert(ctx, pair)
+	return nil
+}
+
+// ChangeMarketPaused Sets whether trading on 'pair' is halted. Margin changes
+// and swaps of a paused market return ErrMarketPaused, while queries and
+// liquidations keep working.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeMarketPaused(
+	ctx sdk.Context,
+	pair asset.Pair,
+	paused bool,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if !paused {
+		k.PausedMarkets.Delete(ctx, pair)
+		return nil
+	}
+
+	if _, err := k.GetMarket(ctx, pair); err != nil {
+		return err
+	}
+	k.PausedMarkets.Insert(ctx, pair)
+	return nil
+}
+
+// ChangeMinPositionQuote Updates the minimum notional a position may keep after
+// a partial close or a reducing market order. A partial close or market order
+// that would leave less is turned into a full close. Zero disables the
+// threshold.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ChangeMinPositionQuote(
+	ctx sdk.Context,
+	minPositionQuote sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+
+	if minPositionQuote.IsNil() || minPositionQuote.IsNegative() {
+		return fmt.Errorf("min position quote must be non-negative, got: %s", minPositionQuote)
+	}
+
+	k.MinPositionQuote.Set(ctx, minPositionQuote)
+	return nil
+}
+
+// AddCollateralDenom whitelists 'denom' as secondary collateral that can be
+// posted as margin. Its value in units of the primary collateral is given by
+// the oracle price of 'oraclePair'. [SUDO] Only callable by sudoers.
+func (k sudoExtension) AddCollateralDenom(
+	ctx sdk.Context,
+	denom string,
+	oraclePair asset.Pair,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return types.ErrInvalidCollateral.Wrap(err.Error())
+	}
+	if err := oraclePair.Validate(); err != nil {
+		return err
+	}
+	collateral, err := k.Collateral.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if denom == collateral {
+		return types.ErrInvalidCollateral.Wrapf("%s is already the primary collateral", denom)
+	}
+	k.CollateralDenoms.Insert(ctx, denom, oraclePair)
+	return nil
+}
+
+// RemoveCollateralDenom removes 'denom' from the secondary collateral
+// whitelist. Margin that was already posted in it is unaffected.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) RemoveCollateralDenom(
+	ctx sdk.Context,
+	denom string,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+	return k.CollateralDenoms.Delete(ctx, denom)
+}
+
 // ShiftPegMultiplier Edit the peg multiplier of an amm pool after making sure
 // there's enough money in the perp fund to pay for the repeg. These funds get
 // send to the vault to pay for trader's new net margin.
@@ -272,3 +725,16 @@ func (k sudoExtension) ShiftSwapInvariant(
 		CostPaid:         costPaid,
 	})
 }
+
+// ClampMarkToOracleBand moves the mark price of 'pair' back toward the band of
+// its max oracle spread ratio around the oracle price, see
+// clampMarkToOracleBand, and returns the inventory cost paid by the perp fund.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) ClampMarkToOracleBand(
+	ctx sdk.Context, pair asset.Pair, sender sdk.AccAddress,
+) (cost sdkmath.Int, err error) {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return sdkmath.Int{}, err
+	}
+	return k.clampMarkToOracleBand(ctx, pair)
+}
@@ -150,9 +150,15 @@ func TestCreateMarket(t *testing.T) {
 	err = admin.CreateMarket(ctx, keeper.ArgsCreateMarket{
 		Pair:            pair,
 		PriceMultiplier: amm.PriceMultiplier,
-		SqrtDepth:       amm.SqrtDepth,
+		SqrtDepth:       amm.SqrtDepth.MulInt64(2),
 	})
-	require.ErrorContains(t, err, "already exists")
+	require.ErrorIs(t, err, types.ErrMarketAlreadyExists)
+
+	// The reserves of the existing market are not reset
+	ammAfter, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, amm.SqrtDepth.String(), ammAfter.SqrtDepth.String())
+	require.Equal(t, amm.BaseReserve.String(), ammAfter.BaseReserve.String())
 
 	// Close the market to test that we can create it again but with an increased version
 	err = admin.CloseMarket(ctx, pair, adminUser)
@@ -426,6 +432,44 @@ func (s *TestSuiteAdmin) HandleMsg(txMsg sdk.Msg) (err error) {
 		_, err = s.perpMsgServer.ChangeCollateralDenom(ctx, msg)
 	case *perptypes.MsgWithdrawFromPerpFund:
 		_, err = s.perpMsgServer.WithdrawFromPerpFund(ctx, msg)
+	case *perptypes.MsgChangeMinSnapshotIntervalMs:
+		_, err = s.perpMsgServer.ChangeMinSnapshotIntervalMs(ctx, msg)
+	case *perptypes.MsgChangeSnapshotRetentionMs:
+		_, err = s.perpMsgServer.ChangeSnapshotRetentionMs(ctx, msg)
+	case *perptypes.MsgChangeLiquidationTwapLookbackMs:
+		_, err = s.perpMsgServer.ChangeLiquidationTwapLookbackMs(ctx, msg)
+	case *perptypes.MsgChangeLiquidatorRewardRatio:
+		_, err = s.perpMsgServer.ChangeLiquidatorRewardRatio(ctx, msg)
+	case *perptypes.MsgChangeFundingRateIntervalMs:
+		_, err = s.perpMsgServer.ChangeFundingRateIntervalMs(ctx, msg)
+	case *perptypes.MsgChangeMaxPairsPerBlock:
+		_, err = s.perpMsgServer.ChangeMaxPairsPerBlock(ctx, msg)
+	case *perptypes.MsgChangeImbalanceFeeRatio:
+		_, err = s.perpMsgServer.ChangeImbalanceFeeRatio(ctx, msg)
+	case *perptypes.MsgChangeOpenInterestCap:
+		_, err = s.perpMsgServer.ChangeOpenInterestCap(ctx, msg)
+	case *perptypes.MsgChangeFluctuationLimitRatio:
+		_, err = s.perpMsgServer.ChangeFluctuationLimitRatio(ctx, msg)
+	case *perptypes.MsgChangeTradeLimitRatio:
+		_, err = s.perpMsgServer.ChangeTradeLimitRatio(ctx, msg)
+	case *perptypes.MsgChangeSideTradeLimitRatios:
+		_, err = s.perpMsgServer.ChangeSideTradeLimitRatios(ctx, msg)
+	case *perptypes.MsgChangeCloseAtOracle:
+		_, err = s.perpMsgServer.ChangeCloseAtOracle(ctx, msg)
+	case *perptypes.MsgChangeMaxOracleSpreadRatio:
+		_, err = s.perpMsgServer.ChangeMaxOracleSpreadRatio(ctx, msg)
+	case *perptypes.MsgChangeSpreadLimitedSwaps:
+		_, err = s.perpMsgServer.ChangeSpreadLimitedSwaps(ctx, msg)
+	case *perptypes.MsgChangeMarketPaused:
+		_, err = s.perpMsgServer.ChangeMarketPaused(ctx, msg)
+	case *perptypes.MsgChangeMinPositionQuote:
+		_, err = s.perpMsgServer.ChangeMinPositionQuote(ctx, msg)
+	case *perptypes.MsgAddCollateralDenom:
+		_, err = s.perpMsgServer.AddCollateralDenom(ctx, msg)
+	case *perptypes.MsgRemoveCollateralDenom:
+		_, err = s.perpMsgServer.RemoveCollateralDenom(ctx, msg)
+	case *perptypes.MsgClampMarkToOracleBand:
+		_, err = s.perpMsgServer.ClampMarkToOracleBand(ctx, msg)
 	default:
 		return fmt.Errorf("unexpected message of type %T encountered", msg)
 	}
@@ -458,6 +502,63 @@ func (s *TestSuiteAdmin) TestCheckPermissions() {
 			Denom:  "",
 			ToAddr: sender,
 		},
+		&perptypes.MsgChangeMinSnapshotIntervalMs{
+			Sender: sender, IntervalMs: 60_000,
+		},
+		&perptypes.MsgChangeSnapshotRetentionMs{
+			Sender: sender, RetentionMs: 86_400_000,
+		},
+		&perptypes.MsgChangeLiquidationTwapLookbackMs{
+			Sender: sender, LookbackMs: 60_000,
+		},
+		&perptypes.MsgChangeLiquidatorRewardRatio{
+			Sender: sender, LiquidatorRewardRatio: sdk.MustNewDecFromStr("0.4"),
+		},
+		&perptypes.MsgChangeFundingRateIntervalMs{
+			Sender: sender, IntervalMs: 3_600_000,
+		},
+		&perptypes.MsgChangeMaxPairsPerBlock{
+			Sender: sender, MaxPairs: 5,
+		},
+		&perptypes.MsgChangeImbalanceFeeRatio{
+			Sender: sender, Pair: asset.Pair("valid:pair"), ImbalanceFeeRatio: sdk.MustNewDecFromStr("0.001"),
+		},
+		&perptypes.MsgChangeOpenInterestCap{
+			Sender: sender, Pair: asset.Pair("valid:pair"), OpenInterestCap: sdk.MustNewDecFromStr("1000000"),
+		},
+		&perptypes.MsgChangeFluctuationLimitRatio{
+			Sender: sender, Pair: asset.Pair("valid:pair"), FluctuationLimitRatio: sdk.MustNewDecFromStr("0.1"),
+		},
+		&perptypes.MsgChangeTradeLimitRatio{
+			Sender: sender, Pair: asset.Pair("valid:pair"), TradeLimitRatio: sdk.MustNewDecFromStr("0.1"),
+		},
+		&perptypes.MsgChangeSideTradeLimitRatios{
+			Sender: sender, Pair: asset.Pair("valid:pair"),
+		},
+		&perptypes.MsgChangeCloseAtOracle{
+			Sender: sender, Pair: asset.Pair("valid:pair"), CloseAtOracle: true, MaxOracleSpreadRatio: sdk.MustNewDecFromStr("0.05"),
+		},
+		&perptypes.MsgChangeMaxOracleSpreadRatio{
+			Sender: sender, Pair: asset.Pair("valid:pair"), MaxOracleSpreadRatio: sdk.MustNewDecFromStr("0.05"),
+		},
+		&perptypes.MsgChangeSpreadLimitedSwaps{
+			Sender: sender, Pair: asset.Pair("valid:pair"), SpreadLimited: true,
+		},
+		&perptypes.MsgChangeMarketPaused{
+			Sender: sender, Pair: asset.Pair("valid:pair"), Paused: true,
+		},
+		&perptypes.MsgChangeMinPositionQuote{
+			Sender: sender, MinPositionQuote: sdk.NewDec(10),
+		},
+		&perptypes.MsgAddCollateralDenom{
+			Sender: sender, Denom: "uatom", OraclePair: asset.Pair("uatom:unusd"),
+		},
+		&perptypes.MsgRemoveCollateralDenom{
+			Sender: sender, Denom: "uatom",
+		},
+		&perptypes.MsgClampMarkToOracleBand{
+			Sender: sender, Pair: asset.Pair("valid:pair"),
+		},
 	} {
 		s.Run(fmt.Sprintf("%T", testCaseMsg), func() {
 			err := s.HandleMsg(testCaseMsg)
@@ -530,3 +631,269 @@ func (s *TestSuiteAdmin) TestAdmin_SadPathsInvalidPair() {
 		s.Error(err)
 	}
 }
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeMinSnapshotIntervalMs() {
+	_, err := s.perpMsgServer.ChangeMinSnapshotIntervalMs(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeMinSnapshotIntervalMs{
+			Sender:     s.addrAdmin.String(),
+			IntervalMs: 60_000,
+		},
+	)
+	s.Require().NoError(err)
+	s.EqualValues(60_000, s.perpKeeper.MinSnapshotIntervalMs.GetOr(s.ctx, 0))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeSnapshotRetentionMs() {
+	_, err := s.perpMsgServer.ChangeSnapshotRetentionMs(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeSnapshotRetentionMs{
+			Sender:      s.addrAdmin.String(),
+			RetentionMs: 86_400_000,
+		},
+	)
+	s.Require().NoError(err)
+	s.EqualValues(86_400_000, s.perpKeeper.SnapshotRetentionMs.GetOr(s.ctx, 0))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeLiquidationTwapLookbackMs() {
+	_, err := s.perpMsgServer.ChangeLiquidationTwapLookbackMs(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeLiquidationTwapLookbackMs{
+			Sender:     s.addrAdmin.String(),
+			LookbackMs: 60_000,
+		},
+	)
+	s.Require().NoError(err)
+	s.EqualValues(60_000, s.perpKeeper.LiquidationTwapLookbackMs.GetOr(s.ctx, 0))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeLiquidatorRewardRatio() {
+	_, err := s.perpMsgServer.ChangeLiquidatorRewardRatio(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeLiquidatorRewardRatio{
+			Sender:                s.addrAdmin.String(),
+			LiquidatorRewardRatio: sdk.MustNewDecFromStr("0.4"),
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(sdk.MustNewDecFromStr("0.4"), s.perpKeeper.LiquidatorRewardRatio.GetOr(s.ctx, sdk.ZeroDec()))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeFundingRateIntervalMs() {
+	_, err := s.perpMsgServer.ChangeFundingRateIntervalMs(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeFundingRateIntervalMs{
+			Sender:     s.addrAdmin.String(),
+			IntervalMs: 3_600_000,
+		},
+	)
+	s.Require().NoError(err)
+	s.EqualValues(3_600_000, s.perpKeeper.FundingRateIntervalMs.GetOr(s.ctx, 0))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeMaxPairsPerBlock() {
+	_, err := s.perpMsgServer.ChangeMaxPairsPerBlock(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeMaxPairsPerBlock{
+			Sender:   s.addrAdmin.String(),
+			MaxPairs: 5,
+		},
+	)
+	s.Require().NoError(err)
+	s.EqualValues(5, s.perpKeeper.MaxPairsPerBlock.GetOr(s.ctx, 0))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeImbalanceFeeRatio() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	_, err := s.perpMsgServer.ChangeImbalanceFeeRatio(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeImbalanceFeeRatio{
+			Sender:            s.addrAdmin.String(),
+			Pair:              pair,
+			ImbalanceFeeRatio: sdk.MustNewDecFromStr("0.001"),
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(sdk.MustNewDecFromStr("0.001"), s.perpKeeper.ImbalanceFeeRatios.GetOr(s.ctx, pair, sdk.ZeroDec()))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeOpenInterestCap() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	_, err := s.perpMsgServer.ChangeOpenInterestCap(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeOpenInterestCap{
+			Sender:          s.addrAdmin.String(),
+			Pair:            pair,
+			OpenInterestCap: sdk.MustNewDecFromStr("1000000"),
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(sdk.MustNewDecFromStr("1000000"), s.perpKeeper.OpenInterestCaps.GetOr(s.ctx, pair, sdk.ZeroDec()))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeFluctuationLimitRatio() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	_, err := s.perpMsgServer.ChangeFluctuationLimitRatio(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeFluctuationLimitRatio{
+			Sender:                s.addrAdmin.String(),
+			Pair:                  pair,
+			FluctuationLimitRatio: sdk.MustNewDecFromStr("0.1"),
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(sdk.MustNewDecFromStr("0.1"), s.perpKeeper.FluctuationLimitRatios.GetOr(s.ctx, pair, sdk.ZeroDec()))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeTradeLimitRatio() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	_, err := s.perpMsgServer.ChangeTradeLimitRatio(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeTradeLimitRatio{
+			Sender:          s.addrAdmin.String(),
+			Pair:            pair,
+			TradeLimitRatio: sdk.MustNewDecFromStr("0.1"),
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(sdk.MustNewDecFromStr("0.1"), s.perpKeeper.TradeLimitRatios.GetOr(s.ctx, pair, sdk.ZeroDec()))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeSideTradeLimitRatios() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	longTradeLimitRatio := sdk.MustNewDecFromStr("0.1")
+	_, err := s.perpMsgServer.ChangeSideTradeLimitRatios(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeSideTradeLimitRatios{
+			Sender:              s.addrAdmin.String(),
+			Pair:                pair,
+			LongTradeLimitRatio: &longTradeLimitRatio,
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(longTradeLimitRatio, s.perpKeeper.LongTradeLimitRatios.GetOr(s.ctx, pair, sdk.ZeroDec()))
+	_, err = s.perpKeeper.ShortTradeLimitRatios.Get(s.ctx, pair)
+	s.Error(err)
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeCloseAtOracle() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	_, err := s.perpMsgServer.ChangeCloseAtOracle(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeCloseAtOracle{
+			Sender:               s.addrAdmin.String(),
+			Pair:                 pair,
+			CloseAtOracle:        true,
+			MaxOracleSpreadRatio: sdk.MustNewDecFromStr("0.05"),
+		},
+	)
+	s.Require().NoError(err)
+	s.True(s.perpKeeper.CloseAtOracle.Has(s.ctx, pair))
+	s.Equal(sdk.MustNewDecFromStr("0.05"), s.perpKeeper.MaxOracleSpreadRatios.GetOr(s.ctx, pair, sdk.ZeroDec()))
+
+	// closing at the mark price again keeps the spread settings
+	s.Require().NoError(s.perpKeeper.Sudo().ChangeSpreadLimitedSwaps(s.ctx, pair, true, s.addrAdmin))
+	_, err = s.perpMsgServer.ChangeCloseAtOracle(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeCloseAtOracle{
+			Sender:        s.addrAdmin.String(),
+			Pair:          pair,
+			CloseAtOracle: false,
+		},
+	)
+	s.Require().NoError(err)
+	s.False(s.perpKeeper.CloseAtOracle.Has(s.ctx, pair))
+	s.Equal(sdk.MustNewDecFromStr("0.05"), s.perpKeeper.MaxOracleSpreadRatios.GetOr(s.ctx, pair, sdk.ZeroDec()))
+	s.True(s.perpKeeper.SpreadLimitedSwaps.Has(s.ctx, pair))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeMaxOracleSpreadRatio() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	_, err := s.perpMsgServer.ChangeMaxOracleSpreadRatio(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeMaxOracleSpreadRatio{
+			Sender:               s.addrAdmin.String(),
+			Pair:                 pair,
+			MaxOracleSpreadRatio: sdk.MustNewDecFromStr("0.05"),
+		},
+	)
+	s.Require().NoError(err)
+	s.False(s.perpKeeper.CloseAtOracle.Has(s.ctx, pair))
+	s.Equal(sdk.MustNewDecFromStr("0.05"), s.perpKeeper.MaxOracleSpreadRatios.GetOr(s.ctx, pair, sdk.ZeroDec()))
+
+	// spread limited swaps need the ratio
+	s.Require().NoError(s.perpKeeper.Sudo().ChangeSpreadLimitedSwaps(s.ctx, pair, true, s.addrAdmin))
+	s.Error(s.perpKeeper.Sudo().ChangeMaxOracleSpreadRatio(s.ctx, pair, sdk.ZeroDec(), s.addrAdmin))
+	s.Require().NoError(s.perpKeeper.Sudo().ChangeSpreadLimitedSwaps(s.ctx, pair, false, s.addrAdmin))
+
+	s.Require().NoError(s.perpKeeper.Sudo().ChangeMaxOracleSpreadRatio(s.ctx, pair, sdk.ZeroDec(), s.addrAdmin))
+	_, err = s.perpKeeper.MaxOracleSpreadRatios.Get(s.ctx, pair)
+	s.Error(err)
+
+	s.Error(s.perpKeeper.Sudo().ChangeMaxOracleSpreadRatio(s.ctx, pair, sdk.OneDec(), s.addrAdmin))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeSpreadLimitedSwaps() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	s.Require().NoError(s.perpKeeper.Sudo().ChangeMaxOracleSpreadRatio(
+		s.ctx, pair, sdk.MustNewDecFromStr("0.05"), s.addrAdmin,
+	))
+	_, err := s.perpMsgServer.ChangeSpreadLimitedSwaps(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeSpreadLimitedSwaps{
+			Sender:        s.addrAdmin.String(),
+			Pair:          pair,
+			SpreadLimited: true,
+		},
+	)
+	s.Require().NoError(err)
+	s.True(s.perpKeeper.SpreadLimitedSwaps.Has(s.ctx, pair))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeMarketPaused() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	for _, paused := range []bool{true, false} {
+		_, err := s.perpMsgServer.ChangeMarketPaused(
+			sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeMarketPaused{
+				Sender: s.addrAdmin.String(),
+				Pair:   pair,
+				Paused: paused,
+			},
+		)
+		s.Require().NoError(err)
+		s.Equal(paused, s.perpKeeper.PausedMarkets.Has(s.ctx, pair))
+	}
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ChangeMinPositionQuote() {
+	_, err := s.perpMsgServer.ChangeMinPositionQuote(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgChangeMinPositionQuote{
+			Sender:           s.addrAdmin.String(),
+			MinPositionQuote: sdk.NewDec(10),
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(sdk.NewDec(10), s.perpKeeper.MinPositionQuote.GetOr(s.ctx, sdk.ZeroDec()))
+}
+
+func (s *TestSuiteAdmin) TestAdmin_AddAndRemoveCollateralDenom() {
+	oraclePair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	_, err := s.perpMsgServer.AddCollateralDenom(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgAddCollateralDenom{
+			Sender:     s.addrAdmin.String(),
+			Denom:      denoms.ATOM,
+			OraclePair: oraclePair,
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(oraclePair, s.perpKeeper.CollateralDenoms.GetOr(s.ctx, denoms.ATOM, ""))
+
+	_, err = s.perpMsgServer.RemoveCollateralDenom(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgRemoveCollateralDenom{
+			Sender: s.addrAdmin.String(),
+			Denom:  denoms.ATOM,
+		},
+	)
+	s.Require().NoError(err)
+	_, err = s.perpKeeper.CollateralDenoms.Get(s.ctx, denoms.ATOM)
+	s.Error(err)
+}
+
+func (s *TestSuiteAdmin) TestAdmin_ClampMarkToOracleBand() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	resp, err := s.perpMsgServer.ClampMarkToOracleBand(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgClampMarkToOracleBand{
+			Sender: s.addrAdmin.String(),
+			Pair:   pair,
+		},
+	)
+	s.Require().NoError(err)
+	// no max oracle spread ratio, so the mark price is left unchanged
+	s.True(resp.Cost.IsZero())
+}
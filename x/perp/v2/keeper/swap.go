@@ -1,8 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
@@ -56,6 +62,26 @@ func (k Keeper) SwapQuoteAsset(
 	quoteAssetAmt sdk.Dec, // unsigned
 	baseAssetLimit sdk.Dec, // unsigned
 ) (updatedAMM *types.AMM, baseAssetDelta sdk.Dec, err error) {
+	return k.swapQuoteAsset(ctx, amm, dir, quoteAssetAmt, baseAssetLimit, true)
+}
+
+// swapQuoteAsset is SwapQuoteAsset with the pause, trade and fluctuation limit
+// checks optional, so that liquidations are not blocked by them.
+func (k Keeper) swapQuoteAsset(
+	ctx sdk.Context,
+	amm types.AMM,
+	dir types.Direction,
+	quoteAssetAmt sdk.Dec, // unsigned
+	baseAssetLimit sdk.Dec, // unsigned
+	checkLimits bool,
+) (updatedAMM *types.AMM, baseAssetDelta sdk.Dec, err error) {
+	if checkLimits {
+		if err := k.requireMarketActive(ctx, amm.Pair); err != nil {
+			return nil, sdk.Dec{}, err
+		}
+	}
+
+	reserves := amm
 	baseAssetDelta, err = amm.SwapQuoteAsset(quoteAssetAmt, dir)
 	if err != nil {
 		return nil, sdk.Dec{}, err
@@ -65,7 +91,20 @@ func (k Keeper) SwapQuoteAsset(
 		return nil, sdk.Dec{}, err
 	}
 
+	if checkLimits {
+		if err := k.checkTradeLimit(ctx, reserves, dir, quoteAssetAmt, baseAssetDelta); err != nil {
+			return nil, sdk.Dec{}, err
+		}
+		if err := k.checkSpreadLimit(ctx, reserves, amm); err != nil {
+			return nil, sdk.Dec{}, err
+		}
+		if err := k.checkFluctuationLimit(ctx, amm); err != nil {
+			return nil, sdk.Dec{}, err
+		}
+	}
+
 	k.SaveAMM(ctx, amm)
+	emitPriceChanged(ctx, amm)
 
 	return &amm, baseAssetDelta, nil
 }
@@ -93,11 +132,31 @@ func (k Keeper) SwapBaseAsset(
 	dir types.Direction,
 	baseAssetAmt sdk.Dec,
 	quoteAssetLimit sdk.Dec,
+) (updatedAMM *types.AMM, quoteAssetDelta sdk.Dec, err error) {
+	return k.swapBaseAsset(ctx, amm, dir, baseAssetAmt, quoteAssetLimit, true)
+}
+
+// swapBaseAsset is SwapBaseAsset with the pause, trade and fluctuation limit
+// checks optional, so that admin operations such as settling positions of a
+// closed market, and liquidations, are not blocked by them.
+func (k Keeper) swapBaseAsset(
+	ctx sdk.Context,
+	amm types.AMM,
+	dir types.Direction,
+	baseAssetAmt sdk.Dec,
+	quoteAssetLimit sdk.Dec,
+	checkLimits bool,
 ) (updatedAMM *types.AMM, quoteAssetDelta sdk.Dec, err error) {
 	if baseAssetAmt.IsZero() {
 		return &amm, sdk.ZeroDec(), nil
 	}
+	if checkLimits {
+		if err := k.requireMarketActive(ctx, amm.Pair); err != nil {
+			return nil, sdk.Dec{}, err
+		}
+	}
 
+	reserves := amm
 	quoteAssetDelta, err = amm.SwapBaseAsset(baseAssetAmt, dir)
 	if err != nil {
 		return nil, sdk.Dec{}, err
@@ -107,7 +166,247 @@ func (k Keeper) SwapBaseAsset(
 		return nil, sdk.Dec{}, err
 	}
 
+	if checkLimits {
+		if err := k.checkTradeLimit(ctx, reserves, dir, quoteAssetDelta, baseAssetAmt); err != nil {
+			return nil, sdk.Dec{}, err
+		}
+		if err := k.checkSpreadLimit(ctx, reserves, amm); err != nil {
+			return nil, sdk.Dec{}, err
+		}
+		if err := k.checkFluctuationLimit(ctx, amm); err != nil {
+			return nil, sdk.Dec{}, err
+		}
+	}
+
 	k.SaveAMM(ctx, amm)
+	emitPriceChanged(ctx, amm)
 
 	return &amm, quoteAssetDelta, err
 }
+
+// requireMarketActive returns ErrMarketPaused if trading on 'pair' is halted.
+func (k Keeper) requireMarketActive(ctx sdk.Context, pair asset.Pair) error {
+	if k.PausedMarkets.Has(ctx, pair) {
+		return types.ErrMarketPaused.Wrapf("pair: %s", pair)
+	}
+	return nil
+}
+
+// emitPriceChanged emits the mark price and reserves of 'amm' after a swap, so
+// that indexers can follow the price series without reading snapshots.
+func emitPriceChanged(ctx sdk.Context, amm types.AMM) {
+	_ = ctx.EventManager().EmitTypedEvent(&types.PriceChangedEvent{
+		Pair:         amm.Pair,
+		MarkPrice:    amm.InstMarkPrice(),
+		BaseReserve:  amm.BaseReserve,
+		QuoteReserve: amm.QuoteReserve,
+	})
+}
+
+// TradeLimitRatio returns the trade limit ratio of swaps of 'pair' in
+// direction 'dir'. A side without its own ratio uses the symmetric trade limit
+// ratio of the pair. Zero means the side is not limited.
+func (k Keeper) TradeLimitRatio(ctx sdk.Context, pair asset.Pair, dir types.Direction) sdk.Dec {
+	limitRatio := k.TradeLimitRatios.GetOr(ctx, pair, sdk.ZeroDec())
+	switch dir {
+	case types.Direction_LONG:
+		return k.LongTradeLimitRatios.GetOr(ctx, pair, limitRatio)
+	case types.Direction_SHORT:
+		return k.ShortTradeLimitRatios.GetOr(ctx, pair, limitRatio)
+	default:
+		return limitRatio
+	}
+}
+
+// checkTradeLimit returns an error if a swap of 'quoteAssetAmt' quote assets
+// for 'baseAssetAmt' base assets in direction 'dir' trades more than the
+// pair's trade limit ratio for that direction of either reserve of 'reserves',
+// the AMM before the swap. Pairs without a limit are not checked. Neither are
+// liquidations, so that large underwater positions can still be closed.
+func (k Keeper) checkTradeLimit(
+	ctx sdk.Context, reserves types.AMM, dir types.Direction, quoteAssetAmt sdk.Dec, baseAssetAmt sdk.Dec,
+) error {
+	limitRatio := k.TradeLimitRatio(ctx, reserves.Pair, dir)
+	if limitRatio.IsZero() {
+		return nil
+	}
+
+	if !reserves.HasEnoughQuoteReserve(quoteAssetAmt, limitRatio) {
+		return types.ErrOverTradingLimit.Wrapf(
+			"%s quote amount %s exceeds %s of the quote reserve %s", dir, quoteAssetAmt, limitRatio, reserves.QuoteReserve,
+		)
+	}
+	if !reserves.HasEnoughBaseReserve(baseAssetAmt, limitRatio) {
+		return types.ErrOverTradingLimit.Wrapf(
+			"%s base amount %s exceeds %s of the base reserve %s", dir, baseAssetAmt, limitRatio, reserves.BaseReserve,
+		)
+	}
+	return nil
+}
+
+// checkFluctuationLimit returns an error if the mark price of 'amm' moved from
+// the mark price of the latest reserve snapshot of its pair by more than the
+// pair's fluctuation limit ratio. Pairs without a limit, or without snapshots,
+// are not checked. Neither are liquidations, which must be able to close
+// underwater positions however far the price has to move.
+func (k Keeper) checkFluctuationLimit(ctx sdk.Context, amm types.AMM) error {
+	limitRatio, snapshotPrice, limited := k.fluctuationLimit(ctx, amm.Pair)
+	if !limited {
+		return nil
+	}
+
+	markPrice := amm.InstMarkPrice()
+	if fluctuation := markPrice.Sub(snapshotPrice).Abs().Quo(snapshotPrice); fluctuation.GT(limitRatio) {
+		return types.ErrOverFluctuationLimit.Wrapf(
+			"mark price %s is %s away from the snapshot price %s of %s, above the limit of %s",
+			markPrice, fluctuation, snapshotPrice, amm.Pair, limitRatio)
+	}
+	return nil
+}
+
+// fluctuationLimit returns the fluctuation limit ratio of 'pair' and the mark
+// price of its latest reserve snapshot, which swaps are checked against.
+// 'limited' is false if the pair has no limit or no snapshot to compare to.
+func (k Keeper) fluctuationLimit(
+	ctx sdk.Context, pair asset.Pair,
+) (limitRatio sdk.Dec, snapshotPrice sdk.Dec, limited bool) {
+	limitRatio = k.FluctuationLimitRatios.GetOr(ctx, pair, sdk.ZeroDec())
+	if limitRatio.IsZero() {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+
+	iter := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			EndInclusive(ctx.BlockTime()).
+			Descending(),
+	)
+	defer iter.Close()
+	if !iter.Valid() {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+
+	snapshotPrice = iter.Value().Amm.InstMarkPrice()
+	if snapshotPrice.IsZero() {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+	return limitRatio, snapshotPrice, true
+}
+
+// GetMaxQuoteWithinFluctuationLimit returns the largest amount of quote assets
+// a single swap in direction 'dir' can trade on 'pair' without moving its mark
+// price further from the mark price of the latest reserve snapshot than the
+// fluctuation limit ratio allows. A long adds the amount to the quote reserve,
+// a short removes it. The amount is zero if the mark price is already at or
+// beyond the limit on that side.
+//
+// returns:
+//   - maxQuoteAssetAmt: the quote assets that move the mark price to the limit
+//   - limited: false, with a nil amount, if swaps of the pair are not checked
+//     against a fluctuation limit
+//   - err: error if the pair does not exist
+func (k Keeper) GetMaxQuoteWithinFluctuationLimit(
+	ctx sdk.Context, pair asset.Pair, dir types.Direction,
+) (maxQuoteAssetAmt sdk.Dec, limited bool, err error) {
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, false, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	limitRatio, snapshotPrice, limited := k.fluctuationLimit(ctx, pair)
+	if !limited {
+		return sdk.Dec{}, false, nil
+	}
+
+	var limitPrice sdk.Dec
+	switch dir {
+	case types.Direction_LONG:
+		limitPrice = snapshotPrice.Mul(sdk.OneDec().Add(limitRatio))
+	case types.Direction_SHORT:
+		limitPrice = snapshotPrice.Mul(sdk.OneDec().Sub(limitRatio))
+	default:
+		return sdk.Dec{}, false, fmt.Errorf("invalid direction: %s", dir)
+	}
+
+	// Along x * y = k, the mark price is y^2 * priceMultiplier / k, so the
+	// quote reserve y at the limit price p is sqrt(p * k / priceMultiplier).
+	invariant := amm.QuoteReserve.Mul(amm.BaseReserve)
+	limitQuoteReserve, err := common.SqrtDec(limitPrice.Mul(invariant).Quo(amm.PriceMultiplier))
+	if err != nil {
+		return sdk.Dec{}, false, err
+	}
+
+	quoteReserveDelta := limitQuoteReserve.Sub(amm.QuoteReserve)
+	if dir == types.Direction_SHORT {
+		quoteReserveDelta = quoteReserveDelta.Neg()
+	}
+	if !quoteReserveDelta.IsPositive() {
+		return sdk.ZeroDec(), true, nil
+	}
+	return amm.QuoteReserveToAsset(quoteReserveDelta), true, nil
+}
+
+// IsOverSpreadLimit returns whether the mark price of 'pair' is further than
+// its max oracle spread ratio from the oracle price, relative to the oracle
+// price. Pairs without a max oracle spread ratio are never over the limit.
+func (k Keeper) IsOverSpreadLimit(ctx sdk.Context, pair asset.Pair) (bool, error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return false, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	amm, err := k.GetAMM(ctx, pair)
+	if err != nil {
+		return false, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	return k.isOverSpreadLimit(ctx, market, amm)
+}
+
+func (k Keeper) isOverSpreadLimit(ctx sdk.Context, market types.Market, amm types.AMM) (bool, error) {
+	maxSpreadRatio, err := k.MaxOracleSpreadRatios.Get(ctx, market.Pair)
+	if err != nil {
+		return false, nil
+	}
+
+	oraclePrice, err := k.OracleKeeper.GetUnderlyingPrice(ctx, market.OraclePair)
+	if err != nil {
+		return false, err
+	}
+	if !oraclePrice.IsPositive() {
+		return false, fmt.Errorf("oracle price of %s must be positive, not: %s", market.OraclePair, oraclePrice)
+	}
+
+	spread := amm.InstMarkPrice().Sub(oraclePrice).Abs().Quo(oraclePrice)
+	return spread.GT(maxSpreadRatio), nil
+}
+
+// checkSpreadLimit returns an error if swaps of the pair of 'reserves', the AMM
+// before the swap, are spread limited, its mark price is over the spread limit
+// and the swap to 'amm' widens the gap between the mark and oracle prices.
+// Swaps that narrow the gap, such as closing the positions that pushed the mark
+// price over the limit, go through. A stale oracle price rejects the swap.
+func (k Keeper) checkSpreadLimit(ctx sdk.Context, reserves types.AMM, amm types.AMM) error {
+	if !k.SpreadLimitedSwaps.Has(ctx, reserves.Pair) {
+		return nil
+	}
+
+	market, err := k.GetMarket(ctx, reserves.Pair)
+	if err != nil {
+		return types.ErrPairNotFound.Wrapf("pair: %s", reserves.Pair)
+	}
+	overSpreadLimit, err := k.isOverSpreadLimit(ctx, market, reserves)
+	if err != nil || !overSpreadLimit {
+		return err
+	}
+
+	oraclePrice, err := k.OracleKeeper.GetUnderlyingPrice(ctx, market.OraclePair)
+	if err != nil {
+		return err
+	}
+	gapBefore := reserves.InstMarkPrice().Sub(oraclePrice).Abs()
+	gapAfter := amm.InstMarkPrice().Sub(oraclePrice).Abs()
+	if gapAfter.LT(gapBefore) {
+		return nil
+	}
+	return types.ErrOverSpreadLimit.Wrapf(
+		"mark price %s of %s", reserves.InstMarkPrice(), reserves.Pair)
+}
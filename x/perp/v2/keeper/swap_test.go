@@ -3,13 +3,21 @@ package keeper_test
 import (
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
+
+	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
 )
 
 func TestSwapQuoteAsset(t *testing.T) {
@@ -211,3 +219,502 @@ func TestSwapBaseAsset(t *testing.T) {
 		})
 	}
 }
+
+func TestSwapFluctuationLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		swap        func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error
+		expectedErr error
+	}{
+		{
+			// mark price: (1 + 0.01)^2 = 1.0201
+			name: "small quote swap stays within the limit",
+			swap: func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error {
+				_, _, err := nibiru.PerpKeeperV2.SwapQuoteAsset(ctx, amm, types.Direction_LONG, sdk.NewDec(1e10), sdk.ZeroDec())
+				return err
+			},
+		},
+		{
+			// mark price: (1 + 0.1)^2 = 1.21
+			name: "large quote swap trips the limit",
+			swap: func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error {
+				_, _, err := nibiru.PerpKeeperV2.SwapQuoteAsset(ctx, amm, types.Direction_LONG, sdk.NewDec(1e11), sdk.ZeroDec())
+				return err
+			},
+			expectedErr: types.ErrOverFluctuationLimit,
+		},
+		{
+			// mark price: 1 / (1 + 0.01)^2 ~= 0.98
+			name: "small base swap stays within the limit",
+			swap: func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error {
+				_, _, err := nibiru.PerpKeeperV2.SwapBaseAsset(ctx, amm, types.Direction_SHORT, sdk.NewDec(1e10), sdk.ZeroDec())
+				return err
+			},
+		},
+		{
+			// mark price: 1 / (1 + 0.1)^2 ~= 0.83
+			name: "large base swap trips the limit",
+			swap: func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error {
+				_, _, err := nibiru.PerpKeeperV2.SwapBaseAsset(ctx, amm, types.Direction_SHORT, sdk.NewDec(1e11), sdk.ZeroDec())
+				return err
+			},
+			expectedErr: types.ErrOverFluctuationLimit,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+			amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+			require.NoError(t, err)
+			app.PerpKeeperV2.FluctuationLimitRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.1"))
+
+			err = tc.swap(app, ctx, amm)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expectedErr)
+
+			// the swap is reverted
+			ammAfter, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+			require.NoError(t, err)
+			assert.Equal(t, amm.QuoteReserve.String(), ammAfter.QuoteReserve.String())
+			assert.Equal(t, amm.BaseReserve.String(), ammAfter.BaseReserve.String())
+		})
+	}
+}
+
+func TestGetMaxQuoteWithinFluctuationLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		limitRatio sdk.Dec
+		dir        types.Direction
+
+		expectedMaxQuote  sdk.Dec
+		expectedMarkPrice sdk.Dec
+	}{
+		{
+			// quote reserve at the limit: sqrt(1.5625 * 1e24) = 1.25e12
+			name:              "long up to the upper limit",
+			limitRatio:        sdk.MustNewDecFromStr("0.5625"),
+			dir:               types.Direction_LONG,
+			expectedMaxQuote:  sdk.NewDec(2.5e11),
+			expectedMarkPrice: sdk.MustNewDecFromStr("1.5625"),
+		},
+		{
+			// quote reserve at the limit: sqrt(0.64 * 1e24) = 0.8e12
+			name:              "short down to the lower limit",
+			limitRatio:        sdk.MustNewDecFromStr("0.36"),
+			dir:               types.Direction_SHORT,
+			expectedMaxQuote:  sdk.NewDec(2e11),
+			expectedMarkPrice: sdk.MustNewDecFromStr("0.64"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+			amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+			require.NoError(t, err)
+			app.PerpKeeperV2.FluctuationLimitRatios.Insert(ctx, pair, tc.limitRatio)
+
+			maxQuote, limited, err := app.PerpKeeperV2.GetMaxQuoteWithinFluctuationLimit(ctx, pair, tc.dir)
+			require.NoError(t, err)
+			require.True(t, limited)
+			assert.Equal(t, tc.expectedMaxQuote.String(), maxQuote.String())
+
+			// any more trips the limit
+			_, _, err = app.PerpKeeperV2.SwapQuoteAsset(ctx, amm, tc.dir, maxQuote.Add(sdk.OneDec()), sdk.ZeroDec())
+			require.ErrorIs(t, err, types.ErrOverFluctuationLimit)
+
+			// the amount itself lands exactly at the limit
+			updatedAMM, _, err := app.PerpKeeperV2.SwapQuoteAsset(ctx, amm, tc.dir, maxQuote, sdk.ZeroDec())
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMarkPrice.String(), updatedAMM.InstMarkPrice().String())
+
+			// with the mark price at the limit there is no room left
+			maxQuote, limited, err = app.PerpKeeperV2.GetMaxQuoteWithinFluctuationLimit(ctx, pair, tc.dir)
+			require.NoError(t, err)
+			require.True(t, limited)
+			assert.True(t, maxQuote.IsZero())
+		})
+	}
+
+	t.Run("pair without a fluctuation limit", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+		_, limited, err := app.PerpKeeperV2.GetMaxQuoteWithinFluctuationLimit(ctx, pair, types.Direction_LONG)
+		require.NoError(t, err)
+		require.False(t, limited)
+	})
+
+	t.Run("pair not found", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+
+		_, _, err := app.PerpKeeperV2.GetMaxQuoteWithinFluctuationLimit(ctx, mock.TestAMMDefault().Pair, types.Direction_LONG)
+		require.ErrorIs(t, err, types.ErrPairNotFound)
+	})
+}
+
+func TestSwapEmitsPriceChanged(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+
+	updatedAMM, _, err := app.PerpKeeperV2.SwapQuoteAsset(ctx, amm, types.Direction_LONG, sdk.NewDec(1e10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	var priceChangedEvent *types.PriceChangedEvent
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "nibiru.perp.v2.PriceChangedEvent" {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		priceChangedEvent = typedEvent.(*types.PriceChangedEvent)
+	}
+	require.NotNil(t, priceChangedEvent, "price changed event not emitted")
+	assert.Equal(t, pair, priceChangedEvent.Pair)
+	assert.Equal(t, updatedAMM.InstMarkPrice().String(), priceChangedEvent.MarkPrice.String())
+	assert.Equal(t, updatedAMM.BaseReserve.String(), priceChangedEvent.BaseReserve.String())
+	assert.Equal(t, updatedAMM.QuoteReserve.String(), priceChangedEvent.QuoteReserve.String())
+}
+
+func TestSwapTradeLimit(t *testing.T) {
+	// the trade limit is 0.1 * 1e12 = 1e11 of either reserve
+	tests := []struct {
+		name        string
+		swap        func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error
+		expectedErr error
+	}{
+		{
+			// base out: 1e12 - 1e24 / 1.1e12 ~= 9.09e10
+			name: "quote input at the limit",
+			swap: func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error {
+				_, _, err := nibiru.PerpKeeperV2.SwapQuoteAsset(ctx, amm, types.Direction_LONG, sdk.NewDec(1e11), sdk.ZeroDec())
+				return err
+			},
+		},
+		{
+			name: "quote input beyond the limit",
+			swap: func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error {
+				_, _, err := nibiru.PerpKeeperV2.SwapQuoteAsset(ctx, amm, types.Direction_LONG, sdk.NewDec(1e11+1), sdk.ZeroDec())
+				return err
+			},
+			expectedErr: types.ErrOverTradingLimit,
+		},
+		{
+			// quote out: 1e12 - 1e24 / 1.1e12 ~= 9.09e10
+			name: "base input at the limit",
+			swap: func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error {
+				_, _, err := nibiru.PerpKeeperV2.SwapBaseAsset(ctx, amm, types.Direction_SHORT, sdk.NewDec(1e11), sdk.ZeroDec())
+				return err
+			},
+		},
+		{
+			name: "base input beyond the limit",
+			swap: func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error {
+				_, _, err := nibiru.PerpKeeperV2.SwapBaseAsset(ctx, amm, types.Direction_SHORT, sdk.NewDec(1e11+1), sdk.ZeroDec())
+				return err
+			},
+			expectedErr: types.ErrOverTradingLimit,
+		},
+		{
+			// quote in: 1e24 / 9e11 - 1e12 ~= 1.11e11
+			name: "base output at the limit needs a quote input beyond it",
+			swap: func(nibiru *app.NibiruApp, ctx sdk.Context, amm types.AMM) error {
+				_, _, err := nibiru.PerpKeeperV2.SwapBaseAsset(ctx, amm, types.Direction_LONG, sdk.NewDec(1e11), sdk.ZeroDec())
+				return err
+			},
+			expectedErr: types.ErrOverTradingLimit,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+			amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+			require.NoError(t, err)
+			app.PerpKeeperV2.TradeLimitRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.1"))
+
+			err = tc.swap(app, ctx, amm)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestSwapSpreadLimit(t *testing.T) {
+	// max oracle spread ratio of 0.05 around a mark price of 1
+	tests := []struct {
+		name              string
+		oraclePrice       *sdk.Dec
+		spreadLimited     bool
+		expectedOver      bool
+		expectedOverErr   bool
+		expectedSwapError error
+	}{
+		{
+			name:          "oracle price within the spread",
+			oraclePrice:   decPtr(sdk.MustNewDecFromStr("1.02")),
+			spreadLimited: true,
+		},
+		{
+			name:              "oracle price beyond the spread",
+			oraclePrice:       decPtr(sdk.MustNewDecFromStr("1.10")),
+			spreadLimited:     true,
+			expectedOver:      true,
+			expectedSwapError: types.ErrOverSpreadLimit,
+		},
+		{
+			name:          "swaps not spread limited",
+			oraclePrice:   decPtr(sdk.MustNewDecFromStr("1.10")),
+			spreadLimited: false,
+			expectedOver:  true,
+		},
+		{
+			name:              "no oracle price",
+			spreadLimited:     true,
+			expectedOverErr:   true,
+			expectedSwapError: oracletypes.ErrStalePrice,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+			amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+			require.NoError(t, err)
+
+			sudoer := testapp.DefaultSudoRoot()
+			require.NoError(t, app.PerpKeeperV2.Sudo().ChangeMaxOracleSpreadRatio(
+				ctx, pair, sdk.MustNewDecFromStr("0.05"), sudoer))
+			require.NoError(t, app.PerpKeeperV2.Sudo().ChangeSpreadLimitedSwaps(
+				ctx, pair, tc.spreadLimited, sudoer))
+			if tc.oraclePrice != nil {
+				app.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.BTC, denoms.USD), *tc.oraclePrice)
+			}
+
+			overSpreadLimit, err := app.PerpKeeperV2.IsOverSpreadLimit(ctx, pair)
+			if tc.expectedOverErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOver, overSpreadLimit)
+			}
+
+			// both swaps lower the mark price, away from an oracle price above it
+			_, _, quoteErr := app.PerpKeeperV2.SwapQuoteAsset(ctx, amm, types.Direction_SHORT, sdk.NewDec(1e6), sdk.ZeroDec())
+			_, _, baseErr := app.PerpKeeperV2.SwapBaseAsset(ctx, amm, types.Direction_SHORT, sdk.NewDec(1e6), sdk.ZeroDec())
+			if tc.expectedSwapError == nil {
+				require.NoError(t, quoteErr)
+				require.NoError(t, baseErr)
+				return
+			}
+			require.ErrorIs(t, quoteErr, tc.expectedSwapError)
+			require.ErrorIs(t, baseErr, tc.expectedSwapError)
+		})
+	}
+
+	t.Run("positions can be closed while over the spread", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+		trader := testutil.AccAddress()
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, trader,
+			sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 2e6))))
+		_, err := app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_LONG, trader, sdk.NewInt(1e6), sdk.OneDec(), sdk.ZeroDec())
+		require.NoError(t, err)
+
+		sudoer := testapp.DefaultSudoRoot()
+		require.NoError(t, app.PerpKeeperV2.Sudo().ChangeMaxOracleSpreadRatio(
+			ctx, pair, sdk.MustNewDecFromStr("0.05"), sudoer))
+		require.NoError(t, app.PerpKeeperV2.Sudo().ChangeSpreadLimitedSwaps(ctx, pair, true, sudoer))
+		app.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.BTC, denoms.USD), sdk.MustNewDecFromStr("0.9"))
+
+		overSpreadLimit, err := app.PerpKeeperV2.IsOverSpreadLimit(ctx, pair)
+		require.NoError(t, err)
+		require.True(t, overSpreadLimit)
+
+		// adding to the long widens the gap to the oracle price
+		_, err = app.PerpKeeperV2.MarketOrder(
+			ctx, pair, types.Direction_LONG, trader, sdk.NewInt(1e6), sdk.OneDec(), sdk.ZeroDec())
+		require.ErrorIs(t, err, types.ErrOverSpreadLimit)
+
+		// closing it narrows the gap
+		_, err = app.PerpKeeperV2.ClosePosition(ctx, pair, trader)
+		require.NoError(t, err)
+		_, err = app.PerpKeeperV2.GetPosition(ctx, pair, 1, trader)
+		require.ErrorIs(t, err, types.ErrPositionNotFound)
+	})
+
+	t.Run("spread limiting needs a max oracle spread ratio", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		amm := *mock.TestAMMDefault()
+		require.Error(t, app.PerpKeeperV2.Sudo().ChangeSpreadLimitedSwaps(
+			ctx, amm.Pair, true, testapp.DefaultSudoRoot()))
+	})
+}
+
+func decPtr(dec sdk.Dec) *sdk.Dec {
+	return &dec
+}
+
+func TestPausedMarket(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+	app.PerpKeeperV2.Collateral.Set(ctx, denoms.NUSD)
+
+	sudoer := testapp.DefaultSudoRoot()
+	trader := testutil.AccAddress()
+	require.Error(t, app.PerpKeeperV2.Sudo().ChangeMarketPaused(ctx, pair, true, trader))
+	require.NoError(t, app.PerpKeeperV2.Sudo().ChangeMarketPaused(ctx, pair, true, sudoer))
+
+	_, _, err = app.PerpKeeperV2.SwapQuoteAsset(ctx, amm, types.Direction_LONG, sdk.NewDec(1e6), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrMarketPaused)
+	_, _, err = app.PerpKeeperV2.SwapBaseAsset(ctx, amm, types.Direction_SHORT, sdk.NewDec(1e6), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrMarketPaused)
+	_, err = app.PerpKeeperV2.AddMargin(ctx, pair, trader, sdk.NewInt64Coin(denoms.NUSD, 1))
+	require.ErrorIs(t, err, types.ErrMarketPaused)
+	_, err = app.PerpKeeperV2.RemoveMargin(ctx, pair, trader, sdk.NewInt64Coin(denoms.NUSD, 1))
+	require.ErrorIs(t, err, types.ErrMarketPaused)
+
+	// queries still work while the market is paused
+	_, err = app.PerpKeeperV2.GetMarket(ctx, pair)
+	require.NoError(t, err)
+	_, err = app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+
+	require.NoError(t, app.PerpKeeperV2.Sudo().ChangeMarketPaused(ctx, pair, false, sudoer))
+	_, _, err = app.PerpKeeperV2.SwapQuoteAsset(ctx, amm, types.Direction_LONG, sdk.NewDec(1e6), sdk.ZeroDec())
+	require.NoError(t, err)
+}
+
+func TestSideTradeLimitRatios(t *testing.T) {
+	// symmetric trade limit ratio of 0.1 of reserves of 1e12
+	tests := []struct {
+		name          string
+		longRatio     sdk.Dec
+		shortRatio    sdk.Dec
+		dir           types.Direction
+		quoteAssetAmt sdk.Dec
+		expectedErr   error
+	}{
+		{
+			name:          "long within a tighter long limit",
+			longRatio:     sdk.MustNewDecFromStr("0.05"),
+			shortRatio:    sdk.MustNewDecFromStr("0.2"),
+			dir:           types.Direction_LONG,
+			quoteAssetAmt: sdk.NewDec(4e10),
+		},
+		{
+			name:          "long beyond a tighter long limit",
+			longRatio:     sdk.MustNewDecFromStr("0.05"),
+			shortRatio:    sdk.MustNewDecFromStr("0.2"),
+			dir:           types.Direction_LONG,
+			quoteAssetAmt: sdk.NewDec(6e10),
+			expectedErr:   types.ErrOverTradingLimit,
+		},
+		{
+			name:          "short beyond the symmetric limit within a looser short limit",
+			longRatio:     sdk.MustNewDecFromStr("0.05"),
+			shortRatio:    sdk.MustNewDecFromStr("0.2"),
+			dir:           types.Direction_SHORT,
+			quoteAssetAmt: sdk.NewDec(1.5e11),
+		},
+		{
+			name:          "short beyond a looser short limit",
+			longRatio:     sdk.MustNewDecFromStr("0.05"),
+			shortRatio:    sdk.MustNewDecFromStr("0.2"),
+			dir:           types.Direction_SHORT,
+			quoteAssetAmt: sdk.NewDec(2.1e11),
+			expectedErr:   types.ErrOverTradingLimit,
+		},
+		{
+			name:          "side without an override uses the symmetric limit",
+			longRatio:     sdk.MustNewDecFromStr("0.05"),
+			dir:           types.Direction_SHORT,
+			quoteAssetAmt: sdk.NewDec(1.5e11),
+			expectedErr:   types.ErrOverTradingLimit,
+		},
+		{
+			name:          "zero disables the limit of a side",
+			longRatio:     sdk.ZeroDec(),
+			dir:           types.Direction_LONG,
+			quoteAssetAmt: sdk.NewDec(5e11),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+			createTestMarket(t, app, ctx, pair, WithEnabled(true))
+			amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+			require.NoError(t, err)
+
+			sudoer := testapp.DefaultSudoRoot()
+			require.NoError(t, app.PerpKeeperV2.Sudo().ChangeTradeLimitRatio(
+				ctx, pair, sdk.MustNewDecFromStr("0.1"), sudoer))
+			require.NoError(t, app.PerpKeeperV2.Sudo().ChangeSideTradeLimitRatios(
+				ctx, pair, tc.longRatio, tc.shortRatio, sudoer))
+
+			_, _, err = app.PerpKeeperV2.SwapQuoteAsset(ctx, amm, tc.dir, tc.quoteAssetAmt, sdk.ZeroDec())
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+
+	t.Run("removing an override falls back to the symmetric limit", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+		sudoer := testapp.DefaultSudoRoot()
+		require.NoError(t, app.PerpKeeperV2.Sudo().ChangeTradeLimitRatio(
+			ctx, pair, sdk.MustNewDecFromStr("0.1"), sudoer))
+		require.NoError(t, app.PerpKeeperV2.Sudo().ChangeSideTradeLimitRatios(
+			ctx, pair, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.2"), sudoer))
+		require.Equal(t, "0.050000000000000000",
+			app.PerpKeeperV2.TradeLimitRatio(ctx, pair, types.Direction_LONG).String())
+
+		require.NoError(t, app.PerpKeeperV2.Sudo().ChangeSideTradeLimitRatios(
+			ctx, pair, sdk.Dec{}, sdk.Dec{}, sudoer))
+		require.Equal(t, "0.100000000000000000",
+			app.PerpKeeperV2.TradeLimitRatio(ctx, pair, types.Direction_LONG).String())
+		require.Equal(t, "0.100000000000000000",
+			app.PerpKeeperV2.TradeLimitRatio(ctx, pair, types.Direction_SHORT).String())
+	})
+
+	t.Run("ratio above one is rejected", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+		createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+		require.Error(t, app.PerpKeeperV2.Sudo().ChangeSideTradeLimitRatios(
+			ctx, pair, sdk.NewDec(2), sdk.Dec{}, testapp.DefaultSudoRoot()))
+	})
+}
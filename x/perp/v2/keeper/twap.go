@@ -1,15 +1,73 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
+// SaveSnapshot stores a reserve snapshot of the AMM at the current block time.
+// If the latest snapshot of the pair is less than MinSnapshotIntervalMs old, no
+// snapshot is stored, which caps the number of snapshots to one per interval.
+// The first snapshot of an interval keeps the reserves it was taken with until
+// the next one is appended once the interval closes, so the TWAP samples the
+// reserves at interval boundaries rather than at every block.
+func (k Keeper) SaveSnapshot(ctx sdk.Context, amm types.AMM) {
+	snapshotTime := ctx.BlockTime()
+
+	if minIntervalMs := k.MinSnapshotIntervalMs.GetOr(ctx, 0); minIntervalMs > 0 {
+		iter := k.ReserveSnapshots.Iterate(
+			ctx,
+			collections.PairRange[asset.Pair, time.Time]{}.
+				Prefix(amm.Pair).
+				EndInclusive(ctx.BlockTime()).
+				Descending(),
+		)
+		withinInterval := iter.Valid() &&
+			snapshotTime.UnixMilli()-iter.Value().TimestampMs < int64(minIntervalMs)
+		iter.Close()
+		if withinInterval {
+			return
+		}
+	}
+
+	k.ReserveSnapshots.Insert(ctx, collections.Join(amm.Pair, snapshotTime), types.ReserveSnapshot{
+		Amm:         amm.Clone(),
+		TimestampMs: snapshotTime.UnixMilli(),
+	})
+}
+
+// PruneSnapshots deletes the reserve snapshots of 'pair' taken at or before
+// 'before', except for the latest of them. That snapshot holds the reserves in
+// effect at the cutoff, so a TWAP whose lookback window starts after 'before'
+// is the same with or without the pruned snapshots. Returns the number of
+// snapshots deleted.
+func (k Keeper) PruneSnapshots(ctx sdk.Context, pair asset.Pair, before time.Time) (numPruned int) {
+	iter := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			EndInclusive(before).
+			Descending(),
+	)
+	keys := iter.Keys()
+	if len(keys) <= 1 {
+		return 0
+	}
+
+	// keys[0] is the latest snapshot at or before the cutoff and is kept
+	for _, key := range keys[1:] {
+		_ = k.ReserveSnapshots.Delete(ctx, key)
+	}
+	return len(keys) - 1
+}
+
 /*
 CalcTwap Gets the time-weighted average price from [ ctx.BlockTime() - interval, ctx.BlockTime() )
 Note the open-ended right bracket.
@@ -34,16 +92,235 @@ func (k Keeper) CalcTwap(
 	assetAmt sdk.Dec,
 	lookbackInterval time.Duration,
 ) (price sdk.Dec, err error) {
-	// earliest timestamp we'll look back until
-	lowerLimitTimestampMs := ctx.BlockTime().Add(-1 * lookbackInterval).UnixMilli()
+	return k.calcTwap(ctx, pair, twapCalcOption, direction, assetAmt, lookbackInterval, false, sdk.Dec{})
+}
+
+/*
+CalcGeometricTwap Gets the time-weighted geometric mean price from
+[ ctx.BlockTime() - interval, ctx.BlockTime() ). The geometric mean is less
+sensitive than the arithmetic mean to short-lived price spikes, which makes it
+more resistant to manipulation.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - twapCalcOption: one of SPOT, QUOTE_ASSET_SWAP, or BASE_ASSET_SWAP
+  - direction: add or remove, only required for QUOTE_ASSET_SWAP or BASE_ASSET_SWAP
+  - assetAmount: amount of asset to add or remove, only required for QUOTE_ASSET_SWAP or BASE_ASSET_SWAP
+  - lookbackInterval: how far back to calculate TWAP
+
+ret:
+  - price: geometric TWAP as sdk.Dec
+  - err: error
+*/
+func (k Keeper) CalcGeometricTwap(
+	ctx sdk.Context,
+	pair asset.Pair,
+	twapCalcOption types.TwapCalcOption,
+	direction types.Direction,
+	assetAmt sdk.Dec,
+	lookbackInterval time.Duration,
+) (price sdk.Dec, err error) {
+	return k.calcTwap(ctx, pair, twapCalcOption, direction, assetAmt, lookbackInterval, true, sdk.Dec{})
+}
+
+/*
+CalcTrimmedTwap Gets the time-weighted average price from
+[ ctx.BlockTime() - interval, ctx.BlockTime() ), excluding the snapshots whose
+price deviates from the mean snapshot price of the window by more than
+'maxStdDevs' standard deviations. This keeps a brief, manipulated price spike
+from moving the TWAP.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - twapCalcOption: one of SPOT, QUOTE_ASSET_SWAP, or BASE_ASSET_SWAP
+  - direction: add or remove, only required for QUOTE_ASSET_SWAP or BASE_ASSET_SWAP
+  - assetAmount: amount of asset to add or remove, only required for QUOTE_ASSET_SWAP or BASE_ASSET_SWAP
+  - lookbackInterval: how far back to calculate TWAP
+  - maxStdDevs: how many standard deviations a snapshot price may deviate from the mean. Must be positive.
+
+ret:
+  - price: trimmed TWAP as sdk.Dec
+  - err: error
+*/
+func (k Keeper) CalcTrimmedTwap(
+	ctx sdk.Context,
+	pair asset.Pair,
+	twapCalcOption types.TwapCalcOption,
+	direction types.Direction,
+	assetAmt sdk.Dec,
+	lookbackInterval time.Duration,
+	maxStdDevs sdk.Dec,
+) (price sdk.Dec, err error) {
+	if maxStdDevs.IsNil() || !maxStdDevs.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("max standard deviations must be positive, not: %s", maxStdDevs)
+	}
+	return k.calcTwap(ctx, pair, twapCalcOption, direction, assetAmt, lookbackInterval, false, maxStdDevs)
+}
+
+/*
+CalcTwapBetween Gets the time-weighted average price from [ startMs, endMs ),
+independent of the current block time. This allows the TWAP of a past window
+to be recomputed for backtesting and dispute resolution.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - twapCalcOption: one of SPOT, QUOTE_ASSET_SWAP, or BASE_ASSET_SWAP
+  - direction: add or remove, only required for QUOTE_ASSET_SWAP or BASE_ASSET_SWAP
+  - assetAmount: amount of asset to add or remove, only required for QUOTE_ASSET_SWAP or BASE_ASSET_SWAP
+  - startMs: start of the window, as a unix timestamp in milliseconds
+  - endMs: end of the window, as a unix timestamp in milliseconds. Must be after startMs.
+
+ret:
+  - price: TWAP as sdk.Dec
+  - err: error
+*/
+func (k Keeper) CalcTwapBetween(
+	ctx sdk.Context,
+	pair asset.Pair,
+	twapCalcOption types.TwapCalcOption,
+	direction types.Direction,
+	assetAmt sdk.Dec,
+	startMs int64,
+	endMs int64,
+) (price sdk.Dec, err error) {
+	if startMs >= endMs {
+		return sdk.Dec{}, fmt.Errorf("twap window start %d must be before its end %d", startMs, endMs)
+	}
+	return k.calcTwapBetween(ctx, pair, twapCalcOption, direction, assetAmt, startMs, time.UnixMilli(endMs), false, sdk.Dec{}, 0)
+}
+
+/*
+GetCumulativePrice Gets the running sum of the spot price of 'pair' multiplied
+by the milliseconds it was in effect, from its earliest reserve snapshot up to
+'atMs'. Like Uniswap's cumulative price, the difference of two readings divided
+by the time between them is the TWAP of that window, without walking the
+snapshots:
+
+	twap = (GetCumulativePrice(t1) - GetCumulativePrice(t0)) / (t1 - t0)
+
+Only such differences are meaningful: pruning snapshots moves the origin of the
+sum, which shifts every reading taken after the retained snapshot equally.
 
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - atMs: the time up to which prices are accumulated, as a unix timestamp in milliseconds
+
+ret:
+  - cumulativePrice: price * milliseconds, as sdk.Dec
+  - err: ErrNoValidTWAP if the pair has no snapshot at or before 'atMs'
+*/
+func (k Keeper) GetCumulativePrice(
+	ctx sdk.Context,
+	pair asset.Pair,
+	atMs int64,
+) (cumulativePrice sdk.Dec, err error) {
+	snapshots := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			EndInclusive(time.UnixMilli(atMs)),
+	).Values()
+	if len(snapshots) == 0 {
+		return sdk.Dec{}, types.ErrNoValidTWAP
+	}
+
+	cumulativePrice = sdk.ZeroDec()
+	for i, snapshot := range snapshots {
+		// each price is in effect until the next snapshot, the last one until atMs
+		untilMs := atMs
+		if i+1 < len(snapshots) {
+			untilMs = snapshots[i+1].TimestampMs
+		}
+		price, err := getPriceWithSnapshot(snapshot, snapshotPriceOps{twapCalcOption: types.TwapCalcOption_SPOT})
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		cumulativePrice = cumulativePrice.Add(price.MulInt64(untilMs - snapshot.TimestampMs))
+	}
+	return cumulativePrice, nil
+}
+
+/*
+GetExponentialTwap Gets the exponentially weighted moving average of the spot
+price over [ ctx.BlockTime() - lookback, ctx.BlockTime() ). Each snapshot price
+is weighted by the time it was in effect and by exp(-age / halfLife), where age
+is the time between the middle of that interval and the block time. The
+average therefore reacts faster to recent prices than CalcTwap.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - lookback: how far back to calculate the average
+  - halfLife: the decay constant of the weights, must be positive
+
+ret:
+  - price: EWMA as sdk.Dec
+  - err: error
+*/
+func (k Keeper) GetExponentialTwap(
+	ctx sdk.Context,
+	pair asset.Pair,
+	lookback time.Duration,
+	halfLife time.Duration,
+) (price sdk.Dec, err error) {
+	if halfLife.Milliseconds() <= 0 {
+		return sdk.Dec{}, fmt.Errorf("half life must be at least 1ms, got %s", halfLife)
+	}
+	return k.calcTwapBetween(
+		ctx, pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(),
+		ctx.BlockTime().Add(-1*lookback).UnixMilli(), ctx.BlockTime(),
+		false, sdk.Dec{}, halfLife,
+	)
+}
+
+// calcTwap traverses the reserve snapshots of the lookback interval and
+// returns either their arithmetic or their geometric time-weighted mean. The
+// geometric mean accumulates ln(price) * timeElapsed and exponentiates the
+// average at the end. If 'trimStdDevs' is positive, the snapshots whose price
+// is more than that many standard deviations away from the mean are left out.
+func (k Keeper) calcTwap(
+	ctx sdk.Context,
+	pair asset.Pair,
+	twapCalcOption types.TwapCalcOption,
+	direction types.Direction,
+	assetAmt sdk.Dec,
+	lookbackInterval time.Duration,
+	geometric bool,
+	trimStdDevs sdk.Dec,
+) (price sdk.Dec, err error) {
+	return k.calcTwapBetween(
+		ctx, pair, twapCalcOption, direction, assetAmt,
+		ctx.BlockTime().Add(-1*lookbackInterval).UnixMilli(), ctx.BlockTime(),
+		geometric, trimStdDevs, 0,
+	)
+}
+
+// calcTwapBetween is calcTwap over the window [ lowerLimitTimestampMs, end ).
+// If 'halfLife' is positive, the prices are additionally weighted by
+// exp(-age / halfLife), see exponentialMean.
+func (k Keeper) calcTwapBetween(
+	ctx sdk.Context,
+	pair asset.Pair,
+	twapCalcOption types.TwapCalcOption,
+	direction types.Direction,
+	assetAmt sdk.Dec,
+	lowerLimitTimestampMs int64, // earliest timestamp we'll look back until
+	end time.Time,
+	geometric bool,
+	trimStdDevs sdk.Dec,
+	halfLife time.Duration,
+) (price sdk.Dec, err error) {
 	// fetch snapshots from state
 	var snapshots []types.ReserveSnapshot
 	iter := k.ReserveSnapshots.Iterate(
 		ctx,
 		collections.PairRange[asset.Pair, time.Time]{}.
 			Prefix(pair).
-			EndInclusive(ctx.BlockTime()).
+			EndInclusive(end).
 			Descending(),
 	)
 	defer iter.Close()
@@ -59,7 +336,9 @@ func (k Keeper) CalcTwap(
 		return sdk.OneDec().Neg(), types.ErrNoValidTWAP
 	}
 
-	// circuit-breaker when there's only one snapshot to process
+	// circuit-breaker when there's only one snapshot to process: its price was
+	// in effect over the whole window, which may have zero length when the
+	// lookback lands exactly on the snapshot
 	if len(snapshots) == 1 {
 		return getPriceWithSnapshot(
 			snapshots[0],
@@ -71,10 +350,9 @@ func (k Keeper) CalcTwap(
 		)
 	}
 
-	// else, iterate over all snapshots and calculate TWAP
-	prevTimestampMs := ctx.BlockTime().UnixMilli()
-	cumulativePrice := sdk.ZeroDec()
-	cumulativePeriodMs := int64(0)
+	// else, iterate over all snapshots and weigh their prices by the time they were in effect
+	prevTimestampMs := end.UnixMilli()
+	var points []twapPoint
 
 	for _, snapshot := range snapshots {
 		if snapshot.TimestampMs == prevTimestampMs {
@@ -102,9 +380,11 @@ func (k Keeper) CalcTwap(
 		} else {
 			timeElapsedMs = prevTimestampMs - snapshot.TimestampMs
 		}
-
-		cumulativePrice = cumulativePrice.Add(price.MulInt64(timeElapsedMs))
-		cumulativePeriodMs += timeElapsedMs
+		points = append(points, twapPoint{
+			price:         price,
+			timeElapsedMs: timeElapsedMs,
+			ageMs:         end.UnixMilli() - prevTimestampMs + timeElapsedMs/2,
+		})
 
 		if snapshot.TimestampMs <= lowerLimitTimestampMs {
 			break
@@ -112,8 +392,35 @@ func (k Keeper) CalcTwap(
 		prevTimestampMs = snapshot.TimestampMs
 	}
 
+	if !trimStdDevs.IsNil() && trimStdDevs.IsPositive() {
+		points = trimOutliers(points, trimStdDevs)
+		if len(points) == 0 {
+			return sdk.OneDec().Neg(), types.ErrNoValidTWAP
+		}
+	}
+
+	if halfLife > 0 {
+		return exponentialMean(points, halfLife)
+	}
+
+	cumulativePrice := sdk.ZeroDec()
+	cumulativePeriodMs := int64(0)
+	for _, point := range points {
+		price := point.price
+		if geometric {
+			if price, err = common.LnDec(price); err != nil {
+				return sdk.Dec{}, err
+			}
+		}
+
+		cumulativePrice = cumulativePrice.Add(price.MulInt64(point.timeElapsedMs))
+		cumulativePeriodMs += point.timeElapsedMs
+	}
+
 	if cumulativePeriodMs == 0 {
-		// Should not be reachable
+		// no time elapsed between the snapshots of the window, e.g. when they
+		// all share the block time, so fall back to the latest snapshot price
+		// rather than dividing by zero
 		return getPriceWithSnapshot(
 			snapshots[0],
 			snapshotPriceOps{
@@ -124,9 +431,76 @@ func (k Keeper) CalcTwap(
 		)
 	}
 
+	if geometric {
+		return common.ExpDec(cumulativePrice.QuoInt64(cumulativePeriodMs))
+	}
 	return cumulativePrice.QuoInt64(cumulativePeriodMs), nil
 }
 
+// twapPoint is the price of a snapshot and the time it was in effect within
+// the TWAP lookback window.
+type twapPoint struct {
+	price         sdk.Dec
+	timeElapsedMs int64
+	// time between the middle of the interval and the end of the window
+	ageMs int64
+}
+
+// exponentialMean returns the mean price of the points weighted by both the
+// time they were in effect and exp(-age / halfLife), so that recent prices
+// count more than older ones. Falls back to the most recent price if the
+// points have no weight.
+func exponentialMean(points []twapPoint, halfLife time.Duration) (sdk.Dec, error) {
+	cumulativePrice := sdk.ZeroDec()
+	cumulativeWeight := sdk.ZeroDec()
+	for _, point := range points {
+		decay, err := common.ExpDec(sdk.NewDec(-point.ageMs).QuoInt64(halfLife.Milliseconds()))
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		weight := decay.MulInt64(point.timeElapsedMs)
+		cumulativePrice = cumulativePrice.Add(point.price.Mul(weight))
+		cumulativeWeight = cumulativeWeight.Add(weight)
+	}
+
+	if !cumulativeWeight.IsPositive() {
+		return points[0].price, nil
+	}
+	return cumulativePrice.Quo(cumulativeWeight), nil
+}
+
+// trimOutliers drops the points whose price deviates from the mean price by
+// more than 'maxStdDevs' (population) standard deviations. The mean and
+// standard deviation are taken over the snapshot prices, unweighted by time,
+// so that a short-lived spike counts as much as any other snapshot.
+func trimOutliers(points []twapPoint, maxStdDevs sdk.Dec) []twapPoint {
+	if len(points) < 2 {
+		return points
+	}
+
+	mean := sdk.ZeroDec()
+	for _, point := range points {
+		mean = mean.Add(point.price)
+	}
+	mean = mean.QuoInt64(int64(len(points)))
+
+	variance := sdk.ZeroDec()
+	for _, point := range points {
+		deviation := point.price.Sub(mean)
+		variance = variance.Add(deviation.Mul(deviation))
+	}
+	variance = variance.QuoInt64(int64(len(points)))
+	maxDeviation := common.MustSqrtDec(variance).Mul(maxStdDevs)
+
+	trimmed := make([]twapPoint, 0, len(points))
+	for _, point := range points {
+		if point.price.Sub(mean).Abs().LTE(maxDeviation) {
+			trimmed = append(trimmed, point)
+		}
+	}
+	return trimmed
+}
+
 /*
 An object parameter for getPriceWithSnapshot().
 
@@ -402,3 +402,441 @@ func TestCalcTwapExtended(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcGeometricTwap(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, _ := testapp.NewNibiruTestAppAndContext()
+	ctx := app.NewContext(false, tmproto.Header{
+		Height: 1,
+	})
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+	// Same snapshots as "spot price twap calc, t=[10,30]" in TestCalcTwapExtended.
+	// The snapshot at t=30 has no weight, so the expected price is the
+	// geometric mean of 9 and 8.5: sqrt(9 * 8.5)
+	reserveSnapshots := []types.ReserveSnapshot{
+		{
+			Amm:         *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(9)),
+			TimestampMs: 10,
+		},
+		{
+			Amm:         *mock.TestAMM(sdk.NewDec(100), sdk.MustNewDecFromStr("8.5")),
+			TimestampMs: 20,
+		},
+		{
+			Amm:         *mock.TestAMM(sdk.NewDec(100), sdk.MustNewDecFromStr("9.5")),
+			TimestampMs: 30,
+		},
+	}
+	for _, snapshot := range reserveSnapshots {
+		ctx = ctx.WithBlockTime(time.UnixMilli(snapshot.TimestampMs))
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(snapshot.Amm.Pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+	}
+	ctx = ctx.WithBlockTime(time.UnixMilli(30)).WithBlockHeight(3)
+
+	price, err := app.PerpKeeperV2.CalcGeometricTwap(ctx,
+		pair,
+		types.TwapCalcOption_SPOT,
+		types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(),
+		20*time.Millisecond,
+	)
+	require.NoError(t, err)
+	expectedPrice := sdk.MustNewDecFromStr("8.746427842267950706")
+	require.Truef(t, price.Sub(expectedPrice).Abs().LTE(sdk.MustNewDecFromStr("0.000000000000001")),
+		"expected %s, got %s", expectedPrice, price)
+
+	arithmeticPrice, err := app.PerpKeeperV2.CalcTwap(ctx,
+		pair,
+		types.TwapCalcOption_SPOT,
+		types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(),
+		20*time.Millisecond,
+	)
+	require.NoError(t, err)
+	require.True(t, price.LT(arithmeticPrice))
+}
+
+func TestCalcTwapBetween(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, _ := testapp.NewNibiruTestAppAndContext()
+	ctx := app.NewContext(false, tmproto.Header{
+		Height: 1,
+	})
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	// drop the snapshot taken at market creation so only the ones below count
+	require.NoError(t, app.PerpKeeperV2.ReserveSnapshots.Delete(ctx, collections.Join(pair, ctx.BlockTime())))
+
+	reserveSnapshots := []types.ReserveSnapshot{
+		{
+			Amm:         *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(9)),
+			TimestampMs: 10,
+		},
+		{
+			Amm:         *mock.TestAMM(sdk.NewDec(100), sdk.MustNewDecFromStr("8.5")),
+			TimestampMs: 20,
+		},
+		{
+			Amm:         *mock.TestAMM(sdk.NewDec(100), sdk.MustNewDecFromStr("9.5")),
+			TimestampMs: 30,
+		},
+	}
+	for _, snapshot := range reserveSnapshots {
+		ctx = ctx.WithBlockTime(time.UnixMilli(snapshot.TimestampMs))
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(snapshot.Amm.Pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+	}
+	// the window is independent of the block time
+	ctx = ctx.WithBlockTime(time.UnixMilli(1_000)).WithBlockHeight(10)
+
+	for _, tc := range []struct {
+		name          string
+		startMs       int64
+		endMs         int64
+		expectedPrice sdk.Dec
+	}{
+		{
+			// only the snapshot at t=10 is in effect
+			name:          "t=[10,20)",
+			startMs:       10,
+			endMs:         20,
+			expectedPrice: sdk.NewDec(9),
+		},
+		{
+			// (9 * 5 + 8.5 * 5) / 10
+			name:          "t=[15,25)",
+			startMs:       15,
+			endMs:         25,
+			expectedPrice: sdk.MustNewDecFromStr("8.75"),
+		},
+		{
+			// (9 * 10 + 8.5 * 10 + 9.5 * 10) / 30, there is no price before t=10
+			name:          "t=[0,40)",
+			startMs:       0,
+			endMs:         40,
+			expectedPrice: sdk.NewDec(9),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := app.PerpKeeperV2.CalcTwapBetween(ctx,
+				pair,
+				types.TwapCalcOption_SPOT,
+				types.Direction_DIRECTION_UNSPECIFIED,
+				sdk.ZeroDec(),
+				tc.startMs,
+				tc.endMs,
+			)
+			require.NoError(t, err)
+			require.EqualValuesf(t, tc.expectedPrice, price,
+				"expected %s, got %s", tc.expectedPrice.String(), price.String())
+		})
+	}
+
+	_, err := app.PerpKeeperV2.CalcTwapBetween(ctx,
+		pair,
+		types.TwapCalcOption_SPOT,
+		types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(),
+		20,
+		10,
+	)
+	require.Error(t, err)
+}
+
+func TestGetCumulativePrice(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+	// the price of each snapshot is its timestamp in seconds
+	for _, timestampMs := range []int64{1_000, 2_000, 3_000, 4_000, 5_000} {
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, time.UnixMilli(timestampMs)), types.ReserveSnapshot{
+			Amm:         *mock.TestAMMDefault().WithPriceMultiplier(sdk.NewDec(timestampMs / 1_000)),
+			TimestampMs: timestampMs,
+		})
+	}
+
+	// 1 * 1000 + 2 * 1000 + 3 * 1000 + 4 * 1000 + 5 * 2000
+	cumulativePrice, err := app.PerpKeeperV2.GetCumulativePrice(ctx, pair, 7_000)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20_000).String(), cumulativePrice.String())
+
+	for _, tc := range []struct {
+		name    string
+		startMs int64
+		endMs   int64
+	}{
+		{name: "window on snapshots", startMs: 3_000, endMs: 5_000},
+		{name: "window between snapshots", startMs: 2_500, endMs: 4_500},
+		{name: "window past the last snapshot", startMs: 3_000, endMs: 7_000},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			start, err := app.PerpKeeperV2.GetCumulativePrice(ctx, pair, tc.startMs)
+			require.NoError(t, err)
+			end, err := app.PerpKeeperV2.GetCumulativePrice(ctx, pair, tc.endMs)
+			require.NoError(t, err)
+
+			twap, err := app.PerpKeeperV2.CalcTwap(
+				ctx.WithBlockTime(time.UnixMilli(tc.endMs)),
+				pair,
+				types.TwapCalcOption_SPOT,
+				types.Direction_DIRECTION_UNSPECIFIED,
+				sdk.ZeroDec(),
+				time.Duration(tc.endMs-tc.startMs)*time.Millisecond,
+			)
+			require.NoError(t, err)
+			require.Equal(t, twap.String(), end.Sub(start).QuoInt64(tc.endMs-tc.startMs).String())
+		})
+	}
+
+	_, err = app.PerpKeeperV2.GetCumulativePrice(ctx, pair, 500)
+	require.ErrorIs(t, err, types.ErrNoValidTWAP)
+}
+
+func TestGetExponentialTwap(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, _ := testapp.NewNibiruTestAppAndContext()
+	ctx := app.NewContext(false, tmproto.Header{
+		Height: 1,
+	})
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+	// the most recent price is the highest, so the heavier it is weighted,
+	// the higher the average
+	reserveSnapshots := []types.ReserveSnapshot{
+		{
+			Amm:         *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(9)),
+			TimestampMs: 10,
+		},
+		{
+			Amm:         *mock.TestAMM(sdk.NewDec(100), sdk.MustNewDecFromStr("8.5")),
+			TimestampMs: 20,
+		},
+		{
+			Amm:         *mock.TestAMM(sdk.NewDec(100), sdk.MustNewDecFromStr("9.5")),
+			TimestampMs: 30,
+		},
+	}
+	for _, snapshot := range reserveSnapshots {
+		ctx = ctx.WithBlockTime(time.UnixMilli(snapshot.TimestampMs))
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(snapshot.Amm.Pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+	}
+	ctx = ctx.WithBlockTime(time.UnixMilli(40)).WithBlockHeight(4)
+
+	// (9 * 10 + 8.5 * 10 + 9.5 * 10) / 30
+	arithmeticPrice, err := app.PerpKeeperV2.CalcTwap(ctx,
+		pair,
+		types.TwapCalcOption_SPOT,
+		types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(),
+		30*time.Millisecond,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(9).String(), arithmeticPrice.String())
+
+	slowPrice, err := app.PerpKeeperV2.GetExponentialTwap(ctx, pair, 30*time.Millisecond, time.Second)
+	require.NoError(t, err)
+	fastPrice, err := app.PerpKeeperV2.GetExponentialTwap(ctx, pair, 30*time.Millisecond, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	// (9 * e^-2.5 + 8.5 * e^-1.5 + 9.5 * e^-0.5) / (e^-2.5 + e^-1.5 + e^-0.5)
+	expectedFastPrice := sdk.MustNewDecFromStr("9.210256242360012119")
+	require.Truef(t, fastPrice.Sub(expectedFastPrice).Abs().LTE(sdk.MustNewDecFromStr("0.000000000001")),
+		"expected %s, got %s", expectedFastPrice, fastPrice)
+	require.True(t, slowPrice.GT(arithmeticPrice))
+	require.True(t, fastPrice.GT(slowPrice))
+	require.True(t, fastPrice.LT(sdk.MustNewDecFromStr("9.5")))
+
+	// the lookback is clamped, so only the snapshot at t=30 counts
+	price, err := app.PerpKeeperV2.GetExponentialTwap(ctx, pair, 10*time.Millisecond, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("9.5").String(), price.String())
+
+	// a lookback 1_000 times the half-life decays every weight to zero, which
+	// falls back to the most recent price
+	price, err = app.PerpKeeperV2.GetExponentialTwap(
+		ctx.WithBlockTime(time.UnixMilli(10_000)), pair, 10*time.Second, 10*time.Millisecond,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("9.5").String(), price.String())
+
+	_, err = app.PerpKeeperV2.GetExponentialTwap(ctx, pair, 30*time.Millisecond, 0)
+	require.Error(t, err)
+}
+
+func TestCalcTrimmedTwap(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, _ := testapp.NewNibiruTestAppAndContext()
+	ctx := app.NewContext(false, tmproto.Header{
+		Height: 1,
+	})
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+
+	// Five snapshots of 10ms each with a single spike to 100 at t=20. The mean
+	// snapshot price is 28 and the standard deviation 36, so the spike is 2
+	// standard deviations away from the mean and the other prices 0.5.
+	reserveSnapshots := []types.ReserveSnapshot{
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(10)), TimestampMs: 0},
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(10)), TimestampMs: 10},
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(100)), TimestampMs: 20},
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(10)), TimestampMs: 30},
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(10)), TimestampMs: 40},
+	}
+	for _, snapshot := range reserveSnapshots {
+		ctx = ctx.WithBlockTime(time.UnixMilli(snapshot.TimestampMs))
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(snapshot.Amm.Pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+	}
+	ctx = ctx.WithBlockTime(time.UnixMilli(50)).WithBlockHeight(6)
+
+	price, err := app.PerpKeeperV2.CalcTwap(ctx,
+		pair,
+		types.TwapCalcOption_SPOT,
+		types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(),
+		50*time.Millisecond,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(28).String(), price.String())
+
+	trimmedPrice, err := app.PerpKeeperV2.CalcTrimmedTwap(ctx,
+		pair,
+		types.TwapCalcOption_SPOT,
+		types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(),
+		50*time.Millisecond,
+		sdk.MustNewDecFromStr("1.5"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10).String(), trimmedPrice.String())
+
+	// a wide enough band keeps the spike
+	untrimmedPrice, err := app.PerpKeeperV2.CalcTrimmedTwap(ctx,
+		pair,
+		types.TwapCalcOption_SPOT,
+		types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(),
+		50*time.Millisecond,
+		sdk.NewDec(3),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(28).String(), untrimmedPrice.String())
+
+	_, err = app.PerpKeeperV2.CalcTrimmedTwap(ctx,
+		pair,
+		types.TwapCalcOption_SPOT,
+		types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(),
+		50*time.Millisecond,
+		sdk.ZeroDec(),
+	)
+	require.Error(t, err)
+}
+
+func TestPruneSnapshots(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, _ := testapp.NewNibiruTestAppAndContext()
+	ctx := app.NewContext(false, tmproto.Header{
+		Height: 1,
+	})
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	// drop the snapshot taken at market creation so only the ones below count
+	require.NoError(t, app.PerpKeeperV2.ReserveSnapshots.Delete(ctx, collections.Join(pair, ctx.BlockTime())))
+
+	reserveSnapshots := []types.ReserveSnapshot{
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(10)), TimestampMs: 0},
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(20)), TimestampMs: 10},
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(30)), TimestampMs: 20},
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(40)), TimestampMs: 30},
+		{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(50)), TimestampMs: 40},
+	}
+	for _, snapshot := range reserveSnapshots {
+		ctx = ctx.WithBlockTime(time.UnixMilli(snapshot.TimestampMs))
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(snapshot.Amm.Pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+	}
+	ctx = ctx.WithBlockTime(time.UnixMilli(50)).WithBlockHeight(6)
+
+	calcTwap := func() sdk.Dec {
+		price, err := app.PerpKeeperV2.CalcTwap(ctx,
+			pair,
+			types.TwapCalcOption_SPOT,
+			types.Direction_DIRECTION_UNSPECIFIED,
+			sdk.ZeroDec(),
+			25*time.Millisecond,
+		)
+		require.NoError(t, err)
+		return price
+	}
+
+	// (50 * 10ms + 40 * 10ms + 30 * 5ms) / 25ms
+	require.Equal(t, sdk.NewDec(42).String(), calcTwap().String())
+
+	t.Log("prune up to the start of the lookback window, keeping the snapshot at t=20")
+	numPruned := app.PerpKeeperV2.PruneSnapshots(ctx, pair, time.UnixMilli(25))
+	require.Equal(t, 2, numPruned)
+
+	snapshots := app.PerpKeeperV2.ReserveSnapshots.Iterate(
+		ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+	).Values()
+	require.Len(t, snapshots, 3)
+	require.EqualValues(t, 20, snapshots[0].TimestampMs)
+
+	require.Equal(t, sdk.NewDec(42).String(), calcTwap().String())
+
+	t.Log("pruning again is a no-op")
+	require.Equal(t, 0, app.PerpKeeperV2.PruneSnapshots(ctx, pair, time.UnixMilli(25)))
+	require.Equal(t, sdk.NewDec(42).String(), calcTwap().String())
+}
+
+func TestCalcTwapSingleSnapshot(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, _ := testapp.NewNibiruTestAppAndContext()
+	ctx := app.NewContext(false, tmproto.Header{
+		Height: 1,
+	})
+
+	createTestMarket(t, app, ctx, pair, WithEnabled(true))
+	// drop the snapshot taken at market creation so only the ones below count
+	require.NoError(t, app.PerpKeeperV2.ReserveSnapshots.Delete(ctx, collections.Join(pair, ctx.BlockTime())))
+
+	snapshot := types.ReserveSnapshot{Amm: *mock.TestAMM(sdk.NewDec(100), sdk.NewDec(7)), TimestampMs: 10}
+	app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
+
+	for _, tc := range []struct {
+		name             string
+		blockTimeMs      int64
+		lookbackInterval time.Duration
+	}{
+		{name: "lookback lands exactly on the snapshot", blockTimeMs: 20, lookbackInterval: 10 * time.Millisecond},
+		{name: "zero lookback at the snapshot time", blockTimeMs: 10, lookbackInterval: 0},
+		{name: "lookback before the snapshot", blockTimeMs: 20, lookbackInterval: 50 * time.Millisecond},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ctx.WithBlockTime(time.UnixMilli(tc.blockTimeMs))
+
+			price, err := app.PerpKeeperV2.CalcTwap(ctx,
+				pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), tc.lookbackInterval,
+			)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDec(7).String(), price.String())
+
+			geometricPrice, err := app.PerpKeeperV2.CalcGeometricTwap(ctx,
+				pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), tc.lookbackInterval,
+			)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDec(7).String(), geometricPrice.String())
+
+			trimmedPrice, err := app.PerpKeeperV2.CalcTrimmedTwap(ctx,
+				pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), tc.lookbackInterval, sdk.OneDec(),
+			)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDec(7).String(), trimmedPrice.String())
+		})
+	}
+}
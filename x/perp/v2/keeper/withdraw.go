@@ -2,8 +2,10 @@ package keeper
 
 import (
 	sdkmath "cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
@@ -108,6 +110,11 @@ vault contains, so we "credit" ourselves with prepaid bad debt.
 
 then, when bad debt is actually realized (by closing underwater positions), we
 can consume the credit we have built before withdrawing more from the ecosystem fund.
+
+If the ecosystem fund can't cover the rest of the bad debt, it pays what it
+holds and the shortfall is recorded in UncoveredBadDebts instead of failing the
+operation that realized it. The shortfall is paid back out of later perp fund
+inflows, see RepayUncoveredBadDebts.
 */
 func (k Keeper) realizeBadDebt(ctx sdk.Context, market types.Market, badDebtToRealize sdkmath.Int) (
 	err error,
@@ -124,16 +131,88 @@ func (k Keeper) realizeBadDebt(ctx sdk.Context, market types.Market, badDebtToRe
 			return err
 		}
 
-		return k.BankKeeper.SendCoinsFromModuleToModule(ctx,
+		shortfall := badDebtToRealize.Sub(market.PrepaidBadDebt.Amount)
+		perpFundBalance := k.BankKeeper.GetBalance(
+			ctx,
+			k.AccountKeeper.GetModuleAddress(types.PerpFundModuleAccount),
+			collateral,
+		)
+		covered := sdkmath.MinInt(shortfall, perpFundBalance.Amount)
+		uncovered := shortfall.Sub(covered)
+
+		if covered.IsPositive() {
+			if err = k.BankKeeper.SendCoinsFromModuleToModule(ctx,
+				/*from=*/ types.PerpFundModuleAccount,
+				/*to=*/ types.VaultModuleAccount,
+				sdk.NewCoins(sdk.NewCoin(collateral, covered)),
+			); err != nil {
+				return err
+			}
+		}
+		if uncovered.IsPositive() {
+			k.UncoveredBadDebts.Insert(ctx, market.Pair,
+				k.UncoveredBadDebts.GetOr(ctx, market.Pair, sdk.ZeroInt()).Add(uncovered),
+			)
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.BadDebtRealizedEvent{
+			Pair:      market.Pair,
+			Covered:   sdk.NewCoin(collateral, covered),
+			Uncovered: sdk.NewCoin(collateral, uncovered),
+		})
+	}
+
+	return nil
+}
+
+// RepayUncoveredBadDebts pays back the bad debt the perp fund couldn't cover
+// when it was realized, out of whatever the perp fund has received since.
+// Pairs are repaid in order until the perp fund runs dry. Repaid coins go to
+// the vault, which paid the shortfall out on behalf of the perp fund.
+func (k Keeper) RepayUncoveredBadDebts(ctx sdk.Context) error {
+	debts := k.UncoveredBadDebts.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues()
+	if len(debts) == 0 {
+		return nil
+	}
+
+	collateral, err := k.Collateral.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	perpFundBalance := k.BankKeeper.GetBalance(
+		ctx,
+		k.AccountKeeper.GetModuleAddress(types.PerpFundModuleAccount),
+		collateral,
+	).Amount
+
+	for _, debt := range debts {
+		if !perpFundBalance.IsPositive() {
+			break
+		}
+
+		repaid := sdkmath.MinInt(debt.Value, perpFundBalance)
+		if err = k.BankKeeper.SendCoinsFromModuleToModule(ctx,
 			/*from=*/ types.PerpFundModuleAccount,
 			/*to=*/ types.VaultModuleAccount,
-			sdk.NewCoins(
-				sdk.NewCoin(
-					collateral,
-					badDebtToRealize.Sub(market.PrepaidBadDebt.Amount),
-				),
-			),
-		)
+			sdk.NewCoins(sdk.NewCoin(collateral, repaid)),
+		); err != nil {
+			return err
+		}
+		perpFundBalance = perpFundBalance.Sub(repaid)
+
+		remaining := debt.Value.Sub(repaid)
+		if remaining.IsPositive() {
+			k.UncoveredBadDebts.Insert(ctx, debt.Key, remaining)
+		} else {
+			_ = k.UncoveredBadDebts.Delete(ctx, debt.Key)
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.BadDebtRepaidEvent{
+			Pair:      debt.Key,
+			Repaid:    sdk.NewCoin(collateral, repaid),
+			Remaining: sdk.NewCoin(collateral, remaining),
+		})
 	}
 
 	return nil
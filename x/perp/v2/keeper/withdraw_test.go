@@ -5,14 +5,18 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/assertion"
+	"github.com/NibiruChain/nibiru/x/perp/v2/keeper"
 	"github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
@@ -93,3 +97,100 @@ func TestWithdrawFromVault(t *testing.T) {
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
+
+func TestRepayUncoveredBadDebts(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairEthUsdc := asset.Registry.Pair(denoms.ETH, denoms.USDC)
+	startBlockTime := time.Now()
+
+	insertUncoveredBadDebt := func(pair asset.Pair, amount int64) actionFn {
+		return func(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+			app.PerpKeeperV2.UncoveredBadDebts.Insert(ctx, pair, sdk.NewInt(amount))
+			return ctx, nil
+		}
+	}
+	repayUncoveredBadDebts := actionFn(func(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+		return ctx, app.PerpKeeperV2.RepayUncoveredBadDebts(ctx)
+	})
+
+	tc := TestCases{
+		TC("perp fund repays all of the bad debt").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+				insertUncoveredBadDebt(pairBtcUsdc, 300),
+				FundModule(types.PerpFundModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(1000)))),
+			).
+			When(
+				repayUncoveredBadDebts,
+			).
+			Then(
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(300)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(700)),
+				uncoveredBadDebtIs(pairBtcUsdc, sdk.ZeroInt()),
+			),
+
+		TC("perp fund repays what it holds, in pair order").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+				CreateCustomMarket(pairEthUsdc),
+				insertUncoveredBadDebt(pairBtcUsdc, 300),
+				insertUncoveredBadDebt(pairEthUsdc, 300),
+				FundModule(types.PerpFundModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(400)))),
+			).
+			When(
+				repayUncoveredBadDebts,
+			).
+			Then(
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.NewInt(400)),
+				ModuleBalanceEqual(types.PerpFundModuleAccount, types.TestingCollateralDenomNUSD, sdk.ZeroInt()),
+				uncoveredBadDebtIs(pairBtcUsdc, sdk.ZeroInt()),
+				uncoveredBadDebtIs(pairEthUsdc, sdk.NewInt(200)),
+				typedEventEmitted(&types.BadDebtRepaidEvent{
+					Pair:      pairBtcUsdc,
+					Repaid:    sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 300),
+					Remaining: sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 0),
+				}),
+				typedEventEmitted(&types.BadDebtRepaidEvent{
+					Pair:      pairEthUsdc,
+					Repaid:    sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 100),
+					Remaining: sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 200),
+				}),
+			),
+
+		TC("empty perp fund repays nothing").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+				insertUncoveredBadDebt(pairBtcUsdc, 300),
+			).
+			When(
+				repayUncoveredBadDebts,
+			).
+			Then(
+				ModuleBalanceEqual(types.VaultModuleAccount, types.TestingCollateralDenomNUSD, sdk.ZeroInt()),
+				uncoveredBadDebtIs(pairBtcUsdc, sdk.NewInt(300)),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestQueryUncoveredBadDebts(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	resp, err := queryServer.QueryUncoveredBadDebts(sdk.WrapSDKContext(ctx), &types.QueryUncoveredBadDebtsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.BadDebts)
+
+	app.PerpKeeperV2.UncoveredBadDebts.Insert(ctx, pair, sdk.NewInt(300))
+	resp, err = queryServer.QueryUncoveredBadDebts(sdk.WrapSDKContext(ctx), &types.QueryUncoveredBadDebtsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.UncoveredBadDebt{{Pair: pair, Amount: sdk.NewInt(300)}}, resp.BadDebts)
+}
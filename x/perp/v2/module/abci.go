@@ -1,19 +1,22 @@
 package perp
 
 import (
+	"time"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/NibiruChain/collections"
-
-	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/perp/v2/keeper"
 	"github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
-// EndBlocker Called every block to store a snapshot of the perpamm.
+// EndBlocker Called every block to store a snapshot of the perpamm. At most
+// MaxPairsPerBlock AMMs are processed per block, see Keeper.EndBlockAMMs.
+// Funding is then settled if a funding interval elapsed, for at most
+// MaxPairsPerBlock markets too, see Keeper.EndBlockFunding. Finally, bad debt the perp fund couldn't cover is
+// repaid out of its balance, see Keeper.RepayUncoveredBadDebts.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
-	for _, amm := range k.AMMs.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values() {
+	for _, amm := range k.EndBlockAMMs(ctx) {
 		market, err := k.GetMarket(ctx, amm.Pair)
 		if err != nil {
 			k.Logger(ctx).Error("failed to fetch market", "pair", amm.Pair, "error", err)
@@ -25,11 +28,16 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 			continue
 		}
 
-		snapshot := types.ReserveSnapshot{
-			Amm:         amm,
-			TimestampMs: ctx.BlockTime().UnixMilli(),
+		k.SaveSnapshot(ctx, amm)
+
+		// prune snapshots that fell out of both the retention and the lookback windows
+		if retentionMs := k.SnapshotRetentionMs.GetOr(ctx, 0); retentionMs > 0 {
+			retention := time.Duration(retentionMs) * time.Millisecond
+			if retention < market.TwapLookbackWindow {
+				retention = market.TwapLookbackWindow
+			}
+			k.PruneSnapshots(ctx, amm.Pair, ctx.BlockTime().Add(-retention))
 		}
-		k.ReserveSnapshots.Insert(ctx, collections.Join(amm.Pair, ctx.BlockTime()), snapshot)
 
 		markTwap, err := k.CalcTwap(ctx, amm.Pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), market.TwapLookbackWindow)
 		if err != nil {
@@ -65,5 +73,11 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 		})
 	}
 
+	k.EndBlockFunding(ctx)
+
+	if err := k.RepayUncoveredBadDebts(ctx); err != nil {
+		k.Logger(ctx).Error("failed to repay uncovered bad debts", "error", err)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
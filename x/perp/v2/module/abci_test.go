@@ -102,6 +102,125 @@ func TestSnapshotUpdates(t *testing.T) {
 	assert.EqualValues(t, expectedSnapshot, snapshot)
 }
 
+func TestSnapshotCoalescing(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	initialMarket := *mock.TestMarket()
+	initialAmm := *mock.TestAMMDefault()
+	startTime := time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)
+
+	runBlock := func(duration time.Duration) {
+		perp.EndBlocker(ctx, app.PerpKeeperV2)
+		ctx = ctx.
+			WithBlockHeight(ctx.BlockHeight() + 1).
+			WithBlockTime(ctx.BlockTime().Add(duration))
+	}
+
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+
+	require.NoError(t, app.PerpKeeperV2.Sudo().CreateMarket(
+		/* ctx */ ctx, keeper.ArgsCreateMarket{
+			Pair:            pair,
+			PriceMultiplier: initialAmm.PriceMultiplier,
+			SqrtDepth:       initialAmm.SqrtDepth,
+			Market:          &initialMarket,
+		},
+	))
+	app.PerpKeeperV2.MinSnapshotIntervalMs.Set(ctx, 10_000)
+
+	t.Log("blocks of 2 seconds within the first interval are coalesced")
+	for i := 0; i < 5; i++ {
+		runBlock(2 * time.Second)
+	}
+
+	t.Log("move the mark price from 1 to 1.5625 at the start of the second interval")
+	amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+	_, _, err = app.PerpKeeperV2.SwapQuoteAsset(
+		ctx, amm, types.Direction_LONG, sdk.NewDec(250e9), sdk.ZeroDec(),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		runBlock(2 * time.Second)
+	}
+
+	snapshots := app.PerpKeeperV2.ReserveSnapshots.Iterate(
+		ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+	).Values()
+	require.Len(t, snapshots, 2)
+	assert.Equal(t, startTime.UnixMilli(), snapshots[0].TimestampMs)
+	assert.Equal(t, startTime.Add(10*time.Second).UnixMilli(), snapshots[1].TimestampMs)
+	assert.True(t, sdk.NewDec(1.25e12).Equal(snapshots[1].Amm.QuoteReserve))
+
+	t.Log("TWAP over both intervals: (1 * 10s + 1.5625 * 10s) / 20s")
+	twap, err := app.PerpKeeperV2.CalcTwap(
+		ctx, pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(), 20*time.Second,
+	)
+	require.NoError(t, err)
+	assert.Truef(t, sdk.MustNewDecFromStr("1.28125").Equal(twap), "got %s", twap)
+}
+
+func TestSnapshotCoalescingKeepsFirstReserves(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	initialMarket := *mock.TestMarket()
+	initialAmm := *mock.TestAMMDefault()
+	startTime := time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)
+
+	runBlock := func(duration time.Duration) {
+		perp.EndBlocker(ctx, app.PerpKeeperV2)
+		ctx = ctx.
+			WithBlockHeight(ctx.BlockHeight() + 1).
+			WithBlockTime(ctx.BlockTime().Add(duration))
+	}
+
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+
+	require.NoError(t, app.PerpKeeperV2.Sudo().CreateMarket(
+		/* ctx */ ctx, keeper.ArgsCreateMarket{
+			Pair:            pair,
+			PriceMultiplier: initialAmm.PriceMultiplier,
+			SqrtDepth:       initialAmm.SqrtDepth,
+			Market:          &initialMarket,
+		},
+	))
+	app.PerpKeeperV2.MinSnapshotIntervalMs.Set(ctx, 10_000)
+
+	runBlock(2 * time.Second)
+	runBlock(2 * time.Second)
+
+	t.Log("move the mark price from 1 to 1.5625 in the middle of the first interval")
+	amm, err := app.PerpKeeperV2.GetAMM(ctx, pair)
+	require.NoError(t, err)
+	_, _, err = app.PerpKeeperV2.SwapQuoteAsset(
+		ctx, amm, types.Direction_LONG, sdk.NewDec(250e9), sdk.ZeroDec(),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 8; i++ {
+		runBlock(2 * time.Second)
+	}
+
+	snapshots := app.PerpKeeperV2.ReserveSnapshots.Iterate(
+		ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+	).Values()
+	require.Len(t, snapshots, 2)
+	assert.Equal(t, startTime.UnixMilli(), snapshots[0].TimestampMs)
+	assert.True(t, sdk.NewDec(1e12).Equal(snapshots[0].Amm.QuoteReserve))
+	assert.Equal(t, startTime.Add(10*time.Second).UnixMilli(), snapshots[1].TimestampMs)
+	assert.True(t, sdk.NewDec(1.25e12).Equal(snapshots[1].Amm.QuoteReserve))
+
+	t.Log("the move is sampled at the next interval boundary: (1 * 10s + 1.5625 * 10s) / 20s")
+	twap, err := app.PerpKeeperV2.CalcTwap(
+		ctx, pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(), 20*time.Second,
+	)
+	require.NoError(t, err)
+	assert.Truef(t, sdk.MustNewDecFromStr("1.28125").Equal(twap), "got %s", twap)
+}
+
 func TestEndBlocker(t *testing.T) {
 	app, ctx := testapp.NewNibiruTestAppAndContext()
 
@@ -144,3 +263,165 @@ func TestEndBlocker(t *testing.T) {
 
 	// add index price
 }
+
+func TestEndBlockerMaxPairsPerBlock(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithBlockTime(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)).WithBlockHeight(1)
+
+	atomPair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	btcPair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	ethPair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	for _, pair := range []asset.Pair{atomPair, btcPair, ethPair} {
+		market := *mock.TestMarket()
+		market.Pair = pair
+		amm := *mock.TestAMMDefault()
+		require.NoError(t, app.PerpKeeperV2.Sudo().CreateMarket(
+			ctx, keeper.ArgsCreateMarket{
+				Pair:            pair,
+				PriceMultiplier: amm.PriceMultiplier,
+				SqrtDepth:       amm.SqrtDepth,
+				Market:          &market,
+			},
+		))
+	}
+	app.PerpKeeperV2.MaxPairsPerBlock.Set(ctx, 2)
+
+	// snapshottedPairs runs one block and returns the pairs it snapshotted.
+	snapshottedPairs := func() (pairs []asset.Pair) {
+		ctx = ctx.
+			WithBlockHeight(ctx.BlockHeight() + 1).
+			WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+		perp.EndBlocker(ctx, app.PerpKeeperV2)
+		for _, pair := range []asset.Pair{atomPair, btcPair, ethPair} {
+			if _, err := app.PerpKeeperV2.ReserveSnapshots.Get(ctx, collections.Join(pair, ctx.BlockTime())); err == nil {
+				pairs = append(pairs, pair)
+			}
+		}
+		return pairs
+	}
+
+	t.Log("three pairs at two per block are rotated through over consecutive blocks")
+	assert.Equal(t, []asset.Pair{atomPair, btcPair}, snapshottedPairs())
+	assert.Equal(t, []asset.Pair{atomPair, ethPair}, snapshottedPairs())
+	assert.Equal(t, []asset.Pair{btcPair, ethPair}, snapshottedPairs())
+	assert.Equal(t, []asset.Pair{atomPair, btcPair}, snapshottedPairs())
+
+	t.Log("zero processes every pair each block")
+	app.PerpKeeperV2.MaxPairsPerBlock.Set(ctx, 0)
+	assert.Equal(t, []asset.Pair{atomPair, btcPair, ethPair}, snapshottedPairs())
+}
+
+func TestEndBlockerFundingInterval(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithBlockTime(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)).WithBlockHeight(1)
+	startTime := ctx.BlockTime()
+
+	market := *mock.TestMarket()
+	amm := *mock.TestAMMDefault()
+	require.NoError(t, app.PerpKeeperV2.Sudo().CreateMarket(
+		ctx, keeper.ArgsCreateMarket{
+			Pair:            market.Pair,
+			PriceMultiplier: amm.PriceMultiplier,
+			SqrtDepth:       amm.SqrtDepth,
+			Market:          &market,
+		},
+	))
+
+	require.Error(t, app.PerpKeeperV2.Sudo().ChangeFundingRateIntervalMs(
+		ctx, uint64((25*time.Hour).Milliseconds()), testapp.DefaultSudoRoot()))
+	require.NoError(t, app.PerpKeeperV2.Sudo().ChangeFundingRateIntervalMs(
+		ctx, uint64(time.Minute.Milliseconds()), testapp.DefaultSudoRoot()))
+
+	// settlementsAt runs a block at 'offset' from the start time and returns the
+	// number of funding settlements it made.
+	settlementsAt := func(offset time.Duration) (settlements int) {
+		ctx = ctx.
+			WithBlockHeight(ctx.BlockHeight() + 1).
+			WithBlockTime(startTime.Add(offset)).
+			WithEventManager(sdk.NewEventManager())
+		app.OracleKeeper.SetPrice(ctx, market.OraclePair, sdk.MustNewDecFromStr("0.5"))
+		perp.EndBlocker(ctx, app.PerpKeeperV2)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "nibiru.perp.v2.FundingRateChangedEvent" {
+				settlements++
+			}
+		}
+		return settlements
+	}
+
+	t.Log("the first block starts the first funding interval")
+	assert.Equal(t, 0, settlementsAt(0))
+	assert.Equal(t, 0, settlementsAt(20*time.Second))
+	assert.Equal(t, 0, settlementsAt(40*time.Second))
+
+	t.Log("funding is settled once per interval boundary")
+	assert.Equal(t, 1, settlementsAt(60*time.Second))
+	assert.Equal(t, 0, settlementsAt(80*time.Second))
+	assert.Equal(t, 0, settlementsAt(100*time.Second))
+	assert.Equal(t, 1, settlementsAt(120*time.Second))
+
+	t.Log("a block crossing several boundaries settles once and keeps the boundaries aligned")
+	assert.Equal(t, 1, settlementsAt(270*time.Second))
+	assert.Equal(t, 0, settlementsAt(290*time.Second))
+	assert.Equal(t, 1, settlementsAt(300*time.Second))
+
+	startMs, err := app.PerpKeeperV2.LastFundingSettlementsMs.Get(ctx, market.Pair)
+	require.NoError(t, err)
+	assert.EqualValues(t, startTime.Add(300*time.Second).UnixMilli(), startMs)
+
+	t.Log("zero leaves funding to the epoch hooks")
+	require.NoError(t, app.PerpKeeperV2.Sudo().ChangeFundingRateIntervalMs(ctx, 0, testapp.DefaultSudoRoot()))
+	assert.Equal(t, 0, settlementsAt(360*time.Second))
+}
+
+func TestEndBlockerFundingMaxPairsPerBlock(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithBlockTime(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)).WithBlockHeight(1)
+	startTime := ctx.BlockTime()
+
+	atomPair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+	btcPair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	ethPair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	market := *mock.TestMarket()
+	for _, pair := range []asset.Pair{atomPair, btcPair, ethPair} {
+		market.Pair = pair
+		amm := *mock.TestAMMDefault()
+		require.NoError(t, app.PerpKeeperV2.Sudo().CreateMarket(
+			ctx, keeper.ArgsCreateMarket{
+				Pair:            pair,
+				PriceMultiplier: amm.PriceMultiplier,
+				SqrtDepth:       amm.SqrtDepth,
+				Market:          &market,
+			},
+		))
+	}
+	require.NoError(t, app.PerpKeeperV2.Sudo().ChangeFundingRateIntervalMs(
+		ctx, uint64(time.Minute.Milliseconds()), testapp.DefaultSudoRoot()))
+
+	// settledPairsAt runs a block at 'offset' from the start time and returns the
+	// number of markets whose funding it settled.
+	settledPairsAt := func(offset time.Duration) (settlements int) {
+		ctx = ctx.
+			WithBlockHeight(ctx.BlockHeight() + 1).
+			WithBlockTime(startTime.Add(offset)).
+			WithEventManager(sdk.NewEventManager())
+		app.OracleKeeper.SetPrice(ctx, market.OraclePair, sdk.MustNewDecFromStr("0.5"))
+		perp.EndBlocker(ctx, app.PerpKeeperV2)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "nibiru.perp.v2.FundingRateChangedEvent" {
+				settlements++
+			}
+		}
+		return settlements
+	}
+
+	t.Log("the first block starts the funding interval of every market")
+	assert.Equal(t, 0, settledPairsAt(0))
+
+	t.Log("three markets at two per block are rotated through over consecutive blocks")
+	app.PerpKeeperV2.MaxPairsPerBlock.Set(ctx, 2)
+	assert.Equal(t, 2, settledPairsAt(60*time.Second)) // atom, btc
+	assert.Equal(t, 1, settledPairsAt(61*time.Second)) // eth, atom already settled
+	assert.Equal(t, 0, settledPairsAt(62*time.Second)) // btc and eth already settled
+	assert.Equal(t, 2, settledPairsAt(120*time.Second))
+}
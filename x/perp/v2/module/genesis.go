@@ -32,9 +32,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.MarketLastVersion.Insert(ctx, g.Pair, types.MarketLastVersion{Version: g.Version})
 	}
 
+	pairsWithSnapshots := make(map[asset.Pair]bool)
+	for _, snapshot := range genState.ReserveSnapshots {
+		k.ReserveSnapshots.Insert(
+			ctx,
+			collections.Join(snapshot.Amm.Pair, time.UnixMilli(snapshot.TimestampMs)),
+			snapshot,
+		)
+		pairsWithSnapshots[snapshot.Amm.Pair] = true
+	}
+
 	for _, amm := range genState.Amms {
 		pair := amm.Pair
 		k.SaveAMM(ctx, amm)
+		if pairsWithSnapshots[pair] {
+			continue
+		}
+
+		// seed the TWAP of pairs without snapshots with the genesis reserves
 		timestampMs := ctx.BlockTime().UnixMilli()
 		k.ReserveSnapshots.Insert(
 			ctx,
@@ -98,6 +113,95 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			},
 		)
 	}
+
+	for _, badDebt := range genState.UncoveredBadDebts {
+		k.UncoveredBadDebts.Insert(ctx, badDebt.Pair, badDebt.Amount)
+	}
+
+	k.MinSnapshotIntervalMs.Set(ctx, genState.MinSnapshotIntervalMs)
+	k.SnapshotRetentionMs.Set(ctx, genState.SnapshotRetentionMs)
+	k.MaxPairsPerBlock.Set(ctx, genState.MaxPairsPerBlock)
+	k.EndBlockAMMCursor.Set(ctx, genState.EndBlockAmmCursor)
+	k.EndBlockFundingCursor.Set(ctx, genState.EndBlockFundingCursor)
+	k.LiquidationTwapLookbackMs.Set(ctx, genState.LiquidationTwapLookbackMs)
+	k.FundingRateIntervalMs.Set(ctx, genState.FundingRateIntervalMs)
+	if genState.LiquidatorRewardRatio != nil {
+		k.LiquidatorRewardRatio.Set(ctx, *genState.LiquidatorRewardRatio)
+	}
+	if genState.MinPositionQuote != nil {
+		k.MinPositionQuote.Set(ctx, *genState.MinPositionQuote)
+	}
+
+	for _, c := range genState.CollateralDenoms {
+		k.CollateralDenoms.Insert(ctx, c.Denom, c.OraclePair)
+	}
+
+	for _, p := range genState.FundingTopUps {
+		k.FundingTopUps.Insert(ctx, collections.Join(p.Pair, sdk.MustAccAddressFromBech32(p.Trader)))
+	}
+
+	importPairDecs(ctx, k.ImbalanceFeeRatios, genState.ImbalanceFeeRatios)
+	importPairDecs(ctx, k.MaxOracleSpreadRatios, genState.MaxOracleSpreadRatios)
+	importPairDecs(ctx, k.OpenInterestCaps, genState.OpenInterestCaps)
+	importPairDecs(ctx, k.RoundingAccruals, genState.RoundingAccruals)
+	importPairDecs(ctx, k.FluctuationLimitRatios, genState.FluctuationLimitRatios)
+	importPairDecs(ctx, k.TradeLimitRatios, genState.TradeLimitRatios)
+	importPairDecs(ctx, k.LongTradeLimitRatios, genState.LongTradeLimitRatios)
+	importPairDecs(ctx, k.ShortTradeLimitRatios, genState.ShortTradeLimitRatios)
+
+	for _, pair := range genState.CloseAtOracle {
+		k.CloseAtOracle.Insert(ctx, pair)
+	}
+	for _, pair := range genState.SpreadLimitedSwaps {
+		k.SpreadLimitedSwaps.Insert(ctx, pair)
+	}
+	for _, pair := range genState.PausedMarkets {
+		k.PausedMarkets.Insert(ctx, pair)
+	}
+
+	importPairUint64s(ctx, k.LiquidationSequences, genState.LiquidationSequences)
+	for _, r := range genState.LiquidationHistory {
+		k.LiquidationHistory.Insert(ctx, collections.Join(r.Pair, r.Sequence), types.LiquidationRecord{
+			Pair:              r.Pair,
+			TraderAddress:     r.TraderAddress,
+			LiquidatorAddress: r.LiquidatorAddress,
+			LiquidatedSize:    r.LiquidatedSize,
+			Price:             r.Price,
+			BadDebt:           r.BadDebt,
+			BlockHeight:       r.BlockHeight,
+			TimestampMs:       r.TimestampMs,
+			Reason:            types.ChangeReason(r.Reason),
+		})
+	}
+
+	importPairUint64s(ctx, k.FundingSettlementSequences, genState.FundingSettlementSequences)
+	for _, s := range genState.FundingHistory {
+		k.FundingHistory.Insert(ctx, collections.Join(s.Pair, s.Sequence), types.FundingSettlement{
+			Pair:                      s.Pair,
+			PremiumFraction:           s.PremiumFraction,
+			CumulativePremiumFraction: s.CumulativePremiumFraction,
+			BlockHeight:               s.BlockHeight,
+			TimestampMs:               s.TimestampMs,
+		})
+	}
+
+	importPairUint64s(ctx, k.LastFundingSettlementsMs, genState.LastFundingSettlementsMs)
+	for _, payment := range genState.FundingPayments {
+		traderKey := collections.Join(payment.Pair, sdk.MustAccAddressFromBech32(payment.Trader))
+		k.FundingPayments.Insert(ctx, collections.Join(traderKey, uint64(payment.BlockHeight)), payment)
+	}
+}
+
+func importPairDecs(ctx sdk.Context, m collections.Map[asset.Pair, sdk.Dec], values []types.GenesisPairDec) {
+	for _, v := range values {
+		m.Insert(ctx, v.Pair, v.Value)
+	}
+}
+
+func importPairUint64s(ctx sdk.Context, m collections.Map[asset.Pair, uint64], values []types.GenesisPairUint64) {
+	for _, v := range values {
+		m.Insert(ctx, v.Pair, v.Value)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -158,7 +262,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.CustomDiscounts = append(genesis.CustomDiscounts, types.GenesisState_CustomDiscount{
 			Trader: key.K1().String(),
 			Discount: &types.GenesisState_Discount{
-				Fee:    sdk.Dec{},
+				Fee:    customDiscounts.Value(),
 				Volume: key.K2(),
 			},
 		})
@@ -183,5 +287,103 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// export rebates allocations
 	genesis.RebatesAllocations = k.EpochRebateAllocations.Iterate(ctx, collections.Range[uint64]{}).Values()
 
+	// export uncovered bad debts
+	for _, kv := range k.UncoveredBadDebts.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		genesis.UncoveredBadDebts = append(genesis.UncoveredBadDebts, types.UncoveredBadDebt{
+			Pair:   kv.Key,
+			Amount: kv.Value,
+		})
+	}
+
+	genesis.MinSnapshotIntervalMs = k.MinSnapshotIntervalMs.GetOr(ctx, 0)
+	genesis.SnapshotRetentionMs = k.SnapshotRetentionMs.GetOr(ctx, 0)
+	genesis.MaxPairsPerBlock = k.MaxPairsPerBlock.GetOr(ctx, 0)
+	genesis.EndBlockAmmCursor = k.EndBlockAMMCursor.GetOr(ctx, 0)
+	genesis.EndBlockFundingCursor = k.EndBlockFundingCursor.GetOr(ctx, 0)
+	genesis.LiquidationTwapLookbackMs = k.LiquidationTwapLookbackMs.GetOr(ctx, 0)
+	genesis.FundingRateIntervalMs = k.FundingRateIntervalMs.GetOr(ctx, 0)
+	if ratio, err := k.LiquidatorRewardRatio.Get(ctx); err == nil {
+		genesis.LiquidatorRewardRatio = &ratio
+	}
+	if quote, err := k.MinPositionQuote.Get(ctx); err == nil {
+		genesis.MinPositionQuote = &quote
+	}
+
+	for _, kv := range k.CollateralDenoms.Iterate(ctx, collections.Range[string]{}).KeyValues() {
+		genesis.CollateralDenoms = append(genesis.CollateralDenoms, types.GenesisCollateralDenom{
+			Denom:      kv.Key,
+			OraclePair: kv.Value,
+		})
+	}
+
+	for _, key := range k.FundingTopUps.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Keys() {
+		genesis.FundingTopUps = append(genesis.FundingTopUps, types.GenesisPairTrader{
+			Pair:   key.K1(),
+			Trader: key.K2().String(),
+		})
+	}
+
+	genesis.ImbalanceFeeRatios = exportPairDecs(ctx, k.ImbalanceFeeRatios)
+	genesis.MaxOracleSpreadRatios = exportPairDecs(ctx, k.MaxOracleSpreadRatios)
+	genesis.OpenInterestCaps = exportPairDecs(ctx, k.OpenInterestCaps)
+	genesis.RoundingAccruals = exportPairDecs(ctx, k.RoundingAccruals)
+	genesis.FluctuationLimitRatios = exportPairDecs(ctx, k.FluctuationLimitRatios)
+	genesis.TradeLimitRatios = exportPairDecs(ctx, k.TradeLimitRatios)
+	genesis.LongTradeLimitRatios = exportPairDecs(ctx, k.LongTradeLimitRatios)
+	genesis.ShortTradeLimitRatios = exportPairDecs(ctx, k.ShortTradeLimitRatios)
+
+	genesis.CloseAtOracle = k.CloseAtOracle.Iterate(ctx, collections.Range[asset.Pair]{}).Keys()
+	genesis.SpreadLimitedSwaps = k.SpreadLimitedSwaps.Iterate(ctx, collections.Range[asset.Pair]{}).Keys()
+	genesis.PausedMarkets = k.PausedMarkets.Iterate(ctx, collections.Range[asset.Pair]{}).Keys()
+
+	// export liquidation history
+	genesis.LiquidationSequences = exportPairUint64s(ctx, k.LiquidationSequences)
+	for _, kv := range k.LiquidationHistory.Iterate(ctx, collections.PairRange[asset.Pair, uint64]{}).KeyValues() {
+		genesis.LiquidationHistory = append(genesis.LiquidationHistory, types.GenesisLiquidationRecord{
+			Pair:              kv.Key.K1(),
+			Sequence:          kv.Key.K2(),
+			TraderAddress:     kv.Value.TraderAddress,
+			LiquidatorAddress: kv.Value.LiquidatorAddress,
+			LiquidatedSize:    kv.Value.LiquidatedSize,
+			Price:             kv.Value.Price,
+			BadDebt:           kv.Value.BadDebt,
+			BlockHeight:       kv.Value.BlockHeight,
+			TimestampMs:       kv.Value.TimestampMs,
+			Reason:            string(kv.Value.Reason),
+		})
+	}
+
+	// export funding history
+	genesis.FundingSettlementSequences = exportPairUint64s(ctx, k.FundingSettlementSequences)
+	for _, kv := range k.FundingHistory.Iterate(ctx, collections.PairRange[asset.Pair, uint64]{}).KeyValues() {
+		genesis.FundingHistory = append(genesis.FundingHistory, types.GenesisFundingSettlement{
+			Pair:                      kv.Key.K1(),
+			Sequence:                  kv.Key.K2(),
+			PremiumFraction:           kv.Value.PremiumFraction,
+			CumulativePremiumFraction: kv.Value.CumulativePremiumFraction,
+			BlockHeight:               kv.Value.BlockHeight,
+			TimestampMs:               kv.Value.TimestampMs,
+		})
+	}
+
+	genesis.LastFundingSettlementsMs = exportPairUint64s(ctx, k.LastFundingSettlementsMs)
+	genesis.FundingPayments = k.FundingPayments.Iterate(
+		ctx, collections.PairRange[collections.Pair[asset.Pair, sdk.AccAddress], uint64]{},
+	).Values()
+
 	return genesis
 }
+
+func exportPairDecs(ctx sdk.Context, m collections.Map[asset.Pair, sdk.Dec]) (values []types.GenesisPairDec) {
+	for _, kv := range m.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		values = append(values, types.GenesisPairDec{Pair: kv.Key, Value: kv.Value})
+	}
+	return values
+}
+
+func exportPairUint64s(ctx sdk.Context, m collections.Map[asset.Pair, uint64]) (values []types.GenesisPairUint64) {
+	for _, kv := range m.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		values = append(values, types.GenesisPairUint64{Pair: kv.Key, Value: kv.Value})
+	}
+	return values
+}
@@ -3,6 +3,7 @@ package perp_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
@@ -17,6 +18,8 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
+	"github.com/NibiruChain/nibiru/x/perp/v2/keeper"
 	perp "github.com/NibiruChain/nibiru/x/perp/v2/module"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 	sudotypes "github.com/NibiruChain/nibiru/x/sudo/types"
@@ -53,6 +56,9 @@ func TestGenesis(t *testing.T) {
 			LatestCumulativePremiumFraction: sdk.NewDec(5 * 100),
 			LastUpdatedBlockNumber:          i,
 		}
+		if i%2 == 0 {
+			position.MarginMode = types.MarginMode_CROSS
+		}
 		require.NoErrorf(t, position.Validate(), "position: %s", position)
 		positions = append(positions, position)
 	}
@@ -96,6 +102,13 @@ func RunTestGenesis(t *testing.T, tc TestCase) {
 	app.PerpKeeperV2.SaveMarket(ctx, *mock.TestMarket())
 	app.PerpKeeperV2.MarketLastVersion.Insert(ctx, pair, types.MarketLastVersion{Version: 1})
 	app.PerpKeeperV2.SaveAMM(ctx, *mock.TestAMMDefault())
+	for i := int64(0); i < 3; i++ {
+		snapshotTime := ctx.BlockTime().Add(time.Duration(i) * time.Minute)
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, snapshotTime), types.ReserveSnapshot{
+			Amm:         *mock.TestAMMDefault().WithPriceMultiplier(sdk.NewDec(i + 1)),
+			TimestampMs: snapshotTime.UnixMilli(),
+		})
+	}
 	app.PerpKeeperV2.TraderDiscounts.Insert(ctx, collections.Join(testutil.AccAddress(), math.NewInt(1_000_000)), sdk.MustNewDecFromStr("0.1"))
 	app.PerpKeeperV2.GlobalDiscounts.Insert(ctx, sdk.NewInt(1_000_000), sdk.MustNewDecFromStr("0.05"))
 	app.PerpKeeperV2.TraderVolumes.Insert(ctx, collections.Join(testutil.AccAddress(), uint64(0)), math.NewInt(1_000_000))
@@ -106,6 +119,60 @@ func RunTestGenesis(t *testing.T, tc TestCase) {
 	})
 	app.PerpKeeperV2.DnREpochName.Set(ctx, "weekly")
 	app.PerpKeeperV2.DnREpoch.Set(ctx, 1)
+	app.PerpKeeperV2.UncoveredBadDebts.Insert(ctx, pair, math.NewInt(1_000))
+
+	trader := testutil.AccAddress()
+	app.PerpKeeperV2.MinSnapshotIntervalMs.Set(ctx, 1_000)
+	app.PerpKeeperV2.SnapshotRetentionMs.Set(ctx, 3_600_000)
+	app.PerpKeeperV2.MaxPairsPerBlock.Set(ctx, 5)
+	app.PerpKeeperV2.EndBlockAMMCursor.Set(ctx, 2)
+	app.PerpKeeperV2.EndBlockFundingCursor.Set(ctx, 3)
+	app.PerpKeeperV2.LiquidationTwapLookbackMs.Set(ctx, 60_000)
+	app.PerpKeeperV2.FundingRateIntervalMs.Set(ctx, 3_600_000)
+	app.PerpKeeperV2.LiquidatorRewardRatio.Set(ctx, sdk.MustNewDecFromStr("0.3"))
+	app.PerpKeeperV2.MinPositionQuote.Set(ctx, sdk.NewDec(10))
+	app.PerpKeeperV2.CollateralDenoms.Insert(ctx, denoms.USDC, asset.Registry.Pair(denoms.USDC, denoms.USD))
+	app.PerpKeeperV2.FundingTopUps.Insert(ctx, collections.Join(pair, trader))
+	app.PerpKeeperV2.ImbalanceFeeRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.01"))
+	app.PerpKeeperV2.MaxOracleSpreadRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.05"))
+	app.PerpKeeperV2.OpenInterestCaps.Insert(ctx, pair, sdk.NewDec(1_000_000))
+	app.PerpKeeperV2.RoundingAccruals.Insert(ctx, pair, sdk.MustNewDecFromStr("-0.5"))
+	app.PerpKeeperV2.FluctuationLimitRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.2"))
+	app.PerpKeeperV2.TradeLimitRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.1"))
+	app.PerpKeeperV2.LongTradeLimitRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.15"))
+	app.PerpKeeperV2.ShortTradeLimitRatios.Insert(ctx, pair, sdk.MustNewDecFromStr("0.05"))
+	app.PerpKeeperV2.CloseAtOracle.Insert(ctx, pair)
+	app.PerpKeeperV2.SpreadLimitedSwaps.Insert(ctx, pair)
+	app.PerpKeeperV2.PausedMarkets.Insert(ctx, pair)
+	app.PerpKeeperV2.LiquidationSequences.Insert(ctx, pair, 1)
+	app.PerpKeeperV2.LiquidationHistory.Insert(ctx, collections.Join(pair, uint64(0)), types.LiquidationRecord{
+		Pair:              pair,
+		TraderAddress:     trader.String(),
+		LiquidatorAddress: testutil.AccAddress().String(),
+		LiquidatedSize:    sdk.NewDec(-10),
+		Price:             sdk.NewDec(20),
+		BadDebt:           sdk.NewInt64Coin(denoms.NUSD, 5),
+		BlockHeight:       ctx.BlockHeight(),
+		TimestampMs:       ctx.BlockTime().UnixMilli(),
+		Reason:            types.ChangeReason_FullLiquidation,
+	})
+	app.PerpKeeperV2.FundingSettlementSequences.Insert(ctx, pair, 1)
+	app.PerpKeeperV2.FundingHistory.Insert(ctx, collections.Join(pair, uint64(0)), types.FundingSettlement{
+		Pair:                      pair,
+		PremiumFraction:           sdk.MustNewDecFromStr("0.001"),
+		CumulativePremiumFraction: sdk.MustNewDecFromStr("0.002"),
+		BlockHeight:               ctx.BlockHeight(),
+		TimestampMs:               ctx.BlockTime().UnixMilli(),
+	})
+	app.PerpKeeperV2.LastFundingSettlementsMs.Insert(ctx, pair, uint64(ctx.BlockTime().UnixMilli()))
+	app.PerpKeeperV2.FundingPayments.Insert(ctx, collections.Join(collections.Join(pair, trader), uint64(7)), types.AppliedFundingPayment{
+		Pair:                      pair,
+		Trader:                    trader.String(),
+		Payment:                   sdk.MustNewDecFromStr("-1.5"),
+		CumulativePremiumFraction: sdk.MustNewDecFromStr("0.002"),
+		BlockHeight:               7,
+		TimestampMs:               ctx.BlockTime().UnixMilli(),
+	})
 
 	// create some positions
 	for _, position := range tc.positions {
@@ -147,6 +214,11 @@ func RunTestGenesis(t *testing.T, tc TestCase) {
 		require.Equalf(t, pos, genStateAfterInit.Positions[i], "%s <-> %s", pos, genStateAfterInit.Positions[i])
 	}
 
+	require.Len(t, genState.ReserveSnapshots, 3)
+	require.Equal(t, genState.ReserveSnapshots, genStateAfterInit.ReserveSnapshots)
+
+	require.Len(t, genState.CustomDiscounts, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), genState.CustomDiscounts[0].Discount.Fee)
 	require.Equal(t, genState.CustomDiscounts, genStateAfterInit.CustomDiscounts)
 	require.Equal(t, genState.GlobalDiscount, genStateAfterInit.GlobalDiscount)
 	require.Equal(t, genState.TraderVolumes, genStateAfterInit.TraderVolumes)
@@ -155,6 +227,19 @@ func RunTestGenesis(t *testing.T, tc TestCase) {
 	require.Equal(t, genState.RebatesAllocations, genStateAfterInit.RebatesAllocations)
 	require.Equal(t, genState.DnrEpochName, genStateAfterInit.DnrEpochName)
 	require.Equal(t, genState.DnrEpoch, genStateAfterInit.DnrEpoch)
+	require.Len(t, genState.UncoveredBadDebts, 1)
+	require.Equal(t, genState.UncoveredBadDebts, genStateAfterInit.UncoveredBadDebts)
+
+	require.NotNil(t, genState.LiquidatorRewardRatio)
+	require.NotNil(t, genState.MinPositionQuote)
+	require.Len(t, genState.CollateralDenoms, 1)
+	require.Len(t, genState.FundingTopUps, 1)
+	require.Len(t, genState.RoundingAccruals, 1)
+	require.Equal(t, []asset.Pair{pair}, genState.PausedMarkets)
+	require.Len(t, genState.LiquidationHistory, 1)
+	require.Len(t, genState.FundingHistory, 1)
+	require.Len(t, genState.FundingPayments, 1)
+	require.Equal(t, genState, genStateAfterInit)
 }
 
 func TestNewAppModuleBasic(t *testing.T) {
@@ -196,3 +281,52 @@ func TestNewAppModuleBasic(t *testing.T) {
 	cmds = appModule.GetQueryCmd()
 	require.True(t, len(cmds.Commands()) > 0)
 }
+
+func TestGenesisPreservesTwap(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	blockTime := time.Now().UTC().Truncate(time.Millisecond)
+
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithBlockTime(blockTime)
+	_, err := action.CreateCustomMarket(pair, action.WithEnabled(true)).Do(app, ctx)
+	require.NoError(t, err)
+	for i := int64(0); i < 5; i++ {
+		snapshotTime := blockTime.Add(time.Duration(i-5) * time.Minute)
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, snapshotTime), types.ReserveSnapshot{
+			Amm:         *mock.TestAMMDefault().WithPriceMultiplier(sdk.NewDec(i + 1)),
+			TimestampMs: snapshotTime.UnixMilli(),
+		})
+	}
+
+	calcTwaps := func(k keeper.Keeper, ctx sdk.Context) []sdk.Dec {
+		var twaps []sdk.Dec
+		for _, tc := range []struct {
+			twapCalcOption types.TwapCalcOption
+			direction      types.Direction
+			assetAmt       sdk.Dec
+		}{
+			{types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec()},
+			{types.TwapCalcOption_BASE_ASSET_SWAP, types.Direction_LONG, sdk.NewDec(1_000)},
+		} {
+			for _, lookback := range []time.Duration{2 * time.Minute, 10 * time.Minute} {
+				twap, err := k.CalcTwap(ctx, pair, tc.twapCalcOption, tc.direction, tc.assetAmt, lookback)
+				require.NoError(t, err)
+				twaps = append(twaps, twap)
+			}
+		}
+		return twaps
+	}
+	expectedTwaps := calcTwaps(app.PerpKeeperV2, ctx)
+
+	genState := perp.ExportGenesis(ctx, app.PerpKeeperV2)
+	// the five snapshots above and the one taken when the market was created
+	require.Len(t, genState.ReserveSnapshots, 6)
+
+	// import into a fresh app
+	freshApp, freshCtx := testapp.NewNibiruTestAppAndContext()
+	freshCtx = freshCtx.WithBlockTime(blockTime)
+	perp.InitGenesis(freshCtx, freshApp.PerpKeeperV2, *genState)
+
+	require.Equal(t, genState.ReserveSnapshots, perp.ExportGenesis(freshCtx, freshApp.PerpKeeperV2).ReserveSnapshots)
+	require.Equal(t, expectedTwaps, calcTwaps(freshApp.PerpKeeperV2, freshCtx))
+}
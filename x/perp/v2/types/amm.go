@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"math/big"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -12,6 +13,31 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/asset"
 )
 
+// Clone returns a deep copy of the AMM. A plain copy of the struct shares the
+// big.Int of every sdk.Dec with the original, so that in-place operations such
+// as MulMut on one of them change the other as well.
+func (amm AMM) Clone() AMM {
+	return AMM{
+		Pair:            amm.Pair,
+		Version:         amm.Version,
+		BaseReserve:     cloneDec(amm.BaseReserve),
+		QuoteReserve:    cloneDec(amm.QuoteReserve),
+		SqrtDepth:       cloneDec(amm.SqrtDepth),
+		PriceMultiplier: cloneDec(amm.PriceMultiplier),
+		TotalLong:       cloneDec(amm.TotalLong),
+		TotalShort:      cloneDec(amm.TotalShort),
+		SettlementPrice: cloneDec(amm.SettlementPrice),
+	}
+}
+
+// cloneDec deep copies 'dec', leaving nil decimals nil.
+func cloneDec(dec sdk.Dec) sdk.Dec {
+	if dec.IsNil() {
+		return dec
+	}
+	return dec.Clone()
+}
+
 func (amm AMM) Validate() error {
 	if amm.BaseReserve.LTE(sdk.ZeroDec()) {
 		return ErrAmmBaseSupplyNonpositive
@@ -34,7 +60,7 @@ func (amm AMM) Validate() error {
 		return err
 	}
 
-	if !amm.SqrtDepth.Sub(computedSqrtDepth).Abs().LTE(sdk.OneDec()) {
+	if !amm.SqrtDepth.Sub(computedSqrtDepth).Abs().LTE(sqrtDepthTolerance(computedSqrtDepth)) {
 		return ErrLiquidityDepth.Wrap(
 			"computed sqrt and current sqrt are mismatched. pool: " + amm.String())
 	}
@@ -49,6 +75,16 @@ func (amm AMM) Validate() error {
 	return nil
 }
 
+// sqrtDepthTolerance returns how far the sqrt depth of an AMM may be from
+// 'computedSqrtDepth', the square root of the product of its reserves: one
+// millionth of it, capped at one, plus the 1e-9 precision of common.SqrtDec.
+// Swaps round the reserves, so they do not keep the product exactly constant,
+// but a relative bound still catches a tampered depth on a shallow pool.
+func sqrtDepthTolerance(computedSqrtDepth sdk.Dec) sdk.Dec {
+	relative := sdk.MinDec(sdk.OneDec(), computedSqrtDepth.Mul(sdk.NewDecWithPrec(1, 6)))
+	return relative.Add(sdk.NewDecWithPrec(1, 9))
+}
+
 // ComputeSettlementPrice computes the uniform settlement price for the current AMM.
 //
 // Returns:
@@ -146,6 +182,37 @@ func (amm AMM) GetBaseReserveAmt(
 	return baseReserveDelta, nil
 }
 
+// GetBaseReserveAmtWithSlippage is GetBaseReserveAmt that rejects the trade
+// with ErrAssetFailsUserLimit if its execution price would be more than
+// 'maxSlippage' away from the mark price, relative to the mark price.
+//
+// On the x * y = k curve, the execution price of a swap of q quote reserves
+// moves by exactly q / quoteReserve in either direction, so the bound is
+// checked before the swap is computed. Trades that would exhaust the quote
+// reserve are rejected the same way for any slippage below one.
+func (amm AMM) GetBaseReserveAmtWithSlippage(
+	quoteReserveAmt sdk.Dec, // unsigned
+	dir Direction,
+	maxSlippage sdk.Dec,
+) (baseReserveDelta sdk.Dec, err error) {
+	if quoteReserveAmt.IsNegative() {
+		return sdk.Dec{}, ErrInputQuoteAmtNegative
+	}
+	if maxSlippage.IsNil() || maxSlippage.IsNegative() {
+		return sdk.Dec{}, fmt.Errorf("max slippage must not be negative, got: %s", maxSlippage)
+	}
+	if !amm.QuoteReserve.IsPositive() {
+		return sdk.Dec{}, ErrAmmNonpositiveReserves
+	}
+
+	if slippage := quoteReserveAmt.Quo(amm.QuoteReserve); slippage.GT(maxSlippage) {
+		return sdk.Dec{}, ErrAssetFailsUserLimit.Wrapf(
+			"slippage (%s) is greater than the max slippage (%s)", slippage, maxSlippage)
+	}
+
+	return amm.GetBaseReserveAmt(quoteReserveAmt, dir)
+}
+
 // GetQuoteReserveAmt returns the amount of quote reserve equivalent to the amount of base asset given
 //
 // args:
@@ -203,6 +270,97 @@ func (amm AMM) InstMarkPrice() sdk.Dec {
 	return amm.QuoteReserve.Quo(amm.BaseReserve).Mul(amm.PriceMultiplier)
 }
 
+// QuoteReserveForMarkPrice returns the quote reserve at which the instantaneous
+// mark price is 'targetMarkPrice', with the base reserve and the price
+// multiplier unchanged: targetMarkPrice * baseReserve / priceMultiplier.
+func (amm AMM) QuoteReserveForMarkPrice(targetMarkPrice sdk.Dec) (sdk.Dec, error) {
+	if targetMarkPrice.IsNil() || !targetMarkPrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("target mark price must be positive, got: %s", targetMarkPrice)
+	}
+	if !amm.PriceMultiplier.IsPositive() {
+		return sdk.Dec{}, ErrAmmNonPositivePegMult
+	}
+	return targetMarkPrice.Mul(amm.BaseReserve).Quo(amm.PriceMultiplier), nil
+}
+
+// QuoteAmountToReachBaseReserve returns the quote assets that must be swapped
+// into the AMM for its base reserve to become 'targetBaseReserve' along the
+// x * y = k curve. A positive amount is added to the quote reserve (a long), a
+// negative one is removed from it (a short). The reserves are not mutated.
+func (amm AMM) QuoteAmountToReachBaseReserve(targetBaseReserve sdk.Dec) (quoteDelta sdk.Dec, err error) {
+	if targetBaseReserve.IsNil() || !targetBaseReserve.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("target base reserve must be positive, got: %s", targetBaseReserve)
+	}
+
+	invariant := amm.QuoteReserve.Mul(amm.BaseReserve) // x * y = k
+	quoteReserveAfter := invariant.Quo(targetBaseReserve)
+	if !quoteReserveAfter.IsPositive() {
+		return sdk.Dec{}, ErrAmmNonpositiveReserves.Wrapf(
+			"target base reserve %s is above the invariant %s", targetBaseReserve, invariant)
+	}
+
+	return amm.QuoteReserveToAsset(quoteReserveAfter.Sub(amm.QuoteReserve)), nil
+}
+
+// PriceImpact returns the relative difference between the execution price of
+// a swap of 'quoteAssetAmt' in direction 'dir' and the mark price before the
+// swap. The reserves are not mutated.
+//
+// args:
+//   - dir: direction the trader takes
+//   - quoteAssetAmt: amount of quote asset to swap, must be non-negative
+//
+// returns:
+//   - impactRatio: (executionPrice - markPriceBefore) / markPriceBefore.
+//     Positive for longs and negative for shorts.
+//   - err: error if the swap would not be possible
+func (amm AMM) PriceImpact(
+	dir Direction, quoteAssetAmt sdk.Dec,
+) (impactRatio sdk.Dec, err error) {
+	markPriceBefore := amm.InstMarkPrice()
+	if markPriceBefore.IsZero() || quoteAssetAmt.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+
+	executionPrice, err := amm.GetExecutionPrice(dir, quoteAssetAmt)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return executionPrice.Sub(markPriceBefore).Quo(markPriceBefore), nil
+}
+
+// GetExecutionPrice returns the average price, in quote assets per base asset,
+// at which a swap of 'quoteAssetAmt' in direction 'dir' would be filled. The
+// reserves are not mutated.
+//
+// args:
+//   - dir: direction the trader takes
+//   - quoteAssetAmt: amount of quote asset to swap, must be non-negative
+//
+// returns:
+//   - avgPrice: quoteAssetAmt / base assets received. The mark price if
+//     quoteAssetAmt is zero.
+//   - err: error if the swap would not be possible
+func (amm AMM) GetExecutionPrice(
+	dir Direction, quoteAssetAmt sdk.Dec,
+) (avgPrice sdk.Dec, err error) {
+	if quoteAssetAmt.IsZero() {
+		return amm.InstMarkPrice(), nil
+	}
+
+	baseReserveDelta, err := amm.GetBaseReserveAmt(
+		amm.QuoteAssetToReserve(quoteAssetAmt), dir,
+	)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if baseReserveDelta.IsZero() {
+		return amm.InstMarkPrice(), nil
+	}
+
+	return quoteAssetAmt.Quo(baseReserveDelta), nil
+}
+
 // ComputeSqrtDepth returns the sqrt of the product of the reserves
 func (amm AMM) ComputeSqrtDepth() (sqrtDepth sdk.Dec, err error) {
 	liqDepthBigInt := new(big.Int).Mul(
@@ -251,6 +409,39 @@ func (amm *AMM) WithSqrtDepth(sqrtDepth sdk.Dec) *AMM {
 	return amm
 }
 
+// AddToBaseReserve adds the signed 'delta' to the base reserve. It returns
+// ErrAmmNonpositiveReserves and leaves the reserve unchanged if the reserve
+// would not stay strictly positive.
+func (amm *AMM) AddToBaseReserve(delta sdk.Dec) error {
+	return amm.addToReserves(delta, sdk.ZeroDec())
+}
+
+// AddToQuoteReserve adds the signed 'delta' to the quote reserve. It returns
+// ErrAmmNonpositiveReserves and leaves the reserve unchanged if the reserve
+// would not stay strictly positive.
+func (amm *AMM) AddToQuoteReserve(delta sdk.Dec) error {
+	return amm.addToReserves(sdk.ZeroDec(), delta)
+}
+
+// addToReserves adds the signed deltas to the reserves, changing neither of
+// them unless both stay strictly positive.
+func (amm *AMM) addToReserves(baseDelta sdk.Dec, quoteDelta sdk.Dec) error {
+	baseReserve := amm.BaseReserve.Add(baseDelta)
+	if !baseReserve.IsPositive() {
+		return ErrAmmNonpositiveReserves.Wrapf(
+			"base reserve %s would be %s after adding %s", amm.BaseReserve, baseReserve, baseDelta)
+	}
+	quoteReserve := amm.QuoteReserve.Add(quoteDelta)
+	if !quoteReserve.IsPositive() {
+		return ErrAmmNonpositiveReserves.Wrapf(
+			"quote reserve %s would be %s after adding %s", amm.QuoteReserve, quoteReserve, quoteDelta)
+	}
+
+	amm.BaseReserve = baseReserve
+	amm.QuoteReserve = quoteReserve
+	return nil
+}
+
 // SwapQuoteAsset swaps base asset for quote asset
 //
 // args:
@@ -265,26 +456,65 @@ func (amm *AMM) WithSqrtDepth(sqrtDepth sdk.Dec) *AMM {
 func (amm *AMM) SwapQuoteAsset(
 	quoteAssetAmt sdk.Dec, // unsigned
 	dir Direction,
+) (baseAssetDelta sdk.Dec, err error) {
+	return amm.SwapQuoteAssetRounded(quoteAssetAmt, dir, sdk.Precision)
+}
+
+// SwapQuoteAssetRounded is SwapQuoteAsset with the base asset delta rounded to
+// 'precision' decimal places, e.g. the exponent of a token with 6 decimals.
+// The delta is rounded in favor of the reserves: down when base assets leave
+// them on a long, up when they enter them on a short. The reserves move by the
+// rounded delta, so the rounding remainder stays in the reserves.
+func (amm *AMM) SwapQuoteAssetRounded(
+	quoteAssetAmt sdk.Dec, // unsigned
+	dir Direction,
+	precision int64,
 ) (baseAssetDelta sdk.Dec, err error) {
 	quoteReserveAmt := QuoteAssetToReserve(quoteAssetAmt, amm.PriceMultiplier)
 	baseReserveDelta, err := amm.GetBaseReserveAmt(quoteReserveAmt, dir)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
+	baseReserveDelta, err = roundToPrecision(baseReserveDelta, precision, dir == Direction_SHORT)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
 
 	if dir == Direction_LONG {
-		amm.QuoteReserve = amm.QuoteReserve.Add(quoteReserveAmt)
-		amm.BaseReserve = amm.BaseReserve.Sub(baseReserveDelta)
+		if err = amm.addToReserves(baseReserveDelta.Neg(), quoteReserveAmt); err != nil {
+			return sdk.Dec{}, err
+		}
 		amm.TotalLong = amm.TotalLong.Add(baseReserveDelta)
 	} else if dir == Direction_SHORT {
-		amm.QuoteReserve = amm.QuoteReserve.Sub(quoteReserveAmt)
-		amm.BaseReserve = amm.BaseReserve.Add(baseReserveDelta)
+		if err = amm.addToReserves(baseReserveDelta, quoteReserveAmt.Neg()); err != nil {
+			return sdk.Dec{}, err
+		}
 		amm.TotalShort = amm.TotalShort.Add(baseReserveDelta)
 	}
 
 	return baseReserveDelta, nil
 }
 
+// ApplySwap returns a copy of the AMM after a swap of 'quoteAssetAmt' quote
+// assets in direction 'dir', along with the base assets received. Unlike
+// SwapQuoteAsset, the receiver is not mutated, which makes ApplySwap suited to
+// simulations. The SqrtDepth of the copy is recomputed from its reserves.
+func (amm AMM) ApplySwap(
+	dir Direction, quoteAssetAmt sdk.Dec,
+) (newAMM AMM, baseAssetDelta sdk.Dec, err error) {
+	newAMM = amm.Clone()
+	baseAssetDelta, err = newAMM.SwapQuoteAsset(quoteAssetAmt, dir)
+	if err != nil {
+		return AMM{}, sdk.Dec{}, err
+	}
+
+	newAMM.SqrtDepth, err = newAMM.ComputeSqrtDepth()
+	if err != nil {
+		return AMM{}, sdk.Dec{}, err
+	}
+	return newAMM, baseAssetDelta, nil
+}
+
 // SwapBaseAsset swaps base asset for quote asset
 //
 // args:
@@ -295,22 +525,72 @@ func (amm *AMM) SwapQuoteAsset(
 //   - quoteAssetDelta: amount of quote asset received. Always positive
 //   - err: error if any
 func (amm *AMM) SwapBaseAsset(baseAssetAmt sdk.Dec, dir Direction) (quoteAssetDelta sdk.Dec, err error) {
+	return amm.SwapBaseAssetRounded(baseAssetAmt, dir, sdk.Precision)
+}
+
+// SwapBaseAssetRounded is SwapBaseAsset with the quote asset delta rounded to
+// 'precision' decimal places. The delta is rounded in favor of the reserves:
+// up when quote assets enter them on a long, down when they leave them on a
+// short. The reserves move by the rounded delta, so the rounding remainder
+// stays in the reserves.
+func (amm *AMM) SwapBaseAssetRounded(baseAssetAmt sdk.Dec, dir Direction, precision int64) (quoteAssetDelta sdk.Dec, err error) {
 	quoteReserveDelta, err := amm.GetQuoteReserveAmt(baseAssetAmt, dir)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
+	quoteAssetDelta = amm.QuoteReserveToAsset(quoteReserveDelta)
+	if precision < sdk.Precision {
+		quoteAssetDelta, err = roundToPrecision(quoteAssetDelta, precision, dir == Direction_LONG)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		quoteReserveDelta = amm.QuoteAssetToReserve(quoteAssetDelta)
+	}
 
 	if dir == Direction_LONG {
-		amm.QuoteReserve = amm.QuoteReserve.Add(quoteReserveDelta)
-		amm.BaseReserve = amm.BaseReserve.Sub(baseAssetAmt)
+		if err = amm.addToReserves(baseAssetAmt.Neg(), quoteReserveDelta); err != nil {
+			return sdk.Dec{}, err
+		}
 		amm.TotalLong = amm.TotalLong.Add(baseAssetAmt)
 	} else if dir == Direction_SHORT {
-		amm.QuoteReserve = amm.QuoteReserve.Sub(quoteReserveDelta)
-		amm.BaseReserve = amm.BaseReserve.Add(baseAssetAmt)
+		if err = amm.addToReserves(baseAssetAmt, quoteReserveDelta.Neg()); err != nil {
+			return sdk.Dec{}, err
+		}
 		amm.TotalShort = amm.TotalShort.Add(baseAssetAmt)
 	}
 
-	return amm.QuoteReserveToAsset(quoteReserveDelta), nil
+	return quoteAssetDelta, nil
+}
+
+// roundToPrecision rounds 'amount' to 'precision' decimal places, up if
+// 'roundUp' is set and down otherwise. A precision of sdk.Precision returns
+// 'amount' as is.
+func roundToPrecision(amount sdk.Dec, precision int64, roundUp bool) (sdk.Dec, error) {
+	if precision < 0 || precision > sdk.Precision {
+		return sdk.Dec{}, fmt.Errorf("precision must be in [0, %d], not: %d", sdk.Precision, precision)
+	}
+	if precision == sdk.Precision {
+		return amount, nil
+	}
+
+	scale := sdk.NewDecFromInt(sdkmath.NewIntWithDecimal(1, int(precision)))
+	scaled := amount.Mul(scale)
+	if roundUp {
+		return scaled.Ceil().Quo(scale), nil
+	}
+	return scaled.TruncateDec().Quo(scale), nil
+}
+
+// HasEnoughQuoteReserve returns whether swapping 'quoteAssetAmt' of quote
+// assets trades at most 'tradeLimitRatio' of the quote reserve.
+func (amm AMM) HasEnoughQuoteReserve(quoteAssetAmt sdk.Dec, tradeLimitRatio sdk.Dec) bool {
+	return amm.QuoteReserve.Mul(tradeLimitRatio).GTE(amm.QuoteAssetToReserve(quoteAssetAmt))
+}
+
+// HasEnoughBaseReserve returns whether swapping 'baseAssetAmt' of base assets
+// trades at most 'tradeLimitRatio' of the base reserve.
+func (amm AMM) HasEnoughBaseReserve(baseAssetAmt sdk.Dec, tradeLimitRatio sdk.Dec) bool {
+	return amm.BaseReserve.Mul(tradeLimitRatio).GTE(baseAssetAmt)
 }
 
 // Bias returns the bias, or open interest skew, of the market in the base
@@ -416,6 +696,16 @@ func (amm AMM) CalcUpdateSwapInvariantCost(newSwapInvariant sdk.Dec) (sdkmath.In
 	return cost.Ceil().TruncateInt(), nil
 }
 
+// SetDepth rescales both reserves so that the sqrt depth of the amm becomes
+// 'newSqrtDepth' while the mark price stays unchanged. The swap invariant
+// k = base * quote scales with the square of the depth.
+func (amm *AMM) SetDepth(newSqrtDepth sdk.Dec) (err error) {
+	if newSqrtDepth.IsNil() || !newSqrtDepth.IsPositive() {
+		return ErrAmmNonPositiveSwapInvariant.Wrapf("new sqrt depth: %s", newSqrtDepth)
+	}
+	return amm.UpdateSwapInvariant(newSqrtDepth.Mul(newSqrtDepth))
+}
+
 // UpdateSwapInvariant updates the swap invariant of the amm
 func (amm *AMM) UpdateSwapInvariant(newSwapInvariant sdk.Dec) (err error) {
 	// k = x * y
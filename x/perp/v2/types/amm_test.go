@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -205,6 +206,154 @@ func TestSwapQuoteAsset(t *testing.T) {
 	}
 }
 
+func TestPriceImpact(t *testing.T) {
+	tests := []struct {
+		name                string
+		amm                 *types.AMM
+		quoteAssetAmt       sdk.Dec
+		dir                 types.Direction
+		expectedImpactRatio sdk.Dec
+		expectedErr         error
+	}{
+		{
+			name:                "long quote asset",
+			amm:                 mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(2)),
+			quoteAssetAmt:       sdk.NewDec(1e11),
+			dir:                 types.Direction_LONG,
+			expectedImpactRatio: sdk.MustNewDecFromStr("0.05"),
+		},
+		{
+			name:                "short quote asset",
+			amm:                 mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(2)),
+			quoteAssetAmt:       sdk.NewDec(1e11),
+			dir:                 types.Direction_SHORT,
+			expectedImpactRatio: sdk.MustNewDecFromStr("-0.05"),
+		},
+		{
+			name:                "zero quote asset",
+			amm:                 mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(2)),
+			quoteAssetAmt:       sdk.ZeroDec(),
+			dir:                 types.Direction_LONG,
+			expectedImpactRatio: sdk.ZeroDec(),
+		},
+		{
+			name:                "zero reserves",
+			amm:                 mock.TestAMM(sdk.ZeroDec(), sdk.NewDec(2)),
+			quoteAssetAmt:       sdk.NewDec(1e11),
+			dir:                 types.Direction_LONG,
+			expectedImpactRatio: sdk.ZeroDec(),
+		},
+		{
+			name:          "not enough quote in reserves",
+			amm:           mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(2)),
+			quoteAssetAmt: sdk.NewDec(1e13),
+			dir:           types.Direction_SHORT,
+			expectedErr:   types.ErrAmmNonpositiveReserves,
+		},
+		{
+			name:          "negative quote asset amt",
+			amm:           mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(2)),
+			quoteAssetAmt: sdk.NewDec(-1),
+			dir:           types.Direction_LONG,
+			expectedErr:   types.ErrInputQuoteAmtNegative,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ammBefore := *tc.amm
+
+			impactRatio, err := tc.amm.PriceImpact(tc.dir, tc.quoteAssetAmt)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedImpactRatio, impactRatio)
+			}
+			assert.Equal(t, ammBefore, *tc.amm)
+		})
+	}
+}
+
+func TestGetExecutionPrice(t *testing.T) {
+	tests := []struct {
+		name          string
+		quoteAssetAmt sdk.Dec
+		dir           types.Direction
+		expectedPrice sdk.Dec
+		expectedErr   error
+	}{
+		{
+			name:          "long quote asset",
+			quoteAssetAmt: sdk.NewDec(1e11),
+			dir:           types.Direction_LONG,
+			expectedPrice: sdk.MustNewDecFromStr("2.1"),
+		},
+		{
+			name:          "short quote asset",
+			quoteAssetAmt: sdk.NewDec(1e11),
+			dir:           types.Direction_SHORT,
+			expectedPrice: sdk.MustNewDecFromStr("1.9"),
+		},
+		{
+			name:          "zero quote asset is filled at mark price",
+			quoteAssetAmt: sdk.ZeroDec(),
+			dir:           types.Direction_LONG,
+			expectedPrice: sdk.NewDec(2),
+		},
+		{
+			name:          "short draining the quote reserves",
+			quoteAssetAmt: sdk.NewDec(2e12),
+			dir:           types.Direction_SHORT,
+			expectedErr:   types.ErrAmmNonpositiveReserves,
+		},
+		{
+			name:          "negative quote asset amt",
+			quoteAssetAmt: sdk.NewDec(-1),
+			dir:           types.Direction_LONG,
+			expectedErr:   types.ErrInputQuoteAmtNegative,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amm := mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(2))
+			ammBefore := *amm
+
+			price, err := amm.GetExecutionPrice(tc.dir, tc.quoteAssetAmt)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedPrice, price)
+			}
+			assert.Equal(t, ammBefore, *amm)
+		})
+	}
+
+	t.Run("execution price moves away from mark as size increases", func(t *testing.T) {
+		amm := mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(2))
+		markPrice := amm.InstMarkPrice()
+
+		prevLong, prevShort := markPrice, markPrice
+		for _, quoteAssetAmt := range []sdk.Dec{sdk.NewDec(1e8), sdk.NewDec(1e9), sdk.NewDec(1e10), sdk.NewDec(1e11)} {
+			longPrice, err := amm.GetExecutionPrice(types.Direction_LONG, quoteAssetAmt)
+			require.NoError(t, err)
+			require.True(t, longPrice.GT(prevLong), "long %s: %s <= %s", quoteAssetAmt, longPrice, prevLong)
+
+			shortPrice, err := amm.GetExecutionPrice(types.Direction_SHORT, quoteAssetAmt)
+			require.NoError(t, err)
+			require.True(t, shortPrice.LT(prevShort), "short %s: %s >= %s", quoteAssetAmt, shortPrice, prevShort)
+
+			prevLong, prevShort = longPrice, shortPrice
+		}
+	})
+}
+
 // baseReserves := base reserves if no one is trading
 // bias := totalLong (bias) + totalShort (bias) := the net size of all positions together
 // In the test cases you see,
@@ -759,6 +908,48 @@ func TestValidateAMM(t *testing.T) {
 			},
 			expectedErr: types.ErrLiquidityDepth,
 		},
+		{
+			name: "sqrt depth matching the reserves",
+			amm: types.AMM{
+				BaseReserve:     sdk.NewDec(2),
+				QuoteReserve:    sdk.NewDec(8),
+				PriceMultiplier: sdk.OneDec(),
+				SqrtDepth:       sdk.NewDec(4),
+				TotalLong:       sdk.ZeroDec(),
+				TotalShort:      sdk.ZeroDec(),
+			},
+		},
+		{
+			name: "tampered sqrt depth of a shallow pool",
+			amm: types.AMM{
+				BaseReserve:     sdk.OneDec(),
+				QuoteReserve:    sdk.OneDec(),
+				PriceMultiplier: sdk.OneDec(),
+				SqrtDepth:       sdk.MustNewDecFromStr("1.5"),
+			},
+			expectedErr: types.ErrLiquidityDepth,
+		},
+		{
+			name: "sqrt depth of a deep pool within the tolerance",
+			amm: types.AMM{
+				BaseReserve:     sdk.NewDec(1e12),
+				QuoteReserve:    sdk.NewDec(1e12),
+				PriceMultiplier: sdk.OneDec(),
+				SqrtDepth:       sdk.MustNewDecFromStr("1000000000000.5"),
+				TotalLong:       sdk.ZeroDec(),
+				TotalShort:      sdk.ZeroDec(),
+			},
+		},
+		{
+			name: "tampered sqrt depth of a deep pool",
+			amm: types.AMM{
+				BaseReserve:     sdk.NewDec(1e12),
+				QuoteReserve:    sdk.NewDec(1e12),
+				PriceMultiplier: sdk.OneDec(),
+				SqrtDepth:       sdk.NewDec(1e12 + 2),
+			},
+			expectedErr: types.ErrLiquidityDepth,
+		},
 	}
 
 	for _, tc := range tests {
@@ -834,3 +1025,377 @@ func TestAMM_WithMethods(t *testing.T) {
 	require.Equal(t, totalShort, amm.TotalShort)
 	require.Equal(t, sqrtDepth, amm.SqrtDepth)
 }
+
+func TestHasEnoughReserve(t *testing.T) {
+	amm := mock.TestAMMDefault()
+	tradeLimitRatio := sdk.MustNewDecFromStr("0.1")
+
+	// 0.1 * 1e12 reserves
+	assert.True(t, amm.HasEnoughQuoteReserve(sdk.NewDec(1e11), tradeLimitRatio))
+	assert.False(t, amm.HasEnoughQuoteReserve(sdk.NewDec(1e11+1), tradeLimitRatio))
+	assert.True(t, amm.HasEnoughBaseReserve(sdk.NewDec(1e11), tradeLimitRatio))
+	assert.False(t, amm.HasEnoughBaseReserve(sdk.NewDec(1e11+1), tradeLimitRatio))
+
+	// quote assets are converted to quote reserves with the price multiplier
+	amm.PriceMultiplier = sdk.NewDec(2)
+	assert.True(t, amm.HasEnoughQuoteReserve(sdk.NewDec(2e11), tradeLimitRatio))
+	assert.False(t, amm.HasEnoughQuoteReserve(sdk.NewDec(2e11+2), tradeLimitRatio))
+}
+
+func TestQuoteReserveForMarkPrice(t *testing.T) {
+	// mark price: 2e12 / 1e12 * 3 = 6
+	amm := types.AMM{
+		BaseReserve:     sdk.NewDec(1e12),
+		QuoteReserve:    sdk.NewDec(2e12),
+		PriceMultiplier: sdk.NewDec(3),
+	}
+	require.Equal(t, sdk.NewDec(6).String(), amm.InstMarkPrice().String())
+
+	for _, tc := range []struct {
+		name                 string
+		targetMarkPrice      sdk.Dec
+		expectedQuoteReserve sdk.Dec
+	}{
+		{name: "unchanged", targetMarkPrice: sdk.NewDec(6), expectedQuoteReserve: sdk.NewDec(2e12)},
+		{name: "double", targetMarkPrice: sdk.NewDec(12), expectedQuoteReserve: sdk.NewDec(4e12)},
+		{name: "half", targetMarkPrice: sdk.NewDec(3), expectedQuoteReserve: sdk.NewDec(1e12)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			quoteReserve, err := amm.QuoteReserveForMarkPrice(tc.targetMarkPrice)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedQuoteReserve.String(), quoteReserve.String())
+
+			repegged := amm
+			repegged.QuoteReserve = quoteReserve
+			require.Equal(t, tc.targetMarkPrice.String(), repegged.InstMarkPrice().String())
+		})
+	}
+
+	_, err := amm.QuoteReserveForMarkPrice(sdk.ZeroDec())
+	require.Error(t, err)
+	_, err = amm.QuoteReserveForMarkPrice(sdk.NewDec(-1))
+	require.Error(t, err)
+}
+
+func TestQuoteAmountToReachBaseReserve(t *testing.T) {
+	// invariant: 1e12 * 2e12 = 2e24
+	newAmm := func() types.AMM {
+		return types.AMM{
+			BaseReserve:     sdk.NewDec(1e12),
+			QuoteReserve:    sdk.NewDec(2e12),
+			SqrtDepth:       sdk.NewDec(1e12),
+			PriceMultiplier: sdk.NewDec(3),
+			TotalLong:       sdk.ZeroDec(),
+			TotalShort:      sdk.ZeroDec(),
+		}
+	}
+
+	for _, tc := range []struct {
+		name               string
+		targetBaseReserve  sdk.Dec
+		expectedQuoteDelta sdk.Dec
+		dir                types.Direction
+	}{
+		{
+			// quote reserve 2e12 -> 4e12, times the price multiplier
+			name:               "base reserve down",
+			targetBaseReserve:  sdk.NewDec(5e11),
+			expectedQuoteDelta: sdk.NewDec(6e12),
+			dir:                types.Direction_LONG,
+		},
+		{
+			// quote reserve 2e12 -> 1e12, times the price multiplier
+			name:               "base reserve up",
+			targetBaseReserve:  sdk.NewDec(2e12),
+			expectedQuoteDelta: sdk.NewDec(-3e12),
+			dir:                types.Direction_SHORT,
+		},
+		{
+			name:               "unchanged",
+			targetBaseReserve:  sdk.NewDec(1e12),
+			expectedQuoteDelta: sdk.ZeroDec(),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amm := newAmm()
+			quoteDelta, err := amm.QuoteAmountToReachBaseReserve(tc.targetBaseReserve)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedQuoteDelta.String(), quoteDelta.String())
+			require.Equal(t, newAmm(), amm)
+
+			if quoteDelta.IsZero() {
+				return
+			}
+			_, err = amm.SwapQuoteAsset(quoteDelta.Abs(), tc.dir)
+			require.NoError(t, err)
+			require.Equal(t, tc.targetBaseReserve.String(), amm.BaseReserve.String())
+		})
+	}
+
+	amm := newAmm()
+	_, err := amm.QuoteAmountToReachBaseReserve(sdk.ZeroDec())
+	require.Error(t, err)
+	_, err = amm.QuoteAmountToReachBaseReserve(sdk.NewDec(-1))
+	require.Error(t, err)
+	_, err = amm.QuoteAmountToReachBaseReserve(sdk.NewDec(1e15).MulInt64(1e15).MulInt64(1e15))
+	require.ErrorIs(t, err, types.ErrAmmNonpositiveReserves)
+}
+
+func TestAMMClone(t *testing.T) {
+	// mock.TestAMM shares a single decimal between the reserves and the depth
+	amm := *mock.TestAMM(sdk.NewDec(1e6), sdk.NewDec(2))
+	clone := amm.Clone()
+	require.Equal(t, amm.String(), clone.String())
+
+	clone.BaseReserve.MulMut(sdk.NewDec(2))
+	clone.PriceMultiplier.MulMut(sdk.NewDec(2))
+
+	assert.Equal(t, sdk.NewDec(2e6).String(), clone.BaseReserve.String())
+	assert.Equal(t, sdk.NewDec(1e6).String(), clone.QuoteReserve.String())
+	assert.Equal(t, sdk.NewDec(1e6).String(), amm.BaseReserve.String())
+	assert.Equal(t, sdk.NewDec(1e6).String(), amm.SqrtDepth.String())
+	assert.Equal(t, sdk.NewDec(2).String(), amm.PriceMultiplier.String())
+
+	// nil decimals stay nil
+	assert.True(t, types.AMM{}.Clone().SettlementPrice.IsNil())
+}
+
+func TestSetDepth(t *testing.T) {
+	newAmm := func() *types.AMM {
+		return &types.AMM{
+			BaseReserve:     sdk.NewDec(4e6),
+			QuoteReserve:    sdk.NewDec(1e6),
+			SqrtDepth:       sdk.NewDec(2e6),
+			PriceMultiplier: sdk.NewDec(3),
+			TotalLong:       sdk.ZeroDec(),
+			TotalShort:      sdk.ZeroDec(),
+		}
+	}
+
+	tests := []struct {
+		name                 string
+		newSqrtDepth         sdk.Dec
+		expectedBaseReserve  sdk.Dec
+		expectedQuoteReserve sdk.Dec
+	}{
+		{
+			name:                 "double the depth",
+			newSqrtDepth:         sdk.NewDec(4e6),
+			expectedBaseReserve:  sdk.NewDec(8e6),
+			expectedQuoteReserve: sdk.NewDec(2e6),
+		},
+		{
+			name:                 "halve the depth",
+			newSqrtDepth:         sdk.NewDec(1e6),
+			expectedBaseReserve:  sdk.NewDec(2e6),
+			expectedQuoteReserve: sdk.NewDec(5e5),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amm := newAmm()
+			markPriceBefore := amm.InstMarkPrice()
+			swapInvariantBefore := amm.BaseReserve.Mul(amm.QuoteReserve)
+
+			require.NoError(t, amm.SetDepth(tc.newSqrtDepth))
+			require.NoError(t, amm.Validate())
+
+			assert.Equal(t, tc.expectedBaseReserve.String(), amm.BaseReserve.String())
+			assert.Equal(t, tc.expectedQuoteReserve.String(), amm.QuoteReserve.String())
+			assert.Equal(t, tc.newSqrtDepth.String(), amm.SqrtDepth.String())
+			assert.Equal(t, markPriceBefore.String(), amm.InstMarkPrice().String())
+
+			// k scales with the square of the depth ratio
+			depthRatio := tc.newSqrtDepth.QuoInt64(2e6)
+			assert.Equal(t,
+				swapInvariantBefore.Mul(depthRatio).Mul(depthRatio).String(),
+				amm.BaseReserve.Mul(amm.QuoteReserve).String(),
+			)
+		})
+	}
+
+	t.Run("non-positive depth", func(t *testing.T) {
+		amm := newAmm()
+		require.ErrorIs(t, amm.SetDepth(sdk.ZeroDec()), types.ErrAmmNonPositiveSwapInvariant)
+		require.ErrorIs(t, amm.SetDepth(sdk.NewDec(-1)), types.ErrAmmNonPositiveSwapInvariant)
+		require.Equal(t, newAmm(), amm)
+	})
+}
+
+func TestAddToReserves(t *testing.T) {
+	amm := mock.TestAMM(sdk.NewDec(1e6), sdk.OneDec()).Clone()
+
+	require.NoError(t, amm.AddToBaseReserve(sdk.NewDec(-1e5)))
+	require.NoError(t, amm.AddToQuoteReserve(sdk.NewDec(2e5)))
+	assert.Equal(t, sdk.NewDec(9e5).String(), amm.BaseReserve.String())
+	assert.Equal(t, sdk.NewDec(12e5).String(), amm.QuoteReserve.String())
+
+	t.Log("a delta taking a reserve to zero or below leaves it unchanged")
+	require.ErrorIs(t, amm.AddToBaseReserve(sdk.NewDec(-9e5)), types.ErrAmmNonpositiveReserves)
+	require.ErrorIs(t, amm.AddToBaseReserve(sdk.NewDec(-1e6)), types.ErrAmmNonpositiveReserves)
+	require.ErrorIs(t, amm.AddToQuoteReserve(sdk.NewDec(-2e6)), types.ErrAmmNonpositiveReserves)
+	assert.Equal(t, sdk.NewDec(9e5).String(), amm.BaseReserve.String())
+	assert.Equal(t, sdk.NewDec(12e5).String(), amm.QuoteReserve.String())
+}
+
+func TestSwapRounded(t *testing.T) {
+	newAmm := func() *types.AMM {
+		amm := mock.TestAMM(sdk.NewDec(1e6), sdk.OneDec()).Clone()
+		return &amm
+	}
+	invariant := sdk.NewDec(1e12)
+
+	for _, tc := range []struct {
+		name          string
+		swap          func(amm *types.AMM, precision int64) (sdk.Dec, error)
+		expectedDelta string
+	}{
+		{
+			name: "long quote swap rounds the base out down",
+			swap: func(amm *types.AMM, precision int64) (sdk.Dec, error) {
+				return amm.SwapQuoteAssetRounded(sdk.NewDec(3), types.Direction_LONG, precision)
+			},
+			expectedDelta: "2.999991000000000000",
+		},
+		{
+			name: "short quote swap rounds the base in up",
+			swap: func(amm *types.AMM, precision int64) (sdk.Dec, error) {
+				return amm.SwapQuoteAssetRounded(sdk.NewDec(3), types.Direction_SHORT, precision)
+			},
+			expectedDelta: "3.000010000000000000",
+		},
+		{
+			name: "long base swap rounds the quote in up",
+			swap: func(amm *types.AMM, precision int64) (sdk.Dec, error) {
+				return amm.SwapBaseAssetRounded(sdk.NewDec(3), types.Direction_LONG, precision)
+			},
+			expectedDelta: "3.000010000000000000",
+		},
+		{
+			name: "short base swap rounds the quote out down",
+			swap: func(amm *types.AMM, precision int64) (sdk.Dec, error) {
+				return amm.SwapBaseAssetRounded(sdk.NewDec(3), types.Direction_SHORT, precision)
+			},
+			expectedDelta: "2.999991000000000000",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amm := newAmm()
+			delta, err := tc.swap(amm, 6)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDelta, delta.String())
+
+			// the rounding remainder stays in the reserves
+			assert.True(t, amm.BaseReserve.Mul(amm.QuoteReserve).GTE(invariant),
+				"invariant leaked: %s", amm.BaseReserve.Mul(amm.QuoteReserve))
+
+			// full precision does not round
+			unrounded := newAmm()
+			fullDelta, err := tc.swap(unrounded, sdk.Precision)
+			require.NoError(t, err)
+			assert.NotEqual(t, tc.expectedDelta, fullDelta.String())
+		})
+	}
+
+	_, err := newAmm().SwapQuoteAssetRounded(sdk.NewDec(3), types.Direction_LONG, 19)
+	require.ErrorContains(t, err, "precision must be in [0, 18]")
+	_, err = newAmm().SwapBaseAssetRounded(sdk.NewDec(3), types.Direction_LONG, -1)
+	require.ErrorContains(t, err, "precision must be in [0, 18]")
+}
+
+func TestGetBaseReserveAmtWithSlippage(t *testing.T) {
+	tests := []struct {
+		name            string
+		quoteReserveAmt sdk.Dec
+		dir             types.Direction
+		maxSlippage     sdk.Dec
+		expectedErr     error
+	}{
+		{
+			name:            "long within the max slippage",
+			quoteReserveAmt: sdk.NewDec(1e11),
+			dir:             types.Direction_LONG,
+			maxSlippage:     sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:            "short within the max slippage",
+			quoteReserveAmt: sdk.NewDec(1e11),
+			dir:             types.Direction_SHORT,
+			maxSlippage:     sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:            "long beyond the max slippage",
+			quoteReserveAmt: sdk.NewDec(1e11),
+			dir:             types.Direction_LONG,
+			maxSlippage:     sdk.MustNewDecFromStr("0.09"),
+			expectedErr:     types.ErrAssetFailsUserLimit,
+		},
+		{
+			name:            "short exhausting the reserves is rejected before the swap",
+			quoteReserveAmt: sdk.NewDec(1e13),
+			dir:             types.Direction_SHORT,
+			maxSlippage:     sdk.MustNewDecFromStr("0.5"),
+			expectedErr:     types.ErrAssetFailsUserLimit,
+		},
+		{
+			name:            "negative max slippage",
+			quoteReserveAmt: sdk.NewDec(1e11),
+			dir:             types.Direction_LONG,
+			maxSlippage:     sdk.NewDec(-1),
+			expectedErr:     fmt.Errorf("max slippage must not be negative"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amm := mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(2))
+
+			baseReserveDelta, err := amm.GetBaseReserveAmtWithSlippage(tc.quoteReserveAmt, tc.dir, tc.maxSlippage)
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			expectedBaseReserveDelta, err := amm.GetBaseReserveAmt(tc.quoteReserveAmt, tc.dir)
+			require.NoError(t, err)
+			assert.Equal(t, expectedBaseReserveDelta, baseReserveDelta)
+		})
+	}
+}
+
+func TestApplySwap(t *testing.T) {
+	amm := mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(2))
+	ammBefore := amm.Clone()
+
+	newAMM, baseAssetDelta, err := amm.ApplySwap(types.Direction_LONG, sdk.NewDec(2e11))
+	require.NoError(t, err)
+
+	// the receiver is unchanged
+	assert.Equal(t, ammBefore.String(), amm.String())
+
+	// the returned AMM matches a mutating swap
+	swapped := ammBefore.Clone()
+	expectedBaseAssetDelta, err := swapped.SwapQuoteAsset(sdk.NewDec(2e11), types.Direction_LONG)
+	require.NoError(t, err)
+	assert.Equal(t, expectedBaseAssetDelta, baseAssetDelta)
+	assert.Equal(t, swapped.BaseReserve, newAMM.BaseReserve)
+	assert.Equal(t, swapped.QuoteReserve, newAMM.QuoteReserve)
+	assert.Equal(t, swapped.TotalLong, newAMM.TotalLong)
+
+	expectedSqrtDepth, err := newAMM.ComputeSqrtDepth()
+	require.NoError(t, err)
+	assert.Equal(t, expectedSqrtDepth, newAMM.SqrtDepth)
+	require.NoError(t, newAMM.Validate())
+
+	t.Run("failed swap leaves the receiver unchanged", func(t *testing.T) {
+		_, _, err := amm.ApplySwap(types.Direction_SHORT, sdk.NewDec(1e13))
+		require.ErrorIs(t, err, types.ErrAmmNonpositiveReserves)
+		assert.Equal(t, ammBefore.String(), amm.String())
+	})
+}
@@ -25,6 +25,7 @@ const (
 	ChangeReason_PartialLiquidation ChangeReason = "partial_liquidation"
 	ChangeReason_FullLiquidation    ChangeReason = "full_liquidation"
 	ChangeReason_Settlement         ChangeReason = "settlement"
+	ChangeReason_FundingTopUp       ChangeReason = "funding_top_up"
 )
 
 func (c *ChangeReason) Size() int {
@@ -9,6 +9,7 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddMargin{}, "perpv2/add_margin", nil)
+	cdc.RegisterConcrete(&MsgAddMarginBatch{}, "perpv2/add_margin_batch", nil)
 	cdc.RegisterConcrete(&MsgRemoveMargin{}, "perpv2/remove_margin", nil)
 	cdc.RegisterConcrete(&MsgMarketOrder{}, "perpv2/market_order", nil)
 	cdc.RegisterConcrete(&MsgClosePosition{}, "perpv2/close_position", nil)
@@ -18,6 +19,27 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgChangeCollateralDenom{}, "perpv2/change_collateral_denom", nil)
 	cdc.RegisterConcrete(&MsgShiftPegMultiplier{}, "perpv2/shift_peg_multiplier", nil)
 	cdc.RegisterConcrete(&MsgShiftSwapInvariant{}, "perpv2/shift_swap_invariant", nil)
+	cdc.RegisterConcrete(&MsgChangeMinSnapshotIntervalMs{}, "perpv2/change_min_snapshot_interval_ms", nil)
+	cdc.RegisterConcrete(&MsgChangeSnapshotRetentionMs{}, "perpv2/change_snapshot_retention_ms", nil)
+	cdc.RegisterConcrete(&MsgChangeLiquidationTwapLookbackMs{}, "perpv2/change_liquidation_twap_lookback_ms", nil)
+	cdc.RegisterConcrete(&MsgChangeLiquidatorRewardRatio{}, "perpv2/change_liquidator_reward_ratio", nil)
+	cdc.RegisterConcrete(&MsgChangeFundingRateIntervalMs{}, "perpv2/change_funding_rate_interval_ms", nil)
+	cdc.RegisterConcrete(&MsgChangeMaxPairsPerBlock{}, "perpv2/change_max_pairs_per_block", nil)
+	cdc.RegisterConcrete(&MsgChangeImbalanceFeeRatio{}, "perpv2/change_imbalance_fee_ratio", nil)
+	cdc.RegisterConcrete(&MsgChangeOpenInterestCap{}, "perpv2/change_open_interest_cap", nil)
+	cdc.RegisterConcrete(&MsgChangeFluctuationLimitRatio{}, "perpv2/change_fluctuation_limit_ratio", nil)
+	cdc.RegisterConcrete(&MsgChangeTradeLimitRatio{}, "perpv2/change_trade_limit_ratio", nil)
+	cdc.RegisterConcrete(&MsgChangeSideTradeLimitRatios{}, "perpv2/change_side_trade_limit_ratios", nil)
+	cdc.RegisterConcrete(&MsgChangeCloseAtOracle{}, "perpv2/change_close_at_oracle", nil)
+	cdc.RegisterConcrete(&MsgChangeMaxOracleSpreadRatio{}, "perpv2/change_max_oracle_spread_ratio", nil)
+	cdc.RegisterConcrete(&MsgChangeSpreadLimitedSwaps{}, "perpv2/change_spread_limited_swaps", nil)
+	cdc.RegisterConcrete(&MsgChangeMarketPaused{}, "perpv2/change_market_paused", nil)
+	cdc.RegisterConcrete(&MsgChangeMinPositionQuote{}, "perpv2/change_min_position_quote", nil)
+	cdc.RegisterConcrete(&MsgAddCollateralDenom{}, "perpv2/add_collateral_denom", nil)
+	cdc.RegisterConcrete(&MsgRemoveCollateralDenom{}, "perpv2/remove_collateral_denom", nil)
+	cdc.RegisterConcrete(&MsgSetFundingTopUp{}, "perpv2/set_funding_top_up", nil)
+	cdc.RegisterConcrete(&MsgSetMarginMode{}, "perpv2/set_margin_mode", nil)
+	cdc.RegisterConcrete(&MsgClampMarkToOracleBand{}, "perpv2/clamp_mark_to_oracle_band", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,6 +48,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		/* implementations */
 		&MsgRemoveMargin{},
 		&MsgAddMargin{},
+		&MsgAddMarginBatch{},
 		&MsgMarketOrder{},
 		&MsgClosePosition{},
 		&MsgPartialClose{},
@@ -33,6 +56,27 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgChangeCollateralDenom{},
 		&MsgShiftPegMultiplier{},
 		&MsgShiftSwapInvariant{},
+		&MsgChangeMinSnapshotIntervalMs{},
+		&MsgChangeSnapshotRetentionMs{},
+		&MsgChangeLiquidationTwapLookbackMs{},
+		&MsgChangeLiquidatorRewardRatio{},
+		&MsgChangeFundingRateIntervalMs{},
+		&MsgChangeMaxPairsPerBlock{},
+		&MsgChangeImbalanceFeeRatio{},
+		&MsgChangeOpenInterestCap{},
+		&MsgChangeFluctuationLimitRatio{},
+		&MsgChangeTradeLimitRatio{},
+		&MsgChangeSideTradeLimitRatios{},
+		&MsgChangeCloseAtOracle{},
+		&MsgChangeMaxOracleSpreadRatio{},
+		&MsgChangeSpreadLimitedSwaps{},
+		&MsgChangeMarketPaused{},
+		&MsgChangeMinPositionQuote{},
+		&MsgAddCollateralDenom{},
+		&MsgRemoveCollateralDenom{},
+		&MsgSetFundingTopUp{},
+		&MsgSetMarginMode{},
+		&MsgClampMarkToOracleBand{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
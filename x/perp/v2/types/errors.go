@@ -52,6 +52,14 @@ var (
 	ErrCollateralDenomNotSet           = registerError("ErrorCollateral: no collateral denom set for the perp keeper")
 	ErrInvalidCollateral               = registerError("ErrorCollateral: invalid collateral denom")
 	ErrGeneric                         = registerError("perp GenericError")
+	ErrInvalidLiquidationFraction      = registerError("liquidation fraction must be in (0, 1]")
+
+	ErrOpenInterestCapExceeded = errorMarketOrder("open interest cannot exceed the open interest cap of the market")
+	ErrOverFluctuationLimit    = errorAmm("mark price moved beyond the fluctuation limit of the latest snapshot")
+	ErrOverTradingLimit        = errorAmm("swap exceeds the trade limit of the reserves")
+	ErrOverSpreadLimit         = errorAmm("mark price is beyond the max oracle spread from the oracle price")
+	ErrMarketAlreadyExists     = registerError("market already exists and is enabled")
+	ErrMarketPaused            = registerError("market is paused, trading is halted")
 )
 
 // Register error instance for "ErrorMarketOrder"
@@ -62,6 +62,36 @@ func (LiquidationFailedEvent_LiquidationFailedReason) EnumDescriptor() ([]byte,
 	return fileDescriptor_a5313bbc89fa31dd, []int{4, 0}
 }
 
+type PositionReversalEvent_ReversalLeg int32
+
+const (
+	PositionReversalEvent_UNSPECIFIED PositionReversalEvent_ReversalLeg = 0
+	// the existing position was closed.
+	PositionReversalEvent_CLOSED PositionReversalEvent_ReversalLeg = 1
+	// the residual was opened on the opposite side.
+	PositionReversalEvent_OPENED PositionReversalEvent_ReversalLeg = 2
+)
+
+var PositionReversalEvent_ReversalLeg_name = map[int32]string{
+	0: "UNSPECIFIED",
+	1: "CLOSED",
+	2: "OPENED",
+}
+
+var PositionReversalEvent_ReversalLeg_value = map[string]int32{
+	"UNSPECIFIED": 0,
+	"CLOSED":      1,
+	"OPENED":      2,
+}
+
+func (x PositionReversalEvent_ReversalLeg) String() string {
+	return proto.EnumName(PositionReversalEvent_ReversalLeg_name, int32(x))
+}
+
+func (PositionReversalEvent_ReversalLeg) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a5313bbc89fa31dd, []int{10, 0}
+}
+
 // Emitted when a position changes.
 type PositionChangedEvent struct {
 	FinalPosition Position `protobuf:"bytes,1,opt,name=final_position,json=finalPosition,proto3" json:"final_position"`
@@ -106,6 +136,10 @@ type PositionChangedEvent struct {
 	// notional increased, while a negative value indicates that the position
 	// notional decreased.
 	ExchangedNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=exchanged_notional,json=exchangedNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional"`
+	// Fee paid to the perp fund for widening the open interest imbalance of the
+	// market. It is not part of the transaction_fee. Unset if the change paid no
+	// imbalance fee.
+	ImbalanceFee *types.Coin `protobuf:"bytes,12,opt,name=imbalance_fee,json=imbalanceFee,proto3" json:"imbalance_fee,omitempty" yaml:"imbalance_fee"`
 }
 
 func (m *PositionChangedEvent) Reset()         { *m = PositionChangedEvent{} }
@@ -169,6 +203,13 @@ func (m *PositionChangedEvent) GetBlockHeight() int64 {
 	return 0
 }
 
+func (m *PositionChangedEvent) GetImbalanceFee() *types.Coin {
+	if m != nil {
+		return m.ImbalanceFee
+	}
+	return nil
+}
+
 // Emitted when a position is liquidated. Wraps a PositionChanged event since a
 // liquidation causes position changes.
 type PositionLiquidatedEvent struct {
@@ -617,8 +658,228 @@ func (m *EventShiftSwapInvariant) GetCostPaid() types.Coin {
 	return types.Coin{}
 }
 
+// PriceChangedEvent: Emitted after every swap with the mark price and reserves
+// of the AMM, so that indexers can follow the price series without reading
+// reserve snapshots.
+type PriceChangedEvent struct {
+	Pair         github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	MarkPrice    github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,2,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	BaseReserve  github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,3,opt,name=base_reserve,json=baseReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_reserve"`
+	QuoteReserve github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,4,opt,name=quote_reserve,json=quoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_reserve"`
+}
+
+func (m *PriceChangedEvent) Reset()         { *m = PriceChangedEvent{} }
+func (m *PriceChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*PriceChangedEvent) ProtoMessage()    {}
+func (*PriceChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5313bbc89fa31dd, []int{9}
+}
+func (m *PriceChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceChangedEvent.Merge(m, src)
+}
+func (m *PriceChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceChangedEvent proto.InternalMessageInfo
+
+// PositionReversalEvent: Emitted for each leg of a market order that reverses
+// a position, the close of the existing position and the open of the residual
+// on the opposite side. The PositionChangedEvent of the order nets both legs.
+type PositionReversalEvent struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// owner of the position.
+	Trader string `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+	// The leg of the reversal.
+	Leg               PositionReversalEvent_ReversalLeg      `protobuf:"varint,3,opt,name=leg,proto3,enum=nibiru.perp.v2.PositionReversalEvent_ReversalLeg" json:"leg,omitempty"`
+	ExchangedSize     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exchanged_size,json=exchangedSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_size"`
+	ExchangedNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=exchanged_notional,json=exchangedNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional"`
+	RealizedPnl       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	FundingPayment    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
+}
+
+func (m *PositionReversalEvent) Reset()         { *m = PositionReversalEvent{} }
+func (m *PositionReversalEvent) String() string { return proto.CompactTextString(m) }
+func (*PositionReversalEvent) ProtoMessage()    {}
+func (*PositionReversalEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5313bbc89fa31dd, []int{10}
+}
+func (m *PositionReversalEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionReversalEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionReversalEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionReversalEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionReversalEvent.Merge(m, src)
+}
+func (m *PositionReversalEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionReversalEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionReversalEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionReversalEvent proto.InternalMessageInfo
+
+func (m *PositionReversalEvent) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
+}
+
+func (m *PositionReversalEvent) GetLeg() PositionReversalEvent_ReversalLeg {
+	if m != nil {
+		return m.Leg
+	}
+	return PositionReversalEvent_UNSPECIFIED
+}
+
+// BadDebtRealizedEvent: Emitted when bad debt beyond the prepaid bad debt of a
+// market is realized. The perp fund covers what it can and the rest is
+// recorded as uncovered bad debt.
+type BadDebtRealizedEvent struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// bad debt paid by the perp fund
+	Covered types.Coin `protobuf:"bytes,2,opt,name=covered,proto3" json:"covered"`
+	// bad debt the perp fund couldn't pay
+	Uncovered types.Coin `protobuf:"bytes,3,opt,name=uncovered,proto3" json:"uncovered"`
+}
+
+func (m *BadDebtRealizedEvent) Reset()         { *m = BadDebtRealizedEvent{} }
+func (m *BadDebtRealizedEvent) String() string { return proto.CompactTextString(m) }
+func (*BadDebtRealizedEvent) ProtoMessage()    {}
+func (*BadDebtRealizedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5313bbc89fa31dd, []int{11}
+}
+func (m *BadDebtRealizedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadDebtRealizedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadDebtRealizedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadDebtRealizedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadDebtRealizedEvent.Merge(m, src)
+}
+func (m *BadDebtRealizedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadDebtRealizedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadDebtRealizedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadDebtRealizedEvent proto.InternalMessageInfo
+
+func (m *BadDebtRealizedEvent) GetCovered() types.Coin {
+	if m != nil {
+		return m.Covered
+	}
+	return types.Coin{}
+}
+
+func (m *BadDebtRealizedEvent) GetUncovered() types.Coin {
+	if m != nil {
+		return m.Uncovered
+	}
+	return types.Coin{}
+}
+
+// BadDebtRepaidEvent: Emitted when the perp fund pays back uncovered bad debt
+// of a market.
+type BadDebtRepaidEvent struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Repaid types.Coin                                        `protobuf:"bytes,2,opt,name=repaid,proto3" json:"repaid"`
+	// uncovered bad debt left after the repayment
+	Remaining types.Coin `protobuf:"bytes,3,opt,name=remaining,proto3" json:"remaining"`
+}
+
+func (m *BadDebtRepaidEvent) Reset()         { *m = BadDebtRepaidEvent{} }
+func (m *BadDebtRepaidEvent) String() string { return proto.CompactTextString(m) }
+func (*BadDebtRepaidEvent) ProtoMessage()    {}
+func (*BadDebtRepaidEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5313bbc89fa31dd, []int{12}
+}
+func (m *BadDebtRepaidEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadDebtRepaidEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadDebtRepaidEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadDebtRepaidEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadDebtRepaidEvent.Merge(m, src)
+}
+func (m *BadDebtRepaidEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadDebtRepaidEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadDebtRepaidEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadDebtRepaidEvent proto.InternalMessageInfo
+
+func (m *BadDebtRepaidEvent) GetRepaid() types.Coin {
+	if m != nil {
+		return m.Repaid
+	}
+	return types.Coin{}
+}
+
+func (m *BadDebtRepaidEvent) GetRemaining() types.Coin {
+	if m != nil {
+		return m.Remaining
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterEnum("nibiru.perp.v2.LiquidationFailedEvent_LiquidationFailedReason", LiquidationFailedEvent_LiquidationFailedReason_name, LiquidationFailedEvent_LiquidationFailedReason_value)
+	proto.RegisterEnum("nibiru.perp.v2.PositionReversalEvent_ReversalLeg", PositionReversalEvent_ReversalLeg_name, PositionReversalEvent_ReversalLeg_value)
 	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v2.PositionChangedEvent")
 	proto.RegisterType((*PositionLiquidatedEvent)(nil), "nibiru.perp.v2.PositionLiquidatedEvent")
 	proto.RegisterType((*PositionSettledEvent)(nil), "nibiru.perp.v2.PositionSettledEvent")
@@ -628,89 +889,109 @@ func init() {
 	proto.RegisterType((*MarketUpdatedEvent)(nil), "nibiru.perp.v2.MarketUpdatedEvent")
 	proto.RegisterType((*EventShiftPegMultiplier)(nil), "nibiru.perp.v2.EventShiftPegMultiplier")
 	proto.RegisterType((*EventShiftSwapInvariant)(nil), "nibiru.perp.v2.EventShiftSwapInvariant")
+	proto.RegisterType((*PriceChangedEvent)(nil), "nibiru.perp.v2.PriceChangedEvent")
+	proto.RegisterType((*PositionReversalEvent)(nil), "nibiru.perp.v2.PositionReversalEvent")
+	proto.RegisterType((*BadDebtRealizedEvent)(nil), "nibiru.perp.v2.BadDebtRealizedEvent")
+	proto.RegisterType((*BadDebtRepaidEvent)(nil), "nibiru.perp.v2.BadDebtRepaidEvent")
 }
 
 func init() { proto.RegisterFile("nibiru/perp/v2/event.proto", fileDescriptor_a5313bbc89fa31dd) }
 
 var fileDescriptor_a5313bbc89fa31dd = []byte{
-	// 1230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xe3, 0xb8, 0xa4, 0xcd, 0xc4, 0xb1, 0x9d, 0xc1, 0x24, 0xdb, 0x52, 0x39, 0x61, 0x55,
-	0x50, 0x2e, 0xdd, 0x55, 0x83, 0x84, 0xd4, 0x0a, 0x81, 0x92, 0xd4, 0x21, 0x96, 0x1a, 0xd7, 0xac,
-	0x9d, 0xd2, 0xf2, 0x43, 0xcb, 0x78, 0xf7, 0xd9, 0x19, 0x65, 0x77, 0x66, 0xd9, 0x19, 0xc7, 0x4d,
-	0xff, 0x01, 0x38, 0x22, 0x71, 0xe0, 0x7f, 0xe0, 0x2f, 0xe9, 0xb1, 0x47, 0xc4, 0xa1, 0xa0, 0x56,
-	0x1c, 0xb8, 0x21, 0xae, 0x5c, 0xd0, 0xce, 0xce, 0xc6, 0x3f, 0xd2, 0x36, 0xc4, 0xc0, 0x81, 0x53,
-	0xb2, 0xef, 0xcd, 0x7c, 0xde, 0x9b, 0xef, 0xbe, 0xf7, 0x66, 0x8d, 0xae, 0x30, 0xda, 0xa1, 0x71,
-	0xdf, 0x8e, 0x20, 0x8e, 0xec, 0xa3, 0x0d, 0x1b, 0x8e, 0x80, 0x49, 0x2b, 0x8a, 0xb9, 0xe4, 0xb8,
-	0x98, 0xfa, 0xac, 0xc4, 0x67, 0x1d, 0x6d, 0x5c, 0xa9, 0xf4, 0x78, 0x8f, 0x2b, 0x97, 0x9d, 0xfc,
-	0x97, 0xae, 0xba, 0x72, 0xb5, 0xc7, 0x79, 0x2f, 0x00, 0x9b, 0x44, 0xd4, 0x26, 0x8c, 0x71, 0x49,
-	0x24, 0xe5, 0x4c, 0x68, 0x6f, 0xd5, 0xe3, 0x22, 0xe4, 0xc2, 0xee, 0x10, 0x01, 0xf6, 0xd1, 0x8d,
-	0x0e, 0x48, 0x72, 0xc3, 0xf6, 0x38, 0x65, 0xda, 0x3f, 0x19, 0x5f, 0x48, 0x22, 0x41, 0xfb, 0x56,
-	0x35, 0x59, 0x3d, 0x75, 0xfa, 0x5d, 0x5b, 0xd2, 0x10, 0x84, 0x24, 0x61, 0x94, 0x2e, 0x30, 0x7f,
-	0x9f, 0x43, 0x95, 0x26, 0x17, 0x34, 0x09, 0xb8, 0x7d, 0x40, 0x58, 0x0f, 0xfc, 0x5a, 0x92, 0x3f,
-	0xae, 0xa1, 0x62, 0x97, 0x32, 0x12, 0xb8, 0x91, 0xf6, 0x1a, 0xb9, 0xb5, 0xdc, 0xfa, 0xc2, 0x86,
-	0x61, 0x8d, 0x1f, 0xc9, 0xca, 0x76, 0x6f, 0x5d, 0x78, 0xfc, 0x74, 0x75, 0xc6, 0x59, 0x54, 0xbb,
-	0x32, 0x23, 0xfe, 0x0c, 0x2d, 0x65, 0x00, 0x97, 0xf1, 0xe4, 0x0f, 0x09, 0x8c, 0xd9, 0xb5, 0xdc,
-	0xfa, 0xfc, 0x96, 0x95, 0xac, 0xff, 0xe9, 0xe9, 0xea, 0x3b, 0x3d, 0x2a, 0x0f, 0xfa, 0x1d, 0xcb,
-	0xe3, 0xa1, 0xad, 0x8f, 0x9a, 0xfe, 0xb9, 0x2e, 0xfc, 0x43, 0x5b, 0x1e, 0x47, 0x20, 0xac, 0xdb,
-	0xe0, 0x39, 0xe5, 0x0c, 0xd4, 0xd0, 0x1c, 0xdc, 0x41, 0x25, 0x19, 0x13, 0x26, 0x88, 0xa7, 0xf8,
-	0x5d, 0x00, 0x23, 0xaf, 0x92, 0xbc, 0x6c, 0xa5, 0x04, 0x2b, 0xd1, 0xcc, 0xd2, 0x9a, 0x59, 0xdb,
-	0x9c, 0xb2, 0xad, 0x6a, 0x12, 0xf5, 0x8f, 0xa7, 0xab, 0xcb, 0xc7, 0x24, 0x0c, 0x6e, 0x99, 0x13,
-	0xfb, 0x4d, 0xa7, 0x38, 0x62, 0xd9, 0x01, 0xc0, 0x1f, 0xa3, 0x42, 0x0c, 0x24, 0xa0, 0x8f, 0xc0,
-	0x77, 0x23, 0x16, 0x18, 0x17, 0xa6, 0xca, 0x7d, 0x21, 0x63, 0x34, 0x59, 0x80, 0x6f, 0xa1, 0x4b,
-	0x1d, 0xe2, 0xbb, 0x3e, 0x74, 0xa4, 0xf1, 0xda, 0x59, 0xf9, 0xa6, 0xaa, 0x5e, 0xec, 0x10, 0xff,
-	0x36, 0x74, 0x24, 0xfe, 0x04, 0x95, 0xba, 0x7d, 0xe6, 0x53, 0xd6, 0x73, 0x23, 0x72, 0x1c, 0x02,
-	0x93, 0xc6, 0xdc, 0x54, 0x19, 0x15, 0x35, 0xa6, 0x99, 0x52, 0xf0, 0x5b, 0xa8, 0xd0, 0x09, 0xb8,
-	0x77, 0xe8, 0x1e, 0x00, 0xed, 0x1d, 0x48, 0xe3, 0xe2, 0x5a, 0x6e, 0x3d, 0xef, 0x2c, 0x28, 0xdb,
-	0xae, 0x32, 0xe1, 0x36, 0x2a, 0x86, 0x24, 0xee, 0x51, 0xe6, 0x4a, 0xee, 0xf6, 0x05, 0xc4, 0xc6,
-	0xa5, 0x73, 0x87, 0xae, 0x33, 0xe9, 0x14, 0x52, 0x4a, 0x9b, 0xef, 0x0b, 0x88, 0xf1, 0x4d, 0xb4,
-	0xe8, 0xa9, 0xc2, 0x73, 0x63, 0x20, 0x82, 0x33, 0x63, 0x5e, 0x41, 0x2b, 0x1a, 0x5a, 0x48, 0xab,
-	0xd2, 0x51, 0x3e, 0xa7, 0xe0, 0x8d, 0x3c, 0xe1, 0x7d, 0x54, 0x84, 0x87, 0xa9, 0xc5, 0x77, 0x05,
-	0x7d, 0x04, 0x06, 0x9a, 0x4a, 0x8b, 0xc5, 0x13, 0x4a, 0x8b, 0x3e, 0x02, 0xfc, 0x05, 0xc2, 0x43,
-	0xec, 0x49, 0xd1, 0x2e, 0x4c, 0x85, 0x5e, 0x3a, 0x21, 0x65, 0x55, 0x6b, 0x7e, 0x9d, 0x47, 0x2b,
-	0x59, 0x7f, 0xdc, 0xa1, 0x5f, 0xf5, 0xa9, 0x4f, 0x64, 0xd6, 0x75, 0x5f, 0xa2, 0xe5, 0x93, 0x76,
-	0xc9, 0x32, 0x50, 0xf3, 0x44, 0x77, 0xdf, 0xb5, 0x97, 0x75, 0xdf, 0x68, 0xef, 0xea, 0x9a, 0xa9,
-	0x44, 0x2f, 0xea, 0xeb, 0xeb, 0x08, 0x07, 0x3a, 0x28, 0x8f, 0x5d, 0xe2, 0xfb, 0x31, 0x08, 0x91,
-	0x76, 0xa4, 0xb3, 0x34, 0xf4, 0x6c, 0xa6, 0x0e, 0xdc, 0x43, 0x4b, 0x5d, 0x80, 0xe4, 0x85, 0x0f,
-	0x7d, 0x67, 0x37, 0xd9, 0x9a, 0x6e, 0x32, 0x23, 0x6d, 0xb2, 0x53, 0x04, 0xd3, 0x29, 0x75, 0x01,
-	0xda, 0xfc, 0xce, 0x89, 0x05, 0xc7, 0xe8, 0x0d, 0xbd, 0x0c, 0x3c, 0x2e, 0x8e, 0x85, 0x84, 0xd0,
-	0x4d, 0x4a, 0x54, 0x35, 0xdc, 0x2b, 0x83, 0x5d, 0xd3, 0xc1, 0xae, 0x8e, 0x05, 0x1b, 0xa7, 0x98,
-	0x0e, 0x56, 0x01, 0x6b, 0x99, 0x75, 0x27, 0x31, 0x7e, 0x3f, 0x3b, 0x1c, 0x7e, 0x2d, 0x90, 0x32,
-	0xc8, 0x44, 0xda, 0x43, 0x17, 0x22, 0x42, 0x63, 0x25, 0xfa, 0xfc, 0xd6, 0x4d, 0xfd, 0xce, 0x6f,
-	0x8c, 0xbc, 0xf3, 0x86, 0x7a, 0x0d, 0xdb, 0x07, 0x84, 0x32, 0x5b, 0xcf, 0xdf, 0x87, 0xb6, 0xc7,
-	0xc3, 0x90, 0x33, 0x9b, 0x08, 0x01, 0xd2, 0x6a, 0x12, 0x1a, 0x3b, 0x0a, 0x83, 0xdf, 0x46, 0xc9,
-	0x54, 0xf1, 0x61, 0x52, 0xef, 0xc5, 0xd4, 0x9a, 0x69, 0xfd, 0x4d, 0x0e, 0x2d, 0x8a, 0x34, 0x0d,
-	0x37, 0x99, 0xef, 0xc2, 0xc8, 0xaf, 0xe5, 0x5f, 0x7d, 0xf6, 0x5d, 0x7d, 0xf6, 0x4a, 0x7a, 0xf6,
-	0xb1, 0xdd, 0xe6, 0x0f, 0x3f, 0xaf, 0xae, 0xff, 0x8d, 0x32, 0x4d, 0x40, 0xc2, 0x29, 0xe8, 0xbd,
-	0xea, 0xc9, 0xfc, 0x35, 0x8f, 0x56, 0x76, 0xd2, 0x01, 0xe1, 0x10, 0x09, 0x63, 0x15, 0xf4, 0x2f,
-	0x8b, 0x73, 0x0f, 0x95, 0x42, 0x12, 0x1f, 0xba, 0x51, 0x4c, 0x3d, 0x70, 0xe5, 0x80, 0x44, 0x53,
-	0xde, 0x0f, 0x8b, 0x09, 0xa6, 0x99, 0x50, 0xda, 0x03, 0x12, 0xe1, 0xfb, 0xa8, 0x4c, 0x99, 0x0f,
-	0x0f, 0x47, 0xc1, 0xf9, 0xe9, 0x46, 0xa5, 0xe2, 0x0c, 0xc9, 0x0f, 0x50, 0x39, 0x8a, 0x21, 0xa4,
-	0xfd, 0xd0, 0xed, 0xc6, 0xe9, 0x4d, 0xa1, 0xe6, 0xf8, 0xf9, 0xc9, 0x25, 0xcd, 0xd9, 0xd1, 0x18,
-	0xcc, 0xd0, 0x9b, 0x5e, 0x3f, 0xec, 0x07, 0x44, 0xd2, 0x23, 0x70, 0x4f, 0x45, 0x99, 0x6e, 0xd4,
-	0x5f, 0x1e, 0x22, 0x9b, 0xe3, 0xf1, 0xcc, 0xdf, 0x66, 0xd1, 0x72, 0xd6, 0x84, 0xc9, 0x85, 0x47,
-	0xe8, 0x7f, 0xd5, 0x03, 0xcb, 0x68, 0x2e, 0xad, 0x76, 0x5d, 0xfb, 0xfa, 0x09, 0x57, 0x11, 0x9a,
-	0x98, 0x2c, 0xf3, 0xce, 0x88, 0x05, 0xdf, 0x43, 0x73, 0xfa, 0x5e, 0x48, 0x06, 0x41, 0x71, 0xe3,
-	0x83, 0xc9, 0x09, 0xf8, 0xe2, 0xf4, 0x4f, 0x9b, 0xf5, 0x0d, 0xa2, 0x69, 0x66, 0x84, 0x56, 0x5e,
-	0xb2, 0x04, 0x97, 0xd0, 0xc2, 0x7e, 0xa3, 0xd5, 0xac, 0x6d, 0xd7, 0x77, 0xea, 0xb5, 0xdb, 0xe5,
-	0x19, 0x5c, 0x41, 0xe5, 0xe6, 0xdd, 0x56, 0xbd, 0x5d, 0xbf, 0xdb, 0x70, 0x77, 0x6b, 0x9b, 0x77,
-	0xda, 0xbb, 0x0f, 0xca, 0xb9, 0xc4, 0xda, 0xb8, 0xdb, 0xa8, 0xdd, 0xaf, 0xb7, 0xda, 0xb5, 0x46,
-	0xdb, 0x6d, 0x6e, 0xd6, 0x9d, 0xf2, 0x2c, 0x36, 0x50, 0x65, 0xcc, 0xaa, 0xf7, 0x95, 0xf3, 0xe6,
-	0x9f, 0x39, 0x54, 0xda, 0x0c, 0xc3, 0xfd, 0x68, 0x64, 0xde, 0xbf, 0x87, 0xe6, 0xd3, 0xaf, 0x2c,
-	0x12, 0x86, 0x7a, 0xc4, 0xbf, 0x3e, 0x79, 0xc0, 0xcd, 0xbd, 0x3d, 0x3d, 0xd1, 0x2f, 0xa9, 0xb5,
-	0x9b, 0x61, 0xf8, 0xff, 0x6b, 0x1a, 0x73, 0x1f, 0xe1, 0x3d, 0x12, 0x1f, 0x82, 0x1c, 0x3b, 0xff,
-	0x87, 0xa8, 0x90, 0x9e, 0x3f, 0x54, 0x3e, 0x2d, 0xc1, 0xf2, 0xa4, 0x04, 0xe9, 0x4e, 0xad, 0xc2,
-	0x82, 0xda, 0x91, 0x9a, 0xcc, 0xef, 0x66, 0xd1, 0x8a, 0x42, 0xb5, 0x0e, 0x68, 0x57, 0x36, 0xa1,
-	0xb7, 0xd7, 0x0f, 0x24, 0x8d, 0x02, 0x0a, 0x31, 0xfe, 0x1c, 0x61, 0x1e, 0xf8, 0x6e, 0x04, 0x3d,
-	0x37, 0x3c, 0xb1, 0xea, 0x7a, 0x3e, 0xf7, 0xc7, 0x27, 0x0f, 0xfc, 0x53, 0x74, 0x06, 0x83, 0x49,
-	0xfa, 0x94, 0x9f, 0xb6, 0x0c, 0x06, 0xe3, 0xf4, 0xf7, 0xd1, 0xbc, 0xc7, 0x85, 0x74, 0x23, 0x42,
-	0xfd, 0xb3, 0xef, 0x5b, 0x5d, 0x1e, 0xc9, 0x8e, 0x26, 0xa1, 0xfe, 0x84, 0x2a, 0xad, 0x01, 0x89,
-	0xea, 0xec, 0x88, 0xc4, 0x94, 0x30, 0x99, 0xa9, 0x22, 0x06, 0x24, 0x72, 0x69, 0x66, 0x9d, 0x42,
-	0x95, 0xe4, 0x4b, 0x2e, 0x51, 0xe5, 0x14, 0x3d, 0x51, 0x65, 0x82, 0x3e, 0x3b, 0x1d, 0x9d, 0xc1,
-	0x60, 0x9c, 0xfe, 0x8f, 0x54, 0xd9, 0xfa, 0xe8, 0xf1, 0xb3, 0x6a, 0xee, 0xc9, 0xb3, 0x6a, 0xee,
-	0x97, 0x67, 0xd5, 0xdc, 0xb7, 0xcf, 0xab, 0x33, 0x4f, 0x9e, 0x57, 0x67, 0x7e, 0x7c, 0x5e, 0x9d,
-	0xf9, 0xf4, 0xfa, 0x59, 0x53, 0x2d, 0xfb, 0x6d, 0xa5, 0x92, 0xeb, 0xcc, 0xa9, 0xdf, 0x4e, 0xef,
-	0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x50, 0x37, 0x90, 0x96, 0xfa, 0x0d, 0x00, 0x00,
+	// 1478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x98, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x80, 0x2d, 0xc9, 0xb1, 0xa3, 0xd5, 0xc3, 0xf2, 0x56, 0xb1, 0x99, 0x34, 0x90, 0x5d, 0x22,
+	0x2d, 0x7c, 0x89, 0x04, 0xbb, 0x40, 0x8a, 0x04, 0x7d, 0xc0, 0x0f, 0xb9, 0x16, 0x60, 0xcb, 0x2a,
+	0x25, 0xa7, 0x49, 0x1f, 0x60, 0x57, 0xe2, 0x48, 0x5e, 0x98, 0x5c, 0x32, 0xe4, 0x4a, 0x8e, 0xf3,
+	0x07, 0xda, 0x63, 0x81, 0x1e, 0xfa, 0x1f, 0xfa, 0x4b, 0x82, 0x9e, 0x72, 0xec, 0x03, 0x75, 0x8a,
+	0x04, 0x3d, 0xf4, 0x9a, 0x6b, 0x2f, 0xc5, 0x92, 0x4b, 0xbd, 0xec, 0xd4, 0x2e, 0x13, 0x17, 0xe8,
+	0x49, 0xda, 0x99, 0x9d, 0x6f, 0x77, 0x46, 0x33, 0xb3, 0xbb, 0x42, 0xd7, 0x18, 0x6d, 0x52, 0xb7,
+	0x5b, 0x72, 0xc0, 0x75, 0x4a, 0xbd, 0x95, 0x12, 0xf4, 0x80, 0xf1, 0xa2, 0xe3, 0xda, 0xdc, 0xc6,
+	0xd9, 0x40, 0x57, 0x14, 0xba, 0x62, 0x6f, 0xe5, 0x5a, 0xbe, 0x63, 0x77, 0x6c, 0x5f, 0x55, 0x12,
+	0xdf, 0x82, 0x59, 0xd7, 0xae, 0x77, 0x6c, 0xbb, 0x63, 0x42, 0x89, 0x38, 0xb4, 0x44, 0x18, 0xb3,
+	0x39, 0xe1, 0xd4, 0x66, 0x9e, 0xd4, 0x16, 0x5a, 0xb6, 0x67, 0xd9, 0x5e, 0xa9, 0x49, 0x3c, 0x28,
+	0xf5, 0x96, 0x9b, 0xc0, 0xc9, 0x72, 0xa9, 0x65, 0x53, 0x26, 0xf5, 0xe3, 0xeb, 0x7b, 0x9c, 0x70,
+	0x90, 0xba, 0x05, 0x49, 0xf6, 0x47, 0xcd, 0x6e, 0xbb, 0xc4, 0xa9, 0x05, 0x1e, 0x27, 0x96, 0x13,
+	0x4c, 0x50, 0x7f, 0x9c, 0x46, 0xf9, 0x9a, 0xed, 0x51, 0xb1, 0xe0, 0xfa, 0x3e, 0x61, 0x1d, 0x30,
+	0xca, 0x62, 0xff, 0xb8, 0x8c, 0xb2, 0x6d, 0xca, 0x88, 0xa9, 0x3b, 0x52, 0xab, 0xc4, 0x16, 0x63,
+	0x4b, 0xa9, 0x15, 0xa5, 0x38, 0xea, 0x52, 0x31, 0xb4, 0x5e, 0x9b, 0x7c, 0x7c, 0xbc, 0x30, 0xa1,
+	0x65, 0x7c, 0xab, 0x50, 0x88, 0x3f, 0x47, 0xb3, 0x21, 0x40, 0x67, 0xb6, 0xf8, 0x20, 0xa6, 0x12,
+	0x5f, 0x8c, 0x2d, 0x25, 0xd7, 0x8a, 0x62, 0xfe, 0x2f, 0xc7, 0x0b, 0xef, 0x74, 0x28, 0xdf, 0xef,
+	0x36, 0x8b, 0x2d, 0xdb, 0x2a, 0x49, 0x57, 0x83, 0x8f, 0x9b, 0x9e, 0x71, 0x50, 0xe2, 0x47, 0x0e,
+	0x78, 0xc5, 0x0d, 0x68, 0x69, 0xb9, 0x10, 0x54, 0x95, 0x1c, 0xdc, 0x44, 0x33, 0xdc, 0x25, 0xcc,
+	0x23, 0x2d, 0x9f, 0xdf, 0x06, 0x50, 0x12, 0xfe, 0x26, 0xaf, 0x16, 0x03, 0x42, 0x51, 0xc4, 0xac,
+	0x28, 0x63, 0x56, 0x5c, 0xb7, 0x29, 0x5b, 0x2b, 0x88, 0x55, 0x5f, 0x1c, 0x2f, 0xcc, 0x1d, 0x11,
+	0xcb, 0xbc, 0xa3, 0x8e, 0xd9, 0xab, 0x5a, 0x76, 0x48, 0xb2, 0x09, 0x80, 0x3f, 0x41, 0x69, 0x17,
+	0x88, 0x49, 0x1f, 0x81, 0xa1, 0x3b, 0xcc, 0x54, 0x26, 0x23, 0xed, 0x3d, 0x15, 0x32, 0x6a, 0xcc,
+	0xc4, 0x77, 0xd0, 0xe5, 0x26, 0x31, 0x74, 0x03, 0x9a, 0x5c, 0xb9, 0x74, 0xd6, 0x7e, 0x83, 0xa8,
+	0x4e, 0x37, 0x89, 0xb1, 0x01, 0x4d, 0x8e, 0x3f, 0x45, 0x33, 0xed, 0x2e, 0x33, 0x28, 0xeb, 0xe8,
+	0x0e, 0x39, 0xb2, 0x80, 0x71, 0x65, 0x2a, 0xd2, 0x8e, 0xb2, 0x12, 0x53, 0x0b, 0x28, 0xf8, 0x2d,
+	0x94, 0x6e, 0x9a, 0x76, 0xeb, 0x40, 0xdf, 0x07, 0xda, 0xd9, 0xe7, 0xca, 0xf4, 0x62, 0x6c, 0x29,
+	0xa1, 0xa5, 0x7c, 0xd9, 0x96, 0x2f, 0xc2, 0x0d, 0x94, 0xb5, 0x88, 0xdb, 0xa1, 0x4c, 0xe7, 0xb6,
+	0xde, 0xf5, 0xc0, 0x55, 0x2e, 0xff, 0xeb, 0xa5, 0x2b, 0x8c, 0x6b, 0xe9, 0x80, 0xd2, 0xb0, 0xf7,
+	0x3c, 0x70, 0xf1, 0x6d, 0x94, 0x69, 0xf9, 0x89, 0xa7, 0xbb, 0x40, 0x3c, 0x9b, 0x29, 0x49, 0x1f,
+	0x9a, 0x97, 0xd0, 0x74, 0x90, 0x95, 0x9a, 0xaf, 0xd3, 0xd2, 0xad, 0xa1, 0x11, 0xde, 0x43, 0x59,
+	0x78, 0x18, 0x48, 0x0c, 0xdd, 0xa3, 0x8f, 0x40, 0x41, 0x91, 0x62, 0x91, 0xe9, 0x53, 0xea, 0xf4,
+	0x11, 0xe0, 0x2f, 0x11, 0x1e, 0x60, 0xfb, 0x49, 0x9b, 0x8a, 0x84, 0x9e, 0xed, 0x93, 0xfa, 0x59,
+	0x7b, 0x0f, 0x65, 0xa8, 0xd5, 0x24, 0x26, 0x61, 0x2d, 0xf0, 0x73, 0x36, 0x7d, 0x56, 0x0e, 0x28,
+	0x2f, 0x8e, 0x17, 0xf2, 0x41, 0xbe, 0x8e, 0x58, 0xaa, 0x5a, 0xba, 0x3f, 0xde, 0x04, 0x50, 0xbf,
+	0x4e, 0xa0, 0xf9, 0xb0, 0xf2, 0xb6, 0xe9, 0x83, 0x2e, 0x35, 0x08, 0x0f, 0xeb, 0xf9, 0x2b, 0x34,
+	0xd7, 0x2f, 0xc4, 0xd0, 0x37, 0xbf, 0x53, 0xc9, 0xba, 0xbe, 0xf1, 0xb2, 0xba, 0x1e, 0xee, 0x0a,
+	0x32, 0x1b, 0xf3, 0xce, 0x29, 0x3a, 0x7c, 0x13, 0x61, 0x53, 0x2e, 0x6a, 0xbb, 0x3a, 0x31, 0x0c,
+	0x17, 0x3c, 0x2f, 0xa8, 0x75, 0x6d, 0x76, 0xa0, 0x59, 0x0d, 0x14, 0xb8, 0x83, 0x66, 0xdb, 0x00,
+	0x22, 0x95, 0x06, 0xba, 0xb3, 0xcb, 0x77, 0x51, 0x96, 0xaf, 0x12, 0x84, 0xe3, 0x04, 0x41, 0xd5,
+	0x66, 0xda, 0x00, 0x0d, 0x7b, 0xbb, 0x2f, 0xc1, 0x2e, 0xba, 0x22, 0xa7, 0x41, 0xcb, 0xf6, 0x8e,
+	0x3c, 0x0e, 0x96, 0x2e, 0x92, 0x5f, 0x99, 0x3c, 0x6b, 0xb1, 0x1b, 0x72, 0xb1, 0xeb, 0x23, 0x8b,
+	0x8d, 0x52, 0x54, 0x0d, 0xfb, 0x0b, 0x96, 0x43, 0xe9, 0xa6, 0x10, 0x7e, 0x1f, 0x1f, 0xb4, 0xd5,
+	0x3a, 0x70, 0x6e, 0x86, 0x41, 0xda, 0x41, 0x93, 0x0e, 0xa1, 0xae, 0x1f, 0xf4, 0xe4, 0xda, 0x6d,
+	0x99, 0x4d, 0xcb, 0x43, 0xd9, 0x54, 0xf5, 0x7f, 0x86, 0xf5, 0x7d, 0x42, 0x59, 0x49, 0x76, 0xf6,
+	0x87, 0xa5, 0x96, 0x6d, 0x59, 0x36, 0x2b, 0x11, 0xcf, 0x03, 0x5e, 0xac, 0x11, 0xea, 0x6a, 0x3e,
+	0x06, 0xbf, 0x8d, 0x44, 0xbf, 0x32, 0x60, 0x3c, 0xde, 0x99, 0x40, 0x1a, 0xc6, 0xfa, 0x9b, 0x18,
+	0xca, 0x78, 0xc1, 0x36, 0x74, 0x71, 0x72, 0x78, 0x4a, 0x62, 0x31, 0xf1, 0xcf, 0xbe, 0x6f, 0x49,
+	0xdf, 0x65, 0xde, 0x8d, 0x58, 0xab, 0x3f, 0x3c, 0x5d, 0x58, 0x3a, 0x47, 0x01, 0x08, 0x90, 0xa7,
+	0xa5, 0xa5, 0xad, 0x3f, 0x52, 0xff, 0x48, 0xa0, 0xf9, 0xcd, 0xa0, 0xf5, 0x68, 0x84, 0xc3, 0x48,
+	0x06, 0xbd, 0xe6, 0xe0, 0xdc, 0x45, 0x33, 0x16, 0x71, 0x0f, 0x74, 0xc7, 0xa5, 0x2d, 0xd0, 0xf9,
+	0x21, 0x71, 0x22, 0x9e, 0x3c, 0x19, 0x81, 0xa9, 0x09, 0x4a, 0xe3, 0x90, 0x38, 0xf8, 0x1e, 0xca,
+	0x51, 0x66, 0xc0, 0xc3, 0x61, 0x70, 0x22, 0x5a, 0x13, 0xf6, 0x39, 0x03, 0xf2, 0x7d, 0x94, 0x73,
+	0x5c, 0xb0, 0x68, 0xd7, 0xd2, 0xdb, 0x6e, 0x70, 0x06, 0x29, 0x97, 0x22, 0x91, 0x67, 0x24, 0x67,
+	0x53, 0x62, 0x30, 0x43, 0x6f, 0xb6, 0xba, 0x56, 0xd7, 0x24, 0x9c, 0xf6, 0x40, 0x3f, 0xb1, 0x4a,
+	0xb4, 0x43, 0xe4, 0xea, 0x00, 0x59, 0x1b, 0x5d, 0x4f, 0xfd, 0x33, 0x8e, 0xe6, 0xc2, 0x22, 0x14,
+	0x47, 0x29, 0xa1, 0x17, 0x55, 0x03, 0x73, 0x68, 0x2a, 0xc8, 0x76, 0x99, 0xfb, 0x72, 0x84, 0x0b,
+	0x08, 0x8d, 0x75, 0x96, 0xa4, 0x36, 0x24, 0xc1, 0x77, 0xd1, 0x94, 0x3c, 0x71, 0x44, 0x23, 0xc8,
+	0xae, 0x7c, 0x38, 0xde, 0x01, 0x4f, 0xdf, 0xfe, 0x49, 0xb1, 0x3c, 0x9b, 0x24, 0x4d, 0x75, 0xd0,
+	0xfc, 0x4b, 0xa6, 0xe0, 0x19, 0x94, 0xda, 0xab, 0xd6, 0x6b, 0xe5, 0xf5, 0xca, 0x66, 0xa5, 0xbc,
+	0x91, 0x9b, 0xc0, 0x79, 0x94, 0xab, 0xed, 0xd6, 0x2b, 0x8d, 0xca, 0x6e, 0x55, 0xdf, 0x2a, 0xaf,
+	0x6e, 0x37, 0xb6, 0xee, 0xe7, 0x62, 0x42, 0x5a, 0xdd, 0xad, 0x96, 0xef, 0x55, 0xea, 0x8d, 0x72,
+	0xb5, 0xa1, 0xd7, 0x56, 0x2b, 0x5a, 0x2e, 0x8e, 0x15, 0x94, 0x1f, 0x91, 0x4a, 0xbb, 0x5c, 0x42,
+	0xfd, 0x2b, 0x86, 0x66, 0x56, 0x2d, 0x6b, 0xcf, 0x19, 0xea, 0xf7, 0xb7, 0x50, 0x32, 0xb8, 0xbf,
+	0x11, 0xcb, 0x92, 0x2d, 0xfe, 0x8d, 0x71, 0x07, 0x57, 0x77, 0x76, 0x64, 0x47, 0xbf, 0xec, 0xcf,
+	0x5d, 0xb5, 0xac, 0xff, 0x5f, 0xd1, 0xa8, 0x7b, 0x08, 0xef, 0x10, 0xf7, 0x00, 0xf8, 0x88, 0xff,
+	0x1f, 0xa1, 0x74, 0xe0, 0xbf, 0xe5, 0xeb, 0x64, 0x08, 0xe6, 0xc6, 0x43, 0x10, 0x58, 0xca, 0x28,
+	0xa4, 0x7c, 0x8b, 0x40, 0xa4, 0x7e, 0x17, 0x47, 0xf3, 0x3e, 0xaa, 0xbe, 0x4f, 0xdb, 0xbc, 0x06,
+	0x9d, 0x9d, 0xae, 0xc9, 0xa9, 0x63, 0x52, 0x70, 0xf1, 0x17, 0x08, 0xdb, 0xa6, 0xa1, 0x3b, 0xd0,
+	0xd1, 0xad, 0xbe, 0x54, 0x89, 0x45, 0x72, 0x27, 0x67, 0x9b, 0xc6, 0x09, 0x3a, 0x83, 0xc3, 0x71,
+	0x7a, 0xc4, 0x4b, 0x33, 0x83, 0xc3, 0x51, 0xfa, 0xfb, 0x28, 0xd9, 0xb2, 0x3d, 0xae, 0x3b, 0x84,
+	0x1a, 0x67, 0x9f, 0xb7, 0x32, 0x3d, 0x84, 0x45, 0x8d, 0x50, 0x63, 0x2c, 0x2a, 0xf5, 0x43, 0xe2,
+	0x54, 0x58, 0x8f, 0xb8, 0x94, 0x30, 0x1e, 0x46, 0xc5, 0x3b, 0x24, 0x8e, 0x4e, 0x43, 0xa9, 0x12,
+	0x8b, 0x74, 0x47, 0x14, 0x51, 0x39, 0x41, 0x17, 0x51, 0x19, 0xa3, 0xc7, 0xa3, 0xd1, 0x19, 0x1c,
+	0x8e, 0xd2, 0x5f, 0x2d, 0x2a, 0x3f, 0xc7, 0xd1, 0xac, 0x9f, 0x90, 0x17, 0x79, 0x9c, 0xed, 0x20,
+	0x34, 0xa8, 0xcc, 0x88, 0xe9, 0x90, 0xec, 0x17, 0xa5, 0x78, 0xd8, 0x08, 0xc7, 0x74, 0x17, 0x3c,
+	0x70, 0x7b, 0x10, 0xb1, 0x18, 0x53, 0x82, 0xa1, 0x05, 0x08, 0x5c, 0x47, 0x99, 0x07, 0x5d, 0x9b,
+	0x0f, 0x98, 0xd1, 0x1e, 0x4b, 0x69, 0x1f, 0x22, 0xa1, 0xea, 0x6f, 0x93, 0xe8, 0x4a, 0x78, 0x95,
+	0xd2, 0xa0, 0x07, 0xae, 0x47, 0xcc, 0xff, 0xf4, 0x1c, 0x59, 0x47, 0x09, 0x13, 0x3a, 0x7e, 0x7c,
+	0xb2, 0x2b, 0xcb, 0x2f, 0xbb, 0x26, 0x8f, 0x6c, 0xad, 0x18, 0x8e, 0xb6, 0xa1, 0xa3, 0x09, 0xeb,
+	0x53, 0x9e, 0x2a, 0x93, 0x17, 0xf7, 0x54, 0xb9, 0xf4, 0xba, 0x9e, 0x2a, 0xe3, 0x8f, 0xdf, 0xa9,
+	0x57, 0x7f, 0xfc, 0x9e, 0xf2, 0x80, 0x9d, 0x7e, 0x1d, 0x0f, 0x58, 0xf5, 0x16, 0x4a, 0x0d, 0x45,
+	0xfd, 0xe4, 0x51, 0x8b, 0xd0, 0xd4, 0xfa, 0xf6, 0x6e, 0xbd, 0xbc, 0x91, 0x8b, 0x89, 0xef, 0xbb,
+	0xb5, 0x72, 0xb5, 0xbc, 0x91, 0x8b, 0xab, 0x4f, 0x63, 0x28, 0xbf, 0x16, 0xbc, 0xae, 0x35, 0xb9,
+	0xcf, 0x0b, 0x49, 0xaf, 0xdb, 0x68, 0xba, 0x65, 0xf7, 0xc0, 0x05, 0x43, 0x89, 0x9f, 0xaf, 0xbf,
+	0x84, 0xf3, 0xf1, 0x07, 0x28, 0xd9, 0x65, 0xa1, 0xf1, 0x39, 0x9b, 0xd3, 0xc0, 0x42, 0xfd, 0x35,
+	0x86, 0x70, 0xdf, 0x43, 0xd1, 0xe0, 0x2e, 0xc4, 0xbf, 0xf7, 0xc4, 0x75, 0xca, 0x6f, 0x9f, 0xe7,
+	0x74, 0x4f, 0x4e, 0x17, 0xde, 0xb9, 0x60, 0x11, 0xca, 0x28, 0xeb, 0x9c, 0xdb, 0xbb, 0xbe, 0xc5,
+	0xda, 0xc7, 0x8f, 0x9f, 0x15, 0x62, 0x4f, 0x9e, 0x15, 0x62, 0xbf, 0x3f, 0x2b, 0xc4, 0xbe, 0x7d,
+	0x5e, 0x98, 0x78, 0xf2, 0xbc, 0x30, 0xf1, 0xd3, 0xf3, 0xc2, 0xc4, 0x67, 0x37, 0xcf, 0x72, 0x25,
+	0xfc, 0xc7, 0xcc, 0x4f, 0xaa, 0xe6, 0x94, 0xff, 0x8f, 0xd8, 0xbb, 0x7f, 0x0f, 0x00, 0xdb, 0xea,
+	0xce, 0x52, 0xd0, 0x13, 0x00, 0x00,
 }
 
 func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
@@ -733,6 +1014,18 @@ func (m *PositionChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ImbalanceFee != nil {
+		{
+			size, err := m.ImbalanceFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	{
 		size := m.ExchangedNotional.Size()
 		i -= size
@@ -1272,152 +1565,410 @@ func (m *EventShiftSwapInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PriceChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PositionChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FinalPosition.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.TransactionFee.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
-	}
-	l = m.MarginToUser.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ChangeReason.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+
+func (m *PriceChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PositionLiquidatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PriceChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PositionChangedEvent.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.LiquidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size := m.QuoteReserve.Size()
+		i -= size
+		if _, err := m.QuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = m.FeeToLiquidator.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FeeToEcosystemFund.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
-
-func (m *PositionSettledEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseReserve.Size()
+		i -= size
+		if _, err := m.BaseReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if len(m.SettledCoins) > 0 {
-		for _, e := range m.SettledCoins {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *FundingRateChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PositionReversalEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionReversalEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionReversalEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pair.Size()
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExchangedNotional.Size()
+		i -= size
+		if _, err := m.ExchangedNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExchangedSize.Size()
+		i -= size
+		if _, err := m.ExchangedSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Leg != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Leg))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BadDebtRealizedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadDebtRealizedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadDebtRealizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Uncovered.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Covered.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BadDebtRepaidEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadDebtRepaidEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadDebtRepaidEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Repaid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PositionChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalPosition.Size()
 	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPriceTwap.Size()
+	l = m.PositionNotional.Size()
 	n += 1 + l + sovEvent(uint64(l))
-	l = m.IndexPriceTwap.Size()
+	l = m.TransactionFee.Size()
 	n += 1 + l + sovEvent(uint64(l))
-	l = m.PremiumFraction.Size()
+	l = m.RealizedPnl.Size()
 	n += 1 + l + sovEvent(uint64(l))
-	l = m.CumulativePremiumFraction.Size()
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = m.MarginToUser.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ChangeReason.Size()
 	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.ImbalanceFee != nil {
+		l = m.ImbalanceFee.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
-func (m *LiquidationFailedEvent) Size() (n int) {
+func (m *PositionLiquidatedEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Pair.Size()
+	l = m.PositionChangedEvent.Size()
 	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.Trader)
+	l = len(m.LiquidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	l = len(m.Liquidator)
+	l = m.FeeToLiquidator.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeToEcosystemFund.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PositionSettledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.TraderAddress)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	if m.Reason != 0 {
-		n += 1 + sovEvent(uint64(m.Reason))
+	if len(m.SettledCoins) > 0 {
+		for _, e := range m.SettledCoins {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *AmmUpdatedEvent) Size() (n int) {
+func (m *FundingRateChangedEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.FinalAmm.Size()
+	l = m.Pair.Size()
 	n += 1 + l + sovEvent(uint64(l))
 	l = m.MarkPriceTwap.Size()
 	n += 1 + l + sovEvent(uint64(l))
 	l = m.IndexPriceTwap.Size()
 	n += 1 + l + sovEvent(uint64(l))
+	l = m.PremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
 	return n
 }
 
-func (m *MarketUpdatedEvent) Size() (n int) {
+func (m *LiquidationFailedEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.FinalMarket.Size()
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Liquidator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *AmmUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalAmm.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPriceTwap.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.IndexPriceTwap.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *MarketUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalMarket.Size()
 	n += 1 + l + sovEvent(uint64(l))
 	return n
 }
@@ -1426,39 +1977,735 @@ func (m *EventShiftPegMultiplier) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.OldPegMultiplier.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.NewPegMultiplier.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.CostPaid.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.OldPegMultiplier.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewPegMultiplier.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CostPaid.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventShiftSwapInvariant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldSwapInvariant.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewSwapInvariant.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CostPaid.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PriceChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.QuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PositionReversalEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Leg != 0 {
+		n += 1 + sovEvent(uint64(m.Leg))
+	}
+	l = m.ExchangedSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *BadDebtRealizedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Covered.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Uncovered.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *BadDebtRepaidEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Repaid.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPosition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToUser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginToUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChangeReason.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImbalanceFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImbalanceFee == nil {
+				m.ImbalanceFee = &types.Coin{}
+			}
+			if err := m.ImbalanceFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionChangedEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionChangedEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventShiftSwapInvariant) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.OldSwapInvariant.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.NewSwapInvariant.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.CostPaid.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1481,17 +2728,17 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalPosition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1501,28 +2748,29 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1550,13 +2798,11 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1583,13 +2829,64 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SettledCoins = append(m.SettledCoins, types.Coin{})
+			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1617,15 +2914,15 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPriceTwap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1635,28 +2932,29 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarkPriceTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPriceTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1684,15 +2982,15 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexPriceTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumFraction", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1702,14 +3000,113 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationFailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginToUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,13 +3134,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginToUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1771,13 +3168,11 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChangeReason.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1805,15 +3200,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Liquidator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var stringLen uint64
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1823,26 +3216,11 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Reason |= LiquidationFailedEvent_LiquidationFailedReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1864,7 +3242,7 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+func (m *AmmUpdatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1887,15 +3265,15 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: AmmUpdatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AmmUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionChangedEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalAmm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1922,13 +3300,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionChangedEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalAmm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPriceTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1956,13 +3334,15 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MarkPriceTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPriceTwap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1972,28 +3352,79 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexPriceTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketUpdatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketUpdatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalMarket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2020,7 +3451,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2045,7 +3476,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
+func (m *EventShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2068,15 +3499,15 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventShiftPegMultiplier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventShiftPegMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPegMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2104,13 +3535,13 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldPegMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPegMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2138,11 +3569,13 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.NewPegMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CostPaid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2169,8 +3602,7 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettledCoins = append(m.SettledCoins, types.Coin{})
-			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CostPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2195,7 +3627,7 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *EventShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2218,83 +3650,15 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventShiftSwapInvariant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventShiftSwapInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPriceTwap", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MarkPriceTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexPriceTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSwapInvariant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2322,13 +3686,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IndexPriceTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldSwapInvariant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSwapInvariant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2356,15 +3720,15 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewSwapInvariant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CostPaid", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2374,23 +3738,22 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CostPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2415,7 +3778,7 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *PriceChangedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2438,10 +3801,10 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidationFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceChangedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidationFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2480,7 +3843,7 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2508,11 +3871,13 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2540,13 +3905,15 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidator = string(dAtA[iNdEx:postIndex])
+			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
 			}
-			m.Reason = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2556,11 +3923,26 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= LiquidationFailedEvent_LiquidationFailedReason(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2582,7 +3964,7 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AmmUpdatedEvent) Unmarshal(dAtA []byte) error {
+func (m *PositionReversalEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2605,17 +3987,17 @@ func (m *AmmUpdatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AmmUpdatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionReversalEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AmmUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionReversalEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalAmm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2625,28 +4007,29 @@ func (m *AmmUpdatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalAmm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPriceTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2674,13 +4057,30 @@ func (m *AmmUpdatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPriceTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leg", wireType)
+			}
+			m.Leg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Leg |= PositionReversalEvent_ReversalLeg(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexPriceTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2708,65 +4108,83 @@ func (m *AmmUpdatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IndexPriceTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketUpdatedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketUpdatedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalMarket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2776,22 +4194,23 @@ func (m *MarketUpdatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2816,7 +4235,7 @@ func (m *MarketUpdatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventShiftPegMultiplier) Unmarshal(dAtA []byte) error {
+func (m *BadDebtRealizedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2839,15 +4258,15 @@ func (m *EventShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventShiftPegMultiplier: wiretype end group for non-group")
+			return fmt.Errorf("proto: BadDebtRealizedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventShiftPegMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BadDebtRealizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldPegMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2875,15 +4294,15 @@ func (m *EventShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OldPegMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPegMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Covered", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2893,29 +4312,28 @@ func (m *EventShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewPegMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Covered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostPaid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uncovered", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2942,7 +4360,7 @@ func (m *EventShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CostPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uncovered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2967,7 +4385,7 @@ func (m *EventShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventShiftSwapInvariant) Unmarshal(dAtA []byte) error {
+func (m *BadDebtRepaidEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2990,15 +4408,15 @@ func (m *EventShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventShiftSwapInvariant: wiretype end group for non-group")
+			return fmt.Errorf("proto: BadDebtRepaidEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventShiftSwapInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BadDebtRepaidEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldSwapInvariant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3026,15 +4444,15 @@ func (m *EventShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OldSwapInvariant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSwapInvariant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3044,29 +4462,28 @@ func (m *EventShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewSwapInvariant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostPaid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3093,7 +4510,7 @@ func (m *EventShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CostPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -41,6 +41,7 @@ type BankKeeper interface {
 type OracleKeeper interface {
 	GetExchangeRate(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 	GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
+	GetUnderlyingPrice(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 	SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec)
 }
 
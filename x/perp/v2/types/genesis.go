@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,16 +40,198 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	// TODO: validate positions
-	//for _, pos := range gs.Positions {
-	//	if err := pos.Validate(); err != nil {
-	//		return err
-	//	}
-	//}
+	for _, pos := range gs.Positions {
+		if err := pos.Position.Validate(); err != nil {
+			return fmt.Errorf("invalid position of trader %s: %w", pos.Position.TraderAddress, err)
+		}
+		if pos.Pair != pos.Position.Pair {
+			return fmt.Errorf("genesis position pair %s does not match position pair %s", pos.Pair, pos.Position.Pair)
+		}
+	}
+
+	for _, snapshot := range gs.ReserveSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, badDebt := range gs.UncoveredBadDebts {
+		if err := badDebt.Pair.Validate(); err != nil {
+			return err
+		}
+		if badDebt.Amount.IsNil() || !badDebt.Amount.IsPositive() {
+			return fmt.Errorf("uncovered bad debt of pair %s must be positive, got %s", badDebt.Pair, badDebt.Amount)
+		}
+	}
+
+	if gs.FundingRateIntervalMs > uint64((24 * time.Hour).Milliseconds()) {
+		return fmt.Errorf("funding rate interval of %dms is longer than a day", gs.FundingRateIntervalMs)
+	}
+	if gs.SnapshotRetentionMs > 0 && gs.LiquidationTwapLookbackMs > gs.SnapshotRetentionMs {
+		return fmt.Errorf(
+			"liquidation twap lookback of %dms is longer than the snapshot retention of %dms",
+			gs.LiquidationTwapLookbackMs, gs.SnapshotRetentionMs,
+		)
+	}
+	if r := gs.LiquidatorRewardRatio; r != nil && (r.IsNil() || r.IsNegative() || r.GT(sdk.OneDec())) {
+		return fmt.Errorf("liquidator reward ratio must be in [0, 1], got: %s", r)
+	}
+	if q := gs.MinPositionQuote; q != nil && (q.IsNil() || q.IsNegative()) {
+		return fmt.Errorf("min position quote must be non-negative, got: %s", q)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, c := range gs.CollateralDenoms {
+		if err := sdk.ValidateDenom(c.Denom); err != nil {
+			return ErrInvalidCollateral.Wrap(err.Error())
+		}
+		if c.Denom == gs.CollateralDenom {
+			return ErrInvalidCollateral.Wrapf("%s is already the primary collateral", c.Denom)
+		}
+		if seenDenoms[c.Denom] {
+			return ErrInvalidCollateral.Wrapf("duplicate collateral denom %s", c.Denom)
+		}
+		seenDenoms[c.Denom] = true
+		if err := c.OraclePair.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validatePairTraders("funding top up", gs.FundingTopUps); err != nil {
+		return err
+	}
+
+	for _, c := range []struct {
+		name   string
+		values []GenesisPairDec
+		// valid reports whether a value is in bounds. Nil accepts any value.
+		valid  func(sdk.Dec) bool
+		bounds string
+	}{
+		{"imbalance fee ratio", gs.ImbalanceFeeRatios, func(v sdk.Dec) bool { return !v.IsNegative() && v.LT(sdk.OneDec()) }, "[0, 1)"},
+		{"max oracle spread ratio", gs.MaxOracleSpreadRatios, func(v sdk.Dec) bool { return v.IsPositive() && v.LT(sdk.OneDec()) }, "(0, 1)"},
+		{"open interest cap", gs.OpenInterestCaps, func(v sdk.Dec) bool { return !v.IsNegative() }, "[0, inf)"},
+		{"rounding accrual", gs.RoundingAccruals, nil, ""},
+		{"fluctuation limit ratio", gs.FluctuationLimitRatios, isRatio, "[0, 1]"},
+		{"trade limit ratio", gs.TradeLimitRatios, isRatio, "[0, 1]"},
+		{"long trade limit ratio", gs.LongTradeLimitRatios, isRatio, "[0, 1]"},
+		{"short trade limit ratio", gs.ShortTradeLimitRatios, isRatio, "[0, 1]"},
+	} {
+		seen := make(map[asset.Pair]bool)
+		for _, v := range c.values {
+			if err := v.Pair.Validate(); err != nil {
+				return err
+			}
+			if seen[v.Pair] {
+				return fmt.Errorf("duplicate %s of pair %s", c.name, v.Pair)
+			}
+			seen[v.Pair] = true
+			if v.Value.IsNil() {
+				return fmt.Errorf("%s of pair %s must not be nil", c.name, v.Pair)
+			}
+			if c.valid != nil && !c.valid(v.Value) {
+				return fmt.Errorf("%s of pair %s must be in %s, got: %s", c.name, v.Pair, c.bounds, v.Value)
+			}
+		}
+	}
+
+	for _, pairs := range [][]asset.Pair{gs.CloseAtOracle, gs.SpreadLimitedSwaps, gs.PausedMarkets} {
+		for _, pair := range pairs {
+			if err := pair.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	liquidationSequences, err := pairUint64sMap("liquidation sequence", gs.LiquidationSequences)
+	if err != nil {
+		return err
+	}
+	for _, r := range gs.LiquidationHistory {
+		if err := r.Pair.Validate(); err != nil {
+			return err
+		}
+		if r.Sequence >= liquidationSequences[r.Pair] {
+			return fmt.Errorf("liquidation %d of pair %s is past its liquidation sequence", r.Sequence, r.Pair)
+		}
+		if _, err := sdk.AccAddressFromBech32(r.TraderAddress); err != nil {
+			return fmt.Errorf("invalid trader of liquidation %d of pair %s: %w", r.Sequence, r.Pair, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(r.LiquidatorAddress); err != nil {
+			return fmt.Errorf("invalid liquidator of liquidation %d of pair %s: %w", r.Sequence, r.Pair, err)
+		}
+		if r.LiquidatedSize.IsNil() || r.Price.IsNil() || !r.BadDebt.IsValid() {
+			return fmt.Errorf("invalid liquidation %d of pair %s", r.Sequence, r.Pair)
+		}
+	}
+
+	fundingSequences, err := pairUint64sMap("funding settlement sequence", gs.FundingSettlementSequences)
+	if err != nil {
+		return err
+	}
+	for _, f := range gs.FundingHistory {
+		if err := f.Pair.Validate(); err != nil {
+			return err
+		}
+		if f.Sequence >= fundingSequences[f.Pair] {
+			return fmt.Errorf("funding settlement %d of pair %s is past its funding settlement sequence", f.Sequence, f.Pair)
+		}
+		if f.PremiumFraction.IsNil() || f.CumulativePremiumFraction.IsNil() {
+			return fmt.Errorf("invalid funding settlement %d of pair %s", f.Sequence, f.Pair)
+		}
+	}
+
+	if _, err := pairUint64sMap("last funding settlement", gs.LastFundingSettlementsMs); err != nil {
+		return err
+	}
+
+	for _, payment := range gs.FundingPayments {
+		if err := payment.Pair.Validate(); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(payment.Trader); err != nil {
+			return fmt.Errorf("invalid trader of funding payment of pair %s: %w", payment.Pair, err)
+		}
+		if payment.BlockHeight < 0 || payment.Payment.IsNil() || payment.CumulativePremiumFraction.IsNil() {
+			return fmt.Errorf("invalid funding payment of trader %s in pair %s", payment.Trader, payment.Pair)
+		}
+	}
 
 	return nil
 }
 
+func isRatio(v sdk.Dec) bool {
+	return !v.IsNegative() && v.LTE(sdk.OneDec())
+}
+
+func validatePairTraders(name string, positions []GenesisPairTrader) error {
+	for _, p := range positions {
+		if err := p.Pair.Validate(); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(p.Trader); err != nil {
+			return fmt.Errorf("invalid trader of %s in pair %s: %w", name, p.Pair, err)
+		}
+	}
+	return nil
+}
+
+// pairUint64sMap validates the pairs of 'values' and returns the values by
+// pair.
+func pairUint64sMap(name string, values []GenesisPairUint64) (map[asset.Pair]uint64, error) {
+	m := make(map[asset.Pair]uint64)
+	for _, v := range values {
+		if err := v.Pair.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := m[v.Pair]; ok {
+			return nil, fmt.Errorf("duplicate %s of pair %s", name, v.Pair)
+		}
+		m[v.Pair] = v.Value
+	}
+	return m, nil
+}
+
 func DefaultMarket(pair asset.Pair) Market {
 	return Market{
 		Pair:                            pair,
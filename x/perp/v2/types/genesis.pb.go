@@ -6,8 +6,8 @@ package types
 import (
 	fmt "fmt"
 	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -37,14 +37,45 @@ type GenesisState struct {
 	ReserveSnapshots []ReserveSnapshot `protobuf:"bytes,5,rep,name=reserve_snapshots,json=reserveSnapshots,proto3" json:"reserve_snapshots"`
 	DnrEpoch         uint64            `protobuf:"varint,6,opt,name=dnr_epoch,json=dnrEpoch,proto3" json:"dnr_epoch,omitempty"`
 	// For testing purposes, we allow the collateral to be set at genesis
-	CollateralDenom    string                        `protobuf:"bytes,11,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
-	TraderVolumes      []GenesisState_TraderVolume   `protobuf:"bytes,7,rep,name=trader_volumes,json=traderVolumes,proto3" json:"trader_volumes"`
-	GlobalDiscount     []GenesisState_Discount       `protobuf:"bytes,8,rep,name=global_discount,json=globalDiscount,proto3" json:"global_discount"`
-	CustomDiscounts    []GenesisState_CustomDiscount `protobuf:"bytes,9,rep,name=custom_discounts,json=customDiscounts,proto3" json:"custom_discounts"`
-	MarketLastVersions []GenesisMarketLastVersion    `protobuf:"bytes,10,rep,name=market_last_versions,json=marketLastVersions,proto3" json:"market_last_versions"`
-	GlobalVolumes      []GenesisState_GlobalVolume   `protobuf:"bytes,13,rep,name=global_volumes,json=globalVolumes,proto3" json:"global_volumes"`
-	RebatesAllocations []DNRAllocation               `protobuf:"bytes,12,rep,name=rebates_allocations,json=rebatesAllocations,proto3" json:"rebates_allocations"`
-	DnrEpochName       string                        `protobuf:"bytes,14,opt,name=dnr_epoch_name,json=dnrEpochName,proto3" json:"dnr_epoch_name,omitempty"`
+	CollateralDenom           string                        `protobuf:"bytes,11,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	TraderVolumes             []GenesisState_TraderVolume   `protobuf:"bytes,7,rep,name=trader_volumes,json=traderVolumes,proto3" json:"trader_volumes"`
+	GlobalDiscount            []GenesisState_Discount       `protobuf:"bytes,8,rep,name=global_discount,json=globalDiscount,proto3" json:"global_discount"`
+	CustomDiscounts           []GenesisState_CustomDiscount `protobuf:"bytes,9,rep,name=custom_discounts,json=customDiscounts,proto3" json:"custom_discounts"`
+	MarketLastVersions        []GenesisMarketLastVersion    `protobuf:"bytes,10,rep,name=market_last_versions,json=marketLastVersions,proto3" json:"market_last_versions"`
+	GlobalVolumes             []GenesisState_GlobalVolume   `protobuf:"bytes,13,rep,name=global_volumes,json=globalVolumes,proto3" json:"global_volumes"`
+	RebatesAllocations        []DNRAllocation               `protobuf:"bytes,12,rep,name=rebates_allocations,json=rebatesAllocations,proto3" json:"rebates_allocations"`
+	DnrEpochName              string                        `protobuf:"bytes,14,opt,name=dnr_epoch_name,json=dnrEpochName,proto3" json:"dnr_epoch_name,omitempty"`
+	UncoveredBadDebts         []UncoveredBadDebt            `protobuf:"bytes,15,rep,name=uncovered_bad_debts,json=uncoveredBadDebts,proto3" json:"uncovered_bad_debts"`
+	MinSnapshotIntervalMs     uint64                        `protobuf:"varint,16,opt,name=min_snapshot_interval_ms,json=minSnapshotIntervalMs,proto3" json:"min_snapshot_interval_ms,omitempty"`
+	SnapshotRetentionMs       uint64                        `protobuf:"varint,17,opt,name=snapshot_retention_ms,json=snapshotRetentionMs,proto3" json:"snapshot_retention_ms,omitempty"`
+	MaxPairsPerBlock          uint64                        `protobuf:"varint,18,opt,name=max_pairs_per_block,json=maxPairsPerBlock,proto3" json:"max_pairs_per_block,omitempty"`
+	EndBlockAmmCursor         uint64                        `protobuf:"varint,19,opt,name=end_block_amm_cursor,json=endBlockAmmCursor,proto3" json:"end_block_amm_cursor,omitempty"`
+	EndBlockFundingCursor     uint64                        `protobuf:"varint,20,opt,name=end_block_funding_cursor,json=endBlockFundingCursor,proto3" json:"end_block_funding_cursor,omitempty"`
+	LiquidationTwapLookbackMs uint64                        `protobuf:"varint,21,opt,name=liquidation_twap_lookback_ms,json=liquidationTwapLookbackMs,proto3" json:"liquidation_twap_lookback_ms,omitempty"`
+	FundingRateIntervalMs     uint64                        `protobuf:"varint,22,opt,name=funding_rate_interval_ms,json=fundingRateIntervalMs,proto3" json:"funding_rate_interval_ms,omitempty"`
+	// Unset when the default liquidator reward ratio applies.
+	LiquidatorRewardRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=liquidator_reward_ratio,json=liquidatorRewardRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidator_reward_ratio,omitempty"`
+	// Unset when partially closed or reduced positions have no minimum notional.
+	MinPositionQuote           *github_com_cosmos_cosmos_sdk_types.Dec             `protobuf:"bytes,24,opt,name=min_position_quote,json=minPositionQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_position_quote,omitempty"`
+	CollateralDenoms           []GenesisCollateralDenom                            `protobuf:"bytes,25,rep,name=collateral_denoms,json=collateralDenoms,proto3" json:"collateral_denoms"`
+	FundingTopUps              []GenesisPairTrader                                 `protobuf:"bytes,26,rep,name=funding_top_ups,json=fundingTopUps,proto3" json:"funding_top_ups"`
+	ImbalanceFeeRatios         []GenesisPairDec                                    `protobuf:"bytes,28,rep,name=imbalance_fee_ratios,json=imbalanceFeeRatios,proto3" json:"imbalance_fee_ratios"`
+	MaxOracleSpreadRatios      []GenesisPairDec                                    `protobuf:"bytes,29,rep,name=max_oracle_spread_ratios,json=maxOracleSpreadRatios,proto3" json:"max_oracle_spread_ratios"`
+	OpenInterestCaps           []GenesisPairDec                                    `protobuf:"bytes,30,rep,name=open_interest_caps,json=openInterestCaps,proto3" json:"open_interest_caps"`
+	RoundingAccruals           []GenesisPairDec                                    `protobuf:"bytes,31,rep,name=rounding_accruals,json=roundingAccruals,proto3" json:"rounding_accruals"`
+	FluctuationLimitRatios     []GenesisPairDec                                    `protobuf:"bytes,32,rep,name=fluctuation_limit_ratios,json=fluctuationLimitRatios,proto3" json:"fluctuation_limit_ratios"`
+	TradeLimitRatios           []GenesisPairDec                                    `protobuf:"bytes,33,rep,name=trade_limit_ratios,json=tradeLimitRatios,proto3" json:"trade_limit_ratios"`
+	LongTradeLimitRatios       []GenesisPairDec                                    `protobuf:"bytes,34,rep,name=long_trade_limit_ratios,json=longTradeLimitRatios,proto3" json:"long_trade_limit_ratios"`
+	ShortTradeLimitRatios      []GenesisPairDec                                    `protobuf:"bytes,35,rep,name=short_trade_limit_ratios,json=shortTradeLimitRatios,proto3" json:"short_trade_limit_ratios"`
+	CloseAtOracle              []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,36,rep,name=close_at_oracle,json=closeAtOracle,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"close_at_oracle"`
+	SpreadLimitedSwaps         []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,37,rep,name=spread_limited_swaps,json=spreadLimitedSwaps,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"spread_limited_swaps"`
+	PausedMarkets              []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,38,rep,name=paused_markets,json=pausedMarkets,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"paused_markets"`
+	LiquidationSequences       []GenesisPairUint64                                 `protobuf:"bytes,39,rep,name=liquidation_sequences,json=liquidationSequences,proto3" json:"liquidation_sequences"`
+	LiquidationHistory         []GenesisLiquidationRecord                          `protobuf:"bytes,40,rep,name=liquidation_history,json=liquidationHistory,proto3" json:"liquidation_history"`
+	FundingSettlementSequences []GenesisPairUint64                                 `protobuf:"bytes,41,rep,name=funding_settlement_sequences,json=fundingSettlementSequences,proto3" json:"funding_settlement_sequences"`
+	FundingHistory             []GenesisFundingSettlement                          `protobuf:"bytes,42,rep,name=funding_history,json=fundingHistory,proto3" json:"funding_history"`
+	LastFundingSettlementsMs   []GenesisPairUint64                                 `protobuf:"bytes,43,rep,name=last_funding_settlements_ms,json=lastFundingSettlementsMs,proto3" json:"last_funding_settlements_ms"`
+	FundingPayments            []AppliedFundingPayment                             `protobuf:"bytes,44,rep,name=funding_payments,json=fundingPayments,proto3" json:"funding_payments"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -171,6 +202,174 @@ func (m *GenesisState) GetDnrEpochName() string {
 	return ""
 }
 
+func (m *GenesisState) GetUncoveredBadDebts() []UncoveredBadDebt {
+	if m != nil {
+		return m.UncoveredBadDebts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinSnapshotIntervalMs() uint64 {
+	if m != nil {
+		return m.MinSnapshotIntervalMs
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSnapshotRetentionMs() uint64 {
+	if m != nil {
+		return m.SnapshotRetentionMs
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMaxPairsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPairsPerBlock
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEndBlockAmmCursor() uint64 {
+	if m != nil {
+		return m.EndBlockAmmCursor
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEndBlockFundingCursor() uint64 {
+	if m != nil {
+		return m.EndBlockFundingCursor
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLiquidationTwapLookbackMs() uint64 {
+	if m != nil {
+		return m.LiquidationTwapLookbackMs
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFundingRateIntervalMs() uint64 {
+	if m != nil {
+		return m.FundingRateIntervalMs
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCollateralDenoms() []GenesisCollateralDenom {
+	if m != nil {
+		return m.CollateralDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingTopUps() []GenesisPairTrader {
+	if m != nil {
+		return m.FundingTopUps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetImbalanceFeeRatios() []GenesisPairDec {
+	if m != nil {
+		return m.ImbalanceFeeRatios
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaxOracleSpreadRatios() []GenesisPairDec {
+	if m != nil {
+		return m.MaxOracleSpreadRatios
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOpenInterestCaps() []GenesisPairDec {
+	if m != nil {
+		return m.OpenInterestCaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoundingAccruals() []GenesisPairDec {
+	if m != nil {
+		return m.RoundingAccruals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFluctuationLimitRatios() []GenesisPairDec {
+	if m != nil {
+		return m.FluctuationLimitRatios
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTradeLimitRatios() []GenesisPairDec {
+	if m != nil {
+		return m.TradeLimitRatios
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLongTradeLimitRatios() []GenesisPairDec {
+	if m != nil {
+		return m.LongTradeLimitRatios
+	}
+	return nil
+}
+
+func (m *GenesisState) GetShortTradeLimitRatios() []GenesisPairDec {
+	if m != nil {
+		return m.ShortTradeLimitRatios
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidationSequences() []GenesisPairUint64 {
+	if m != nil {
+		return m.LiquidationSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidationHistory() []GenesisLiquidationRecord {
+	if m != nil {
+		return m.LiquidationHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingSettlementSequences() []GenesisPairUint64 {
+	if m != nil {
+		return m.FundingSettlementSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingHistory() []GenesisFundingSettlement {
+	if m != nil {
+		return m.FundingHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastFundingSettlementsMs() []GenesisPairUint64 {
+	if m != nil {
+		return m.LastFundingSettlementsMs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingPayments() []AppliedFundingPayment {
+	if m != nil {
+		return m.FundingPayments
+	}
+	return nil
+}
+
 type GenesisState_TraderVolume struct {
 	Trader string                                 `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty"`
 	Epoch  uint64                                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -459,166 +658,524 @@ func (m *GenesisPosition) GetPosition() Position {
 	return Position{}
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "nibiru.perp.v2.GenesisState")
-	proto.RegisterType((*GenesisState_TraderVolume)(nil), "nibiru.perp.v2.GenesisState.TraderVolume")
-	proto.RegisterType((*GenesisState_Discount)(nil), "nibiru.perp.v2.GenesisState.Discount")
-	proto.RegisterType((*GenesisState_CustomDiscount)(nil), "nibiru.perp.v2.GenesisState.CustomDiscount")
-	proto.RegisterType((*GenesisState_GlobalVolume)(nil), "nibiru.perp.v2.GenesisState.GlobalVolume")
-	proto.RegisterType((*GenesisMarketLastVersion)(nil), "nibiru.perp.v2.GenesisMarketLastVersion")
-	proto.RegisterType((*GenesisPosition)(nil), "nibiru.perp.v2.GenesisPosition")
+// GenesisCollateralDenom is a secondary collateral denom and the oracle pair
+// pricing it in the primary collateral, only used for genesis
+type GenesisCollateralDenom struct {
+	Denom      string                                            `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	OraclePair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=oracle_pair,json=oraclePair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"oracle_pair"`
 }
 
-func init() { proto.RegisterFile("nibiru/perp/v2/genesis.proto", fileDescriptor_c2c7acfef3993fde) }
-
-var fileDescriptor_c2c7acfef3993fde = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdf, 0x6a, 0x13, 0x4f,
-	0x14, 0xce, 0x36, 0x69, 0x9a, 0x4c, 0xd2, 0xa4, 0xbf, 0x69, 0x29, 0x43, 0x7e, 0x35, 0x2d, 0x45,
-	0x25, 0x45, 0xba, 0x4b, 0x23, 0x08, 0x7a, 0x65, 0xd3, 0x68, 0x11, 0x4c, 0x29, 0xdb, 0xd2, 0x0b,
-	0x11, 0xd6, 0xc9, 0x66, 0xdc, 0x2c, 0xdd, 0xdd, 0x59, 0x76, 0x26, 0x41, 0xaf, 0xf5, 0x01, 0x14,
-	0x7c, 0x16, 0x9f, 0xa1, 0x97, 0xbd, 0x14, 0x2f, 0x8a, 0xb4, 0x2f, 0x22, 0x3b, 0x33, 0x9b, 0x3f,
-	0x4b, 0x53, 0x2b, 0x05, 0xaf, 0x92, 0x39, 0xe7, 0x7c, 0xdf, 0xf9, 0xe6, 0xec, 0x37, 0x33, 0x60,
-	0x2d, 0x70, 0xbb, 0x6e, 0x34, 0x30, 0x42, 0x12, 0x85, 0xc6, 0xb0, 0x69, 0x38, 0x24, 0x20, 0xcc,
-	0x65, 0x7a, 0x18, 0x51, 0x4e, 0x61, 0x45, 0x66, 0xf5, 0x38, 0xab, 0x0f, 0x9b, 0xb5, 0xba, 0x4d,
-	0x99, 0x4f, 0x99, 0xd1, 0xc5, 0x8c, 0x18, 0xc3, 0x9d, 0x2e, 0xe1, 0x78, 0xc7, 0xb0, 0xa9, 0x1b,
-	0xc8, 0xfa, 0xda, 0x9a, 0x43, 0xa9, 0xe3, 0x11, 0x03, 0x87, 0xae, 0x81, 0x83, 0x80, 0x72, 0xcc,
-	0x5d, 0x1a, 0x28, 0xb6, 0xda, 0x8a, 0x43, 0x1d, 0x2a, 0xfe, 0x1a, 0xf1, 0x3f, 0x15, 0xad, 0xa5,
-	0x14, 0x30, 0x8e, 0x39, 0x91, 0xb9, 0xcd, 0xaf, 0x25, 0x50, 0xde, 0x97, 0x8a, 0x8e, 0xe2, 0x30,
-	0x7c, 0x02, 0x16, 0x7c, 0x1c, 0x9d, 0x12, 0xce, 0xd0, 0xdc, 0x46, 0xb6, 0x51, 0x6a, 0xae, 0xea,
-	0xd3, 0x12, 0xf5, 0x8e, 0x48, 0xb7, 0x72, 0x67, 0x17, 0xeb, 0x19, 0x33, 0x29, 0x86, 0xdb, 0x20,
-	0x87, 0x7d, 0x9f, 0xa1, 0xac, 0x00, 0x2d, 0xa7, 0x41, 0xbb, 0x9d, 0x8e, 0x42, 0x88, 0x32, 0xb8,
-	0x07, 0x8a, 0x21, 0x65, 0xae, 0x10, 0x8f, 0x72, 0x02, 0xb3, 0x9e, 0xc6, 0x28, 0x5d, 0x87, 0xaa,
-	0x4e, 0xe1, 0xc7, 0x38, 0x68, 0x82, 0xff, 0x22, 0xc2, 0x48, 0x34, 0x24, 0x16, 0x0b, 0x70, 0xc8,
-	0xfa, 0x94, 0x33, 0x34, 0x7f, 0x3d, 0x99, 0x29, 0x0b, 0x8f, 0x54, 0x9d, 0x22, 0x5b, 0x8a, 0xa6,
-	0xc3, 0x0c, 0xfe, 0x0f, 0x8a, 0xbd, 0x20, 0xb2, 0x48, 0x48, 0xed, 0x3e, 0xca, 0x6f, 0x68, 0x8d,
-	0x9c, 0x59, 0xe8, 0x05, 0xd1, 0x8b, 0x78, 0x0d, 0xb7, 0xc0, 0x92, 0x4d, 0x3d, 0x0f, 0x73, 0x12,
-	0x61, 0xcf, 0xea, 0x91, 0x80, 0xfa, 0xa8, 0xb4, 0xa1, 0x35, 0x8a, 0x66, 0x75, 0x1c, 0x6f, 0xc7,
-	0x61, 0x78, 0x02, 0x2a, 0x3c, 0xc2, 0x3d, 0x12, 0x59, 0x43, 0xea, 0x0d, 0x7c, 0xc2, 0xd0, 0x82,
-	0x10, 0xb6, 0x35, 0x63, 0x97, 0x62, 0xfa, 0xfa, 0xb1, 0x80, 0x9c, 0x08, 0x84, 0x92, 0xb8, 0xc8,
-	0x27, 0x62, 0x0c, 0x1e, 0x83, 0xaa, 0xe3, 0xd1, 0x6e, 0xdc, 0xde, 0x65, 0x36, 0x1d, 0x04, 0x1c,
-	0x15, 0x04, 0xf1, 0x83, 0x1b, 0x89, 0xdb, 0xaa, 0x58, 0x91, 0x56, 0x24, 0x47, 0x12, 0x85, 0x6f,
-	0xc1, 0x92, 0x3d, 0x60, 0x9c, 0xfa, 0x23, 0x56, 0x86, 0x8a, 0x82, 0xf6, 0xd1, 0x8d, 0xb4, 0x7b,
-	0x02, 0x94, 0x22, 0xaf, 0xda, 0x53, 0x51, 0x06, 0xdf, 0x81, 0x15, 0x69, 0x13, 0xcb, 0xc3, 0x8c,
-	0x5b, 0x43, 0x12, 0x31, 0xf1, 0xdd, 0x81, 0xe8, 0xd0, 0x98, 0xd1, 0x41, 0xfa, 0xec, 0x35, 0x66,
-	0xfc, 0x44, 0x02, 0x14, 0x3d, 0xf4, 0xd3, 0x09, 0x16, 0x4f, 0x5b, 0x4d, 0x25, 0x99, 0xf6, 0xe2,
-	0x2d, 0xa6, 0xbd, 0x2f, 0x20, 0xd3, 0xd3, 0x76, 0x26, 0x62, 0xf1, 0xb4, 0x97, 0x23, 0xd2, 0xc5,
-	0x9c, 0x30, 0x0b, 0x7b, 0x1e, 0xb5, 0xe5, 0x69, 0x43, 0x65, 0x41, 0x7e, 0x2f, 0x4d, 0xde, 0x3e,
-	0x30, 0x77, 0x47, 0x55, 0x89, 0x5a, 0x85, 0x1f, 0x27, 0x18, 0xbc, 0x0f, 0x2a, 0x23, 0x8f, 0x59,
-	0x01, 0xf6, 0x09, 0xaa, 0x08, 0x13, 0x95, 0x13, 0xa3, 0x1d, 0x60, 0x9f, 0xd4, 0x3e, 0x6b, 0xa0,
-	0x3c, 0xe9, 0x07, 0xb8, 0x0a, 0xf2, 0xd2, 0x0b, 0x48, 0x13, 0xe5, 0x6a, 0x05, 0x57, 0xc0, 0xbc,
-	0xb4, 0xeb, 0x9c, 0xb0, 0xab, 0x5c, 0xc0, 0x97, 0x20, 0x2f, 0x67, 0x81, 0xb2, 0x71, 0x75, 0x4b,
-	0x8f, 0xe5, 0xfc, 0xbc, 0x58, 0x7f, 0xe8, 0xb8, 0xbc, 0x3f, 0xe8, 0xea, 0x36, 0xf5, 0x0d, 0x75,
-	0xd9, 0xc8, 0x9f, 0x6d, 0xd6, 0x3b, 0x35, 0xf8, 0xc7, 0x90, 0x30, 0xfd, 0x55, 0xc0, 0x4d, 0x85,
-	0xae, 0x7d, 0xd3, 0x40, 0x61, 0xe4, 0x93, 0xe7, 0x20, 0xfb, 0x9e, 0x10, 0xd9, 0xff, 0xaf, 0x18,
-	0xdb, 0xc4, 0x36, 0x63, 0xe8, 0x84, 0xac, 0xb9, 0x3b, 0xc9, 0x3a, 0x05, 0x95, 0x69, 0xf3, 0xcd,
-	0x1c, 0xcf, 0x2e, 0x28, 0x8c, 0x8e, 0x4a, 0xdc, 0xf3, 0xb6, 0x47, 0xc5, 0x1c, 0xc1, 0x6a, 0x1e,
-	0x28, 0x4f, 0x7a, 0x65, 0x3c, 0x71, 0xed, 0xfa, 0x89, 0xdf, 0x69, 0x6b, 0x9b, 0x9f, 0x34, 0x80,
-	0x66, 0x9d, 0x01, 0xd8, 0x01, 0xb9, 0x10, 0xbb, 0x6a, 0x8f, 0xad, 0xa7, 0xaa, 0xc5, 0xce, 0x44,
-	0x8b, 0x03, 0xb1, 0xb7, 0xbd, 0x3e, 0x76, 0x03, 0x43, 0xdd, 0xfc, 0x1f, 0x0c, 0x9b, 0xfa, 0x3e,
-	0x0d, 0x0c, 0xcc, 0x18, 0xe1, 0xfa, 0x21, 0x76, 0x23, 0x53, 0xd0, 0x40, 0x04, 0x16, 0xd4, 0x71,
-	0x54, 0xee, 0x49, 0x96, 0x9b, 0xdf, 0x35, 0x50, 0x4d, 0xdd, 0xc0, 0xff, 0xac, 0x39, 0x7c, 0x06,
-	0x0a, 0xc9, 0x35, 0x2f, 0xec, 0x5b, 0x6a, 0xa2, 0xf4, 0x37, 0x4b, 0x3d, 0x0b, 0xa3, 0xfa, 0xd6,
-	0xfe, 0xd9, 0x65, 0x5d, 0x3b, 0xbf, 0xac, 0x6b, 0xbf, 0x2e, 0xeb, 0xda, 0x97, 0xab, 0x7a, 0xe6,
-	0xfc, 0xaa, 0x9e, 0xf9, 0x71, 0x55, 0xcf, 0xbc, 0xd9, 0xfe, 0x93, 0xd0, 0xe4, 0x85, 0x14, 0xdf,
-	0xa4, 0x9b, 0x17, 0x4f, 0xe4, 0xe3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x5d, 0xaf, 0x6c,
-	0xc2, 0x07, 0x00, 0x00,
+func (m *GenesisCollateralDenom) Reset()         { *m = GenesisCollateralDenom{} }
+func (m *GenesisCollateralDenom) String() string { return proto.CompactTextString(m) }
+func (*GenesisCollateralDenom) ProtoMessage()    {}
+func (*GenesisCollateralDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c7acfef3993fde, []int{3}
+}
+func (m *GenesisCollateralDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisCollateralDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisCollateralDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisCollateralDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisCollateralDenom.Merge(m, src)
+}
+func (m *GenesisCollateralDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisCollateralDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisCollateralDenom.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GenesisCollateralDenom proto.InternalMessageInfo
+
+func (m *GenesisCollateralDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GenesisPairTrader is a position of a trader in a pair, only used for genesis
+type GenesisPairTrader struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Trader string                                            `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DnrEpochName) > 0 {
-		i -= len(m.DnrEpochName)
-		copy(dAtA[i:], m.DnrEpochName)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DnrEpochName)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.GlobalVolumes) > 0 {
-		for iNdEx := len(m.GlobalVolumes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GlobalVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	if len(m.RebatesAllocations) > 0 {
-		for iNdEx := len(m.RebatesAllocations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RebatesAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+func (m *GenesisPairTrader) Reset()         { *m = GenesisPairTrader{} }
+func (m *GenesisPairTrader) String() string { return proto.CompactTextString(m) }
+func (*GenesisPairTrader) ProtoMessage()    {}
+func (*GenesisPairTrader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c7acfef3993fde, []int{4}
+}
+func (m *GenesisPairTrader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPairTrader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPairTrader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CollateralDenom) > 0 {
-		i -= len(m.CollateralDenom)
-		copy(dAtA[i:], m.CollateralDenom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralDenom)))
-		i--
-		dAtA[i] = 0x5a
+}
+func (m *GenesisPairTrader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPairTrader.Merge(m, src)
+}
+func (m *GenesisPairTrader) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPairTrader) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPairTrader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPairTrader proto.InternalMessageInfo
+
+func (m *GenesisPairTrader) GetTrader() string {
+	if m != nil {
+		return m.Trader
 	}
-	if len(m.MarketLastVersions) > 0 {
-		for iNdEx := len(m.MarketLastVersions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MarketLastVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
+	return ""
+}
+
+// GenesisPairDec is a decimal value of a pair, only used for genesis
+type GenesisPairDec struct {
+	Pair  github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Value github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+}
+
+func (m *GenesisPairDec) Reset()         { *m = GenesisPairDec{} }
+func (m *GenesisPairDec) String() string { return proto.CompactTextString(m) }
+func (*GenesisPairDec) ProtoMessage()    {}
+func (*GenesisPairDec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c7acfef3993fde, []int{5}
+}
+func (m *GenesisPairDec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPairDec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPairDec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CustomDiscounts) > 0 {
-		for iNdEx := len(m.CustomDiscounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CustomDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+}
+func (m *GenesisPairDec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPairDec.Merge(m, src)
+}
+func (m *GenesisPairDec) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPairDec) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPairDec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPairDec proto.InternalMessageInfo
+
+// GenesisPairUint64 is an integer value of a pair, only used for genesis
+type GenesisPairUint64 struct {
+	Pair  github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Value uint64                                            `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *GenesisPairUint64) Reset()         { *m = GenesisPairUint64{} }
+func (m *GenesisPairUint64) String() string { return proto.CompactTextString(m) }
+func (*GenesisPairUint64) ProtoMessage()    {}
+func (*GenesisPairUint64) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c7acfef3993fde, []int{6}
+}
+func (m *GenesisPairUint64) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPairUint64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPairUint64.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisPairUint64) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPairUint64.Merge(m, src)
+}
+func (m *GenesisPairUint64) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPairUint64) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPairUint64.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPairUint64 proto.InternalMessageInfo
+
+func (m *GenesisPairUint64) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// GenesisLiquidationRecord is a liquidation kept in the recent liquidations
+// history of a pair, only used for genesis
+type GenesisLiquidationRecord struct {
+	Pair              github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Sequence          uint64                                            `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	TraderAddress     string                                            `protobuf:"bytes,3,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	LiquidatorAddress string                                            `protobuf:"bytes,4,opt,name=liquidator_address,json=liquidatorAddress,proto3" json:"liquidator_address,omitempty"`
+	LiquidatedSize    github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,5,opt,name=liquidated_size,json=liquidatedSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidated_size"`
+	Price             github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	BadDebt           types.Coin                                        `protobuf:"bytes,7,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
+	BlockHeight       int64                                             `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TimestampMs       int64                                             `protobuf:"varint,9,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	Reason            string                                            `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *GenesisLiquidationRecord) Reset()         { *m = GenesisLiquidationRecord{} }
+func (m *GenesisLiquidationRecord) String() string { return proto.CompactTextString(m) }
+func (*GenesisLiquidationRecord) ProtoMessage()    {}
+func (*GenesisLiquidationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c7acfef3993fde, []int{7}
+}
+func (m *GenesisLiquidationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisLiquidationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisLiquidationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisLiquidationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisLiquidationRecord.Merge(m, src)
+}
+func (m *GenesisLiquidationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisLiquidationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisLiquidationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisLiquidationRecord proto.InternalMessageInfo
+
+func (m *GenesisLiquidationRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *GenesisLiquidationRecord) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *GenesisLiquidationRecord) GetLiquidatorAddress() string {
+	if m != nil {
+		return m.LiquidatorAddress
+	}
+	return ""
+}
+
+func (m *GenesisLiquidationRecord) GetBadDebt() types.Coin {
+	if m != nil {
+		return m.BadDebt
+	}
+	return types.Coin{}
+}
+
+func (m *GenesisLiquidationRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisLiquidationRecord) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+func (m *GenesisLiquidationRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// GenesisFundingSettlement is a funding settlement kept in the funding history
+// of a pair, only used for genesis
+type GenesisFundingSettlement struct {
+	Pair                      github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Sequence                  uint64                                            `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	PremiumFraction           github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,3,opt,name=premium_fraction,json=premiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"premium_fraction"`
+	CumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,4,opt,name=cumulative_premium_fraction,json=cumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_premium_fraction"`
+	BlockHeight               int64                                             `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TimestampMs               int64                                             `protobuf:"varint,6,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+}
+
+func (m *GenesisFundingSettlement) Reset()         { *m = GenesisFundingSettlement{} }
+func (m *GenesisFundingSettlement) String() string { return proto.CompactTextString(m) }
+func (*GenesisFundingSettlement) ProtoMessage()    {}
+func (*GenesisFundingSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c7acfef3993fde, []int{8}
+}
+func (m *GenesisFundingSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisFundingSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisFundingSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisFundingSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisFundingSettlement.Merge(m, src)
+}
+func (m *GenesisFundingSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisFundingSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisFundingSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisFundingSettlement proto.InternalMessageInfo
+
+func (m *GenesisFundingSettlement) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *GenesisFundingSettlement) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisFundingSettlement) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "nibiru.perp.v2.GenesisState")
+	proto.RegisterType((*GenesisState_TraderVolume)(nil), "nibiru.perp.v2.GenesisState.TraderVolume")
+	proto.RegisterType((*GenesisState_Discount)(nil), "nibiru.perp.v2.GenesisState.Discount")
+	proto.RegisterType((*GenesisState_CustomDiscount)(nil), "nibiru.perp.v2.GenesisState.CustomDiscount")
+	proto.RegisterType((*GenesisState_GlobalVolume)(nil), "nibiru.perp.v2.GenesisState.GlobalVolume")
+	proto.RegisterType((*GenesisMarketLastVersion)(nil), "nibiru.perp.v2.GenesisMarketLastVersion")
+	proto.RegisterType((*GenesisPosition)(nil), "nibiru.perp.v2.GenesisPosition")
+	proto.RegisterType((*GenesisCollateralDenom)(nil), "nibiru.perp.v2.GenesisCollateralDenom")
+	proto.RegisterType((*GenesisPairTrader)(nil), "nibiru.perp.v2.GenesisPairTrader")
+	proto.RegisterType((*GenesisPairDec)(nil), "nibiru.perp.v2.GenesisPairDec")
+	proto.RegisterType((*GenesisPairUint64)(nil), "nibiru.perp.v2.GenesisPairUint64")
+	proto.RegisterType((*GenesisLiquidationRecord)(nil), "nibiru.perp.v2.GenesisLiquidationRecord")
+	proto.RegisterType((*GenesisFundingSettlement)(nil), "nibiru.perp.v2.GenesisFundingSettlement")
+}
+
+func init() { proto.RegisterFile("nibiru/perp/v2/genesis.proto", fileDescriptor_c2c7acfef3993fde) }
+
+var fileDescriptor_c2c7acfef3993fde = []byte{
+	// 1855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x73, 0x1c, 0xb7,
+	0x11, 0xe6, 0xf2, 0x4d, 0x90, 0xe2, 0x03, 0x7c, 0x08, 0x5a, 0xd1, 0x4b, 0x8a, 0xb1, 0x14, 0x2a,
+	0x8e, 0x76, 0x4b, 0x4c, 0xca, 0xa9, 0xe4, 0x92, 0xf0, 0x11, 0xca, 0xaa, 0xe2, 0xca, 0xf4, 0x92,
+	0x92, 0xcb, 0x8e, 0x9c, 0x31, 0x76, 0xa6, 0xb9, 0x9c, 0xe2, 0xcc, 0x60, 0x04, 0x60, 0x56, 0x94,
+	0xae, 0xc9, 0x29, 0xa7, 0x1c, 0x5c, 0x39, 0xe6, 0x96, 0xbf, 0x90, 0xdf, 0xa0, 0xa3, 0x8f, 0x29,
+	0x1f, 0x54, 0x29, 0xe9, 0x8f, 0xb8, 0xf0, 0x9a, 0x7d, 0x91, 0x94, 0x96, 0x54, 0xe9, 0xb4, 0x3b,
+	0x8d, 0xfe, 0xbe, 0x46, 0x37, 0x1a, 0x8d, 0x06, 0xd0, 0x72, 0x12, 0xd6, 0x43, 0x9e, 0x55, 0x52,
+	0xe0, 0x69, 0xa5, 0xb9, 0x51, 0x69, 0x40, 0x02, 0x22, 0x14, 0xe5, 0x94, 0x33, 0xc9, 0xf0, 0xb4,
+	0x19, 0x2d, 0xab, 0xd1, 0x72, 0x73, 0xa3, 0x58, 0xf2, 0x99, 0x88, 0x99, 0xa8, 0xd4, 0xa9, 0x80,
+	0x4a, 0xf3, 0x7e, 0x1d, 0x24, 0xbd, 0x5f, 0xf1, 0x59, 0x98, 0x18, 0xfd, 0xe2, 0x72, 0x83, 0xb1,
+	0x46, 0x04, 0x15, 0x9a, 0x86, 0x15, 0x9a, 0x24, 0x4c, 0x52, 0x19, 0xb2, 0xc4, 0xb2, 0x15, 0x17,
+	0x1a, 0xac, 0xc1, 0xf4, 0xdf, 0x8a, 0xfa, 0x67, 0xa5, 0xc5, 0xae, 0x19, 0x08, 0x49, 0x25, 0x98,
+	0xb1, 0xb5, 0x7f, 0x97, 0xd0, 0xd4, 0x03, 0x33, 0xa3, 0x03, 0x25, 0xc6, 0x9f, 0xa3, 0xb1, 0x98,
+	0xf2, 0x13, 0x90, 0x82, 0x0c, 0xae, 0x0e, 0xad, 0x4f, 0x6e, 0x2c, 0x95, 0x3b, 0xa7, 0x58, 0xae,
+	0xea, 0xe1, 0xad, 0xe1, 0x57, 0xaf, 0x57, 0x06, 0x6a, 0x4e, 0x19, 0xdf, 0x43, 0xc3, 0x34, 0x8e,
+	0x05, 0x19, 0xd2, 0xa0, 0xf9, 0x6e, 0xd0, 0x66, 0xb5, 0x6a, 0x11, 0x5a, 0x0d, 0x6f, 0xa3, 0x89,
+	0x94, 0x89, 0x50, 0x4f, 0x9e, 0x0c, 0x6b, 0xcc, 0x4a, 0x37, 0xc6, 0xce, 0x6b, 0xdf, 0xea, 0x59,
+	0x7c, 0x0b, 0x87, 0x6b, 0x68, 0x8e, 0x83, 0x00, 0xde, 0x04, 0x4f, 0x24, 0x34, 0x15, 0xc7, 0x4c,
+	0x0a, 0x32, 0x72, 0x36, 0x59, 0xcd, 0x28, 0x1e, 0x58, 0x3d, 0x4b, 0x36, 0xcb, 0x3b, 0xc5, 0x02,
+	0xdf, 0x44, 0x13, 0x41, 0xc2, 0x3d, 0x48, 0x99, 0x7f, 0x4c, 0x46, 0x57, 0x0b, 0xeb, 0xc3, 0xb5,
+	0xf1, 0x20, 0xe1, 0x7f, 0x56, 0xdf, 0xf8, 0x2e, 0x9a, 0xf5, 0x59, 0x14, 0x51, 0x09, 0x9c, 0x46,
+	0x5e, 0x00, 0x09, 0x8b, 0xc9, 0xe4, 0x6a, 0x61, 0x7d, 0xa2, 0x36, 0xd3, 0x92, 0xef, 0x28, 0x31,
+	0x7e, 0x82, 0xa6, 0x25, 0xa7, 0x01, 0x70, 0xaf, 0xc9, 0xa2, 0x2c, 0x06, 0x41, 0xc6, 0xf4, 0xc4,
+	0xee, 0x9e, 0xe3, 0xa5, 0x8e, 0x7e, 0xf9, 0x50, 0x43, 0x9e, 0x68, 0x84, 0x9d, 0xe2, 0x35, 0xd9,
+	0x26, 0x13, 0xf8, 0x10, 0xcd, 0x34, 0x22, 0x56, 0x57, 0xe6, 0x43, 0xe1, 0xb3, 0x2c, 0x91, 0x64,
+	0x5c, 0x13, 0xdf, 0xbe, 0x90, 0x78, 0xc7, 0x2a, 0x5b, 0xd2, 0x69, 0xc3, 0xe1, 0xa4, 0xf8, 0x29,
+	0x9a, 0xf5, 0x33, 0x21, 0x59, 0x9c, 0xb3, 0x0a, 0x32, 0xa1, 0x69, 0x3f, 0xbb, 0x90, 0x76, 0x5b,
+	0x83, 0xba, 0xc8, 0x67, 0xfc, 0x0e, 0xa9, 0xc0, 0xdf, 0xa3, 0x05, 0x93, 0x26, 0x5e, 0x44, 0x85,
+	0xf4, 0x9a, 0xc0, 0x85, 0x5e, 0x77, 0xa4, 0x2d, 0xac, 0x9f, 0x63, 0xc1, 0xe4, 0xd9, 0x1e, 0x15,
+	0xf2, 0x89, 0x01, 0x58, 0x7a, 0x1c, 0x77, 0x0f, 0x08, 0x15, 0x6d, 0x1b, 0x15, 0x17, 0xed, 0x6b,
+	0xef, 0x11, 0xed, 0x07, 0x1a, 0xd2, 0x19, 0xed, 0x46, 0x9b, 0x4c, 0x45, 0x7b, 0x9e, 0x43, 0x9d,
+	0x4a, 0x10, 0x1e, 0x8d, 0x22, 0xe6, 0x9b, 0xdd, 0x46, 0xa6, 0x34, 0xf9, 0x27, 0xdd, 0xe4, 0x3b,
+	0x8f, 0x6a, 0x9b, 0xb9, 0x96, 0x9b, 0xad, 0xc5, 0xb7, 0x06, 0x04, 0xfe, 0x14, 0x4d, 0xe7, 0x39,
+	0xe6, 0x25, 0x34, 0x06, 0x32, 0xad, 0x93, 0x68, 0xca, 0x25, 0xda, 0x23, 0x1a, 0x03, 0x7e, 0x82,
+	0xe6, 0xb3, 0xc4, 0x67, 0x4d, 0xe0, 0x10, 0x78, 0x75, 0x1a, 0x78, 0x01, 0xd4, 0xa5, 0x20, 0x33,
+	0xda, 0xf6, 0x6a, 0xb7, 0xed, 0xc7, 0x4e, 0x75, 0x8b, 0x06, 0x3b, 0x50, 0x77, 0x6b, 0x31, 0x97,
+	0x75, 0xc9, 0x05, 0xfe, 0x1d, 0x22, 0x71, 0x98, 0xe4, 0x3b, 0xc6, 0x0b, 0x13, 0x09, 0xbc, 0x49,
+	0x23, 0x2f, 0x16, 0x64, 0x56, 0x27, 0xfc, 0x62, 0x1c, 0x26, 0x6e, 0x47, 0x3c, 0xb4, 0xa3, 0x55,
+	0x81, 0x37, 0xd0, 0x62, 0x0e, 0xe2, 0x20, 0x21, 0x51, 0xde, 0x28, 0xd4, 0x9c, 0x46, 0xcd, 0xbb,
+	0xc1, 0x9a, 0x1b, 0xab, 0xaa, 0xb2, 0x30, 0x1f, 0xd3, 0x53, 0x2f, 0xa5, 0x21, 0x17, 0x5e, 0x0a,
+	0xdc, 0xab, 0x47, 0xcc, 0x3f, 0x21, 0x58, 0x23, 0x66, 0x63, 0x7a, 0xba, 0xaf, 0x46, 0xf6, 0x81,
+	0x6f, 0x29, 0x39, 0xae, 0xa0, 0x05, 0x48, 0x02, 0xa3, 0xe4, 0xd1, 0x38, 0xf6, 0xfc, 0x8c, 0x0b,
+	0xc6, 0xc9, 0xbc, 0xd6, 0x9f, 0x83, 0x24, 0xd0, 0x7a, 0x9b, 0x71, 0xbc, 0xad, 0x07, 0x94, 0x33,
+	0x2d, 0xc0, 0x51, 0x96, 0x04, 0x61, 0xd2, 0x70, 0xa0, 0x05, 0xe3, 0x8c, 0x03, 0xed, 0x9a, 0x51,
+	0x0b, 0xfc, 0x23, 0x5a, 0x8e, 0xc2, 0x67, 0x59, 0x18, 0xe8, 0x35, 0xf1, 0xe4, 0x73, 0x9a, 0x7a,
+	0x11, 0x63, 0x27, 0x75, 0xea, 0x9f, 0x28, 0x9f, 0x16, 0x35, 0xf8, 0x46, 0x9b, 0xce, 0xe1, 0x73,
+	0x9a, 0xee, 0x59, 0x8d, 0xaa, 0x0e, 0xa3, 0xb3, 0xc7, 0xa9, 0x84, 0x8e, 0x30, 0x2e, 0x19, 0xcb,
+	0x76, 0xbc, 0x46, 0x25, 0xb4, 0x85, 0xf1, 0x08, 0x5d, 0x77, 0xac, 0x8c, 0x7b, 0x1c, 0x9e, 0x53,
+	0x1e, 0x28, 0x8a, 0x90, 0x91, 0xeb, 0x2a, 0x0d, 0xb6, 0xca, 0xaf, 0x5e, 0xaf, 0x14, 0x7e, 0x7a,
+	0xbd, 0x72, 0xa7, 0x11, 0xca, 0xe3, 0xac, 0x5e, 0xf6, 0x59, 0x5c, 0xb1, 0xc7, 0x82, 0xf9, 0xb9,
+	0x27, 0x82, 0x93, 0x8a, 0x7c, 0x91, 0x82, 0x28, 0xef, 0x80, 0x5f, 0x5b, 0x6c, 0xd1, 0xd5, 0x34,
+	0x5b, 0x4d, 0x91, 0xe1, 0xa7, 0x08, 0xab, 0x75, 0x76, 0xe5, 0xd2, 0x7b, 0x96, 0x31, 0x09, 0x84,
+	0x5c, 0xca, 0xc4, 0x6c, 0x1c, 0x26, 0xae, 0x0e, 0x7f, 0xa5, 0x78, 0xf0, 0x37, 0x68, 0xae, 0xbb,
+	0x14, 0x0a, 0x72, 0x43, 0xe7, 0xe6, 0x9d, 0x73, 0x36, 0xdd, 0x76, 0x67, 0x89, 0x74, 0x25, 0xb8,
+	0xab, 0x72, 0x0a, 0xfc, 0x25, 0x9a, 0x71, 0x91, 0x95, 0x2c, 0xf5, 0xb2, 0x54, 0x90, 0xa2, 0x26,
+	0xbe, 0x75, 0xde, 0x09, 0x41, 0x43, 0x6e, 0x2a, 0xa7, 0xdb, 0xc5, 0x16, 0x7f, 0xc8, 0xd2, 0xc7,
+	0xa9, 0xaa, 0x0e, 0x0b, 0x61, 0x5c, 0xa7, 0x11, 0x4d, 0x7c, 0xf0, 0x8e, 0x00, 0x4c, 0xb4, 0x05,
+	0x59, 0xd6, 0xac, 0xa5, 0x0b, 0x58, 0x77, 0xc0, 0x77, 0xfb, 0x38, 0x67, 0xd8, 0x05, 0xd0, 0x01,
+	0x16, 0xf8, 0x3b, 0x44, 0x54, 0x72, 0x33, 0x4e, 0xfd, 0x08, 0x3c, 0x91, 0x72, 0xa0, 0x81, 0xe3,
+	0xfe, 0xa4, 0x0f, 0xee, 0xc5, 0x98, 0x9e, 0x7e, 0xa9, 0x49, 0x0e, 0x34, 0x87, 0xa5, 0xaf, 0x21,
+	0xcc, 0x52, 0x48, 0x4c, 0x66, 0x81, 0x90, 0x9e, 0x4f, 0x53, 0x41, 0x4a, 0x7d, 0x10, 0xcf, 0x2a,
+	0xfc, 0x43, 0x0b, 0xdf, 0xa6, 0xa9, 0xc0, 0x5f, 0xa1, 0x39, 0xce, 0x6c, 0x70, 0xa9, 0xef, 0xf3,
+	0x8c, 0x46, 0x82, 0xac, 0xf4, 0x43, 0xe9, 0xe0, 0x9b, 0x16, 0x8d, 0xff, 0x8a, 0xc8, 0x51, 0x94,
+	0xf9, 0x32, 0x33, 0x3b, 0x29, 0x0a, 0xe3, 0x50, 0xba, 0x28, 0xac, 0xf6, 0xc1, 0xbc, 0xd4, 0xc6,
+	0xb2, 0xa7, 0x48, 0x5a, 0x61, 0xd0, 0x47, 0x60, 0x27, 0xf3, 0xad, 0x7e, 0xe6, 0xac, 0xf1, 0xed,
+	0x9c, 0x7f, 0x41, 0xd7, 0x23, 0xa6, 0xf2, 0xab, 0x97, 0x78, 0xad, 0x0f, 0xe2, 0x05, 0x45, 0x72,
+	0xd8, 0x4d, 0xfe, 0x1d, 0x22, 0xe2, 0x98, 0x71, 0x79, 0x16, 0xfb, 0x2f, 0xfa, 0x49, 0x0b, 0xcd,
+	0xd2, 0x43, 0x4f, 0xd1, 0x8c, 0x1f, 0x31, 0x01, 0x1e, 0x95, 0x36, 0xf5, 0xc8, 0xa7, 0xab, 0x43,
+	0xeb, 0x13, 0x5b, 0xbf, 0x57, 0xa8, 0x9f, 0x5e, 0xaf, 0xdc, 0x6f, 0xdb, 0xd4, 0x8f, 0xb4, 0x9d,
+	0xed, 0x63, 0x1a, 0x26, 0x15, 0xdb, 0x06, 0x9e, 0x56, 0x7c, 0x16, 0xc7, 0x2c, 0xa9, 0x50, 0x21,
+	0x40, 0x96, 0x95, 0xcd, 0xda, 0x35, 0xcd, 0xb8, 0x29, 0x4d, 0x16, 0xe2, 0x13, 0xb4, 0x60, 0xb3,
+	0x59, 0x4f, 0x1e, 0x02, 0x4f, 0x3c, 0x57, 0xb9, 0x77, 0xfb, 0xaa, 0x76, 0xb0, 0xa1, 0xdd, 0x33,
+	0xac, 0x07, 0x8a, 0x14, 0x7f, 0x8f, 0xa6, 0x53, 0x9a, 0x09, 0x08, 0x3c, 0xd7, 0x78, 0xde, 0xb9,
+	0xb2, 0x3b, 0x86, 0xb0, 0x6a, 0x7b, 0xd3, 0xa7, 0x68, 0xb1, 0xbd, 0xd6, 0x0b, 0x78, 0x96, 0x41,
+	0xe2, 0x83, 0x20, 0xbf, 0x7c, 0x67, 0x59, 0x79, 0x1c, 0x26, 0xf2, 0xf3, 0xdf, 0xe6, 0xcb, 0xdd,
+	0x62, 0x39, 0x70, 0x24, 0xd8, 0x43, 0xf3, 0xed, 0xec, 0xc7, 0xa1, 0x90, 0x8c, 0xbf, 0x20, 0xeb,
+	0x17, 0x36, 0x37, 0x7b, 0x2d, 0x44, 0x0d, 0x7c, 0xc6, 0x03, 0x57, 0x66, 0xda, 0xa8, 0xbe, 0x30,
+	0x4c, 0x38, 0x44, 0xcb, 0xae, 0x1e, 0x0a, 0x90, 0x32, 0x82, 0x18, 0x12, 0xd9, 0xe6, 0xc5, 0xdd,
+	0xfe, 0xbc, 0x28, 0x5a, 0xb2, 0x83, 0x9c, 0xab, 0xe5, 0xcb, 0xd7, 0xad, 0xd2, 0xeb, 0xfc, 0xf8,
+	0xd5, 0x85, 0x7e, 0xec, 0x76, 0x73, 0xb9, 0x06, 0xd3, 0xd2, 0x38, 0x1f, 0x8e, 0xd0, 0x4d, 0xdd,
+	0xfb, 0xf5, 0x3a, 0x22, 0xd4, 0x81, 0xf9, 0x59, 0x7f, 0x2e, 0x10, 0xc5, 0xd5, 0x63, 0x5a, 0x54,
+	0x55, 0xa9, 0x9f, 0x75, 0x26, 0x52, 0xfa, 0x42, 0x4b, 0xc9, 0xaf, 0xcf, 0xee, 0x8f, 0x37, 0xd3,
+	0x34, 0x0a, 0x21, 0xb0, 0x34, 0xfb, 0x46, 0xdb, 0xb5, 0xb0, 0x47, 0x1d, 0x52, 0x51, 0xfc, 0x7b,
+	0x01, 0x4d, 0xb5, 0x37, 0xe7, 0x78, 0x09, 0x8d, 0x9a, 0xc6, 0x9c, 0x14, 0x74, 0xef, 0x66, 0xbf,
+	0xf0, 0x02, 0x1a, 0x31, 0x77, 0x87, 0x41, 0xdd, 0x03, 0x98, 0x0f, 0xbc, 0x8b, 0x46, 0x4d, 0x63,
+	0x4a, 0x86, 0xf2, 0xf3, 0x77, 0xe0, 0x3d, 0xcf, 0xdf, 0x87, 0x89, 0xac, 0x59, 0x74, 0xf1, 0x87,
+	0x02, 0x1a, 0xcf, 0x9b, 0xf6, 0x3f, 0xa1, 0xa1, 0x23, 0x00, 0x52, 0xe8, 0x9b, 0x51, 0x9d, 0xe8,
+	0x0a, 0xda, 0x36, 0xad, 0xc1, 0x2b, 0x4d, 0xeb, 0x04, 0x4d, 0x77, 0xde, 0x04, 0xce, 0x0d, 0xcf,
+	0x26, 0x1a, 0xcf, 0xef, 0x2d, 0xca, 0xe6, 0xfb, 0xde, 0x5b, 0x6a, 0x39, 0xac, 0x18, 0xa1, 0xa9,
+	0xf6, 0xc6, 0xbd, 0x15, 0xf1, 0xc2, 0xd9, 0x11, 0xbf, 0x92, 0x6b, 0x6b, 0x7f, 0x2b, 0x20, 0x72,
+	0xde, 0x85, 0x04, 0x57, 0xd1, 0xb0, 0xea, 0x6c, 0xed, 0x12, 0x5c, 0xa1, 0x60, 0x69, 0x1a, 0x4c,
+	0xd0, 0x98, 0xbd, 0x1b, 0xd9, 0xec, 0x71, 0x9f, 0x6b, 0xff, 0x2d, 0xa0, 0x99, 0xae, 0xeb, 0xf0,
+	0x47, 0x33, 0x8e, 0xff, 0x80, 0xc6, 0x5d, 0x13, 0xa9, 0xd3, 0x77, 0x72, 0x83, 0x74, 0xaf, 0x59,
+	0xd7, 0x1d, 0x3d, 0xd7, 0x5f, 0xfb, 0x47, 0x01, 0x2d, 0x9d, 0xdd, 0xfe, 0xa9, 0x75, 0x33, 0x37,
+	0x68, 0x93, 0x21, 0xe6, 0x03, 0x7f, 0x8b, 0x26, 0x6d, 0x3f, 0xa5, 0x9d, 0x1b, 0xbc, 0xaa, 0x73,
+	0xc8, 0xb0, 0xa9, 0xff, 0x6b, 0x2f, 0xd1, 0x5c, 0x4f, 0xc7, 0xf8, 0xa1, 0xc3, 0xd8, 0x4a, 0xfc,
+	0xc1, 0xf6, 0xc4, 0x5f, 0xfb, 0x4f, 0x01, 0x4d, 0x77, 0x9e, 0xf2, 0x1f, 0xda, 0xf2, 0x0e, 0x1a,
+	0x69, 0xd2, 0x28, 0xbb, 0x4c, 0xc2, 0xab, 0x82, 0x60, 0xc0, 0x6b, 0xa7, 0x68, 0xae, 0xa7, 0xea,
+	0x7e, 0xe8, 0x99, 0x2e, 0xb4, 0xcf, 0x74, 0xd8, 0x59, 0xfe, 0x61, 0x18, 0x91, 0xf3, 0x4e, 0xc7,
+	0x0f, 0x3d, 0x83, 0x22, 0x1a, 0x77, 0xe7, 0xa7, 0x9d, 0x44, 0xfe, 0x8d, 0x6f, 0xe7, 0x2f, 0x37,
+	0x34, 0x08, 0x38, 0x08, 0x61, 0x6a, 0xb6, 0x7b, 0x88, 0xd9, 0x34, 0x42, 0x7c, 0x0f, 0xe1, 0xb6,
+	0x6b, 0x9c, 0x53, 0x1d, 0xd6, 0xaa, 0x73, 0xad, 0x11, 0xa7, 0xfe, 0x35, 0x9a, 0x71, 0x42, 0xd5,
+	0x4e, 0x85, 0x2f, 0x81, 0x8c, 0x5c, 0x6a, 0x9d, 0xa6, 0x5b, 0x34, 0x07, 0xe1, 0x4b, 0x50, 0xcb,
+	0x9e, 0xf2, 0xd0, 0x07, 0x32, 0x7a, 0x29, 0x3a, 0x03, 0x56, 0x7b, 0xdc, 0x3d, 0x31, 0x90, 0x31,
+	0xbd, 0xc7, 0x6f, 0x94, 0x8d, 0x7e, 0x59, 0x3d, 0x45, 0x96, 0xed, 0x53, 0x64, 0x79, 0x9b, 0x85,
+	0x6e, 0x93, 0x8f, 0xd5, 0xcd, 0x8b, 0x02, 0xbe, 0x85, 0xa6, 0xcc, 0xfd, 0xfb, 0x18, 0xc2, 0xc6,
+	0xb1, 0x7a, 0x8f, 0x2a, 0xac, 0x0f, 0xd5, 0x26, 0xb5, 0xec, 0x0b, 0x2d, 0x52, 0x2a, 0x32, 0x8c,
+	0x41, 0x48, 0x1a, 0xa7, 0xea, 0xbc, 0x9f, 0x30, 0x2a, 0xb9, 0xac, 0x2a, 0xd4, 0xc6, 0xe1, 0x40,
+	0x05, 0x4b, 0x08, 0x32, 0x1b, 0xc7, 0x7c, 0xad, 0xfd, 0x6b, 0x28, 0x4f, 0x8b, 0x9e, 0x13, 0xff,
+	0x63, 0xa6, 0xc5, 0x37, 0x68, 0x36, 0xe5, 0x10, 0x87, 0x59, 0xec, 0x1d, 0x71, 0xea, 0xe7, 0xd5,
+	0xb0, 0xff, 0x90, 0xcf, 0x58, 0x9e, 0x5d, 0x4b, 0x83, 0x13, 0x74, 0xd3, 0xcf, 0xe2, 0x2c, 0xa2,
+	0x32, 0x6c, 0x82, 0xd7, 0x63, 0x65, 0xf8, 0x52, 0x56, 0x6e, 0xb4, 0x28, 0xf7, 0xbb, 0xec, 0x75,
+	0x2f, 0xd8, 0xc8, 0xbb, 0x17, 0x6c, 0xb4, 0x67, 0xc1, 0xb6, 0x1e, 0xbc, 0x7a, 0x53, 0x2a, 0xfc,
+	0xf8, 0xa6, 0x54, 0xf8, 0xff, 0x9b, 0x52, 0xe1, 0x9f, 0x6f, 0x4b, 0x03, 0x3f, 0xbe, 0x2d, 0x0d,
+	0xfc, 0xef, 0x6d, 0x69, 0xe0, 0xdb, 0x7b, 0xef, 0x8a, 0xbf, 0x7b, 0x89, 0xd6, 0xb3, 0xad, 0x8f,
+	0xea, 0xa7, 0xe8, 0xdf, 0xfc, 0x3c, 0x00, 0x18, 0x4e, 0xe3, 0x44, 0x2a, 0x17, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundingPayments) > 0 {
+		for iNdEx := len(m.FundingPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
 				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.GlobalDiscount) > 0 {
-		for iNdEx := len(m.GlobalDiscount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LastFundingSettlementsMs) > 0 {
+		for iNdEx := len(m.LastFundingSettlementsMs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.GlobalDiscount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LastFundingSettlementsMs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -626,13 +1183,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.TraderVolumes) > 0 {
-		for iNdEx := len(m.TraderVolumes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FundingHistory) > 0 {
+		for iNdEx := len(m.FundingHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TraderVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FundingHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -640,18 +1199,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if m.DnrEpoch != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.DnrEpoch))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.ReserveSnapshots) > 0 {
-		for iNdEx := len(m.ReserveSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FundingSettlementSequences) > 0 {
+		for iNdEx := len(m.FundingSettlementSequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReserveSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FundingSettlementSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -659,13 +1215,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LiquidationHistory) > 0 {
+		for iNdEx := len(m.LiquidationHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LiquidationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -673,13 +1231,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.Amms) > 0 {
-		for iNdEx := len(m.Amms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LiquidationSequences) > 0 {
+		for iNdEx := len(m.LiquidationSequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LiquidationSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -687,233 +1247,473 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PausedMarkets) > 0 {
+		for iNdEx := len(m.PausedMarkets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
+				size := m.PausedMarkets[iNdEx].Size()
+				i -= size
+				if _, err := m.PausedMarkets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
 					return 0, err
 				}
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.SpreadLimitedSwaps) > 0 {
+		for iNdEx := len(m.SpreadLimitedSwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SpreadLimitedSwaps[iNdEx].Size()
 				i -= size
+				if _, err := m.SpreadLimitedSwaps[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GenesisState_TraderVolume) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.CloseAtOracle) > 0 {
+		for iNdEx := len(m.CloseAtOracle) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CloseAtOracle[iNdEx].Size()
+				i -= size
+				if _, err := m.CloseAtOracle[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState_TraderVolume) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState_TraderVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Volume.Size()
-		i -= size
-		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.ShortTradeLimitRatios) > 0 {
+		for iNdEx := len(m.ShortTradeLimitRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTradeLimitRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Epoch != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x10
+	if len(m.LongTradeLimitRatios) > 0 {
+		for iNdEx := len(m.LongTradeLimitRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LongTradeLimitRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.TradeLimitRatios) > 0 {
+		for iNdEx := len(m.TradeLimitRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeLimitRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GenesisState_Discount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.FluctuationLimitRatios) > 0 {
+		for iNdEx := len(m.FluctuationLimitRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FluctuationLimitRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState_Discount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState_Discount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Volume.Size()
-		i -= size
-		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.RoundingAccruals) > 0 {
+		for iNdEx := len(m.RoundingAccruals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundingAccruals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Fee.Size()
-		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.OpenInterestCaps) > 0 {
+		for iNdEx := len(m.OpenInterestCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpenInterestCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *GenesisState_CustomDiscount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.MaxOracleSpreadRatios) > 0 {
+		for iNdEx := len(m.MaxOracleSpreadRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxOracleSpreadRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState_CustomDiscount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState_CustomDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Discount != nil {
+	if len(m.ImbalanceFeeRatios) > 0 {
+		for iNdEx := len(m.ImbalanceFeeRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImbalanceFeeRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.FundingTopUps) > 0 {
+		for iNdEx := len(m.FundingTopUps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingTopUps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.CollateralDenoms) > 0 {
+		for iNdEx := len(m.CollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.MinPositionQuote != nil {
 		{
-			size, err := m.Discount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.MinPositionQuote.Size()
+			i -= size
+			if _, err := m.MinPositionQuote.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.LiquidatorRewardRatio != nil {
+		{
+			size := m.LiquidatorRewardRatio.Size()
 			i -= size
+			if _, err := m.LiquidatorRewardRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Trader)))
+	if m.FundingRateIntervalMs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FundingRateIntervalMs))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GenesisState_GlobalVolume) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.LiquidationTwapLookbackMs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LiquidationTwapLookbackMs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState_GlobalVolume) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState_GlobalVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Volume.Size()
-		i -= size
-		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.EndBlockFundingCursor != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndBlockFundingCursor))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Epoch != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+	if m.EndBlockAmmCursor != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndBlockAmmCursor))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GenesisMarketLastVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.MaxPairsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPairsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisMarketLastVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisMarketLastVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
+	if m.SnapshotRetentionMs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SnapshotRetentionMs))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.MinSnapshotIntervalMs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinSnapshotIntervalMs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.UncoveredBadDebts) > 0 {
+		for iNdEx := len(m.UncoveredBadDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UncoveredBadDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.DnrEpochName) > 0 {
+		i -= len(m.DnrEpochName)
+		copy(dAtA[i:], m.DnrEpochName)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DnrEpochName)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.GlobalVolumes) > 0 {
+		for iNdEx := len(m.GlobalVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RebatesAllocations) > 0 {
+		for iNdEx := len(m.RebatesAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RebatesAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.MarketLastVersions) > 0 {
+		for iNdEx := len(m.MarketLastVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketLastVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CustomDiscounts) > 0 {
+		for iNdEx := len(m.CustomDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.GlobalDiscount) > 0 {
+		for iNdEx := len(m.GlobalDiscount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalDiscount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TraderVolumes) > 0 {
+		for iNdEx := len(m.TraderVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DnrEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DnrEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ReserveSnapshots) > 0 {
+		for iNdEx := len(m.ReserveSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amms) > 0 {
+		for iNdEx := len(m.Amms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisPosition) Marshal() (dAtA []byte, err error) {
+func (m *GenesisState_TraderVolume) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -923,35 +1723,75 @@ func (m *GenesisPosition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisPosition) MarshalTo(dAtA []byte) (int, error) {
+func (m *GenesisState_TraderVolume) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GenesisState_TraderVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if m.Version != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
 		i--
 		dAtA[i] = 0x10
 	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState_Discount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState_Discount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState_Discount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.Pair.Size()
+		size := m.Volume.Size()
 		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
@@ -961,196 +1801,2796 @@ func (m *GenesisPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenesisState_CustomDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Amms) > 0 {
-		for _, e := range m.Amms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.ReserveSnapshots) > 0 {
-		for _, e := range m.ReserveSnapshots {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.DnrEpoch != 0 {
-		n += 1 + sovGenesis(uint64(m.DnrEpoch))
-	}
-	if len(m.TraderVolumes) > 0 {
-		for _, e := range m.TraderVolumes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.GlobalDiscount) > 0 {
-		for _, e := range m.GlobalDiscount {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.CustomDiscounts) > 0 {
-		for _, e := range m.CustomDiscounts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.MarketLastVersions) > 0 {
-		for _, e := range m.MarketLastVersions {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	l = len(m.CollateralDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.RebatesAllocations) > 0 {
-		for _, e := range m.RebatesAllocations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.GlobalVolumes) > 0 {
-		for _, e := range m.GlobalVolumes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+
+func (m *GenesisState_CustomDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState_CustomDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Discount != nil {
+		{
+			size, err := m.Discount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.DnrEpochName)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState_TraderVolume) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState_GlobalVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState_GlobalVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState_GlobalVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.Epoch != 0 {
-		n += 1 + sovGenesis(uint64(m.Epoch))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Volume.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState_Discount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisMarketLastVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.Volume.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GenesisState_CustomDiscount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GenesisMarketLastVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisMarketLastVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Discount != nil {
-		l = m.Discount.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState_GlobalVolume) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovGenesis(uint64(m.Epoch))
+func (m *GenesisPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Volume.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GenesisMarketLastVersion) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GenesisPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Version != 0 {
-		n += 1 + sovGenesis(uint64(m.Version))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisPosition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisCollateralDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
+	return dAtA[:n], nil
+}
+
+func (m *GenesisCollateralDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisCollateralDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OraclePair.Size()
+		i -= size
+		if _, err := m.OraclePair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisPairTrader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPairTrader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPairTrader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisPairDec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPairDec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPairDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisPairUint64) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPairUint64) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPairUint64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisLiquidationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisLiquidationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisLiquidationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TimestampMs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LiquidatedSize.Size()
+		i -= size
+		if _, err := m.LiquidatedSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisFundingSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisFundingSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisFundingSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampMs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.CumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PremiumFraction.Size()
+		i -= size
+		if _, err := m.PremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Amms) > 0 {
+		for _, e := range m.Amms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReserveSnapshots) > 0 {
+		for _, e := range m.ReserveSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DnrEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.DnrEpoch))
+	}
+	if len(m.TraderVolumes) > 0 {
+		for _, e := range m.TraderVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GlobalDiscount) > 0 {
+		for _, e := range m.GlobalDiscount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CustomDiscounts) > 0 {
+		for _, e := range m.CustomDiscounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketLastVersions) > 0 {
+		for _, e := range m.MarketLastVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RebatesAllocations) > 0 {
+		for _, e := range m.RebatesAllocations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GlobalVolumes) > 0 {
+		for _, e := range m.GlobalVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.DnrEpochName)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.UncoveredBadDebts) > 0 {
+		for _, e := range m.UncoveredBadDebts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MinSnapshotIntervalMs != 0 {
+		n += 2 + sovGenesis(uint64(m.MinSnapshotIntervalMs))
+	}
+	if m.SnapshotRetentionMs != 0 {
+		n += 2 + sovGenesis(uint64(m.SnapshotRetentionMs))
+	}
+	if m.MaxPairsPerBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxPairsPerBlock))
+	}
+	if m.EndBlockAmmCursor != 0 {
+		n += 2 + sovGenesis(uint64(m.EndBlockAmmCursor))
+	}
+	if m.EndBlockFundingCursor != 0 {
+		n += 2 + sovGenesis(uint64(m.EndBlockFundingCursor))
+	}
+	if m.LiquidationTwapLookbackMs != 0 {
+		n += 2 + sovGenesis(uint64(m.LiquidationTwapLookbackMs))
+	}
+	if m.FundingRateIntervalMs != 0 {
+		n += 2 + sovGenesis(uint64(m.FundingRateIntervalMs))
+	}
+	if m.LiquidatorRewardRatio != nil {
+		l = m.LiquidatorRewardRatio.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.MinPositionQuote != nil {
+		l = m.MinPositionQuote.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.CollateralDenoms) > 0 {
+		for _, e := range m.CollateralDenoms {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingTopUps) > 0 {
+		for _, e := range m.FundingTopUps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ImbalanceFeeRatios) > 0 {
+		for _, e := range m.ImbalanceFeeRatios {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MaxOracleSpreadRatios) > 0 {
+		for _, e := range m.MaxOracleSpreadRatios {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OpenInterestCaps) > 0 {
+		for _, e := range m.OpenInterestCaps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoundingAccruals) > 0 {
+		for _, e := range m.RoundingAccruals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FluctuationLimitRatios) > 0 {
+		for _, e := range m.FluctuationLimitRatios {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TradeLimitRatios) > 0 {
+		for _, e := range m.TradeLimitRatios {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LongTradeLimitRatios) > 0 {
+		for _, e := range m.LongTradeLimitRatios {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ShortTradeLimitRatios) > 0 {
+		for _, e := range m.ShortTradeLimitRatios {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CloseAtOracle) > 0 {
+		for _, e := range m.CloseAtOracle {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpreadLimitedSwaps) > 0 {
+		for _, e := range m.SpreadLimitedSwaps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedMarkets) > 0 {
+		for _, e := range m.PausedMarkets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidationSequences) > 0 {
+		for _, e := range m.LiquidationSequences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidationHistory) > 0 {
+		for _, e := range m.LiquidationHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingSettlementSequences) > 0 {
+		for _, e := range m.FundingSettlementSequences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingHistory) > 0 {
+		for _, e := range m.FundingHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastFundingSettlementsMs) > 0 {
+		for _, e := range m.LastFundingSettlementsMs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingPayments) > 0 {
+		for _, e := range m.FundingPayments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState_TraderVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState_Discount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState_CustomDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Discount != nil {
+		l = m.Discount.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState_GlobalVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisMarketLastVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovGenesis(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *GenesisPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovGenesis(uint64(m.Version))
+	}
+	l = m.Position.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisCollateralDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.OraclePair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisPairTrader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovGenesis(uint64(m.Version))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisPairDec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisPairUint64) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Value != 0 {
+		n += 1 + sovGenesis(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *GenesisLiquidationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.LiquidatedSize.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovGenesis(uint64(m.TimestampMs))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisFundingSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.PremiumFraction.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovGenesis(uint64(m.TimestampMs))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amms = append(m.Amms, AMM{})
+			if err := m.Amms[len(m.Amms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, GenesisPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveSnapshots = append(m.ReserveSnapshots, ReserveSnapshot{})
+			if err := m.ReserveSnapshots[len(m.ReserveSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnrEpoch", wireType)
+			}
+			m.DnrEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DnrEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderVolumes = append(m.TraderVolumes, GenesisState_TraderVolume{})
+			if err := m.TraderVolumes[len(m.TraderVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalDiscount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalDiscount = append(m.GlobalDiscount, GenesisState_Discount{})
+			if err := m.GlobalDiscount[len(m.GlobalDiscount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomDiscounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomDiscounts = append(m.CustomDiscounts, GenesisState_CustomDiscount{})
+			if err := m.CustomDiscounts[len(m.CustomDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketLastVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketLastVersions = append(m.MarketLastVersions, GenesisMarketLastVersion{})
+			if err := m.MarketLastVersions[len(m.MarketLastVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebatesAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RebatesAllocations = append(m.RebatesAllocations, DNRAllocation{})
+			if err := m.RebatesAllocations[len(m.RebatesAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalVolumes = append(m.GlobalVolumes, GenesisState_GlobalVolume{})
+			if err := m.GlobalVolumes[len(m.GlobalVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnrEpochName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DnrEpochName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncoveredBadDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UncoveredBadDebts = append(m.UncoveredBadDebts, UncoveredBadDebt{})
+			if err := m.UncoveredBadDebts[len(m.UncoveredBadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSnapshotIntervalMs", wireType)
+			}
+			m.MinSnapshotIntervalMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSnapshotIntervalMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionMs", wireType)
+			}
+			m.SnapshotRetentionMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRetentionMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPairsPerBlock", wireType)
+			}
+			m.MaxPairsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPairsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockAmmCursor", wireType)
+			}
+			m.EndBlockAmmCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlockAmmCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockFundingCursor", wireType)
+			}
+			m.EndBlockFundingCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlockFundingCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationTwapLookbackMs", wireType)
+			}
+			m.LiquidationTwapLookbackMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationTwapLookbackMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateIntervalMs", wireType)
+			}
+			m.FundingRateIntervalMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRateIntervalMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorRewardRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LiquidatorRewardRatio = &v
+			if err := m.LiquidatorRewardRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPositionQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinPositionQuote = &v
+			if err := m.MinPositionQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenoms = append(m.CollateralDenoms, GenesisCollateralDenom{})
+			if err := m.CollateralDenoms[len(m.CollateralDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingTopUps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingTopUps = append(m.FundingTopUps, GenesisPairTrader{})
+			if err := m.FundingTopUps[len(m.FundingTopUps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImbalanceFeeRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImbalanceFeeRatios = append(m.ImbalanceFeeRatios, GenesisPairDec{})
+			if err := m.ImbalanceFeeRatios[len(m.ImbalanceFeeRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxOracleSpreadRatios = append(m.MaxOracleSpreadRatios, GenesisPairDec{})
+			if err := m.MaxOracleSpreadRatios[len(m.MaxOracleSpreadRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpenInterestCaps = append(m.OpenInterestCaps, GenesisPairDec{})
+			if err := m.OpenInterestCaps[len(m.OpenInterestCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundingAccruals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundingAccruals = append(m.RoundingAccruals, GenesisPairDec{})
+			if err := m.RoundingAccruals[len(m.RoundingAccruals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FluctuationLimitRatios = append(m.FluctuationLimitRatios, GenesisPairDec{})
+			if err := m.FluctuationLimitRatios[len(m.FluctuationLimitRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeLimitRatios = append(m.TradeLimitRatios, GenesisPairDec{})
+			if err := m.TradeLimitRatios[len(m.TradeLimitRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongTradeLimitRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LongTradeLimitRatios = append(m.LongTradeLimitRatios, GenesisPairDec{})
+			if err := m.LongTradeLimitRatios[len(m.LongTradeLimitRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTradeLimitRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortTradeLimitRatios = append(m.ShortTradeLimitRatios, GenesisPairDec{})
+			if err := m.ShortTradeLimitRatios[len(m.ShortTradeLimitRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseAtOracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
+			m.CloseAtOracle = append(m.CloseAtOracle, v)
+			if err := m.CloseAtOracle[len(m.CloseAtOracle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadLimitedSwaps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
+			m.SpreadLimitedSwaps = append(m.SpreadLimitedSwaps, v)
+			if err := m.SpreadLimitedSwaps[len(m.SpreadLimitedSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedMarkets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
+			m.PausedMarkets = append(m.PausedMarkets, v)
+			if err := m.PausedMarkets[len(m.PausedMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationSequences = append(m.LiquidationSequences, GenesisPairUint64{})
+			if err := m.LiquidationSequences[len(m.LiquidationSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationHistory = append(m.LiquidationHistory, GenesisLiquidationRecord{})
+			if err := m.LiquidationHistory[len(m.LiquidationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingSettlementSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingSettlementSequences = append(m.FundingSettlementSequences, GenesisPairUint64{})
+			if err := m.FundingSettlementSequences[len(m.FundingSettlementSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingHistory = append(m.FundingHistory, GenesisFundingSettlement{})
+			if err := m.FundingHistory[len(m.FundingHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFundingSettlementsMs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastFundingSettlementsMs = append(m.LastFundingSettlementsMs, GenesisPairUint64{})
+			if err := m.LastFundingSettlementsMs[len(m.LastFundingSettlementsMs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingPayments = append(m.FundingPayments, AppliedFundingPayment{})
+			if err := m.FundingPayments[len(m.FundingPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState_TraderVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState_Discount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Discount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Discount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Position.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *GenesisState_CustomDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Discount == nil {
+				m.Discount = &GenesisState_Discount{}
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GenesisState_GlobalVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *GenesisMarketLastVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1173,17 +4613,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisMarketLastVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisMarketLastVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1193,31 +4633,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amms", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1227,65 +4667,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amms = append(m.Amms, AMM{})
-			if err := m.Amms[len(m.Amms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, GenesisPosition{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveSnapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1295,31 +4736,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveSnapshots = append(m.ReserveSnapshots, ReserveSnapshot{})
-			if err := m.ReserveSnapshots[len(m.ReserveSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DnrEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.DnrEpoch = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1329,14 +4770,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DnrEpoch |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1363,16 +4804,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderVolumes = append(m.TraderVolumes, GenesisState_TraderVolume{})
-			if err := m.TraderVolumes[len(m.TraderVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisCollateralDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisCollateralDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisCollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalDiscount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1382,31 +4872,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalDiscount = append(m.GlobalDiscount, GenesisState_Discount{})
-			if err := m.GlobalDiscount[len(m.GlobalDiscount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomDiscounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1416,31 +4904,81 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OraclePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisPairTrader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CustomDiscounts = append(m.CustomDiscounts, GenesisState_CustomDiscount{})
-			if err := m.CustomDiscounts[len(m.CustomDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPairTrader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPairTrader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketLastVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1450,29 +4988,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketLastVersions = append(m.MarketLastVersions, GenesisMarketLastVersion{})
-			if err := m.MarketLastVersions[len(m.MarketLastVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1500,47 +5038,63 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			m.Trader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebatesAllocations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisPairDec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RebatesAllocations = append(m.RebatesAllocations, DNRAllocation{})
-			if err := m.RebatesAllocations[len(m.RebatesAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 13:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPairDec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPairDec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVolumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1550,29 +5104,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalVolumes = append(m.GlobalVolumes, GenesisState_GlobalVolume{})
-			if err := m.GlobalVolumes[len(m.GlobalVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DnrEpochName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1600,7 +5154,9 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DnrEpochName = string(dAtA[iNdEx:postIndex])
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1623,7 +5179,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState_TraderVolume) Unmarshal(dAtA []byte) error {
+func (m *GenesisPairUint64) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1646,15 +5202,15 @@ func (m *GenesisState_TraderVolume) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TraderVolume: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisPairUint64: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TraderVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisPairUint64: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1682,32 +5238,15 @@ func (m *GenesisState_TraderVolume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
-			}
-			var stringLen uint64
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1717,26 +5256,11 @@ func (m *GenesisState_TraderVolume) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Value |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1758,7 +5282,7 @@ func (m *GenesisState_TraderVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState_Discount) Unmarshal(dAtA []byte) error {
+func (m *GenesisLiquidationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1781,15 +5305,100 @@ func (m *GenesisState_Discount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Discount: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisLiquidationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Discount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisLiquidationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1817,13 +5426,11 @@ func (m *GenesisState_Discount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatedSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1851,63 +5458,13 @@ func (m *GenesisState_Discount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidatedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState_CustomDiscount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CustomDiscount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1935,11 +5492,13 @@ func (m *GenesisState_CustomDiscount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1966,68 +5525,34 @@ func (m *GenesisState_CustomDiscount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Discount == nil {
-				m.Discount = &GenesisState_Discount{}
-			}
-			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState_GlobalVolume) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalVolume: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalVolume: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
 			}
-			m.Epoch = 0
+			m.TimestampMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2037,14 +5562,14 @@ func (m *GenesisState_GlobalVolume) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.TimestampMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2072,9 +5597,7 @@ func (m *GenesisState_GlobalVolume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2097,7 +5620,7 @@ func (m *GenesisState_GlobalVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisMarketLastVersion) Unmarshal(dAtA []byte) error {
+func (m *GenesisFundingSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2120,10 +5643,10 @@ func (m *GenesisMarketLastVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisMarketLastVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisFundingSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisMarketLastVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisFundingSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2162,9 +5685,9 @@ func (m *GenesisMarketLastVersion) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.Version = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2174,64 +5697,48 @@ func (m *GenesisMarketLastVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumFraction", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisPosition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisPosition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisPosition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2259,15 +5766,15 @@ func (m *GenesisPosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Version = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2277,16 +5784,16 @@ func (m *GenesisPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
 			}
-			var msglen int
+			m.TimestampMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2296,25 +5803,11 @@ func (m *GenesisPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TimestampMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,6 +11,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
 	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
@@ -164,6 +165,147 @@ func TestGenesisValidate(t *testing.T) {
 	}
 }
 
+func TestGenesisValidateStores(t *testing.T) {
+	pair := asset.MustNewPair("ubtc:unusd")
+	trader := testutil.AccAddress().String()
+
+	tests := []struct {
+		name       string
+		setup      func(genesis *types.GenesisState)
+		shouldFail bool
+	}{
+		{
+			name:  "valid stores",
+			setup: func(genesis *types.GenesisState) {},
+		},
+		{
+			name: "funding rate interval longer than a day",
+			setup: func(genesis *types.GenesisState) {
+				genesis.FundingRateIntervalMs = uint64((25 * time.Hour).Milliseconds())
+			},
+			shouldFail: true,
+		},
+		{
+			name: "liquidation twap lookback longer than snapshot retention",
+			setup: func(genesis *types.GenesisState) {
+				genesis.SnapshotRetentionMs = 1_000
+				genesis.LiquidationTwapLookbackMs = 2_000
+			},
+			shouldFail: true,
+		},
+		{
+			name: "liquidator reward ratio above one",
+			setup: func(genesis *types.GenesisState) {
+				ratio := sdk.NewDec(2)
+				genesis.LiquidatorRewardRatio = &ratio
+			},
+			shouldFail: true,
+		},
+		{
+			name: "negative min position quote",
+			setup: func(genesis *types.GenesisState) {
+				quote := sdk.NewDec(-1)
+				genesis.MinPositionQuote = &quote
+			},
+			shouldFail: true,
+		},
+		{
+			name: "secondary collateral is the primary collateral",
+			setup: func(genesis *types.GenesisState) {
+				genesis.CollateralDenoms[0].Denom = genesis.CollateralDenom
+			},
+			shouldFail: true,
+		},
+		{
+			name: "imbalance fee ratio of one",
+			setup: func(genesis *types.GenesisState) {
+				genesis.ImbalanceFeeRatios[0].Value = sdk.OneDec()
+			},
+			shouldFail: true,
+		},
+		{
+			name: "duplicate trade limit ratio",
+			setup: func(genesis *types.GenesisState) {
+				genesis.TradeLimitRatios = append(genesis.TradeLimitRatios, genesis.TradeLimitRatios[0])
+			},
+			shouldFail: true,
+		},
+		{
+			name: "invalid paused market",
+			setup: func(genesis *types.GenesisState) {
+				genesis.PausedMarkets = append(genesis.PausedMarkets, "ubtc")
+			},
+			shouldFail: true,
+		},
+		{
+			name: "liquidation past its sequence",
+			setup: func(genesis *types.GenesisState) {
+				genesis.LiquidationHistory[0].Sequence = 1
+			},
+			shouldFail: true,
+		},
+		{
+			name: "funding settlement without sequence",
+			setup: func(genesis *types.GenesisState) {
+				genesis.FundingSettlementSequences = nil
+			},
+			shouldFail: true,
+		},
+		{
+			name: "invalid funding payment trader",
+			setup: func(genesis *types.GenesisState) {
+				genesis.FundingPayments[0].Trader = "invalid"
+			},
+			shouldFail: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := types.DefaultGenesis()
+			genesis.CollateralDenoms = []types.GenesisCollateralDenom{
+				{Denom: denoms.USDC, OraclePair: asset.Registry.Pair(denoms.USDC, denoms.USD)},
+			}
+			genesis.ImbalanceFeeRatios = []types.GenesisPairDec{{Pair: pair, Value: sdk.MustNewDecFromStr("0.01")}}
+			genesis.TradeLimitRatios = []types.GenesisPairDec{{Pair: pair, Value: sdk.MustNewDecFromStr("0.1")}}
+			genesis.PausedMarkets = []asset.Pair{pair}
+			genesis.LiquidationSequences = []types.GenesisPairUint64{{Pair: pair, Value: 1}}
+			genesis.LiquidationHistory = []types.GenesisLiquidationRecord{{
+				Pair:              pair,
+				Sequence:          0,
+				TraderAddress:     trader,
+				LiquidatorAddress: trader,
+				LiquidatedSize:    sdk.OneDec(),
+				Price:             sdk.OneDec(),
+				BadDebt:           sdk.NewInt64Coin(denoms.NUSD, 0),
+				Reason:            string(types.ChangeReason_FullLiquidation),
+			}}
+			genesis.FundingSettlementSequences = []types.GenesisPairUint64{{Pair: pair, Value: 1}}
+			genesis.FundingHistory = []types.GenesisFundingSettlement{{
+				Pair:                      pair,
+				Sequence:                  0,
+				PremiumFraction:           sdk.OneDec(),
+				CumulativePremiumFraction: sdk.OneDec(),
+			}}
+			genesis.FundingPayments = []types.AppliedFundingPayment{{
+				Pair:                      pair,
+				Trader:                    trader,
+				Payment:                   sdk.OneDec(),
+				CumulativePremiumFraction: sdk.OneDec(),
+				BlockHeight:               1,
+			}}
+
+			tt.setup(genesis)
+			err := genesis.Validate()
+			if tt.shouldFail {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDefaultMarket(t *testing.T) {
 	// Initialize asset pair
 	pair := asset.NewPair("ubtc", "unusd")
@@ -13,6 +13,20 @@ func isPercent(v sdk.Dec) bool {
 	return v.GTE(sdk.ZeroDec()) && v.LTE(sdk.OneDec())
 }
 
+// Clone returns a deep copy of the market, see AMM.Clone.
+func (market Market) Clone() Market {
+	clone := market
+	clone.MaintenanceMarginRatio = cloneDec(market.MaintenanceMarginRatio)
+	clone.MaxLeverage = cloneDec(market.MaxLeverage)
+	clone.LatestCumulativePremiumFraction = cloneDec(market.LatestCumulativePremiumFraction)
+	clone.ExchangeFeeRatio = cloneDec(market.ExchangeFeeRatio)
+	clone.EcosystemFundFeeRatio = cloneDec(market.EcosystemFundFeeRatio)
+	clone.LiquidationFeeRatio = cloneDec(market.LiquidationFeeRatio)
+	clone.PartialLiquidationRatio = cloneDec(market.PartialLiquidationRatio)
+	clone.MaxFundingRate = cloneDec(market.MaxFundingRate)
+	return clone
+}
+
 func (market Market) Validate() error {
 	if !isPercent(market.MaintenanceMarginRatio) {
 		return fmt.Errorf("maintenance margin ratio ratio must be 0 <= ratio <= 1")
@@ -190,3 +190,17 @@ func TestMarketsAreEqual(t *testing.T) {
 
 	require.NoError(t, MarketsAreEqual(market1, market1))
 }
+
+func TestMarketClone(t *testing.T) {
+	market := Market{}.
+		WithMaintenanceMarginRatio(sdk.NewDecWithPrec(1, 1)).
+		WithMaxLeverage(sdk.NewDec(10)).
+		WithLatestCumulativePremiumFraction(sdk.OneDec())
+	clone := market.Clone()
+	require.Equal(t, market.String(), clone.String())
+
+	clone.LatestCumulativePremiumFraction.MulMut(sdk.NewDec(2))
+	require.Equal(t, sdk.OneDec().String(), market.LatestCumulativePremiumFraction.String())
+	require.Equal(t, sdk.NewDec(2).String(), clone.LatestCumulativePremiumFraction.String())
+	require.True(t, clone.ExchangeFeeRatio.IsNil())
+}
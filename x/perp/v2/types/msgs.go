@@ -7,11 +7,14 @@ import (
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
 )
 
 var (
 	_ sdk.Msg = &MsgRemoveMargin{}
 	_ sdk.Msg = &MsgAddMargin{}
+	_ sdk.Msg = &MsgAddMarginBatch{}
 	_ sdk.Msg = &MsgMarketOrder{}
 	_ sdk.Msg = &MsgMultiLiquidate{}
 	_ sdk.Msg = &MsgClosePosition{}
@@ -22,6 +25,8 @@ var (
 	_ sdk.Msg = &MsgShiftPegMultiplier{}
 	_ sdk.Msg = &MsgShiftSwapInvariant{}
 	_ sdk.Msg = &MsgWithdrawFromPerpFund{}
+	_ sdk.Msg = &MsgSetFundingTopUp{}
+	_ sdk.Msg = &MsgSetMarginMode{}
 )
 
 // ------------------------ MsgRemoveMargin ------------------------
@@ -92,6 +97,50 @@ func (m MsgAddMargin) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+// ------------------------ MsgAddMarginBatch ------------------------
+
+func (m MsgAddMarginBatch) Route() string { return "perp" }
+func (m MsgAddMarginBatch) Type() string  { return "add_margin_batch_msg" }
+
+func (m MsgAddMarginBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+
+	if len(m.Margins) == 0 {
+		return fmt.Errorf("no margins to add")
+	}
+
+	seenPairs := make(map[asset.Pair]bool, len(m.Margins))
+	for _, margin := range m.Margins {
+		if err := margin.Pair.Validate(); err != nil {
+			return err
+		}
+		if seenPairs[margin.Pair] {
+			return fmt.Errorf("duplicate pair in batch: %s", margin.Pair)
+		}
+		seenPairs[margin.Pair] = true
+
+		if !margin.Margin.Amount.IsPositive() {
+			return fmt.Errorf("margin must be positive, not: %v", margin.Margin.Amount.String())
+		}
+	}
+
+	return nil
+}
+
+func (m MsgAddMarginBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddMarginBatch) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
 // ------------------------ MsgMarketOrder ------------------------
 
 func (m MsgMarketOrder) Route() string { return "perp" }
@@ -462,3 +511,521 @@ func (m MsgWithdrawFromPerpFund) GetSigners() []sdk.AccAddress {
 func (m MsgWithdrawFromPerpFund) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+// ------------------------ MsgChangeMinSnapshotIntervalMs ------------------------
+
+func (m MsgChangeMinSnapshotIntervalMs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgChangeMinSnapshotIntervalMs) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeMinSnapshotIntervalMs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeSnapshotRetentionMs ------------------------
+
+func (m MsgChangeSnapshotRetentionMs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgChangeSnapshotRetentionMs) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeSnapshotRetentionMs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeLiquidationTwapLookbackMs ------------------------
+
+func (m MsgChangeLiquidationTwapLookbackMs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgChangeLiquidationTwapLookbackMs) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeLiquidationTwapLookbackMs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeLiquidatorRewardRatio ------------------------
+
+func (m MsgChangeLiquidatorRewardRatio) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if m.LiquidatorRewardRatio.IsNil() || m.LiquidatorRewardRatio.IsNegative() || m.LiquidatorRewardRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquidator reward ratio must be in [0, 1], got: %s", m.LiquidatorRewardRatio)
+	}
+	return nil
+}
+
+func (m MsgChangeLiquidatorRewardRatio) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeLiquidatorRewardRatio) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeFundingRateIntervalMs ------------------------
+
+func (m MsgChangeFundingRateIntervalMs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgChangeFundingRateIntervalMs) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeFundingRateIntervalMs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeMaxPairsPerBlock ------------------------
+
+func (m MsgChangeMaxPairsPerBlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgChangeMaxPairsPerBlock) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeMaxPairsPerBlock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeImbalanceFeeRatio ------------------------
+
+func (m MsgChangeImbalanceFeeRatio) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.ImbalanceFeeRatio.IsNil() || m.ImbalanceFeeRatio.IsNegative() || m.ImbalanceFeeRatio.GTE(sdk.OneDec()) {
+		return fmt.Errorf("imbalance fee ratio must be in [0, 1), got: %s", m.ImbalanceFeeRatio)
+	}
+	return nil
+}
+
+func (m MsgChangeImbalanceFeeRatio) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeImbalanceFeeRatio) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeOpenInterestCap ------------------------
+
+func (m MsgChangeOpenInterestCap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.OpenInterestCap.IsNil() || m.OpenInterestCap.IsNegative() {
+		return fmt.Errorf("open interest cap must be non-negative, got: %s", m.OpenInterestCap)
+	}
+	return nil
+}
+
+func (m MsgChangeOpenInterestCap) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeOpenInterestCap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeFluctuationLimitRatio ------------------------
+
+func (m MsgChangeFluctuationLimitRatio) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.FluctuationLimitRatio.IsNil() || m.FluctuationLimitRatio.IsNegative() || m.FluctuationLimitRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("fluctuation limit ratio must be in [0, 1], got: %s", m.FluctuationLimitRatio)
+	}
+	return nil
+}
+
+func (m MsgChangeFluctuationLimitRatio) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeFluctuationLimitRatio) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeTradeLimitRatio ------------------------
+
+func (m MsgChangeTradeLimitRatio) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.TradeLimitRatio.IsNil() || m.TradeLimitRatio.IsNegative() || m.TradeLimitRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("trade limit ratio must be in [0, 1], got: %s", m.TradeLimitRatio)
+	}
+	return nil
+}
+
+func (m MsgChangeTradeLimitRatio) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeTradeLimitRatio) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeSideTradeLimitRatios ------------------------
+
+func (m MsgChangeSideTradeLimitRatios) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	for _, ratio := range []*sdk.Dec{m.LongTradeLimitRatio, m.ShortTradeLimitRatio} {
+		if ratio != nil && !ratio.IsNil() && (ratio.IsNegative() || ratio.GT(sdk.OneDec())) {
+			return fmt.Errorf("trade limit ratio must be in [0, 1], got: %s", ratio)
+		}
+	}
+	return nil
+}
+
+func (m MsgChangeSideTradeLimitRatios) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeSideTradeLimitRatios) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeCloseAtOracle ------------------------
+
+func (m MsgChangeCloseAtOracle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.CloseAtOracle && (m.MaxOracleSpreadRatio.IsNil() || !m.MaxOracleSpreadRatio.IsPositive() || m.MaxOracleSpreadRatio.GTE(sdk.OneDec())) {
+		return fmt.Errorf("max oracle spread ratio must be in (0, 1), got: %s", m.MaxOracleSpreadRatio)
+	}
+	return nil
+}
+
+func (m MsgChangeCloseAtOracle) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeCloseAtOracle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeMaxOracleSpreadRatio ------------------------
+
+func (m MsgChangeMaxOracleSpreadRatio) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.MaxOracleSpreadRatio.IsNil() || m.MaxOracleSpreadRatio.IsNegative() || m.MaxOracleSpreadRatio.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max oracle spread ratio must be in [0, 1), got: %s", m.MaxOracleSpreadRatio)
+	}
+	return nil
+}
+
+func (m MsgChangeMaxOracleSpreadRatio) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeMaxOracleSpreadRatio) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeSpreadLimitedSwaps ------------------------
+
+func (m MsgChangeSpreadLimitedSwaps) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgChangeSpreadLimitedSwaps) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeSpreadLimitedSwaps) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeMarketPaused ------------------------
+
+func (m MsgChangeMarketPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgChangeMarketPaused) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeMarketPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgChangeMinPositionQuote ------------------------
+
+func (m MsgChangeMinPositionQuote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if m.MinPositionQuote.IsNil() || m.MinPositionQuote.IsNegative() {
+		return fmt.Errorf("min position quote must be non-negative, got: %s", m.MinPositionQuote)
+	}
+	return nil
+}
+
+func (m MsgChangeMinPositionQuote) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgChangeMinPositionQuote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgAddCollateralDenom ------------------------
+
+func (m MsgAddCollateralDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return ErrInvalidCollateral.Wrap(err.Error())
+	}
+	if err := m.OraclePair.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgAddCollateralDenom) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgAddCollateralDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgRemoveCollateralDenom ------------------------
+
+func (m MsgRemoveCollateralDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return ErrInvalidCollateral.Wrap(err.Error())
+	}
+	return nil
+}
+
+func (m MsgRemoveCollateralDenom) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgRemoveCollateralDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgSetFundingTopUp ------------------------
+
+func (m MsgSetFundingTopUp) Route() string { return "perp" }
+func (m MsgSetFundingTopUp) Type() string  { return "set_funding_top_up_msg" }
+
+func (m MsgSetFundingTopUp) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	return m.Pair.Validate()
+}
+
+func (m MsgSetFundingTopUp) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetFundingTopUp) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ------------------------ MsgSetMarginMode ------------------------
+
+func (m MsgSetMarginMode) Route() string { return "perp" }
+func (m MsgSetMarginMode) Type() string  { return "set_margin_mode_msg" }
+
+func (m MsgSetMarginMode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	return MarginMode(m.Mode).Validate()
+}
+
+func (m MsgSetMarginMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMarginMode) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ------------------------ MsgClampMarkToOracleBand ------------------------
+
+func (m MsgClampMarkToOracleBand) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgClampMarkToOracleBand) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgClampMarkToOracleBand) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
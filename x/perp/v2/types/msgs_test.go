@@ -460,6 +460,69 @@ func TestMsgValidateBasic(t *testing.T) {
 			expectErr:     true,
 			expectedError: ErrGeneric.Error(),
 		},
+
+		// MsgSetMarginMode test cases
+		{
+			name: "MsgSetMarginMode: valid",
+			msg: &MsgSetMarginMode{
+				Sender: validSender,
+				Pair:   asset.Pair("valid:pair"),
+				Mode:   string(MarginMode_CROSS),
+			},
+			expectErr: false,
+		},
+		{
+			name: "MsgSetMarginMode: invalid mode",
+			msg: &MsgSetMarginMode{
+				Sender: validSender,
+				Pair:   asset.Pair("valid:pair"),
+				Mode:   "portfolio",
+			},
+			expectErr:     true,
+			expectedError: "invalid margin mode",
+		},
+
+		// MsgAddMarginBatch test cases
+		{
+			name: "MsgAddMarginBatch: valid",
+			msg: &MsgAddMarginBatch{
+				Sender: validSender,
+				Margins: []MsgAddMarginBatch_Margin{
+					{Pair: asset.Pair("valid:pair"), Margin: sdk.NewInt64Coin("unusd", 10)},
+					{Pair: asset.Pair("other:pair"), Margin: sdk.NewInt64Coin("unusd", 10)},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name:          "MsgAddMarginBatch: no margins",
+			msg:           &MsgAddMarginBatch{Sender: validSender},
+			expectErr:     true,
+			expectedError: "no margins to add",
+		},
+		{
+			name: "MsgAddMarginBatch: duplicate pair",
+			msg: &MsgAddMarginBatch{
+				Sender: validSender,
+				Margins: []MsgAddMarginBatch_Margin{
+					{Pair: asset.Pair("valid:pair"), Margin: sdk.NewInt64Coin("unusd", 10)},
+					{Pair: asset.Pair("valid:pair"), Margin: sdk.NewInt64Coin("unusd", 10)},
+				},
+			},
+			expectErr:     true,
+			expectedError: "duplicate pair in batch",
+		},
+		{
+			name: "MsgAddMarginBatch: nonpositive margin",
+			msg: &MsgAddMarginBatch{
+				Sender: validSender,
+				Margins: []MsgAddMarginBatch_Margin{
+					{Pair: asset.Pair("valid:pair"), Margin: sdk.NewInt64Coin("unusd", 0)},
+				},
+			},
+			expectErr:     true,
+			expectedError: "margin must be positive",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -491,6 +554,28 @@ func TestMsg_GetSigners(t *testing.T) {
 		&MsgShiftPegMultiplier{Sender: validSender},
 		&MsgShiftSwapInvariant{Sender: validSender},
 		&MsgWithdrawFromPerpFund{Sender: validSender},
+		&MsgChangeMinSnapshotIntervalMs{Sender: validSender},
+		&MsgChangeSnapshotRetentionMs{Sender: validSender},
+		&MsgChangeLiquidationTwapLookbackMs{Sender: validSender},
+		&MsgChangeLiquidatorRewardRatio{Sender: validSender},
+		&MsgChangeFundingRateIntervalMs{Sender: validSender},
+		&MsgChangeMaxPairsPerBlock{Sender: validSender},
+		&MsgChangeImbalanceFeeRatio{Sender: validSender},
+		&MsgChangeOpenInterestCap{Sender: validSender},
+		&MsgChangeFluctuationLimitRatio{Sender: validSender},
+		&MsgChangeTradeLimitRatio{Sender: validSender},
+		&MsgChangeSideTradeLimitRatios{Sender: validSender},
+		&MsgChangeCloseAtOracle{Sender: validSender},
+		&MsgChangeMaxOracleSpreadRatio{Sender: validSender},
+		&MsgChangeSpreadLimitedSwaps{Sender: validSender},
+		&MsgChangeMarketPaused{Sender: validSender},
+		&MsgChangeMinPositionQuote{Sender: validSender},
+		&MsgAddCollateralDenom{Sender: validSender},
+		&MsgRemoveCollateralDenom{Sender: validSender},
+		&MsgSetFundingTopUp{Sender: validSender},
+		&MsgSetMarginMode{Sender: validSender},
+		&MsgAddMarginBatch{Sender: validSender},
+		&MsgClampMarkToOracleBand{Sender: validSender},
 	}
 	msgInvalidSenderList := []sdk.Msg{
 		&MsgAddMargin{Sender: invalidSender},
@@ -504,6 +589,28 @@ func TestMsg_GetSigners(t *testing.T) {
 		&MsgShiftPegMultiplier{Sender: invalidSender},
 		&MsgShiftSwapInvariant{Sender: invalidSender},
 		&MsgWithdrawFromPerpFund{Sender: invalidSender},
+		&MsgChangeMinSnapshotIntervalMs{Sender: invalidSender},
+		&MsgChangeSnapshotRetentionMs{Sender: invalidSender},
+		&MsgChangeLiquidationTwapLookbackMs{Sender: invalidSender},
+		&MsgChangeLiquidatorRewardRatio{Sender: invalidSender},
+		&MsgChangeFundingRateIntervalMs{Sender: invalidSender},
+		&MsgChangeMaxPairsPerBlock{Sender: invalidSender},
+		&MsgChangeImbalanceFeeRatio{Sender: invalidSender},
+		&MsgChangeOpenInterestCap{Sender: invalidSender},
+		&MsgChangeFluctuationLimitRatio{Sender: invalidSender},
+		&MsgChangeTradeLimitRatio{Sender: invalidSender},
+		&MsgChangeSideTradeLimitRatios{Sender: invalidSender},
+		&MsgChangeCloseAtOracle{Sender: invalidSender},
+		&MsgChangeMaxOracleSpreadRatio{Sender: invalidSender},
+		&MsgChangeSpreadLimitedSwaps{Sender: invalidSender},
+		&MsgChangeMarketPaused{Sender: invalidSender},
+		&MsgChangeMinPositionQuote{Sender: invalidSender},
+		&MsgAddCollateralDenom{Sender: invalidSender},
+		&MsgRemoveCollateralDenom{Sender: invalidSender},
+		&MsgSetFundingTopUp{Sender: invalidSender},
+		&MsgSetMarginMode{Sender: invalidSender},
+		&MsgAddMarginBatch{Sender: invalidSender},
+		&MsgClampMarkToOracleBand{Sender: invalidSender},
 	}
 
 	for _, msg := range msgValidSenderList {
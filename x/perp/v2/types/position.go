@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	fmt "fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,6 +9,84 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/asset"
 )
 
+// MarginMode is how the margin of a position backs the trader's other positions.
+type MarginMode string
+
+const (
+	// MarginMode_ISOLATED positions are backed by their own margin only.
+	MarginMode_ISOLATED MarginMode = "isolated"
+	// MarginMode_CROSS positions share their free collateral with the trader's
+	// other cross margin positions.
+	MarginMode_CROSS MarginMode = "cross"
+)
+
+// Validate returns an error if the margin mode is neither isolated nor cross.
+func (m MarginMode) Validate() error {
+	switch m {
+	case MarginMode_ISOLATED, MarginMode_CROSS:
+		return nil
+	default:
+		return fmt.Errorf("invalid margin mode: %s", m)
+	}
+}
+
+var _ customProtobufType = (*MarginMode)(nil)
+
+func (m *MarginMode) Size() int {
+	return len(*m)
+}
+
+func (m *MarginMode) Marshal() ([]byte, error) {
+	return []byte(*m), nil
+}
+
+func (m *MarginMode) MarshalTo(data []byte) (n int, err error) {
+	return copy(data, *m), nil
+}
+
+func (m *MarginMode) Unmarshal(data []byte) error {
+	*m = MarginMode(data)
+	return nil
+}
+
+func (m *MarginMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(*m)
+}
+
+func (m *MarginMode) UnmarshalJSON(data []byte) error {
+	var s string
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	*m = MarginMode(s)
+	return nil
+}
+
+// NotionalCalcOption is the price a position notional is computed with.
+type NotionalCalcOption string
+
+const (
+	// NotionalCalcOption_SPOT values the position at the current reserves.
+	NotionalCalcOption_SPOT NotionalCalcOption = "spot"
+	// NotionalCalcOption_TWAP values the position at the TWAP of the market's
+	// lookback window.
+	NotionalCalcOption_TWAP NotionalCalcOption = "twap"
+)
+
+// PnLPreferenceOption selects which of the spot and TWAP notionals of a
+// position is used, by the unrealized PnL it gives the trader.
+type PnLPreferenceOption string
+
+const (
+	// PnLPreferenceOption_MAX picks the notional with the higher PnL.
+	PnLPreferenceOption_MAX PnLPreferenceOption = "max"
+	// PnLPreferenceOption_MIN picks the notional with the lower PnL.
+	PnLPreferenceOption_MIN PnLPreferenceOption = "min"
+)
+
 func ZeroPosition(ctx sdk.Context, tokenPair asset.Pair, traderAddr sdk.AccAddress) Position {
 	return Position{
 		TraderAddress:                   traderAddr.String(),
@@ -105,6 +184,12 @@ func (m *Position) Validate() error {
 		return fmt.Errorf("invalid block number")
 	}
 
+	if m.MarginMode != "" {
+		if err := m.MarginMode.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -143,6 +228,11 @@ func (position *Position) WithLastUpdatedBlockNumber(value int64) *Position {
 	return position
 }
 
+func (position *Position) WithMarginMode(value MarginMode) *Position {
+	position.MarginMode = value
+	return position
+}
+
 func (p *Position) copy() *Position {
 	return &Position{
 		TraderAddress:                   p.TraderAddress,
@@ -152,5 +242,6 @@ func (p *Position) copy() *Position {
 		OpenNotional:                    p.OpenNotional,
 		LatestCumulativePremiumFraction: p.LatestCumulativePremiumFraction,
 		LastUpdatedBlockNumber:          p.LastUpdatedBlockNumber,
+		MarginMode:                      p.MarginMode,
 	}
 }
@@ -127,6 +127,10 @@ func TestPositionValidate(t *testing.T) {
 			modifier:      func(p *Position) { p.WithLastUpdatedBlockNumber(-1) },
 			requiredError: "invalid block number",
 		},
+		{
+			modifier:      func(p *Position) { p.WithMarginMode("invalid") },
+			requiredError: "invalid margin mode",
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -697,1112 +697,7547 @@ func (m *QueryCollateralResponse) GetCollateralDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryPositionsRequest)(nil), "nibiru.perp.v2.QueryPositionsRequest")
-	proto.RegisterType((*QueryPositionsResponse)(nil), "nibiru.perp.v2.QueryPositionsResponse")
-	proto.RegisterType((*QueryPositionStoreRequest)(nil), "nibiru.perp.v2.QueryPositionStoreRequest")
-	proto.RegisterType((*QueryPositionStoreResponse)(nil), "nibiru.perp.v2.QueryPositionStoreResponse")
-	proto.RegisterType((*QueryPositionRequest)(nil), "nibiru.perp.v2.QueryPositionRequest")
-	proto.RegisterType((*QueryPositionResponse)(nil), "nibiru.perp.v2.QueryPositionResponse")
-	proto.RegisterType((*QueryModuleAccountsRequest)(nil), "nibiru.perp.v2.QueryModuleAccountsRequest")
-	proto.RegisterType((*QueryModuleAccountsResponse)(nil), "nibiru.perp.v2.QueryModuleAccountsResponse")
-	proto.RegisterType((*AccountWithBalance)(nil), "nibiru.perp.v2.AccountWithBalance")
-	proto.RegisterType((*AmmMarket)(nil), "nibiru.perp.v2.AmmMarket")
-	proto.RegisterType((*QueryMarketsRequest)(nil), "nibiru.perp.v2.QueryMarketsRequest")
-	proto.RegisterType((*QueryMarketsResponse)(nil), "nibiru.perp.v2.QueryMarketsResponse")
-	proto.RegisterType((*QueryCollateralRequest)(nil), "nibiru.perp.v2.QueryCollateralRequest")
-	proto.RegisterType((*QueryCollateralResponse)(nil), "nibiru.perp.v2.QueryCollateralResponse")
+// QueryUncoveredBadDebtsRequest: Request type for the
+// "nibiru.perp.v2.Query/UncoveredBadDebts" gRPC service method
+type QueryUncoveredBadDebtsRequest struct {
 }
 
-func init() { proto.RegisterFile("nibiru/perp/v2/query.proto", fileDescriptor_fc8f0be94fac333f) }
-
-var fileDescriptor_fc8f0be94fac333f = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0x93, 0x90, 0x26, 0x2f, 0x6d, 0x5a, 0xa6, 0x25, 0xf5, 0xba, 0xd1, 0x6e, 0x6a, 0x4a,
-	0x92, 0xb6, 0xaa, 0x4d, 0xb6, 0x5c, 0x40, 0x1c, 0xe8, 0x26, 0xa2, 0xe2, 0xb0, 0x55, 0x6a, 0x84,
-	0x40, 0x70, 0x58, 0xcd, 0x7a, 0x47, 0x1b, 0xab, 0xf6, 0x8c, 0xeb, 0xf1, 0x46, 0xb4, 0x12, 0x1c,
-	0x7a, 0xe0, 0x8c, 0xe8, 0x47, 0xe0, 0x04, 0x9f, 0x81, 0x0f, 0xd0, 0x63, 0x25, 0x2e, 0xa8, 0x87,
-	0x82, 0x12, 0x3e, 0x48, 0xe5, 0xf1, 0x1b, 0xef, 0xda, 0xde, 0x66, 0xa3, 0x9e, 0x76, 0x3c, 0xef,
-	0xf7, 0xde, 0xfb, 0xbd, 0xbf, 0xb3, 0x60, 0xf1, 0xa0, 0x1f, 0x24, 0x23, 0x37, 0x66, 0x49, 0xec,
-	0x1e, 0xb5, 0xdd, 0xc7, 0x23, 0x96, 0x3c, 0x71, 0xe2, 0x44, 0xa4, 0x82, 0xac, 0xe5, 0x32, 0x27,
-	0x93, 0x39, 0x47, 0x6d, 0xeb, 0xca, 0x50, 0x0c, 0x85, 0x12, 0xb9, 0xd9, 0x29, 0x47, 0x59, 0x1b,
-	0x43, 0x21, 0x86, 0x21, 0x73, 0x69, 0x1c, 0xb8, 0x94, 0x73, 0x91, 0xd2, 0x34, 0x10, 0x5c, 0xa2,
-	0xb4, 0x6a, 0x5f, 0xa6, 0x34, 0x65, 0x28, 0x6b, 0xfa, 0x42, 0x46, 0x42, 0xba, 0x7d, 0x2a, 0x99,
-	0x7b, 0xb4, 0xdb, 0x67, 0x29, 0xdd, 0x75, 0x7d, 0x11, 0x70, 0x94, 0xdf, 0x9a, 0x94, 0x2b, 0x62,
-	0x05, 0x2a, 0xa6, 0xc3, 0x80, 0x2b, 0x47, 0x39, 0xd6, 0x76, 0xe1, 0x83, 0x87, 0x19, 0xe2, 0x40,
-	0xc8, 0x40, 0xf9, 0xf7, 0xd8, 0xe3, 0x11, 0x93, 0x29, 0x59, 0x87, 0xa5, 0x34, 0xa1, 0x03, 0x96,
-	0x98, 0xc6, 0xa6, 0xb1, 0xb3, 0xe2, 0xe1, 0x97, 0xed, 0xc3, 0x7a, 0x55, 0x41, 0xc6, 0x82, 0x4b,
-	0x46, 0xbe, 0x82, 0x95, 0x58, 0x5f, 0x9a, 0xc6, 0xe6, 0xc2, 0xce, 0x6a, 0xfb, 0x23, 0xa7, 0x9c,
-	0x0a, 0xa7, 0xa4, 0xaa, 0x35, 0x3b, 0x8b, 0x2f, 0x5e, 0xb7, 0xe6, 0xbc, 0xb1, 0xb6, 0xed, 0x43,
-	0xa3, 0x84, 0xfc, 0x3a, 0x15, 0x09, 0xd3, 0xcc, 0xbe, 0x04, 0x18, 0x87, 0xa1, 0xd8, 0xad, 0xb6,
-	0xb7, 0x9c, 0x3c, 0x66, 0x27, 0x8b, 0xd9, 0xc9, 0x8b, 0x81, 0x31, 0x3b, 0x07, 0x74, 0xa8, 0x75,
-	0xbd, 0x09, 0x4d, 0xfb, 0x77, 0x03, 0xac, 0x69, 0x5e, 0x30, 0x9c, 0xcf, 0xeb, 0xe1, 0x98, 0xd5,
-	0x70, 0xb4, 0x66, 0x2d, 0x02, 0x72, 0xbf, 0x44, 0x72, 0x5e, 0x91, 0xdc, 0x9e, 0x49, 0x32, 0x77,
-	0x5d, 0x62, 0xf9, 0x13, 0x5c, 0xa9, 0x24, 0x2d, 0xcf, 0x42, 0x17, 0x16, 0x63, 0x1a, 0x60, 0x75,
-	0x3a, 0x9f, 0x66, 0xfe, 0x5f, 0xbd, 0x6e, 0xed, 0x0e, 0x83, 0xf4, 0x70, 0xd4, 0x77, 0x7c, 0x11,
-	0xb9, 0x0f, 0x14, 0xd7, 0xbd, 0x43, 0x1a, 0x70, 0x17, 0xbb, 0xe9, 0x47, 0xd7, 0x17, 0x51, 0x24,
-	0xb8, 0x4b, 0xa5, 0x64, 0xa9, 0x73, 0x40, 0x83, 0xc4, 0x53, 0x66, 0x26, 0xca, 0x3d, 0x5f, 0x2a,
-	0xf7, 0xab, 0xf9, 0x4a, 0x83, 0x14, 0xf9, 0xf9, 0x0c, 0x96, 0x75, 0xb8, 0x58, 0x84, 0x59, 0xe9,
-	0x29, 0xf0, 0xe4, 0x07, 0x78, 0x5f, 0x9f, 0x7b, 0x5c, 0x64, 0x3f, 0x34, 0xcc, 0x1d, 0x77, 0x1c,
-	0x8c, 0x64, 0x6b, 0x22, 0x12, 0xec, 0xe7, 0xfc, 0xe7, 0x8e, 0x1c, 0x3c, 0x72, 0xd3, 0x27, 0x31,
-	0x93, 0xce, 0x3e, 0xf3, 0xbd, 0x4b, 0xda, 0xd0, 0x03, 0xb4, 0x43, 0xbe, 0x81, 0xb5, 0x11, 0x4f,
-	0x18, 0x0d, 0x83, 0xa7, 0x6c, 0xd0, 0x8b, 0x79, 0x68, 0x2e, 0xbc, 0x93, 0xe5, 0x0b, 0x63, 0x2b,
-	0x07, 0x3c, 0x24, 0x0f, 0xe1, 0x7c, 0x44, 0x93, 0x61, 0xc0, 0x7b, 0x49, 0x56, 0x19, 0x73, 0xf1,
-	0x9d, 0x8c, 0xae, 0xe6, 0x36, 0xbc, 0xcc, 0x84, 0xbd, 0x81, 0x0d, 0xd8, 0x15, 0x83, 0x51, 0xc8,
-	0xee, 0xf9, 0xbe, 0x18, 0xf1, 0x54, 0x4f, 0xa0, 0xed, 0xc3, 0xb5, 0xa9, 0x52, 0xcc, 0xff, 0x3e,
-	0x2c, 0x53, 0xbc, 0xc3, 0xf6, 0xb4, 0xab, 0xf9, 0x47, 0x9d, 0x6f, 0x83, 0xf4, 0xb0, 0x43, 0x43,
-	0xca, 0x7d, 0x3d, 0x6a, 0x85, 0xa6, 0xfd, 0x87, 0x01, 0xa4, 0x0e, 0x23, 0x04, 0x16, 0x39, 0x8d,
-	0x18, 0xce, 0xbe, 0x3a, 0x13, 0x13, 0xce, 0xd1, 0xc1, 0x20, 0x61, 0x52, 0x62, 0x8f, 0xe8, 0x4f,
-	0xc2, 0xe0, 0x5c, 0x3f, 0x57, 0x34, 0x17, 0x14, 0x93, 0x46, 0xa9, 0xd3, 0x75, 0x8f, 0xef, 0x89,
-	0x80, 0x77, 0x3e, 0xce, 0x08, 0xfc, 0xf9, 0x6f, 0x6b, 0xe7, 0x0c, 0x09, 0xcb, 0x14, 0xa4, 0xa7,
-	0x6d, 0xdb, 0x1c, 0x56, 0xee, 0x45, 0x51, 0x97, 0x26, 0x8f, 0x58, 0x4a, 0x3e, 0x81, 0xa5, 0x48,
-	0x9d, 0xb0, 0xf9, 0xd6, 0xab, 0xc1, 0xe7, 0x38, 0x0c, 0x18, 0xb1, 0xe4, 0x36, 0x2c, 0xd0, 0x28,
-	0xc2, 0x79, 0xbc, 0x5c, 0xcb, 0x57, 0xb7, 0x8b, 0xf8, 0x0c, 0x65, 0xdf, 0x85, 0xcb, 0x79, 0x01,
-	0x94, 0x6e, 0xb1, 0x19, 0x37, 0x60, 0xe5, 0x88, 0x25, 0x32, 0x10, 0x9c, 0x0d, 0x94, 0xf3, 0x65,
-	0x6f, 0x7c, 0x61, 0x7f, 0x87, 0xf3, 0x5a, 0x28, 0x61, 0xb9, 0xbe, 0x80, 0x55, 0x1a, 0x45, 0xbd,
-	0x9c, 0x87, 0xae, 0x58, 0xa3, 0xc6, 0x40, 0xc7, 0x87, 0x3c, 0x80, 0xea, 0x0b, 0x69, 0x9b, 0xb8,
-	0x79, 0xf7, 0x44, 0x18, 0xd2, 0x94, 0x25, 0x34, 0xd4, 0x9d, 0xb2, 0x0f, 0x57, 0x6b, 0x12, 0x74,
-	0x7b, 0x13, 0x2e, 0xf9, 0xc5, 0x6d, 0x6f, 0xc0, 0xb8, 0x88, 0xb0, 0xa8, 0x17, 0xc7, 0xf7, 0xfb,
-	0xd9, 0x75, 0xfb, 0xaf, 0x25, 0x78, 0x4f, 0x99, 0x21, 0x3f, 0xc3, 0x85, 0xd2, 0xcc, 0x93, 0x1b,
-	0x33, 0xf6, 0xb8, 0xa2, 0x61, 0x9d, 0x6d, 0xdb, 0xdb, 0x9b, 0xcf, 0xfe, 0xfe, 0xff, 0xf9, 0xbc,
-	0x45, 0x4c, 0xb7, 0xf2, 0xc6, 0x15, 0xeb, 0xe1, 0x99, 0x01, 0x6b, 0xe5, 0x47, 0x86, 0x9c, 0x6e,
-	0x5b, 0xd7, 0xc6, 0xda, 0x9a, 0x05, 0x43, 0x0e, 0xd7, 0x15, 0x87, 0x6b, 0xa4, 0xf1, 0x36, 0x0e,
-	0x92, 0x3c, 0x37, 0x80, 0xd4, 0x9f, 0x07, 0x72, 0xf3, 0x54, 0x0f, 0x93, 0x0f, 0x95, 0x75, 0xeb,
-	0x2c, 0x50, 0x24, 0xb4, 0xa5, 0x08, 0x6d, 0x92, 0xe6, 0xdb, 0x08, 0xf5, 0xa4, 0x72, 0xff, 0x9b,
-	0x01, 0x6b, 0xe5, 0x85, 0x40, 0xa6, 0xbb, 0x99, 0xba, 0x53, 0xac, 0xdb, 0x67, 0xc2, 0x22, 0xa7,
-	0x6d, 0xc5, 0xe9, 0x3a, 0x69, 0x55, 0x39, 0x45, 0x0a, 0xdf, 0xd3, 0x4b, 0x84, 0x3c, 0x85, 0xf3,
-	0x93, 0x3d, 0x4f, 0x3e, 0x9c, 0xee, 0xa5, 0x34, 0x46, 0xd6, 0x8d, 0xd3, 0x41, 0xc8, 0xa1, 0xa5,
-	0x38, 0x34, 0xc8, 0xd5, 0x1a, 0x07, 0xf4, 0xf5, 0x8b, 0x01, 0x17, 0x2b, 0xcd, 0x4f, 0xa6, 0x77,
-	0x41, 0x6d, 0x6e, 0xac, 0xed, 0x99, 0x38, 0x64, 0x61, 0x2b, 0x16, 0x1b, 0xc4, 0xaa, 0xb2, 0x18,
-	0xcf, 0x50, 0xe7, 0xfe, 0x8b, 0xe3, 0xa6, 0xf1, 0xf2, 0xb8, 0x69, 0xfc, 0x77, 0xdc, 0x34, 0x7e,
-	0x3d, 0x69, 0xce, 0xbd, 0x3c, 0x69, 0xce, 0xfd, 0x73, 0xd2, 0x9c, 0xfb, 0xfe, 0xce, 0xac, 0x47,
-	0x59, 0x5b, 0x53, 0x5b, 0xaf, 0xbf, 0xa4, 0xfe, 0x99, 0xdd, 0x7d, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x87, 0x56, 0xd6, 0x1b, 0x63, 0x0a, 0x00, 0x00,
+func (m *QueryUncoveredBadDebtsRequest) Reset()         { *m = QueryUncoveredBadDebtsRequest{} }
+func (m *QueryUncoveredBadDebtsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUncoveredBadDebtsRequest) ProtoMessage()    {}
+func (*QueryUncoveredBadDebtsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{14}
+}
+func (m *QueryUncoveredBadDebtsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUncoveredBadDebtsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUncoveredBadDebtsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUncoveredBadDebtsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUncoveredBadDebtsRequest.Merge(m, src)
+}
+func (m *QueryUncoveredBadDebtsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUncoveredBadDebtsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUncoveredBadDebtsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryUncoveredBadDebtsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryUncoveredBadDebtsResponse: Response type for the
+// "nibiru.perp.v2.Query/UncoveredBadDebts" gRPC service method
+type QueryUncoveredBadDebtsResponse struct {
+	BadDebts []UncoveredBadDebt `protobuf:"bytes,1,rep,name=bad_debts,json=badDebts,proto3" json:"bad_debts"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// QueryPosition: Query one position on the given market for a user
-	QueryPosition(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
-	// QueryPositions: Query all positions for a user
-	QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
-	// QueryPositionStore queries all of the positions in the KV store.
-	QueryPositionStore(ctx context.Context, in *QueryPositionStoreRequest, opts ...grpc.CallOption) (*QueryPositionStoreResponse, error)
-	// Queries the module accounts for x/perp
-	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
-	// QueryMarkets: Query all markets
-	QueryMarkets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
-	// QueryCollateral: Queries info about the collateral
-	QueryCollateral(ctx context.Context, in *QueryCollateralRequest, opts ...grpc.CallOption) (*QueryCollateralResponse, error)
+func (m *QueryUncoveredBadDebtsResponse) Reset()         { *m = QueryUncoveredBadDebtsResponse{} }
+func (m *QueryUncoveredBadDebtsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUncoveredBadDebtsResponse) ProtoMessage()    {}
+func (*QueryUncoveredBadDebtsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{15}
+}
+func (m *QueryUncoveredBadDebtsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUncoveredBadDebtsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUncoveredBadDebtsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUncoveredBadDebtsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUncoveredBadDebtsResponse.Merge(m, src)
+}
+func (m *QueryUncoveredBadDebtsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUncoveredBadDebtsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUncoveredBadDebtsResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryUncoveredBadDebtsResponse proto.InternalMessageInfo
+
+func (m *QueryUncoveredBadDebtsResponse) GetBadDebts() []UncoveredBadDebt {
+	if m != nil {
+		return m.BadDebts
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryFundingPaymentsRequest: Request type for the
+// "nibiru.perp.v2.Query/FundingPayments" gRPC service method
+type QueryFundingPaymentsRequest struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Trader string                                            `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
 }
 
-func (c *queryClient) QueryPosition(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
-	out := new(QueryPositionResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryPosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFundingPaymentsRequest) Reset()         { *m = QueryFundingPaymentsRequest{} }
+func (m *QueryFundingPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingPaymentsRequest) ProtoMessage()    {}
+func (*QueryFundingPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{16}
+}
+func (m *QueryFundingPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFundingPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingPaymentsRequest.Merge(m, src)
+}
+func (m *QueryFundingPaymentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingPaymentsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error) {
-	out := new(QueryPositionsResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFundingPaymentsRequest proto.InternalMessageInfo
+
+func (m *QueryFundingPaymentsRequest) GetTrader() string {
+	if m != nil {
+		return m.Trader
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryPositionStore(ctx context.Context, in *QueryPositionStoreRequest, opts ...grpc.CallOption) (*QueryPositionStoreResponse, error) {
-	out := new(QueryPositionStoreResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryPositionStore", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryFundingPaymentsResponse: Response type for the
+// "nibiru.perp.v2.Query/FundingPayments" gRPC service method
+type QueryFundingPaymentsResponse struct {
+	FundingPayments []AppliedFundingPayment `protobuf:"bytes,1,rep,name=funding_payments,json=fundingPayments,proto3" json:"funding_payments"`
 }
 
-func (c *queryClient) ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error) {
-	out := new(QueryModuleAccountsResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/ModuleAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFundingPaymentsResponse) Reset()         { *m = QueryFundingPaymentsResponse{} }
+func (m *QueryFundingPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingPaymentsResponse) ProtoMessage()    {}
+func (*QueryFundingPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{17}
+}
+func (m *QueryFundingPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFundingPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingPaymentsResponse.Merge(m, src)
+}
+func (m *QueryFundingPaymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingPaymentsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryMarkets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
-	out := new(QueryMarketsResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFundingPaymentsResponse proto.InternalMessageInfo
+
+func (m *QueryFundingPaymentsResponse) GetFundingPayments() []AppliedFundingPayment {
+	if m != nil {
+		return m.FundingPayments
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryCollateral(ctx context.Context, in *QueryCollateralRequest, opts ...grpc.CallOption) (*QueryCollateralResponse, error) {
-	out := new(QueryCollateralResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryPositionSettlementPreviewRequest: Request type for the
+// "nibiru.perp.v2.Query/PositionSettlementPreview" gRPC service method
+type QueryPositionSettlementPreviewRequest struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Trader string                                            `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+}
+
+func (m *QueryPositionSettlementPreviewRequest) Reset()         { *m = QueryPositionSettlementPreviewRequest{} }
+func (m *QueryPositionSettlementPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionSettlementPreviewRequest) ProtoMessage()    {}
+func (*QueryPositionSettlementPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{18}
+}
+func (m *QueryPositionSettlementPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionSettlementPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionSettlementPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPositionSettlementPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionSettlementPreviewRequest.Merge(m, src)
+}
+func (m *QueryPositionSettlementPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionSettlementPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionSettlementPreviewRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// QueryPosition: Query one position on the given market for a user
-	QueryPosition(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
-	// QueryPositions: Query all positions for a user
-	QueryPositions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
-	// QueryPositionStore queries all of the positions in the KV store.
-	QueryPositionStore(context.Context, *QueryPositionStoreRequest) (*QueryPositionStoreResponse, error)
-	// Queries the module accounts for x/perp
-	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
-	// QueryMarkets: Query all markets
-	QueryMarkets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
-	// QueryCollateral: Queries info about the collateral
-	QueryCollateral(context.Context, *QueryCollateralRequest) (*QueryCollateralResponse, error)
+var xxx_messageInfo_QueryPositionSettlementPreviewRequest proto.InternalMessageInfo
+
+func (m *QueryPositionSettlementPreviewRequest) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryPositionSettlementPreviewResponse: Response type for the
+// "nibiru.perp.v2.Query/PositionSettlementPreview" gRPC service method
+type QueryPositionSettlementPreviewResponse struct {
+	// The margin left in the position after its funding payment, never negative
+	Margin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
+	// The amount by which the funding payment exceeds the margin
+	BadDebt github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bad_debt"`
+	// The funding payment, paid by the trader if positive and received if
+	// negative
+	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
+	// The market's latest cumulative premium fraction the payment is settled at
+	LatestCumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=latest_cumulative_premium_fraction,json=latestCumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_cumulative_premium_fraction"`
 }
 
-func (*UnimplementedQueryServer) QueryPosition(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPosition not implemented")
+func (m *QueryPositionSettlementPreviewResponse) Reset() {
+	*m = QueryPositionSettlementPreviewResponse{}
 }
-func (*UnimplementedQueryServer) QueryPositions(ctx context.Context, req *QueryPositionsRequest) (*QueryPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPositions not implemented")
+func (m *QueryPositionSettlementPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionSettlementPreviewResponse) ProtoMessage()    {}
+func (*QueryPositionSettlementPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{19}
 }
-func (*UnimplementedQueryServer) QueryPositionStore(ctx context.Context, req *QueryPositionStoreRequest) (*QueryPositionStoreResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPositionStore not implemented")
+func (m *QueryPositionSettlementPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
+func (m *QueryPositionSettlementPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionSettlementPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryMarkets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryMarkets not implemented")
+func (m *QueryPositionSettlementPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionSettlementPreviewResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryCollateral(ctx context.Context, req *QueryCollateralRequest) (*QueryCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCollateral not implemented")
+func (m *QueryPositionSettlementPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionSettlementPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionSettlementPreviewResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryPositionSettlementPreviewResponse proto.InternalMessageInfo
+
+// QueryAllMarkPricesRequest: Request type for the
+// "nibiru.perp.v2.Query/AllMarkPrices" gRPC service method
+type QueryAllMarkPricesRequest struct {
 }
 
-func _Query_QueryPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPositionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Query/QueryPosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPosition(ctx, req.(*QueryPositionRequest))
+func (m *QueryAllMarkPricesRequest) Reset()         { *m = QueryAllMarkPricesRequest{} }
+func (m *QueryAllMarkPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMarkPricesRequest) ProtoMessage()    {}
+func (*QueryAllMarkPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{20}
+}
+func (m *QueryAllMarkPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMarkPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMarkPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllMarkPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMarkPricesRequest.Merge(m, src)
+}
+func (m *QueryAllMarkPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMarkPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMarkPricesRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Query/QueryPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPositions(ctx, req.(*QueryPositionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryAllMarkPricesRequest proto.InternalMessageInfo
+
+// QueryAllMarkPricesResponse: Response type for the
+// "nibiru.perp.v2.Query/AllMarkPrices" gRPC service method
+type QueryAllMarkPricesResponse struct {
+	// mark_prices: the mark price of each enabled market, ordered by pair
+	MarkPrices []PairMarkPrice `protobuf:"bytes,1,rep,name=mark_prices,json=markPrices,proto3" json:"mark_prices"`
 }
 
-func _Query_QueryPositionStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPositionStoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPositionStore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Query/QueryPositionStore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPositionStore(ctx, req.(*QueryPositionStoreRequest))
+func (m *QueryAllMarkPricesResponse) Reset()         { *m = QueryAllMarkPricesResponse{} }
+func (m *QueryAllMarkPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMarkPricesResponse) ProtoMessage()    {}
+func (*QueryAllMarkPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{21}
+}
+func (m *QueryAllMarkPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMarkPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMarkPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllMarkPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMarkPricesResponse.Merge(m, src)
+}
+func (m *QueryAllMarkPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMarkPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMarkPricesResponse.DiscardUnknown(m)
 }
 
-func _Query_ModuleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Query/ModuleAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleAccounts(ctx, req.(*QueryModuleAccountsRequest))
+var xxx_messageInfo_QueryAllMarkPricesResponse proto.InternalMessageInfo
+
+func (m *QueryAllMarkPricesResponse) GetMarkPrices() []PairMarkPrice {
+	if m != nil {
+		return m.MarkPrices
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Query/QueryMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryMarkets(ctx, req.(*QueryMarketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// PairMarkPrice: The instantaneous mark price of the AMM of a pair
+type PairMarkPrice struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// mark_price: zero when the AMM has no reserves
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
 }
 
-func _Query_QueryCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCollateralRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCollateral(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Query/QueryCollateral",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCollateral(ctx, req.(*QueryCollateralRequest))
+func (m *PairMarkPrice) Reset()         { *m = PairMarkPrice{} }
+func (m *PairMarkPrice) String() string { return proto.CompactTextString(m) }
+func (*PairMarkPrice) ProtoMessage()    {}
+func (*PairMarkPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{22}
+}
+func (m *PairMarkPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairMarkPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairMarkPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PairMarkPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairMarkPrice.Merge(m, src)
+}
+func (m *PairMarkPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairMarkPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairMarkPrice.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.perp.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryPosition",
-			Handler:    _Query_QueryPosition_Handler,
-		},
-		{
-			MethodName: "QueryPositions",
-			Handler:    _Query_QueryPositions_Handler,
-		},
-		{
-			MethodName: "QueryPositionStore",
-			Handler:    _Query_QueryPositionStore_Handler,
-		},
-		{
-			MethodName: "ModuleAccounts",
-			Handler:    _Query_ModuleAccounts_Handler,
-		},
-		{
-			MethodName: "QueryMarkets",
-			Handler:    _Query_QueryMarkets_Handler,
-		},
-		{
-			MethodName: "QueryCollateral",
-			Handler:    _Query_QueryCollateral_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nibiru/perp/v2/query.proto",
+var xxx_messageInfo_PairMarkPrice proto.InternalMessageInfo
+
+// QueryMarketConfigRequest: Request type for the
+// "nibiru.perp.v2.Query/MarketConfig" gRPC service method
+type QueryMarketConfigRequest struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
 }
 
-func (m *QueryPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMarketConfigRequest) Reset()         { *m = QueryMarketConfigRequest{} }
+func (m *QueryMarketConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketConfigRequest) ProtoMessage()    {}
+func (*QueryMarketConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{23}
+}
+func (m *QueryMarketConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryMarketConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketConfigRequest.Merge(m, src)
+}
+func (m *QueryMarketConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketConfigRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMarketConfigRequest proto.InternalMessageInfo
+
+// QueryMarketConfigResponse: Response type for the
+// "nibiru.perp.v2.Query/MarketConfig" gRPC service method
+type QueryMarketConfigResponse struct {
+	Config MarketConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
 }
 
-func (m *QueryPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryMarketConfigResponse) Reset()         { *m = QueryMarketConfigResponse{} }
+func (m *QueryMarketConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketConfigResponse) ProtoMessage()    {}
+func (*QueryMarketConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{24}
+}
+func (m *QueryMarketConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMarketConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketConfigResponse.Merge(m, src)
+}
+func (m *QueryMarketConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketConfigResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMarketConfigResponse proto.InternalMessageInfo
+
+func (m *QueryMarketConfigResponse) GetConfig() MarketConfig {
+	if m != nil {
+		return m.Config
 	}
-	return dAtA[:n], nil
+	return MarketConfig{}
 }
 
-func (m *QueryPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketConfig: The full configuration of the market of a pair along with the
+// current state of its AMM
+type MarketConfig struct {
+	// market: margin ratios, max leverage, fees and funding settings
+	Market Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+	// amm: current reserves and price multiplier
+	Amm       AMM                                    `protobuf:"bytes,2,opt,name=amm,proto3" json:"amm"`
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// trade_limit_ratio: zero when the pair has no trade limit
+	TradeLimitRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=trade_limit_ratio,json=tradeLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trade_limit_ratio"`
+	// trade_limit_ratio_long: trade limit ratio of long swaps, trade_limit_ratio
+	// unless overridden
+	TradeLimitRatioLong github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trade_limit_ratio_long,json=tradeLimitRatioLong,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trade_limit_ratio_long"`
+	// trade_limit_ratio_short: trade limit ratio of short swaps,
+	// trade_limit_ratio unless overridden
+	TradeLimitRatioShort github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=trade_limit_ratio_short,json=tradeLimitRatioShort,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trade_limit_ratio_short"`
+	// fluctuation_limit_ratio: zero when the pair has no fluctuation limit
+	FluctuationLimitRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=fluctuation_limit_ratio,json=fluctuationLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fluctuation_limit_ratio"`
+	// max_oracle_spread_ratio: zero when the pair does not close at the oracle
+	// price
+	MaxOracleSpreadRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_oracle_spread_ratio,json=maxOracleSpreadRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_oracle_spread_ratio"`
+}
+
+func (m *MarketConfig) Reset()         { *m = MarketConfig{} }
+func (m *MarketConfig) String() string { return proto.CompactTextString(m) }
+func (*MarketConfig) ProtoMessage()    {}
+func (*MarketConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{25}
+}
+func (m *MarketConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MarketConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MarketConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketConfig.Merge(m, src)
+}
+func (m *MarketConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketConfig.DiscardUnknown(m)
 }
 
-func (m *QueryPositionStoreRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketConfig proto.InternalMessageInfo
+
+func (m *MarketConfig) GetMarket() Market {
+	if m != nil {
+		return m.Market
 	}
-	return dAtA[:n], nil
+	return Market{}
 }
 
-func (m *QueryPositionStoreRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketConfig) GetAmm() AMM {
+	if m != nil {
+		return m.Amm
+	}
+	return AMM{}
 }
 
-func (m *QueryPositionStoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryReserveSnapshotsRequest: Request type for the
+// "nibiru.perp.v2.Query/ReserveSnapshots" gRPC service method
+type QueryReserveSnapshotsRequest struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// from_ms: start of the time range in unix milliseconds, inclusive
+	FromMs int64 `protobuf:"varint,2,opt,name=from_ms,json=fromMs,proto3" json:"from_ms,omitempty"`
+	// to_ms: end of the time range in unix milliseconds, inclusive
+	ToMs int64 `protobuf:"varint,3,opt,name=to_ms,json=toMs,proto3" json:"to_ms,omitempty"`
+	// pagination defines a paginated request
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPositionStoreResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReserveSnapshotsRequest) Reset()         { *m = QueryReserveSnapshotsRequest{} }
+func (m *QueryReserveSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveSnapshotsRequest) ProtoMessage()    {}
+func (*QueryReserveSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{26}
+}
+func (m *QueryReserveSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserveSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveSnapshotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryPositionStoreResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReserveSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveSnapshotsRequest.Merge(m, src)
+}
+func (m *QueryReserveSnapshotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveSnapshotsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPositionStoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryReserveSnapshotsRequest proto.InternalMessageInfo
+
+func (m *QueryReserveSnapshotsRequest) GetFromMs() int64 {
+	if m != nil {
+		return m.FromMs
 	}
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *QueryReserveSnapshotsRequest) GetToMs() int64 {
+	if m != nil {
+		return m.ToMs
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryPositionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReserveSnapshotsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPositionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReserveSnapshotsResponse: Response type for the
+// "nibiru.perp.v2.Query/ReserveSnapshots" gRPC service method
+type QueryReserveSnapshotsResponse struct {
+	Snapshots []ReserveSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	// pagination defines a paginated response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryReserveSnapshotsResponse) Reset()         { *m = QueryReserveSnapshotsResponse{} }
+func (m *QueryReserveSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveSnapshotsResponse) ProtoMessage()    {}
+func (*QueryReserveSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{27}
+}
+func (m *QueryReserveSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserveSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveSnapshotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryReserveSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveSnapshotsResponse.Merge(m, src)
+}
+func (m *QueryReserveSnapshotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveSnapshotsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReserveSnapshotsResponse proto.InternalMessageInfo
+
+func (m *QueryReserveSnapshotsResponse) GetSnapshots() []ReserveSnapshot {
+	if m != nil {
+		return m.Snapshots
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReserveSnapshotsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MarginRatio.Size()
-		i -= size
-		if _, err := m.MarginRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.UnrealizedPnl.Size()
-		i -= size
-		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+// QueryTraderPositionsRequest: Request type for the
+// "nibiru.perp.v2.Query/TraderPositions" gRPC service method
+type QueryTraderPositionsRequest struct {
+	Trader string `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty"`
+	// pagination defines a paginated request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTraderPositionsRequest) Reset()         { *m = QueryTraderPositionsRequest{} }
+func (m *QueryTraderPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraderPositionsRequest) ProtoMessage()    {}
+func (*QueryTraderPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{28}
+}
+func (m *QueryTraderPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraderPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraderPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTraderPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraderPositionsRequest.Merge(m, src)
+}
+func (m *QueryTraderPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraderPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraderPositionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryModuleAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTraderPositionsRequest proto.InternalMessageInfo
+
+func (m *QueryTraderPositionsRequest) GetTrader() string {
+	if m != nil {
+		return m.Trader
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryModuleAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTraderPositionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryModuleAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryTraderPositionsResponse: Response type for the
+// "nibiru.perp.v2.Query/TraderPositions" gRPC service method
+type QueryTraderPositionsResponse struct {
+	// positions: ordered by pair and then by market version
+	Positions []Position `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	// pagination defines a paginated response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTraderPositionsResponse) Reset()         { *m = QueryTraderPositionsResponse{} }
+func (m *QueryTraderPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraderPositionsResponse) ProtoMessage()    {}
+func (*QueryTraderPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{29}
+}
+func (m *QueryTraderPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraderPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraderPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTraderPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraderPositionsResponse.Merge(m, src)
 }
-
-func (m *QueryModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryTraderPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraderPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraderPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraderPositionsResponse proto.InternalMessageInfo
+
+func (m *QueryTraderPositionsResponse) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AccountWithBalance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTraderPositionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AccountWithBalance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMarketsPageRequest: Request type for the
+// "nibiru.perp.v2.Query/MarketsPage" gRPC service method
+type QueryMarketsPageRequest struct {
+	// versioned: include disabled markets, as in QueryMarketsRequest
+	Versioned bool `protobuf:"varint,1,opt,name=versioned,proto3" json:"versioned,omitempty"`
+	// pagination defines a paginated request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *AccountWithBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryMarketsPageRequest) Reset()         { *m = QueryMarketsPageRequest{} }
+func (m *QueryMarketsPageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketsPageRequest) ProtoMessage()    {}
+func (*QueryMarketsPageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{30}
+}
+func (m *QueryMarketsPageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketsPageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketsPageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryMarketsPageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketsPageRequest.Merge(m, src)
+}
+func (m *QueryMarketsPageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketsPageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketsPageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketsPageRequest proto.InternalMessageInfo
+
+func (m *QueryMarketsPageRequest) GetVersioned() bool {
+	if m != nil {
+		return m.Versioned
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *AmmMarket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMarketsPageRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AmmMarket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMarketsPageResponse: Response type for the
+// "nibiru.perp.v2.Query/MarketsPage" gRPC service method
+type QueryMarketsPageResponse struct {
+	AmmMarkets []AmmMarket `protobuf:"bytes,1,rep,name=amm_markets,json=ammMarkets,proto3" json:"amm_markets"`
+	// pagination defines a paginated response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *AmmMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amm.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMarketsPageResponse) Reset()         { *m = QueryMarketsPageResponse{} }
+func (m *QueryMarketsPageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketsPageResponse) ProtoMessage()    {}
+func (*QueryMarketsPageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{31}
+}
+func (m *QueryMarketsPageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketsPageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketsPageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryMarketsPageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketsPageResponse.Merge(m, src)
+}
+func (m *QueryMarketsPageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketsPageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketsPageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketsPageResponse proto.InternalMessageInfo
+
+func (m *QueryMarketsPageResponse) GetAmmMarkets() []AmmMarket {
+	if m != nil {
+		return m.AmmMarkets
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryMarketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMarketsPageResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMarkIndexDivergenceRequest: Request type for the
+// "nibiru.perp.v2.Query/MarkIndexDivergence" gRPC service method
+type QueryMarkIndexDivergenceRequest struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// lookback_ms: how far back from the block time the snapshots are taken
+	LookbackMs int64 `protobuf:"varint,2,opt,name=lookback_ms,json=lookbackMs,proto3" json:"lookback_ms,omitempty"`
 }
 
-func (m *QueryMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Versioned {
-		i--
-		if m.Versioned {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryMarkIndexDivergenceRequest) Reset()         { *m = QueryMarkIndexDivergenceRequest{} }
+func (m *QueryMarkIndexDivergenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarkIndexDivergenceRequest) ProtoMessage()    {}
+func (*QueryMarkIndexDivergenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{32}
+}
+func (m *QueryMarkIndexDivergenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarkIndexDivergenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarkIndexDivergenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMarkIndexDivergenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarkIndexDivergenceRequest.Merge(m, src)
+}
+func (m *QueryMarkIndexDivergenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarkIndexDivergenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarkIndexDivergenceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMarkIndexDivergenceRequest proto.InternalMessageInfo
+
+func (m *QueryMarkIndexDivergenceRequest) GetLookbackMs() int64 {
+	if m != nil {
+		return m.LookbackMs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMarkIndexDivergenceResponse: Response type for the
+// "nibiru.perp.v2.Query/MarkIndexDivergence" gRPC service method
+type QueryMarkIndexDivergenceResponse struct {
+	// series: one point per reserve snapshot, oldest first
+	Series []MarkIndexDivergence `protobuf:"bytes,1,rep,name=series,proto3" json:"series"`
 }
 
-func (m *QueryMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AmmMarkets) > 0 {
-		for iNdEx := len(m.AmmMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AmmMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMarkIndexDivergenceResponse) Reset()         { *m = QueryMarkIndexDivergenceResponse{} }
+func (m *QueryMarkIndexDivergenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarkIndexDivergenceResponse) ProtoMessage()    {}
+func (*QueryMarkIndexDivergenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{33}
+}
+func (m *QueryMarkIndexDivergenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarkIndexDivergenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarkIndexDivergenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMarkIndexDivergenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarkIndexDivergenceResponse.Merge(m, src)
+}
+func (m *QueryMarkIndexDivergenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarkIndexDivergenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarkIndexDivergenceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCollateralRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMarkIndexDivergenceResponse proto.InternalMessageInfo
+
+func (m *QueryMarkIndexDivergenceResponse) GetSeries() []MarkIndexDivergence {
+	if m != nil {
+		return m.Series
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarkIndexDivergence: The difference between the mark price of a reserve
+// snapshot and the index price, at the time of the snapshot
+type MarkIndexDivergence struct {
+	TimestampMs int64                                  `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	MarkPrice   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// divergence: mark_price - index price
+	Divergence github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=divergence,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"divergence"`
 }
 
-func (m *QueryCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarkIndexDivergence) Reset()         { *m = MarkIndexDivergence{} }
+func (m *MarkIndexDivergence) String() string { return proto.CompactTextString(m) }
+func (*MarkIndexDivergence) ProtoMessage()    {}
+func (*MarkIndexDivergence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{34}
 }
-
-func (m *QueryCollateralResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarkIndexDivergence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkIndexDivergence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkIndexDivergence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarkIndexDivergence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkIndexDivergence.Merge(m, src)
+}
+func (m *MarkIndexDivergence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkIndexDivergence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkIndexDivergence.DiscardUnknown(m)
 }
 
-func (m *QueryCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralDenom) > 0 {
-		i -= len(m.CollateralDenom)
-		copy(dAtA[i:], m.CollateralDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MarkIndexDivergence proto.InternalMessageInfo
+
+func (m *MarkIndexDivergence) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// QueryRoundingAccrualRequest: Request type for the
+// "nibiru.perp.v2.Query/RoundingAccrual" gRPC service method
+type QueryRoundingAccrualRequest struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
 }
-func (m *QueryPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryRoundingAccrualRequest) Reset()         { *m = QueryRoundingAccrualRequest{} }
+func (m *QueryRoundingAccrualRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoundingAccrualRequest) ProtoMessage()    {}
+func (*QueryRoundingAccrualRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{35}
+}
+func (m *QueryRoundingAccrualRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoundingAccrualRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoundingAccrualRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRoundingAccrualRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoundingAccrualRequest.Merge(m, src)
+}
+func (m *QueryRoundingAccrualRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoundingAccrualRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoundingAccrualRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryRoundingAccrualRequest proto.InternalMessageInfo
+
+// QueryRoundingAccrualResponse: Response type for the
+// "nibiru.perp.v2.Query/RoundingAccrual" gRPC service method
+type QueryRoundingAccrualResponse struct {
+	// rounding_accrual: never negative, since margin transfers round in favor
+	// of the vault
+	RoundingAccrual github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rounding_accrual,json=roundingAccrual,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rounding_accrual"`
+}
+
+func (m *QueryRoundingAccrualResponse) Reset()         { *m = QueryRoundingAccrualResponse{} }
+func (m *QueryRoundingAccrualResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoundingAccrualResponse) ProtoMessage()    {}
+func (*QueryRoundingAccrualResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{36}
+}
+func (m *QueryRoundingAccrualResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoundingAccrualResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoundingAccrualResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRoundingAccrualResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoundingAccrualResponse.Merge(m, src)
+}
+func (m *QueryRoundingAccrualResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoundingAccrualResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoundingAccrualResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPositionStoreRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var xxx_messageInfo_QueryRoundingAccrualResponse proto.InternalMessageInfo
+
+// QueryPositionNotionalRequest: Request type for the
+// "nibiru.perp.v2.Query/PositionNotional" gRPC service method
+type QueryPositionNotionalRequest struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Trader string                                            `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+	// calc_option: "spot" or "twap", defaults to "spot" when empty
+	CalcOption string `protobuf:"bytes,3,opt,name=calc_option,json=calcOption,proto3" json:"calc_option,omitempty"`
 }
 
-func (m *QueryPositionStoreResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPositionNotionalRequest) Reset()         { *m = QueryPositionNotionalRequest{} }
+func (m *QueryPositionNotionalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionNotionalRequest) ProtoMessage()    {}
+func (*QueryPositionNotionalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{37}
+}
+func (m *QueryPositionNotionalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionNotionalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionNotionalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryPositionNotionalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionNotionalRequest.Merge(m, src)
+}
+func (m *QueryPositionNotionalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionNotionalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionNotionalRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPositionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryPositionNotionalRequest proto.InternalMessageInfo
+
+func (m *QueryPositionNotionalRequest) GetTrader() string {
+	if m != nil {
+		return m.Trader
 	}
-	return n
+	return ""
 }
 
-func (m *QueryPositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPositionNotionalRequest) GetCalcOption() string {
+	if m != nil {
+		return m.CalcOption
 	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.UnrealizedPnl.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.MarginRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryModuleAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryPositionNotionalResponse: Response type for the
+// "nibiru.perp.v2.Query/PositionNotional" gRPC service method
+type QueryPositionNotionalResponse struct {
+	PositionNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=position_notional,json=positionNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_notional"`
 }
 
-func (m *QueryModuleAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPositionNotionalResponse) Reset()         { *m = QueryPositionNotionalResponse{} }
+func (m *QueryPositionNotionalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionNotionalResponse) ProtoMessage()    {}
+func (*QueryPositionNotionalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{38}
+}
+func (m *QueryPositionNotionalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionNotionalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionNotionalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPositionNotionalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionNotionalResponse.Merge(m, src)
+}
+func (m *QueryPositionNotionalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionNotionalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionNotionalResponse.DiscardUnknown(m)
 }
 
-func (m *AccountWithBalance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryPositionNotionalResponse proto.InternalMessageInfo
+
+// QueryTwapRequest: Request type for the "nibiru.perp.v2.Query/Twap" gRPC
+// service method
+type QueryTwapRequest struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// lookback_ms: how far back from the block time the TWAP is taken over
+	LookbackMs int64 `protobuf:"varint,2,opt,name=lookback_ms,json=lookbackMs,proto3" json:"lookback_ms,omitempty"`
+}
+
+func (m *QueryTwapRequest) Reset()         { *m = QueryTwapRequest{} }
+func (m *QueryTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapRequest) ProtoMessage()    {}
+func (*QueryTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{39}
+}
+func (m *QueryTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapRequest.Merge(m, src)
+}
+func (m *QueryTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapRequest.DiscardUnknown(m)
 }
 
-func (m *AmmMarket) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTwapRequest proto.InternalMessageInfo
+
+func (m *QueryTwapRequest) GetLookbackMs() int64 {
+	if m != nil {
+		return m.LookbackMs
 	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Amm.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryMarketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryTwapResponse: Response type for the "nibiru.perp.v2.Query/Twap" gRPC
+// service method
+type QueryTwapResponse struct {
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+}
+
+func (m *QueryTwapResponse) Reset()         { *m = QueryTwapResponse{} }
+func (m *QueryTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapResponse) ProtoMessage()    {}
+func (*QueryTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc8f0be94fac333f, []int{40}
+}
+func (m *QueryTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Versioned {
-		n += 2
+}
+func (m *QueryTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapResponse.Merge(m, src)
+}
+func (m *QueryTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTwapResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryPositionsRequest)(nil), "nibiru.perp.v2.QueryPositionsRequest")
+	proto.RegisterType((*QueryPositionsResponse)(nil), "nibiru.perp.v2.QueryPositionsResponse")
+	proto.RegisterType((*QueryPositionStoreRequest)(nil), "nibiru.perp.v2.QueryPositionStoreRequest")
+	proto.RegisterType((*QueryPositionStoreResponse)(nil), "nibiru.perp.v2.QueryPositionStoreResponse")
+	proto.RegisterType((*QueryPositionRequest)(nil), "nibiru.perp.v2.QueryPositionRequest")
+	proto.RegisterType((*QueryPositionResponse)(nil), "nibiru.perp.v2.QueryPositionResponse")
+	proto.RegisterType((*QueryModuleAccountsRequest)(nil), "nibiru.perp.v2.QueryModuleAccountsRequest")
+	proto.RegisterType((*QueryModuleAccountsResponse)(nil), "nibiru.perp.v2.QueryModuleAccountsResponse")
+	proto.RegisterType((*AccountWithBalance)(nil), "nibiru.perp.v2.AccountWithBalance")
+	proto.RegisterType((*AmmMarket)(nil), "nibiru.perp.v2.AmmMarket")
+	proto.RegisterType((*QueryMarketsRequest)(nil), "nibiru.perp.v2.QueryMarketsRequest")
+	proto.RegisterType((*QueryMarketsResponse)(nil), "nibiru.perp.v2.QueryMarketsResponse")
+	proto.RegisterType((*QueryCollateralRequest)(nil), "nibiru.perp.v2.QueryCollateralRequest")
+	proto.RegisterType((*QueryCollateralResponse)(nil), "nibiru.perp.v2.QueryCollateralResponse")
+	proto.RegisterType((*QueryUncoveredBadDebtsRequest)(nil), "nibiru.perp.v2.QueryUncoveredBadDebtsRequest")
+	proto.RegisterType((*QueryUncoveredBadDebtsResponse)(nil), "nibiru.perp.v2.QueryUncoveredBadDebtsResponse")
+	proto.RegisterType((*QueryFundingPaymentsRequest)(nil), "nibiru.perp.v2.QueryFundingPaymentsRequest")
+	proto.RegisterType((*QueryFundingPaymentsResponse)(nil), "nibiru.perp.v2.QueryFundingPaymentsResponse")
+	proto.RegisterType((*QueryPositionSettlementPreviewRequest)(nil), "nibiru.perp.v2.QueryPositionSettlementPreviewRequest")
+	proto.RegisterType((*QueryPositionSettlementPreviewResponse)(nil), "nibiru.perp.v2.QueryPositionSettlementPreviewResponse")
+	proto.RegisterType((*QueryAllMarkPricesRequest)(nil), "nibiru.perp.v2.QueryAllMarkPricesRequest")
+	proto.RegisterType((*QueryAllMarkPricesResponse)(nil), "nibiru.perp.v2.QueryAllMarkPricesResponse")
+	proto.RegisterType((*PairMarkPrice)(nil), "nibiru.perp.v2.PairMarkPrice")
+	proto.RegisterType((*QueryMarketConfigRequest)(nil), "nibiru.perp.v2.QueryMarketConfigRequest")
+	proto.RegisterType((*QueryMarketConfigResponse)(nil), "nibiru.perp.v2.QueryMarketConfigResponse")
+	proto.RegisterType((*MarketConfig)(nil), "nibiru.perp.v2.MarketConfig")
+	proto.RegisterType((*QueryReserveSnapshotsRequest)(nil), "nibiru.perp.v2.QueryReserveSnapshotsRequest")
+	proto.RegisterType((*QueryReserveSnapshotsResponse)(nil), "nibiru.perp.v2.QueryReserveSnapshotsResponse")
+	proto.RegisterType((*QueryTraderPositionsRequest)(nil), "nibiru.perp.v2.QueryTraderPositionsRequest")
+	proto.RegisterType((*QueryTraderPositionsResponse)(nil), "nibiru.perp.v2.QueryTraderPositionsResponse")
+	proto.RegisterType((*QueryMarketsPageRequest)(nil), "nibiru.perp.v2.QueryMarketsPageRequest")
+	proto.RegisterType((*QueryMarketsPageResponse)(nil), "nibiru.perp.v2.QueryMarketsPageResponse")
+	proto.RegisterType((*QueryMarkIndexDivergenceRequest)(nil), "nibiru.perp.v2.QueryMarkIndexDivergenceRequest")
+	proto.RegisterType((*QueryMarkIndexDivergenceResponse)(nil), "nibiru.perp.v2.QueryMarkIndexDivergenceResponse")
+	proto.RegisterType((*MarkIndexDivergence)(nil), "nibiru.perp.v2.MarkIndexDivergence")
+	proto.RegisterType((*QueryRoundingAccrualRequest)(nil), "nibiru.perp.v2.QueryRoundingAccrualRequest")
+	proto.RegisterType((*QueryRoundingAccrualResponse)(nil), "nibiru.perp.v2.QueryRoundingAccrualResponse")
+	proto.RegisterType((*QueryPositionNotionalRequest)(nil), "nibiru.perp.v2.QueryPositionNotionalRequest")
+	proto.RegisterType((*QueryPositionNotionalResponse)(nil), "nibiru.perp.v2.QueryPositionNotionalResponse")
+	proto.RegisterType((*QueryTwapRequest)(nil), "nibiru.perp.v2.QueryTwapRequest")
+	proto.RegisterType((*QueryTwapResponse)(nil), "nibiru.perp.v2.QueryTwapResponse")
+}
+
+func init() { proto.RegisterFile("nibiru/perp/v2/query.proto", fileDescriptor_fc8f0be94fac333f) }
+
+var fileDescriptor_fc8f0be94fac333f = []byte{
+	// 2118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0xdb, 0xc8,
+	0x19, 0x0f, 0x63, 0xc7, 0x8f, 0xcf, 0x89, 0xed, 0x8c, 0x1d, 0x5b, 0x66, 0x6c, 0xc9, 0xa6, 0xe3,
+	0x47, 0x1e, 0x96, 0x36, 0x4e, 0x5b, 0xa0, 0x45, 0x0f, 0xf5, 0x03, 0x5e, 0x04, 0x58, 0x65, 0xb5,
+	0x4a, 0xb7, 0x69, 0xb7, 0x07, 0x62, 0x44, 0x8d, 0x65, 0xc2, 0x24, 0x87, 0x4b, 0x52, 0x4a, 0xb2,
+	0x7d, 0x01, 0x41, 0xd1, 0x4b, 0x81, 0x76, 0xb7, 0x7b, 0x6b, 0x0f, 0x3d, 0xf4, 0x01, 0xb4, 0xe8,
+	0x9e, 0xfb, 0x0f, 0xf4, 0xb0, 0xc7, 0x05, 0x7a, 0x68, 0xb1, 0x87, 0x6d, 0x91, 0xf4, 0x0f, 0x29,
+	0x38, 0xfc, 0x48, 0x89, 0x2f, 0x4b, 0x55, 0x9c, 0x74, 0x4f, 0xa6, 0x66, 0xbe, 0xc7, 0xef, 0x9b,
+	0xf9, 0xbe, 0xf9, 0x1e, 0x09, 0xc8, 0x96, 0xde, 0xd0, 0x9d, 0x76, 0xc5, 0x66, 0x8e, 0x5d, 0xe9,
+	0xec, 0x56, 0xde, 0x6f, 0x33, 0xe7, 0x69, 0xd9, 0x76, 0xb8, 0xc7, 0xc9, 0x74, 0xb0, 0x57, 0xf6,
+	0xf7, 0xca, 0x9d, 0x5d, 0x79, 0xbe, 0xc5, 0x5b, 0x5c, 0x6c, 0x55, 0xfc, 0xaf, 0x80, 0x4a, 0x5e,
+	0x6e, 0x71, 0xde, 0x32, 0x58, 0x85, 0xda, 0x7a, 0x85, 0x5a, 0x16, 0xf7, 0xa8, 0xa7, 0x73, 0xcb,
+	0xc5, 0xdd, 0xa4, 0x7c, 0xd7, 0xa3, 0x1e, 0xc3, 0xbd, 0xa2, 0xc6, 0x5d, 0x93, 0xbb, 0x95, 0x06,
+	0x75, 0x59, 0xa5, 0x73, 0xb7, 0xc1, 0x3c, 0x7a, 0xb7, 0xa2, 0x71, 0xdd, 0xc2, 0xfd, 0x5b, 0xbd,
+	0xfb, 0x02, 0x58, 0x44, 0x65, 0xd3, 0x96, 0x6e, 0x09, 0x45, 0x01, 0xad, 0x52, 0x81, 0x6b, 0xef,
+	0xf8, 0x14, 0x35, 0xee, 0xea, 0x42, 0x7f, 0x9d, 0xbd, 0xdf, 0x66, 0xae, 0x47, 0x16, 0x60, 0xcc,
+	0x73, 0x68, 0x93, 0x39, 0x05, 0x69, 0x55, 0xda, 0x9e, 0xac, 0xe3, 0x2f, 0x45, 0x83, 0x85, 0x24,
+	0x83, 0x6b, 0x73, 0xcb, 0x65, 0xe4, 0x3e, 0x4c, 0xda, 0xe1, 0x62, 0x41, 0x5a, 0x1d, 0xd9, 0x9e,
+	0xda, 0xdd, 0x28, 0xc7, 0x8f, 0xa2, 0x1c, 0x63, 0x0d, 0x39, 0xf7, 0x47, 0x3f, 0xfd, 0xa2, 0x74,
+	0xa1, 0xde, 0xe5, 0x56, 0x34, 0x58, 0x8a, 0x51, 0x3e, 0xf4, 0xb8, 0xc3, 0x42, 0x64, 0x47, 0x00,
+	0x5d, 0x33, 0x04, 0xba, 0xa9, 0xdd, 0xcd, 0x72, 0x60, 0x73, 0xd9, 0xb7, 0xb9, 0x1c, 0x5c, 0x06,
+	0xda, 0x5c, 0xae, 0xd1, 0x56, 0xc8, 0x5b, 0xef, 0xe1, 0x54, 0x7e, 0x27, 0x81, 0x9c, 0xa5, 0x05,
+	0xcd, 0xf9, 0x66, 0xda, 0x9c, 0x42, 0xd2, 0x9c, 0x90, 0x33, 0x65, 0x01, 0x79, 0x33, 0x06, 0xf2,
+	0xa2, 0x00, 0xb9, 0xd5, 0x17, 0x64, 0xa0, 0x3a, 0x86, 0xf2, 0x47, 0x30, 0x9f, 0x38, 0xb4, 0xe0,
+	0x14, 0xaa, 0x30, 0x6a, 0x53, 0x1d, 0x6f, 0x67, 0xff, 0xeb, 0xbe, 0xfe, 0xcf, 0xbf, 0x28, 0xdd,
+	0x6d, 0xe9, 0xde, 0x49, 0xbb, 0x51, 0xd6, 0xb8, 0x59, 0x79, 0x20, 0xb0, 0x1e, 0x9c, 0x50, 0xdd,
+	0xaa, 0xa0, 0x37, 0x3d, 0xa9, 0x68, 0xdc, 0x34, 0xb9, 0x55, 0xa1, 0xae, 0xcb, 0xbc, 0x72, 0x8d,
+	0xea, 0x4e, 0x5d, 0x88, 0xe9, 0xb9, 0xee, 0x8b, 0xb1, 0xeb, 0xfe, 0xfc, 0x62, 0xc2, 0x41, 0xa2,
+	0xf3, 0xf9, 0x06, 0x4c, 0x84, 0xe6, 0xe2, 0x25, 0xf4, 0x3b, 0x9e, 0x88, 0x9e, 0x7c, 0x1f, 0xae,
+	0x86, 0xdf, 0xaa, 0xc5, 0xfd, 0x3f, 0xd4, 0x08, 0x14, 0xef, 0x97, 0xd1, 0x92, 0xcd, 0x1e, 0x4b,
+	0xd0, 0x9f, 0x83, 0x3f, 0x3b, 0x6e, 0xf3, 0xb4, 0xe2, 0x3d, 0xb5, 0x99, 0x5b, 0x3e, 0x64, 0x5a,
+	0x7d, 0x36, 0x14, 0xf4, 0x00, 0xe5, 0x90, 0x77, 0x61, 0xba, 0x6d, 0x39, 0x8c, 0x1a, 0xfa, 0x07,
+	0xac, 0xa9, 0xda, 0x96, 0x51, 0x18, 0x19, 0x4a, 0xf2, 0x95, 0xae, 0x94, 0x9a, 0x65, 0x90, 0x77,
+	0xe0, 0xb2, 0x49, 0x9d, 0x96, 0x6e, 0xa9, 0x8e, 0x7f, 0x33, 0x85, 0xd1, 0xa1, 0x84, 0x4e, 0x05,
+	0x32, 0xea, 0xbe, 0x08, 0x65, 0x19, 0x1d, 0xb0, 0xca, 0x9b, 0x6d, 0x83, 0xed, 0x69, 0x1a, 0x6f,
+	0x5b, 0x5e, 0x18, 0x81, 0x8a, 0x06, 0xd7, 0x33, 0x77, 0xf1, 0xfc, 0x0f, 0x61, 0x82, 0xe2, 0x1a,
+	0xba, 0xa7, 0x92, 0x3c, 0x7f, 0xe4, 0x79, 0xa4, 0x7b, 0x27, 0xfb, 0xd4, 0xa0, 0x96, 0x16, 0x86,
+	0x5a, 0xc4, 0xa9, 0xfc, 0x49, 0x02, 0x92, 0x26, 0x23, 0x04, 0x46, 0x2d, 0x6a, 0x32, 0x8c, 0x7d,
+	0xf1, 0x4d, 0x0a, 0x30, 0x4e, 0x9b, 0x4d, 0x87, 0xb9, 0x2e, 0xfa, 0x48, 0xf8, 0x93, 0x30, 0x18,
+	0x6f, 0x04, 0x8c, 0x85, 0x11, 0x81, 0x64, 0x29, 0xe6, 0xe9, 0xa1, 0x8f, 0x1f, 0x70, 0xdd, 0xda,
+	0x7f, 0xc3, 0x07, 0xf0, 0xe7, 0x7f, 0x95, 0xb6, 0x07, 0x38, 0x30, 0x9f, 0xc1, 0xad, 0x87, 0xb2,
+	0x15, 0x0b, 0x26, 0xf7, 0x4c, 0xb3, 0x4a, 0x9d, 0x53, 0xe6, 0x91, 0xaf, 0xc0, 0x98, 0x29, 0xbe,
+	0xd0, 0xf9, 0x16, 0x92, 0xc6, 0x07, 0x74, 0x68, 0x30, 0xd2, 0x92, 0xdb, 0x30, 0x42, 0x4d, 0x13,
+	0xe3, 0x71, 0x2e, 0x75, 0x5e, 0xd5, 0x2a, 0xd2, 0xfb, 0x54, 0xca, 0x3d, 0x98, 0x0b, 0x2e, 0x40,
+	0xf0, 0x46, 0x2f, 0xe3, 0x32, 0x4c, 0x76, 0x98, 0xe3, 0xea, 0xdc, 0x62, 0x4d, 0xa1, 0x7c, 0xa2,
+	0xde, 0x5d, 0x50, 0xbe, 0x0b, 0xf3, 0x71, 0x26, 0xbc, 0xae, 0x6f, 0xc1, 0x14, 0x35, 0x4d, 0x35,
+	0xc0, 0x11, 0xde, 0xd8, 0x52, 0x0a, 0x41, 0x68, 0x1f, 0xe2, 0x00, 0x1a, 0x2e, 0xb8, 0x4a, 0x01,
+	0x5f, 0xde, 0x03, 0x6e, 0x18, 0xd4, 0x63, 0x0e, 0x35, 0x42, 0x4f, 0x39, 0x84, 0xc5, 0xd4, 0x0e,
+	0xaa, 0xbd, 0x09, 0xb3, 0x5a, 0xb4, 0xaa, 0x36, 0x99, 0xc5, 0x4d, 0xbc, 0xd4, 0x99, 0xee, 0xfa,
+	0xa1, 0xbf, 0xac, 0x94, 0x60, 0x45, 0x48, 0x79, 0xd7, 0xd2, 0x78, 0x87, 0x39, 0xac, 0xb9, 0x4f,
+	0x9b, 0x87, 0xac, 0xd1, 0x75, 0x48, 0x06, 0xc5, 0x3c, 0x02, 0xd4, 0x76, 0x00, 0x93, 0x0d, 0xda,
+	0x54, 0x9b, 0xac, 0x11, 0x99, 0xb8, 0x9a, 0x34, 0x31, 0xc9, 0x1d, 0xba, 0x64, 0x03, 0x85, 0x29,
+	0x3f, 0x95, 0xd0, 0xf1, 0x8f, 0xda, 0x56, 0x53, 0xb7, 0x5a, 0x35, 0xfa, 0xd4, 0x64, 0xdd, 0xb8,
+	0x78, 0x5d, 0x2f, 0x5f, 0x07, 0x96, 0xb3, 0x51, 0xa0, 0xad, 0xdf, 0x81, 0xd9, 0xe3, 0x60, 0x4b,
+	0xb5, 0x71, 0x2f, 0x2f, 0xeb, 0xed, 0xd9, 0xb6, 0xa1, 0xb3, 0x66, 0x5c, 0x12, 0xda, 0x3d, 0x73,
+	0x1c, 0x97, 0xaf, 0xfc, 0x42, 0x82, 0x8d, 0x78, 0x5a, 0x62, 0x9e, 0x67, 0x30, 0x7f, 0xb3, 0xe6,
+	0xb0, 0x8e, 0xce, 0x1e, 0xbf, 0xe6, 0x83, 0xf8, 0x68, 0x04, 0x36, 0xfb, 0x01, 0xc2, 0x33, 0x39,
+	0x12, 0x41, 0xd9, 0xd2, 0xad, 0x82, 0x34, 0xd4, 0xeb, 0x88, 0xdc, 0xe4, 0x3e, 0x4c, 0x84, 0x7e,
+	0x34, 0x64, 0x5a, 0x18, 0x47, 0x77, 0x22, 0x8f, 0x60, 0x26, 0x71, 0x4d, 0x43, 0xa6, 0x83, 0xe9,
+	0xf8, 0x45, 0x91, 0x1f, 0x80, 0xe2, 0x87, 0x8f, 0xeb, 0xa9, 0x5a, 0xdb, 0x6c, 0x1b, 0xd4, 0xd3,
+	0x3b, 0x4c, 0xb5, 0x1d, 0x66, 0xea, 0x6d, 0x53, 0x3d, 0x76, 0xa8, 0x26, 0x32, 0xe3, 0x70, 0x59,
+	0xa2, 0x14, 0x48, 0x3e, 0x88, 0x04, 0xd7, 0x02, 0xb9, 0x47, 0x28, 0x56, 0xb9, 0x8e, 0x05, 0xd2,
+	0x9e, 0x61, 0xf8, 0xcf, 0x43, 0xcd, 0xd1, 0x35, 0x16, 0xc5, 0x69, 0x03, 0xe4, 0xac, 0xcd, 0x28,
+	0x6f, 0xf8, 0x39, 0xe8, 0x54, 0xb5, 0xc5, 0x32, 0xba, 0xec, 0x4a, 0x2a, 0x75, 0x53, 0xdd, 0x89,
+	0x98, 0xc3, 0xc7, 0xc8, 0x8c, 0xa4, 0x29, 0x9f, 0x48, 0x70, 0x25, 0x46, 0x73, 0xde, 0xde, 0x58,
+	0x05, 0xe8, 0xc2, 0x1c, 0xd2, 0x09, 0x26, 0x23, 0xc0, 0x8a, 0x0e, 0x85, 0x9e, 0x67, 0xf9, 0x80,
+	0x5b, 0xc7, 0x7a, 0xeb, 0xd5, 0xc4, 0x91, 0xf2, 0x08, 0x96, 0x32, 0x54, 0x45, 0x55, 0xd3, 0x98,
+	0x26, 0x56, 0x30, 0x6d, 0x2d, 0x67, 0xa7, 0xad, 0x80, 0x2b, 0x4c, 0x5e, 0x01, 0x87, 0xf2, 0xd7,
+	0x4b, 0x70, 0xb9, 0x77, 0xfb, 0x35, 0xe4, 0xc0, 0xc4, 0x35, 0x8c, 0xbc, 0xe4, 0x35, 0x90, 0xf7,
+	0xe0, 0xaa, 0x78, 0x55, 0x54, 0x43, 0x37, 0x75, 0xef, 0xa5, 0x2a, 0xa9, 0x19, 0x21, 0xe8, 0x2d,
+	0x5f, 0x8e, 0xa8, 0xa6, 0x88, 0x06, 0x0b, 0x29, 0xd9, 0xaa, 0xc1, 0xad, 0x56, 0xe1, 0xd2, 0x50,
+	0x0a, 0xe6, 0x12, 0x0a, 0xde, 0xe2, 0x56, 0x8b, 0x30, 0x58, 0x4c, 0x2b, 0x71, 0x4f, 0xb8, 0xe3,
+	0x15, 0xc6, 0x86, 0xd2, 0x32, 0x9f, 0xd0, 0xf2, 0xd0, 0x97, 0x45, 0x8e, 0x61, 0xf1, 0xd8, 0x68,
+	0x6b, 0x5e, 0x9b, 0x8a, 0x1a, 0xb9, 0xf7, 0xb4, 0xc6, 0x87, 0x52, 0x73, 0xad, 0x47, 0x5c, 0xcf,
+	0x99, 0x31, 0x58, 0x34, 0xe9, 0x13, 0x95, 0x3b, 0x54, 0x33, 0x98, 0xea, 0xda, 0x0e, 0xa3, 0x4d,
+	0xd4, 0x33, 0x31, 0x9c, 0x39, 0x26, 0x7d, 0xf2, 0xb6, 0x90, 0xf6, 0x50, 0x08, 0x0b, 0x0a, 0xdd,
+	0x17, 0x12, 0x26, 0xd3, 0x3a, 0x73, 0x99, 0xd3, 0x61, 0x0f, 0x2d, 0x6a, 0xbb, 0x27, 0xfc, 0x95,
+	0xe5, 0xf4, 0x45, 0x18, 0x3f, 0x76, 0xb8, 0xa9, 0x9a, 0x41, 0xa9, 0x3a, 0x52, 0x1f, 0xf3, 0x7f,
+	0x56, 0x5d, 0x32, 0x07, 0x97, 0x3c, 0xee, 0x2f, 0x8f, 0x88, 0xe5, 0x51, 0x8f, 0x57, 0xdd, 0x44,
+	0x43, 0x39, 0x3a, 0x74, 0x43, 0xf9, 0x89, 0x04, 0x2b, 0x39, 0x56, 0x76, 0xeb, 0x23, 0x37, 0x5c,
+	0xc4, 0x97, 0xb7, 0x94, 0x0c, 0xc0, 0x04, 0x73, 0xd8, 0x5a, 0x46, 0x7c, 0xe7, 0xd9, 0x5a, 0x06,
+	0x75, 0xd6, 0xb7, 0x45, 0x9e, 0x1f, 0x74, 0x02, 0x40, 0x8e, 0x32, 0xf4, 0x0f, 0x73, 0x5c, 0x7f,
+	0x08, 0x9d, 0x22, 0xa5, 0xff, 0xcb, 0xd5, 0x81, 0xff, 0x04, 0xab, 0x6b, 0xac, 0xc3, 0x7b, 0xcc,
+	0x39, 0xbb, 0x15, 0x38, 0xcf, 0x83, 0x2a, 0xa4, 0x11, 0x9c, 0x57, 0x5f, 0x71, 0x7e, 0x07, 0xf5,
+	0x91, 0x04, 0xa5, 0x08, 0xe7, 0x7d, 0xab, 0xc9, 0x9e, 0x1c, 0xea, 0x1d, 0xe6, 0xb4, 0x98, 0xa5,
+	0xb1, 0x57, 0x14, 0xe8, 0x25, 0x98, 0x32, 0x38, 0x3f, 0x6d, 0x50, 0xed, 0xb4, 0x1b, 0xec, 0x10,
+	0x2e, 0x55, 0x5d, 0x85, 0xc1, 0x6a, 0x3e, 0x24, 0x3c, 0xc2, 0x3d, 0x18, 0x73, 0x99, 0xa3, 0x47,
+	0xc5, 0xd0, 0x7a, 0x56, 0x1a, 0x4d, 0x30, 0x87, 0x39, 0x35, 0x60, 0x54, 0xfe, 0x21, 0xc1, 0x5c,
+	0x06, 0x15, 0x59, 0x83, 0xcb, 0x9e, 0x6e, 0x32, 0xd7, 0xa3, 0xa6, 0xed, 0x03, 0x94, 0x04, 0xc0,
+	0xa9, 0x68, 0xad, 0xea, 0x9e, 0x73, 0xa1, 0x43, 0x1e, 0x00, 0x34, 0x23, 0xfd, 0x43, 0x26, 0xec,
+	0x1e, 0x09, 0x8a, 0x81, 0x8f, 0x44, 0x9d, 0x07, 0xe5, 0xef, 0x9e, 0xa6, 0x39, 0x6d, 0x6a, 0xbc,
+	0x9a, 0xfb, 0x54, 0x9e, 0xc2, 0x72, 0xb6, 0x36, 0xbc, 0xaa, 0xef, 0xc1, 0xac, 0x83, 0x5b, 0x2a,
+	0x0d, 0xf6, 0x86, 0x6c, 0x35, 0x66, 0x9c, 0xb8, 0x0a, 0xe5, 0x8f, 0xe1, 0x73, 0x54, 0x4b, 0x0c,
+	0x94, 0x5e, 0x6f, 0xbb, 0xe5, 0xbb, 0xb4, 0x46, 0x0d, 0x4d, 0xe5, 0xb6, 0x88, 0x47, 0x71, 0x83,
+	0x75, 0xf0, 0x97, 0xde, 0x16, 0x2b, 0xca, 0x0f, 0x61, 0x25, 0x07, 0x27, 0x1e, 0x52, 0xe6, 0x74,
+	0x4d, 0x3a, 0x9f, 0xe9, 0x9a, 0xf2, 0x4c, 0x82, 0xd9, 0xe0, 0xd5, 0x7e, 0x4c, 0xed, 0xff, 0x57,
+	0x54, 0x3f, 0x82, 0xab, 0x3d, 0x18, 0xd0, 0xec, 0x7d, 0x18, 0xf5, 0x1e, 0x53, 0x7b, 0x48, 0x4b,
+	0x05, 0xef, 0xee, 0xaf, 0xaf, 0xc1, 0x25, 0x21, 0x99, 0xfc, 0x18, 0xae, 0xc4, 0x4e, 0x99, 0xdc,
+	0xe8, 0x33, 0xcb, 0x16, 0x27, 0x21, 0x0f, 0x36, 0xf1, 0x56, 0x56, 0x9f, 0xfd, 0xfd, 0x3f, 0x1f,
+	0x5f, 0x94, 0x49, 0xa1, 0x92, 0x98, 0xf3, 0x47, 0x23, 0xd2, 0x67, 0x12, 0x4c, 0xc7, 0x78, 0x5d,
+	0x72, 0xb6, 0xec, 0x30, 0x6f, 0xcb, 0x9b, 0xfd, 0xc8, 0x10, 0xc3, 0x9a, 0xc0, 0x70, 0x9d, 0x2c,
+	0xe5, 0x61, 0x70, 0xc9, 0xc7, 0x12, 0x90, 0xf4, 0x88, 0x9c, 0xdc, 0x3c, 0x53, 0x43, 0xef, 0xb0,
+	0x5e, 0xbe, 0x35, 0x08, 0x29, 0x02, 0xda, 0x14, 0x80, 0x56, 0x49, 0x31, 0x0f, 0x90, 0xea, 0x0a,
+	0xf5, 0xbf, 0x92, 0x60, 0x3a, 0x3e, 0x14, 0x25, 0xd9, 0x6a, 0x32, 0xe7, 0xaa, 0xf2, 0xed, 0x81,
+	0x68, 0x11, 0xd3, 0x96, 0xc0, 0xb4, 0x46, 0x4a, 0x49, 0x4c, 0xa6, 0xa0, 0x57, 0xc3, 0x41, 0x2a,
+	0xf9, 0x00, 0x2e, 0xf7, 0xe6, 0x68, 0xb2, 0x9e, 0xad, 0x25, 0x36, 0x4a, 0x94, 0x6f, 0x9c, 0x4d,
+	0x84, 0x18, 0x4a, 0x02, 0xc3, 0x12, 0x59, 0x4c, 0x61, 0x40, 0x5d, 0x3f, 0x93, 0x60, 0x26, 0x31,
+	0x00, 0x24, 0xd9, 0x5e, 0x90, 0x9a, 0x1d, 0xca, 0x5b, 0x7d, 0xe9, 0x10, 0x85, 0x22, 0x50, 0x2c,
+	0x13, 0x39, 0x89, 0xa2, 0x3b, 0x47, 0x24, 0xbf, 0x97, 0x70, 0x46, 0x99, 0x1a, 0x11, 0x92, 0x9d,
+	0x4c, 0x3d, 0x79, 0xb3, 0x46, 0xb9, 0x3c, 0x28, 0x39, 0xa2, 0xbb, 0x2d, 0xd0, 0x6d, 0x90, 0xf5,
+	0x24, 0xba, 0x76, 0xc8, 0xa2, 0x46, 0x93, 0x49, 0xf2, 0x1b, 0x09, 0x87, 0xb4, 0x89, 0xd9, 0x1e,
+	0xc9, 0x76, 0x8d, 0xec, 0x39, 0xa4, 0x7c, 0x67, 0x30, 0x62, 0x04, 0xb8, 0x2d, 0x00, 0x2a, 0x64,
+	0x35, 0x09, 0x30, 0x39, 0x44, 0x24, 0x7f, 0x93, 0xa0, 0x18, 0x8f, 0x92, 0xe4, 0xbc, 0x8d, 0x7c,
+	0xf5, 0xec, 0xa8, 0xca, 0x19, 0x18, 0xca, 0x5f, 0xfb, 0x5f, 0xd9, 0x10, 0xfb, 0x3d, 0x81, 0x7d,
+	0x87, 0xdc, 0xce, 0x0f, 0xcc, 0x88, 0x57, 0xb5, 0x03, 0x66, 0xf2, 0x61, 0xf8, 0x76, 0xc4, 0xc6,
+	0x50, 0x39, 0x6f, 0x47, 0xd6, 0x1c, 0x4b, 0xbe, 0x35, 0x08, 0x29, 0x42, 0x5c, 0x17, 0x10, 0x57,
+	0xc8, 0xf5, 0xac, 0x18, 0xc1, 0x59, 0x17, 0xf9, 0xa5, 0x84, 0x79, 0x23, 0x36, 0x45, 0xd9, 0x3e,
+	0x23, 0x08, 0x63, 0x83, 0x22, 0xf9, 0xe6, 0x00, 0x94, 0x88, 0x67, 0x43, 0xe0, 0x29, 0x91, 0x95,
+	0xec, 0x98, 0x55, 0x83, 0x91, 0x0e, 0xf9, 0xad, 0x84, 0xff, 0xbc, 0x96, 0x6c, 0x19, 0x49, 0xb6,
+	0x77, 0xe5, 0xf4, 0xcf, 0xf2, 0xce, 0x80, 0xd4, 0x88, 0xee, 0xa6, 0x40, 0xb7, 0x4e, 0xd6, 0x92,
+	0xe8, 0x9c, 0x80, 0x43, 0xed, 0x76, 0x9b, 0x51, 0xac, 0x24, 0xba, 0xb4, 0x9c, 0x58, 0xc9, 0xee,
+	0x25, 0xe5, 0x3b, 0x83, 0x11, 0xf7, 0x8b, 0x95, 0xa0, 0x44, 0x52, 0xbb, 0x09, 0xea, 0xe7, 0x61,
+	0x35, 0xd2, 0xd3, 0x1a, 0x91, 0xad, 0xb3, 0x5e, 0xd5, 0x9e, 0x26, 0x4b, 0xde, 0xee, 0x4f, 0x88,
+	0x88, 0x6e, 0x08, 0x44, 0x45, 0xb2, 0x9c, 0xf3, 0x04, 0xab, 0xb6, 0xaf, 0xf8, 0x2f, 0xbd, 0x8d,
+	0x5a, 0xb2, 0x15, 0xa8, 0xe4, 0x2a, 0xcb, 0x6e, 0x95, 0xe4, 0x37, 0x06, 0x67, 0x40, 0x94, 0x3b,
+	0x02, 0xe5, 0x16, 0xd9, 0xc8, 0x0c, 0x02, 0xdd, 0xe7, 0x52, 0xbb, 0xa5, 0x7d, 0xf7, 0x6a, 0x13,
+	0xd5, 0x76, 0xce, 0xd5, 0x66, 0x77, 0x00, 0xf2, 0x9d, 0xc1, 0x88, 0xfb, 0x5d, 0x6d, 0xb2, 0xac,
+	0xef, 0x86, 0x46, 0xb2, 0xce, 0xcd, 0x09, 0x8d, 0x9c, 0xb2, 0x5d, 0xde, 0x19, 0x90, 0xba, 0x5f,
+	0x68, 0xa4, 0x4a, 0x6a, 0x62, 0xc1, 0x64, 0x54, 0x85, 0x92, 0xd5, 0x6c, 0x0f, 0xef, 0x16, 0xc9,
+	0xf2, 0xda, 0x19, 0x14, 0xa8, 0x7c, 0x59, 0x28, 0x5f, 0x20, 0xf3, 0x29, 0xc7, 0x7f, 0x4c, 0xed,
+	0xfd, 0x37, 0x3f, 0x7d, 0x5e, 0x94, 0x3e, 0x7b, 0x5e, 0x94, 0xfe, 0xfd, 0xbc, 0x28, 0x7d, 0xf8,
+	0xa2, 0x78, 0xe1, 0xb3, 0x17, 0xc5, 0x0b, 0xff, 0x7c, 0x51, 0xbc, 0xf0, 0xde, 0x4e, 0xbf, 0x4a,
+	0x3b, 0x92, 0xe3, 0xd7, 0xbb, 0x8d, 0x31, 0xf1, 0x7f, 0x3f, 0xee, 0xfd, 0x77, 0x00, 0x37, 0xb1,
+	0xe1, 0x41, 0xc5, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// QueryPosition: Query one position on the given market for a user
+	QueryPosition(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
+	// QueryPositions: Query all positions for a user
+	QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
+	// QueryPositionStore queries all of the positions in the KV store.
+	QueryPositionStore(ctx context.Context, in *QueryPositionStoreRequest, opts ...grpc.CallOption) (*QueryPositionStoreResponse, error)
+	// Queries the module accounts for x/perp
+	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
+	// QueryMarkets: Query all markets
+	QueryMarkets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
+	// QueryCollateral: Queries info about the collateral
+	QueryCollateral(ctx context.Context, in *QueryCollateralRequest, opts ...grpc.CallOption) (*QueryCollateralResponse, error)
+	// QueryUncoveredBadDebts: Queries the bad debt the perp fund couldn't cover
+	QueryUncoveredBadDebts(ctx context.Context, in *QueryUncoveredBadDebtsRequest, opts ...grpc.CallOption) (*QueryUncoveredBadDebtsResponse, error)
+	// QueryFundingPayments: Queries the funding payments a trader's position
+	// realized in a market, oldest first
+	QueryFundingPayments(ctx context.Context, in *QueryFundingPaymentsRequest, opts ...grpc.CallOption) (*QueryFundingPaymentsResponse, error)
+	// QueryPositionSettlementPreview: Queries the margin, bad debt and funding
+	// payment a trader's position would have if its funding payment were
+	// settled now
+	QueryPositionSettlementPreview(ctx context.Context, in *QueryPositionSettlementPreviewRequest, opts ...grpc.CallOption) (*QueryPositionSettlementPreviewResponse, error)
+	// QueryAllMarkPrices: Queries the spot mark price of the AMM of every
+	// enabled market
+	QueryAllMarkPrices(ctx context.Context, in *QueryAllMarkPricesRequest, opts ...grpc.CallOption) (*QueryAllMarkPricesResponse, error)
+	// QueryMarketConfig: Queries the full configuration of the current market
+	// of a pair, and the reserves and mark price of its AMM
+	QueryMarketConfig(ctx context.Context, in *QueryMarketConfigRequest, opts ...grpc.CallOption) (*QueryMarketConfigResponse, error)
+	// QueryReserveSnapshots: Queries the reserve snapshots of a pair taken in a
+	// time range, oldest first, so that the TWAP can be recomputed off-chain
+	QueryReserveSnapshots(ctx context.Context, in *QueryReserveSnapshotsRequest, opts ...grpc.CallOption) (*QueryReserveSnapshotsResponse, error)
+	// QueryTraderPositions: Queries a page of the positions of a trader on every
+	// pair and market version
+	QueryTraderPositions(ctx context.Context, in *QueryTraderPositionsRequest, opts ...grpc.CallOption) (*QueryTraderPositionsResponse, error)
+	// QueryMarketsPage: Queries a page of the markets, ordered by pair and then
+	// by version
+	QueryMarketsPage(ctx context.Context, in *QueryMarketsPageRequest, opts ...grpc.CallOption) (*QueryMarketsPageResponse, error)
+	// QueryMarkIndexDivergence: Queries the divergence of the mark price of a
+	// pair from its index price over the reserve snapshots of a lookback window
+	QueryMarkIndexDivergence(ctx context.Context, in *QueryMarkIndexDivergenceRequest, opts ...grpc.CallOption) (*QueryMarkIndexDivergenceResponse, error)
+	// QueryRoundingAccrual: Queries the margin the vault has gained on a pair
+	// from rounding trader margin transfers to whole coins
+	QueryRoundingAccrual(ctx context.Context, in *QueryRoundingAccrualRequest, opts ...grpc.CallOption) (*QueryRoundingAccrualResponse, error)
+	// QueryPositionNotional: Queries the notional value of a trader's position,
+	// priced with either the spot reserves or the TWAP of its market
+	QueryPositionNotional(ctx context.Context, in *QueryPositionNotionalRequest, opts ...grpc.CallOption) (*QueryPositionNotionalResponse, error)
+	// QueryTwap: Queries the time-weighted average spot price of a pair over a
+	// lookback window
+	QueryTwap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryPosition(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
+	out := new(QueryPositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error) {
+	out := new(QueryPositionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AmmMarkets) > 0 {
-		for _, e := range m.AmmMarkets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryPositionStore(ctx context.Context, in *QueryPositionStoreRequest, opts ...grpc.CallOption) (*QueryPositionStoreResponse, error) {
+	out := new(QueryPositionStoreResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryPositionStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error) {
+	out := new(QueryModuleAccountsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/ModuleAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryMarkets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
+	out := new(QueryMarketsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCollateral(ctx context.Context, in *QueryCollateralRequest, opts ...grpc.CallOption) (*QueryCollateralResponse, error) {
+	out := new(QueryCollateralResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryUncoveredBadDebts(ctx context.Context, in *QueryUncoveredBadDebtsRequest, opts ...grpc.CallOption) (*QueryUncoveredBadDebtsResponse, error) {
+	out := new(QueryUncoveredBadDebtsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryUncoveredBadDebts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryFundingPayments(ctx context.Context, in *QueryFundingPaymentsRequest, opts ...grpc.CallOption) (*QueryFundingPaymentsResponse, error) {
+	out := new(QueryFundingPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryFundingPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPositionSettlementPreview(ctx context.Context, in *QueryPositionSettlementPreviewRequest, opts ...grpc.CallOption) (*QueryPositionSettlementPreviewResponse, error) {
+	out := new(QueryPositionSettlementPreviewResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryPositionSettlementPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAllMarkPrices(ctx context.Context, in *QueryAllMarkPricesRequest, opts ...grpc.CallOption) (*QueryAllMarkPricesResponse, error) {
+	out := new(QueryAllMarkPricesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryAllMarkPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryMarketConfig(ctx context.Context, in *QueryMarketConfigRequest, opts ...grpc.CallOption) (*QueryMarketConfigResponse, error) {
+	out := new(QueryMarketConfigResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryMarketConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryReserveSnapshots(ctx context.Context, in *QueryReserveSnapshotsRequest, opts ...grpc.CallOption) (*QueryReserveSnapshotsResponse, error) {
+	out := new(QueryReserveSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryReserveSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTraderPositions(ctx context.Context, in *QueryTraderPositionsRequest, opts ...grpc.CallOption) (*QueryTraderPositionsResponse, error) {
+	out := new(QueryTraderPositionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryTraderPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryMarketsPage(ctx context.Context, in *QueryMarketsPageRequest, opts ...grpc.CallOption) (*QueryMarketsPageResponse, error) {
+	out := new(QueryMarketsPageResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryMarketsPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryMarkIndexDivergence(ctx context.Context, in *QueryMarkIndexDivergenceRequest, opts ...grpc.CallOption) (*QueryMarkIndexDivergenceResponse, error) {
+	out := new(QueryMarkIndexDivergenceResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryMarkIndexDivergence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRoundingAccrual(ctx context.Context, in *QueryRoundingAccrualRequest, opts ...grpc.CallOption) (*QueryRoundingAccrualResponse, error) {
+	out := new(QueryRoundingAccrualResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryRoundingAccrual", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPositionNotional(ctx context.Context, in *QueryPositionNotionalRequest, opts ...grpc.CallOption) (*QueryPositionNotionalResponse, error) {
+	out := new(QueryPositionNotionalResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryPositionNotional", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTwap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error) {
+	out := new(QueryTwapResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Query/QueryTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// QueryPosition: Query one position on the given market for a user
+	QueryPosition(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
+	// QueryPositions: Query all positions for a user
+	QueryPositions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
+	// QueryPositionStore queries all of the positions in the KV store.
+	QueryPositionStore(context.Context, *QueryPositionStoreRequest) (*QueryPositionStoreResponse, error)
+	// Queries the module accounts for x/perp
+	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
+	// QueryMarkets: Query all markets
+	QueryMarkets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
+	// QueryCollateral: Queries info about the collateral
+	QueryCollateral(context.Context, *QueryCollateralRequest) (*QueryCollateralResponse, error)
+	// QueryUncoveredBadDebts: Queries the bad debt the perp fund couldn't cover
+	QueryUncoveredBadDebts(context.Context, *QueryUncoveredBadDebtsRequest) (*QueryUncoveredBadDebtsResponse, error)
+	// QueryFundingPayments: Queries the funding payments a trader's position
+	// realized in a market, oldest first
+	QueryFundingPayments(context.Context, *QueryFundingPaymentsRequest) (*QueryFundingPaymentsResponse, error)
+	// QueryPositionSettlementPreview: Queries the margin, bad debt and funding
+	// payment a trader's position would have if its funding payment were
+	// settled now
+	QueryPositionSettlementPreview(context.Context, *QueryPositionSettlementPreviewRequest) (*QueryPositionSettlementPreviewResponse, error)
+	// QueryAllMarkPrices: Queries the spot mark price of the AMM of every
+	// enabled market
+	QueryAllMarkPrices(context.Context, *QueryAllMarkPricesRequest) (*QueryAllMarkPricesResponse, error)
+	// QueryMarketConfig: Queries the full configuration of the current market
+	// of a pair, and the reserves and mark price of its AMM
+	QueryMarketConfig(context.Context, *QueryMarketConfigRequest) (*QueryMarketConfigResponse, error)
+	// QueryReserveSnapshots: Queries the reserve snapshots of a pair taken in a
+	// time range, oldest first, so that the TWAP can be recomputed off-chain
+	QueryReserveSnapshots(context.Context, *QueryReserveSnapshotsRequest) (*QueryReserveSnapshotsResponse, error)
+	// QueryTraderPositions: Queries a page of the positions of a trader on every
+	// pair and market version
+	QueryTraderPositions(context.Context, *QueryTraderPositionsRequest) (*QueryTraderPositionsResponse, error)
+	// QueryMarketsPage: Queries a page of the markets, ordered by pair and then
+	// by version
+	QueryMarketsPage(context.Context, *QueryMarketsPageRequest) (*QueryMarketsPageResponse, error)
+	// QueryMarkIndexDivergence: Queries the divergence of the mark price of a
+	// pair from its index price over the reserve snapshots of a lookback window
+	QueryMarkIndexDivergence(context.Context, *QueryMarkIndexDivergenceRequest) (*QueryMarkIndexDivergenceResponse, error)
+	// QueryRoundingAccrual: Queries the margin the vault has gained on a pair
+	// from rounding trader margin transfers to whole coins
+	QueryRoundingAccrual(context.Context, *QueryRoundingAccrualRequest) (*QueryRoundingAccrualResponse, error)
+	// QueryPositionNotional: Queries the notional value of a trader's position,
+	// priced with either the spot reserves or the TWAP of its market
+	QueryPositionNotional(context.Context, *QueryPositionNotionalRequest) (*QueryPositionNotionalResponse, error)
+	// QueryTwap: Queries the time-weighted average spot price of a pair over a
+	// lookback window
+	QueryTwap(context.Context, *QueryTwapRequest) (*QueryTwapResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryPosition(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPosition not implemented")
+}
+func (*UnimplementedQueryServer) QueryPositions(ctx context.Context, req *QueryPositionsRequest) (*QueryPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPositions not implemented")
+}
+func (*UnimplementedQueryServer) QueryPositionStore(ctx context.Context, req *QueryPositionStoreRequest) (*QueryPositionStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPositionStore not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
+}
+func (*UnimplementedQueryServer) QueryMarkets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMarkets not implemented")
+}
+func (*UnimplementedQueryServer) QueryCollateral(ctx context.Context, req *QueryCollateralRequest) (*QueryCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCollateral not implemented")
+}
+func (*UnimplementedQueryServer) QueryUncoveredBadDebts(ctx context.Context, req *QueryUncoveredBadDebtsRequest) (*QueryUncoveredBadDebtsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUncoveredBadDebts not implemented")
+}
+func (*UnimplementedQueryServer) QueryFundingPayments(ctx context.Context, req *QueryFundingPaymentsRequest) (*QueryFundingPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFundingPayments not implemented")
+}
+func (*UnimplementedQueryServer) QueryPositionSettlementPreview(ctx context.Context, req *QueryPositionSettlementPreviewRequest) (*QueryPositionSettlementPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPositionSettlementPreview not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllMarkPrices(ctx context.Context, req *QueryAllMarkPricesRequest) (*QueryAllMarkPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllMarkPrices not implemented")
+}
+func (*UnimplementedQueryServer) QueryMarketConfig(ctx context.Context, req *QueryMarketConfigRequest) (*QueryMarketConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMarketConfig not implemented")
+}
+func (*UnimplementedQueryServer) QueryReserveSnapshots(ctx context.Context, req *QueryReserveSnapshotsRequest) (*QueryReserveSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryReserveSnapshots not implemented")
+}
+func (*UnimplementedQueryServer) QueryTraderPositions(ctx context.Context, req *QueryTraderPositionsRequest) (*QueryTraderPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTraderPositions not implemented")
+}
+func (*UnimplementedQueryServer) QueryMarketsPage(ctx context.Context, req *QueryMarketsPageRequest) (*QueryMarketsPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMarketsPage not implemented")
+}
+func (*UnimplementedQueryServer) QueryMarkIndexDivergence(ctx context.Context, req *QueryMarkIndexDivergenceRequest) (*QueryMarkIndexDivergenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMarkIndexDivergence not implemented")
+}
+func (*UnimplementedQueryServer) QueryRoundingAccrual(ctx context.Context, req *QueryRoundingAccrualRequest) (*QueryRoundingAccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRoundingAccrual not implemented")
+}
+func (*UnimplementedQueryServer) QueryPositionNotional(ctx context.Context, req *QueryPositionNotionalRequest) (*QueryPositionNotionalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPositionNotional not implemented")
+}
+func (*UnimplementedQueryServer) QueryTwap(ctx context.Context, req *QueryTwapRequest) (*QueryTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTwap not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPosition(ctx, req.(*QueryPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPositions(ctx, req.(*QueryPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPositionStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPositionStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryPositionStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPositionStore(ctx, req.(*QueryPositionStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/ModuleAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccounts(ctx, req.(*QueryModuleAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryMarkets(ctx, req.(*QueryMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCollateral(ctx, req.(*QueryCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryUncoveredBadDebts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUncoveredBadDebtsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUncoveredBadDebts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryUncoveredBadDebts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUncoveredBadDebts(ctx, req.(*QueryUncoveredBadDebtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFundingPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFundingPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryFundingPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFundingPayments(ctx, req.(*QueryFundingPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPositionSettlementPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionSettlementPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPositionSettlementPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryPositionSettlementPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPositionSettlementPreview(ctx, req.(*QueryPositionSettlementPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAllMarkPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMarkPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllMarkPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryAllMarkPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllMarkPrices(ctx, req.(*QueryAllMarkPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryMarketConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryMarketConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryMarketConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryMarketConfig(ctx, req.(*QueryMarketConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryReserveSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserveSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryReserveSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryReserveSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryReserveSnapshots(ctx, req.(*QueryReserveSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTraderPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraderPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTraderPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryTraderPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTraderPositions(ctx, req.(*QueryTraderPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryMarketsPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketsPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryMarketsPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryMarketsPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryMarketsPage(ctx, req.(*QueryMarketsPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryMarkIndexDivergence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarkIndexDivergenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryMarkIndexDivergence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryMarkIndexDivergence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryMarkIndexDivergence(ctx, req.(*QueryMarkIndexDivergenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRoundingAccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoundingAccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRoundingAccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryRoundingAccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRoundingAccrual(ctx, req.(*QueryRoundingAccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPositionNotional_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionNotionalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPositionNotional(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryPositionNotional",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPositionNotional(ctx, req.(*QueryPositionNotionalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Query/QueryTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTwap(ctx, req.(*QueryTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.perp.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryPosition",
+			Handler:    _Query_QueryPosition_Handler,
+		},
+		{
+			MethodName: "QueryPositions",
+			Handler:    _Query_QueryPositions_Handler,
+		},
+		{
+			MethodName: "QueryPositionStore",
+			Handler:    _Query_QueryPositionStore_Handler,
+		},
+		{
+			MethodName: "ModuleAccounts",
+			Handler:    _Query_ModuleAccounts_Handler,
+		},
+		{
+			MethodName: "QueryMarkets",
+			Handler:    _Query_QueryMarkets_Handler,
+		},
+		{
+			MethodName: "QueryCollateral",
+			Handler:    _Query_QueryCollateral_Handler,
+		},
+		{
+			MethodName: "QueryUncoveredBadDebts",
+			Handler:    _Query_QueryUncoveredBadDebts_Handler,
+		},
+		{
+			MethodName: "QueryFundingPayments",
+			Handler:    _Query_QueryFundingPayments_Handler,
+		},
+		{
+			MethodName: "QueryPositionSettlementPreview",
+			Handler:    _Query_QueryPositionSettlementPreview_Handler,
+		},
+		{
+			MethodName: "QueryAllMarkPrices",
+			Handler:    _Query_QueryAllMarkPrices_Handler,
+		},
+		{
+			MethodName: "QueryMarketConfig",
+			Handler:    _Query_QueryMarketConfig_Handler,
+		},
+		{
+			MethodName: "QueryReserveSnapshots",
+			Handler:    _Query_QueryReserveSnapshots_Handler,
+		},
+		{
+			MethodName: "QueryTraderPositions",
+			Handler:    _Query_QueryTraderPositions_Handler,
+		},
+		{
+			MethodName: "QueryMarketsPage",
+			Handler:    _Query_QueryMarketsPage_Handler,
+		},
+		{
+			MethodName: "QueryMarkIndexDivergence",
+			Handler:    _Query_QueryMarkIndexDivergence_Handler,
+		},
+		{
+			MethodName: "QueryRoundingAccrual",
+			Handler:    _Query_QueryRoundingAccrual_Handler,
+		},
+		{
+			MethodName: "QueryPositionNotional",
+			Handler:    _Query_QueryPositionNotional_Handler,
+		},
+		{
+			MethodName: "QueryTwap",
+			Handler:    _Query_QueryTwap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nibiru/perp/v2/query.proto",
+}
+
+func (m *QueryPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionStoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionStoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionStoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionStoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionStoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionStoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarginRatio.Size()
+		i -= size
+		if _, err := m.MarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.UnrealizedPnl.Size()
+		i -= size
+		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountWithBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountWithBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountWithBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AmmMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmmMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmmMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amm.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Versioned {
+		i--
+		if m.Versioned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmmMarkets) > 0 {
+		for iNdEx := len(m.AmmMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmmMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUncoveredBadDebtsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUncoveredBadDebtsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUncoveredBadDebtsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUncoveredBadDebtsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUncoveredBadDebtsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUncoveredBadDebtsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BadDebts) > 0 {
+		for iNdEx := len(m.BadDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundingPayments) > 0 {
+		for iNdEx := len(m.FundingPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionSettlementPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionSettlementPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionSettlementPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionSettlementPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionSettlementPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionSettlementPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LatestCumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BadDebt.Size()
+		i -= size
+		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarkPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarkPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarkPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarkPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarkPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarkPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarkPrices) > 0 {
+		for iNdEx := len(m.MarkPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarkPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairMarkPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairMarkPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairMarkPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxOracleSpreadRatio.Size()
+		i -= size
+		if _, err := m.MaxOracleSpreadRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FluctuationLimitRatio.Size()
+		i -= size
+		if _, err := m.FluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TradeLimitRatioShort.Size()
+		i -= size
+		if _, err := m.TradeLimitRatioShort.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TradeLimitRatioLong.Size()
+		i -= size
+		if _, err := m.TradeLimitRatioLong.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TradeLimitRatio.Size()
+		i -= size
+		if _, err := m.TradeLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amm.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserveSnapshotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveSnapshotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveSnapshotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserveSnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveSnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraderPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraderPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraderPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraderPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraderPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraderPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsPageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsPageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsPageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Versioned {
+		i--
+		if m.Versioned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsPageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsPageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsPageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AmmMarkets) > 0 {
+		for iNdEx := len(m.AmmMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmmMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarkIndexDivergenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkIndexDivergenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkIndexDivergenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarkIndexDivergenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkIndexDivergenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkIndexDivergenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Series) > 0 {
+		for iNdEx := len(m.Series) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Series[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkIndexDivergence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkIndexDivergence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkIndexDivergence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Divergence.Size()
+		i -= size
+		if _, err := m.Divergence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TimestampMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoundingAccrualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoundingAccrualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoundingAccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoundingAccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoundingAccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoundingAccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RoundingAccrual.Size()
+		i -= size
+		if _, err := m.RoundingAccrual.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionNotionalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionNotionalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionNotionalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CalcOption) > 0 {
+		i -= len(m.CalcOption)
+		copy(dAtA[i:], m.CalcOption)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CalcOption)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionNotionalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionNotionalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionNotionalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionStoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionStoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnrealizedPnl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarginRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountWithBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AmmMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amm.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Versioned {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AmmMarkets) > 0 {
+		for _, e := range m.AmmMarkets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUncoveredBadDebtsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUncoveredBadDebtsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BadDebts) > 0 {
+		for _, e := range m.BadDebts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFundingPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundingPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FundingPayments) > 0 {
+		for _, e := range m.FundingPayments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionSettlementPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionSettlementPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Margin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LatestCumulativePremiumFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMarkPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllMarkPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarkPrices) > 0 {
+		for _, e := range m.MarkPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairMarkPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMarketConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMarketConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amm.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TradeLimitRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TradeLimitRatioLong.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TradeLimitRatioShort.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FluctuationLimitRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxOracleSpreadRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReserveSnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FromMs != 0 {
+		n += 1 + sovQuery(uint64(m.FromMs))
+	}
+	if m.ToMs != 0 {
+		n += 1 + sovQuery(uint64(m.ToMs))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReserveSnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraderPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraderPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketsPageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Versioned {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketsPageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AmmMarkets) > 0 {
+		for _, e := range m.AmmMarkets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarkIndexDivergenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LookbackMs != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackMs))
+	}
+	return n
+}
+
+func (m *QueryMarkIndexDivergenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Series) > 0 {
+		for _, e := range m.Series {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarkIndexDivergence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimestampMs != 0 {
+		n += 1 + sovQuery(uint64(m.TimestampMs))
+	}
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Divergence.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRoundingAccrualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRoundingAccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RoundingAccrual.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPositionNotionalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CalcOption)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionNotionalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LookbackMs != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackMs))
+	}
+	return n
+}
+
+func (m *QueryTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, QueryPositionResponse{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionStoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionStoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionStoreResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionStoreResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, AccountWithBalance{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountWithBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountWithBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountWithBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmmMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmmMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmmMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versioned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Versioned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmmMarkets = append(m.AmmMarkets, AmmMarket{})
+			if err := m.AmmMarkets[len(m.AmmMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUncoveredBadDebtsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUncoveredBadDebtsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUncoveredBadDebtsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUncoveredBadDebtsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUncoveredBadDebtsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUncoveredBadDebtsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadDebts = append(m.BadDebts, UncoveredBadDebt{})
+			if err := m.BadDebts[len(m.BadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingPaymentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingPaymentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingPaymentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingPaymentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingPayments = append(m.FundingPayments, AppliedFundingPayment{})
+			if err := m.FundingPayments[len(m.FundingPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionSettlementPreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionSettlementPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionSettlementPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionSettlementPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionSettlementPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionSettlementPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarkPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarkPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarkPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarkPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarkPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarkPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkPrices = append(m.MarkPrices, PairMarkPrice{})
+			if err := m.MarkPrices[len(m.MarkPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairMarkPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairMarkPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairMarkPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatioLong", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeLimitRatioLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatioShort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeLimitRatioShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReserveSnapshotsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveSnapshotsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveSnapshotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromMs", wireType)
+			}
+			m.FromMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToMs", wireType)
+			}
+			m.ToMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryCollateralRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CollateralDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryReserveSnapshotsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveSnapshotsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveSnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, ReserveSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraderPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,10 +8260,10 @@ func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraderPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraderPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1863,6 +8298,42 @@ func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Trader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1884,7 +8355,7 @@ func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraderPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1907,10 +8378,10 @@ func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraderPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraderPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1942,11 +8413,47 @@ func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, QueryPositionResponse{})
+			m.Positions = append(m.Positions, Position{})
 			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1968,7 +8475,7 @@ func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionStoreRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketsPageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1991,13 +8498,33 @@ func (m *QueryPositionStoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionStoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketsPageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketsPageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versioned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Versioned = bool(v != 0)
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2054,7 +8581,7 @@ func (m *QueryPositionStoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionStoreResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketsPageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2077,15 +8604,15 @@ func (m *QueryPositionStoreResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionStoreResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketsPageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketsPageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmMarkets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2112,8 +8639,8 @@ func (m *QueryPositionStoreResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, Position{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AmmMarkets = append(m.AmmMarkets, AmmMarket{})
+			if err := m.AmmMarkets[len(m.AmmMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2174,7 +8701,7 @@ func (m *QueryPositionStoreResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMarkIndexDivergenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2197,10 +8724,10 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarkIndexDivergenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarkIndexDivergenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2238,10 +8765,10 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackMs", wireType)
 			}
-			var stringLen uint64
+			m.LookbackMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2251,24 +8778,11 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LookbackMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2290,7 +8804,7 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarkIndexDivergenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2313,15 +8827,15 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarkIndexDivergenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarkIndexDivergenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Series", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2348,15 +8862,66 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Series = append(m.Series, MarkIndexDivergence{})
+			if err := m.Series[len(m.Series)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkIndexDivergence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkIndexDivergence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkIndexDivergence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2366,29 +8931,14 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimestampMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2416,13 +8966,13 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Divergence", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2450,7 +9000,7 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Divergence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2475,7 +9025,7 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoundingAccrualRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2485,25 +9035,59 @@ func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoundingAccrualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoundingAccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2525,7 +9109,7 @@ func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoundingAccrualResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2548,17 +9132,17 @@ func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoundingAccrualResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoundingAccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundingAccrual", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2568,23 +9152,23 @@ func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, AccountWithBalance{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RoundingAccrual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2609,7 +9193,7 @@ func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountWithBalance) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionNotionalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2632,15 +9216,15 @@ func (m *AccountWithBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountWithBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionNotionalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountWithBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionNotionalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2668,11 +9252,13 @@ func (m *AccountWithBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2700,13 +9286,13 @@ func (m *AccountWithBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Trader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CalcOption", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2716,25 +9302,23 @@ func (m *AccountWithBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CalcOption = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2757,7 +9341,7 @@ func (m *AccountWithBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AmmMarket) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionNotionalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2780,50 +9364,17 @@ func (m *AmmMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AmmMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionNotionalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AmmMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionNotionalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2833,22 +9384,23 @@ func (m *AmmMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2873,7 +9425,7 @@ func (m *AmmMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2896,17 +9448,17 @@ func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versioned", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2916,67 +9468,31 @@ func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Versioned = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmMarkets", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackMs", wireType)
 			}
-			var msglen int
+			m.LookbackMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2986,76 +9502,11 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LookbackMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AmmMarkets = append(m.AmmMarkets, AmmMarket{})
-			if err := m.AmmMarkets[len(m.AmmMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3077,7 +9528,7 @@ func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3100,15 +9551,15 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3136,7 +9587,9 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
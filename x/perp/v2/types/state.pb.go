@@ -334,6 +334,10 @@ type Position struct {
 	LatestCumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=latest_cumulative_premium_fraction,json=latestCumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_cumulative_premium_fraction"`
 	// last block number this position was updated
 	LastUpdatedBlockNumber int64 `protobuf:"varint,7,opt,name=last_updated_block_number,json=lastUpdatedBlockNumber,proto3" json:"last_updated_block_number,omitempty"`
+	// margin_mode is whether the position is isolated or shares its free
+	// collateral with the trader's other cross margin positions. Positions
+	// without a margin mode are isolated.
+	MarginMode MarginMode `protobuf:"bytes,8,opt,name=margin_mode,json=marginMode,proto3,customtype=MarginMode" json:"margin_mode"`
 }
 
 func (m *Position) Reset()         { *m = Position{} }
@@ -492,6 +496,258 @@ func (m *DNRAllocation) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// UncoveredBadDebt is the bad debt of a pair that the perp fund had no funds to
+// cover when it was realized, and which it hasn't repaid yet.
+type UncoveredBadDebt struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// amount of collateral still owed to the vault
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *UncoveredBadDebt) Reset()         { *m = UncoveredBadDebt{} }
+func (m *UncoveredBadDebt) String() string { return proto.CompactTextString(m) }
+func (*UncoveredBadDebt) ProtoMessage()    {}
+func (*UncoveredBadDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4829f34f7b8040, []int{6}
+}
+func (m *UncoveredBadDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UncoveredBadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UncoveredBadDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UncoveredBadDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UncoveredBadDebt.Merge(m, src)
+}
+func (m *UncoveredBadDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *UncoveredBadDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_UncoveredBadDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UncoveredBadDebt proto.InternalMessageInfo
+
+// AppliedFundingPayment is a funding payment realized by a position, kept in
+// the funding payment history of its trader.
+type AppliedFundingPayment struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Trader string                                            `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+	// funding paid by the position, negative if it received funding
+	Payment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=payment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"payment"`
+	// cumulative premium fraction of the market the payment brought the
+	// position up to
+	CumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_premium_fraction,json=cumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_premium_fraction"`
+	BlockHeight               int64                                  `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TimestampMs               int64                                  `protobuf:"varint,6,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+}
+
+func (m *AppliedFundingPayment) Reset()         { *m = AppliedFundingPayment{} }
+func (m *AppliedFundingPayment) String() string { return proto.CompactTextString(m) }
+func (*AppliedFundingPayment) ProtoMessage()    {}
+func (*AppliedFundingPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4829f34f7b8040, []int{7}
+}
+func (m *AppliedFundingPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppliedFundingPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppliedFundingPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppliedFundingPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppliedFundingPayment.Merge(m, src)
+}
+func (m *AppliedFundingPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppliedFundingPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppliedFundingPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppliedFundingPayment proto.InternalMessageInfo
+
+func (m *AppliedFundingPayment) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
+}
+
+func (m *AppliedFundingPayment) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AppliedFundingPayment) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+// LiquidationRecord is a liquidation kept in the recent liquidations history of
+// a pair.
+type LiquidationRecord struct {
+	Pair              github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	TraderAddress     string                                            `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	LiquidatorAddress string                                            `protobuf:"bytes,3,opt,name=liquidator_address,json=liquidatorAddress,proto3" json:"liquidator_address,omitempty"`
+	// signed base amount of the position that was liquidated
+	LiquidatedSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidated_size,json=liquidatedSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidated_size"`
+	// average price, in quote per base, at which the size was liquidated
+	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	BadDebt     types.Coin                             `protobuf:"bytes,6,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
+	BlockHeight int64                                  `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TimestampMs int64                                  `protobuf:"varint,8,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	Reason      ChangeReason                           `protobuf:"bytes,9,opt,name=reason,proto3,customtype=ChangeReason" json:"reason"`
+}
+
+func (m *LiquidationRecord) Reset()         { *m = LiquidationRecord{} }
+func (m *LiquidationRecord) String() string { return proto.CompactTextString(m) }
+func (*LiquidationRecord) ProtoMessage()    {}
+func (*LiquidationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4829f34f7b8040, []int{8}
+}
+func (m *LiquidationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationRecord.Merge(m, src)
+}
+func (m *LiquidationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationRecord proto.InternalMessageInfo
+
+func (m *LiquidationRecord) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *LiquidationRecord) GetLiquidatorAddress() string {
+	if m != nil {
+		return m.LiquidatorAddress
+	}
+	return ""
+}
+
+func (m *LiquidationRecord) GetBadDebt() types.Coin {
+	if m != nil {
+		return m.BadDebt
+	}
+	return types.Coin{}
+}
+
+func (m *LiquidationRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *LiquidationRecord) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+// FundingSettlement is a funding settlement kept in the funding history of a
+// pair.
+type FundingSettlement struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// premium fraction of the funding interval, paid by longs if positive
+	PremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=premium_fraction,json=premiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"premium_fraction"`
+	// cumulative premium fraction of the market after the settlement
+	CumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=cumulative_premium_fraction,json=cumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_premium_fraction"`
+	BlockHeight               int64                                  `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TimestampMs               int64                                  `protobuf:"varint,5,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+}
+
+func (m *FundingSettlement) Reset()         { *m = FundingSettlement{} }
+func (m *FundingSettlement) String() string { return proto.CompactTextString(m) }
+func (*FundingSettlement) ProtoMessage()    {}
+func (*FundingSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4829f34f7b8040, []int{9}
+}
+func (m *FundingSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingSettlement.Merge(m, src)
+}
+func (m *FundingSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingSettlement proto.InternalMessageInfo
+
+func (m *FundingSettlement) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *FundingSettlement) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("nibiru.perp.v2.Direction", Direction_name, Direction_value)
 	proto.RegisterEnum("nibiru.perp.v2.TwapCalcOption", TwapCalcOption_name, TwapCalcOption_value)
@@ -501,87 +757,109 @@ func init() {
 	proto.RegisterType((*Position)(nil), "nibiru.perp.v2.Position")
 	proto.RegisterType((*ReserveSnapshot)(nil), "nibiru.perp.v2.ReserveSnapshot")
 	proto.RegisterType((*DNRAllocation)(nil), "nibiru.perp.v2.DNRAllocation")
+	proto.RegisterType((*UncoveredBadDebt)(nil), "nibiru.perp.v2.UncoveredBadDebt")
+	proto.RegisterType((*AppliedFundingPayment)(nil), "nibiru.perp.v2.AppliedFundingPayment")
+	proto.RegisterType((*LiquidationRecord)(nil), "nibiru.perp.v2.LiquidationRecord")
+	proto.RegisterType((*FundingSettlement)(nil), "nibiru.perp.v2.FundingSettlement")
 }
 
 func init() { proto.RegisterFile("nibiru/perp/v2/state.proto", fileDescriptor_8f4829f34f7b8040) }
 
 var fileDescriptor_8f4829f34f7b8040 = []byte{
-	// 1197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0xc7, 0x4d, 0x4b, 0x76, 0xac, 0x95, 0x5f, 0xf4, 0x6c, 0xec, 0x3c, 0x74, 0xf0, 0x40, 0xf6,
-	0x23, 0xa0, 0x85, 0x91, 0x22, 0x64, 0xed, 0x9e, 0x82, 0x9e, 0xf4, 0x62, 0xa7, 0x06, 0xf4, 0x16,
-	0x4a, 0x6e, 0xd0, 0x20, 0xc5, 0x62, 0x49, 0xae, 0xa9, 0xad, 0x49, 0x2e, 0xb3, 0xbb, 0x94, 0x9d,
-	0xf6, 0x1b, 0xf4, 0xd4, 0x63, 0xfb, 0x15, 0xfa, 0x25, 0x7a, 0xcd, 0x31, 0xc7, 0xa2, 0x87, 0xa4,
-	0x48, 0x8e, 0xfd, 0x12, 0xc5, 0x2e, 0x29, 0x59, 0x06, 0x8a, 0x36, 0x65, 0x9b, 0x93, 0xb9, 0x1c,
-	0xce, 0x6f, 0x86, 0xe3, 0x99, 0xff, 0x50, 0xe0, 0x6e, 0x4c, 0x5d, 0xca, 0x53, 0x3b, 0x21, 0x3c,
-	0xb1, 0xa7, 0x47, 0xb6, 0x90, 0x58, 0x12, 0x2b, 0xe1, 0x4c, 0x32, 0xb8, 0x99, 0xd9, 0x2c, 0x65,
-	0xb3, 0xa6, 0x47, 0x77, 0xb7, 0x03, 0x16, 0x30, 0x6d, 0xb2, 0xd5, 0x55, 0xf6, 0xd4, 0xdd, 0xba,
-	0xc7, 0x44, 0xc4, 0x84, 0xed, 0x62, 0x41, 0xec, 0xe9, 0xa1, 0x4b, 0x24, 0x3e, 0xb4, 0x3d, 0x46,
-	0xe3, 0xdc, 0xbe, 0x9b, 0xd9, 0x51, 0xe6, 0x98, 0x1d, 0x66, 0xae, 0x01, 0x63, 0x41, 0x48, 0x6c,
-	0x7d, 0x72, 0xd3, 0x73, 0xdb, 0x4f, 0x39, 0x96, 0x94, 0xe5, 0xae, 0x8d, 0xdf, 0x2a, 0x60, 0xb5,
-	0x87, 0xf9, 0x05, 0x91, 0xb0, 0x07, 0xca, 0x09, 0xa6, 0xdc, 0x34, 0xf6, 0x8d, 0x83, 0x4a, 0xeb,
-	0xc1, 0x8b, 0x57, 0x7b, 0x4b, 0xbf, 0xbc, 0xda, 0x3b, 0x0c, 0xa8, 0x9c, 0xa4, 0xae, 0xe5, 0xb1,
-	0xc8, 0xee, 0xeb, 0x64, 0xdb, 0x13, 0x4c, 0x63, 0x3b, 0x7f, 0xa9, 0x2b, 0xdb, 0x63, 0x51, 0xc4,
-	0x62, 0x1b, 0x0b, 0x41, 0xa4, 0x35, 0xc4, 0x94, 0x3b, 0x1a, 0x03, 0x4d, 0x70, 0x8b, 0xc4, 0xd8,
-	0x0d, 0x89, 0x6f, 0x2e, 0xef, 0x1b, 0x07, 0x6b, 0xce, 0xec, 0xa8, 0x2c, 0x53, 0xc2, 0x05, 0x65,
-	0xb1, 0xb9, 0xb9, 0x6f, 0x1c, 0x94, 0x9d, 0xd9, 0x11, 0x4e, 0x80, 0x19, 0x61, 0x1a, 0x4b, 0x12,
-	0xe3, 0xd8, 0x23, 0x28, 0xc2, 0x3c, 0xa0, 0x31, 0xd2, 0x09, 0x9b, 0x25, 0x9d, 0x96, 0x95, 0xa7,
-	0xf5, 0xe1, 0x42, 0x5a, 0x79, 0x75, 0xb2, 0x3f, 0xf7, 0x85, 0x7f, 0x61, 0xcb, 0xe7, 0x09, 0x11,
-	0x56, 0x87, 0x78, 0xce, 0x9d, 0x05, 0x5e, 0x4f, 0xe3, 0x1c, 0x45, 0x83, 0x8f, 0xc0, 0x7a, 0x84,
-	0xaf, 0x50, 0x48, 0xa6, 0x84, 0xe3, 0x80, 0x98, 0xe5, 0x42, 0xf4, 0x6a, 0x84, 0xaf, 0xba, 0x39,
-	0x02, 0x7e, 0x03, 0x1a, 0x21, 0x96, 0x44, 0x48, 0xe4, 0xa5, 0x51, 0x1a, 0x62, 0x49, 0xa7, 0x04,
-	0x25, 0x9c, 0x44, 0x34, 0x8d, 0xd0, 0x39, 0xc7, 0x9e, 0x2a, 0xbb, 0xb9, 0x52, 0x28, 0xd0, 0x5e,
-	0x46, 0x6e, 0xcf, 0xc1, 0xc3, 0x8c, 0x7b, 0x92, 0x63, 0xe1, 0x53, 0x00, 0xc9, 0x95, 0x37, 0xc1,
-	0x71, 0x40, 0xd0, 0x39, 0x21, 0x79, 0xcd, 0x56, 0x0b, 0x05, 0xab, 0xcd, 0x48, 0x27, 0x84, 0x64,
-	0xd5, 0x0a, 0x80, 0x49, 0x3c, 0x26, 0x9e, 0x0b, 0x49, 0x22, 0x74, 0x9e, 0xc6, 0xfe, 0x42, 0x8c,
-	0x5b, 0x85, 0x62, 0xec, 0xcc, 0x79, 0x27, 0x69, 0xec, 0xcf, 0x03, 0xb9, 0x60, 0x27, 0xa4, 0xcf,
-	0x52, 0xea, 0xeb, 0x1e, 0x5d, 0x88, 0xb2, 0x56, 0x28, 0xca, 0xed, 0x05, 0xd8, 0x3c, 0xc6, 0x57,
-	0x60, 0x37, 0xc1, 0x5c, 0x52, 0x1c, 0xa2, 0xc5, 0x58, 0x59, 0x9c, 0x4a, 0xa1, 0x38, 0xff, 0xcd,
-	0x81, 0xdd, 0x6b, 0x5e, 0x16, 0xeb, 0x10, 0xec, 0xa8, 0x72, 0xd1, 0x38, 0x50, 0x7c, 0x82, 0x48,
-	0xc2, 0xbc, 0x09, 0xa2, 0xbe, 0x09, 0x54, 0x1c, 0x07, 0xe6, 0x46, 0x07, 0x4b, 0x72, 0xac, 0x4c,
-	0xa7, 0x3e, 0x3c, 0x03, 0xdb, 0xf2, 0x12, 0x27, 0x28, 0x64, 0xec, 0xc2, 0xc5, 0xde, 0x05, 0xba,
-	0xa4, 0xb1, 0xcf, 0x2e, 0xcd, 0xea, 0xbe, 0x71, 0x50, 0x3d, 0xda, 0xb5, 0xb2, 0x81, 0xb6, 0x66,
-	0x03, 0x6d, 0x75, 0xf2, 0x81, 0x6e, 0xad, 0xa9, 0xa4, 0xbf, 0x7f, 0xbd, 0x67, 0x38, 0x50, 0x01,
-	0xba, 0xb9, 0xff, 0x63, 0xed, 0x0e, 0x4f, 0x41, 0x2d, 0xe1, 0x24, 0xc1, 0xd4, 0x47, 0x2e, 0xf6,
-	0x91, 0x4f, 0x5c, 0x69, 0xae, 0xe7, 0xc8, 0x5c, 0x31, 0x94, 0xbc, 0x58, 0xb9, 0xbc, 0x58, 0x6d,
-	0x46, 0xe3, 0x56, 0x59, 0x21, 0x9d, 0xcd, 0xdc, 0xb1, 0x85, 0xfd, 0x0e, 0x71, 0x25, 0x7c, 0x0a,
-	0x6a, 0x6a, 0x76, 0x16, 0x5f, 0xcc, 0xdc, 0xd0, 0x75, 0x3b, 0xfa, 0x7b, 0x75, 0xd3, 0xc9, 0x6e,
-	0x46, 0xf8, 0xea, 0xe4, 0xba, 0x0c, 0xf0, 0x09, 0xa8, 0x32, 0x8e, 0xbd, 0x90, 0x20, 0xad, 0x46,
-	0x5b, 0xff, 0x54, 0x8d, 0x40, 0x46, 0x53, 0xd7, 0x8d, 0xfb, 0xe0, 0x3f, 0x99, 0xd8, 0x75, 0xb1,
-	0x90, 0x9f, 0xe7, 0xa2, 0xb3, 0x20, 0x47, 0xc6, 0x0d, 0x39, 0x6a, 0xfc, 0xb4, 0x02, 0x4a, 0xcd,
-	0x5e, 0xef, 0x3d, 0x28, 0xe3, 0x2c, 0xe0, 0xda, 0x4d, 0xfd, 0x7b, 0x04, 0xd6, 0xd5, 0x3f, 0x01,
-	0x71, 0x22, 0x08, 0x9f, 0x12, 0x2d, 0x9c, 0x05, 0x54, 0x49, 0x31, 0x9c, 0x0c, 0x01, 0x47, 0x60,
-	0xe3, 0x59, 0xca, 0xe4, 0x35, 0xb3, 0x98, 0x8e, 0xae, 0x6b, 0xc8, 0x0c, 0xda, 0x03, 0x40, 0x3c,
-	0xe3, 0x12, 0xf9, 0x24, 0x91, 0x93, 0x82, 0xda, 0x59, 0x51, 0x84, 0x8e, 0x02, 0xc0, 0x2f, 0x54,
-	0x6f, 0x52, 0x25, 0xf8, 0x69, 0x28, 0x69, 0x12, 0x52, 0xc2, 0x0b, 0xea, 0xe4, 0x96, 0xe6, 0xf4,
-	0xe6, 0x18, 0x95, 0xa9, 0x64, 0x52, 0x8d, 0x3a, 0x8b, 0x83, 0x82, 0x7a, 0x58, 0xd1, 0x84, 0x2e,
-	0x8b, 0x03, 0x38, 0x00, 0xd5, 0x0c, 0x27, 0x26, 0x8c, 0xcb, 0x82, 0xda, 0x97, 0x65, 0x34, 0x52,
-	0x04, 0xf8, 0x25, 0xa8, 0x09, 0x22, 0x65, 0x48, 0x22, 0x12, 0x4b, 0xa4, 0xb3, 0xcf, 0x35, 0xa8,
-	0xc8, 0x2c, 0x6d, 0x5d, 0xb3, 0x86, 0x0a, 0xd5, 0xf8, 0xa1, 0x0c, 0xd6, 0x86, 0x4c, 0x50, 0xbd,
-	0x23, 0x3e, 0x00, 0x9b, 0x92, 0x63, 0x9f, 0x70, 0x84, 0x7d, 0x9f, 0x13, 0x21, 0xb2, 0x86, 0x76,
-	0x36, 0xb2, 0xbb, 0xcd, 0xec, 0xe6, 0xbc, 0xdb, 0x97, 0xff, 0x9d, 0x6e, 0x6f, 0x81, 0xb2, 0xa0,
-	0x5f, 0x17, 0xed, 0x3b, 0xed, 0x0b, 0x4f, 0xc0, 0x6a, 0xf6, 0x2d, 0x50, 0xb0, 0xd7, 0x72, 0x6f,
-	0x35, 0x0c, 0x2c, 0x21, 0x31, 0x8a, 0x99, 0x2a, 0x08, 0x0e, 0x0b, 0x76, 0xd9, 0xba, 0x82, 0xf4,
-	0x73, 0xc6, 0x3b, 0xee, 0xfd, 0xd5, 0xf7, 0xb3, 0xf7, 0x1f, 0x80, 0xdd, 0x10, 0x0b, 0x89, 0xd2,
-	0xc4, 0xc7, 0x92, 0xf8, 0xc8, 0x0d, 0x99, 0x77, 0x81, 0xe2, 0x34, 0x72, 0x09, 0xd7, 0xed, 0x59,
-	0x72, 0xee, 0xa8, 0x07, 0xce, 0x32, 0x7b, 0x4b, 0x99, 0xfb, 0xda, 0xda, 0xc0, 0x60, 0x2b, 0x9f,
-	0xe7, 0x51, 0x8c, 0x13, 0x31, 0x61, 0x12, 0x7e, 0x04, 0x4a, 0x38, 0x8a, 0x74, 0x5b, 0x54, 0x8f,
-	0x6e, 0x5b, 0x37, 0x3f, 0x4e, 0xad, 0x66, 0xaf, 0x97, 0x6f, 0x04, 0xf5, 0x14, 0xfc, 0x3f, 0x58,
-	0x97, 0x34, 0x22, 0x42, 0xe2, 0x28, 0x41, 0x91, 0xd0, 0xfd, 0x52, 0x72, 0xaa, 0xf3, 0x7b, 0x3d,
-	0xd1, 0xf8, 0xd6, 0x00, 0x1b, 0x9d, 0xbe, 0xd3, 0x0c, 0x43, 0xe6, 0xe9, 0x25, 0x05, 0xb7, 0xc1,
-	0x8a, 0xde, 0x81, 0xb9, 0xd4, 0x66, 0x07, 0xe8, 0x81, 0x55, 0x1c, 0xb1, 0x34, 0x96, 0xe6, 0xf2,
-	0x7e, 0xe9, 0xcf, 0x57, 0xd2, 0xc7, 0x2a, 0x81, 0x1f, 0x5f, 0xef, 0x1d, 0xbc, 0x43, 0x05, 0x95,
-	0x83, 0x70, 0x72, 0xf4, 0xbd, 0x4f, 0x41, 0xa5, 0x43, 0x39, 0xc9, 0xea, 0xb6, 0x0b, 0x76, 0x3a,
-	0xa7, 0xce, 0x71, 0x7b, 0x7c, 0x3a, 0xe8, 0xa3, 0xb3, 0xfe, 0x68, 0x78, 0xdc, 0x3e, 0x3d, 0x39,
-	0x3d, 0xee, 0xd4, 0x96, 0xe0, 0x1a, 0x28, 0x77, 0x07, 0xfd, 0x87, 0x35, 0x03, 0x56, 0xc0, 0xca,
-	0xe8, 0xb3, 0x81, 0x33, 0xae, 0x2d, 0xdf, 0x0b, 0xc0, 0xe6, 0xf8, 0x12, 0x27, 0x6d, 0x1c, 0x7a,
-	0x83, 0x44, 0x13, 0xf6, 0xc1, 0xff, 0xc6, 0x8f, 0x9b, 0x43, 0xd4, 0x6e, 0x76, 0xdb, 0x68, 0x30,
-	0xfc, 0x63, 0xd0, 0x68, 0x38, 0x18, 0xd7, 0x0c, 0xb8, 0x0d, 0x6a, 0x8f, 0xce, 0x06, 0xe3, 0x63,
-	0xd4, 0x1c, 0x8d, 0x8e, 0xc7, 0x68, 0xf4, 0xb8, 0x39, 0xac, 0x2d, 0xc3, 0xdb, 0x60, 0xab, 0xd5,
-	0x1c, 0xdd, 0xb8, 0x59, 0x6a, 0x3d, 0x7c, 0xf1, 0xa6, 0x6e, 0xbc, 0x7c, 0x53, 0x37, 0x7e, 0x7d,
-	0x53, 0x37, 0xbe, 0x7b, 0x5b, 0x5f, 0x7a, 0xf9, 0xb6, 0xbe, 0xf4, 0xf3, 0xdb, 0xfa, 0xd2, 0x93,
-	0xfb, 0x7f, 0x35, 0x81, 0xb3, 0x1f, 0x18, 0xfa, 0xe5, 0xdd, 0x55, 0xfd, 0x85, 0xf0, 0xc9, 0xef,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x43, 0xfe, 0x66, 0xfb, 0x7f, 0x0c, 0x00, 0x00,
+	// 1477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x53, 0x1b, 0x47,
+	0x16, 0x47, 0x7f, 0x10, 0xd2, 0x93, 0x00, 0xd1, 0x06, 0xef, 0xe0, 0xdd, 0x02, 0xac, 0xaa, 0xdd,
+	0xa2, 0xbc, 0x8b, 0xb4, 0xe0, 0x93, 0x77, 0x4f, 0xfa, 0x03, 0x36, 0x55, 0x08, 0xc9, 0x23, 0x08,
+	0x15, 0x97, 0x53, 0x5d, 0xad, 0x99, 0x46, 0x9a, 0x30, 0x33, 0x3d, 0x9e, 0x69, 0x09, 0x9c, 0x7c,
+	0x80, 0x54, 0xe5, 0x94, 0x63, 0xbe, 0x42, 0xf2, 0x25, 0x72, 0xf5, 0x29, 0xe5, 0x63, 0x2a, 0x07,
+	0x3b, 0x65, 0x1f, 0x73, 0xcc, 0x3d, 0x95, 0xea, 0x3f, 0x12, 0xc2, 0x38, 0xc6, 0x9e, 0xe0, 0x13,
+	0xd3, 0xfd, 0xfa, 0xfd, 0xde, 0xeb, 0xd7, 0xef, 0xfd, 0xde, 0x13, 0x70, 0xcb, 0x77, 0xba, 0x4e,
+	0x38, 0xa8, 0x04, 0x34, 0x0c, 0x2a, 0xc3, 0xad, 0x4a, 0xc4, 0x09, 0xa7, 0xe5, 0x20, 0x64, 0x9c,
+	0xa1, 0x39, 0x25, 0x2b, 0x0b, 0x59, 0x79, 0xb8, 0x75, 0x6b, 0xb1, 0xc7, 0x7a, 0x4c, 0x8a, 0x2a,
+	0xe2, 0x4b, 0x9d, 0xba, 0xb5, 0x62, 0xb1, 0xc8, 0x63, 0x51, 0xa5, 0x4b, 0x22, 0x5a, 0x19, 0x6e,
+	0x76, 0x29, 0x27, 0x9b, 0x15, 0x8b, 0x39, 0xbe, 0x96, 0x2f, 0x2b, 0x39, 0x56, 0x8a, 0x6a, 0x31,
+	0x52, 0xed, 0x31, 0xd6, 0x73, 0x69, 0x45, 0xae, 0xba, 0x83, 0xe3, 0x8a, 0x3d, 0x08, 0x09, 0x77,
+	0x98, 0x56, 0x2d, 0xfd, 0x9a, 0x83, 0x4c, 0x93, 0x84, 0x27, 0x94, 0xa3, 0x26, 0xa4, 0x03, 0xe2,
+	0x84, 0x46, 0x62, 0x2d, 0xb1, 0x9e, 0xab, 0xdd, 0x7b, 0xf6, 0x62, 0x75, 0xea, 0xe7, 0x17, 0xab,
+	0x9b, 0x3d, 0x87, 0xf7, 0x07, 0xdd, 0xb2, 0xc5, 0xbc, 0xca, 0xbe, 0x74, 0xb6, 0xde, 0x27, 0x8e,
+	0x5f, 0xd1, 0x97, 0x3a, 0xab, 0x58, 0xcc, 0xf3, 0x98, 0x5f, 0x21, 0x51, 0x44, 0x79, 0xb9, 0x4d,
+	0x9c, 0xd0, 0x94, 0x30, 0xc8, 0x80, 0x19, 0xea, 0x93, 0xae, 0x4b, 0x6d, 0x23, 0xb9, 0x96, 0x58,
+	0xcf, 0x9a, 0xa3, 0xa5, 0x90, 0x0c, 0x69, 0x18, 0x39, 0xcc, 0x37, 0xe6, 0xd6, 0x12, 0xeb, 0x69,
+	0x73, 0xb4, 0x44, 0x7d, 0x30, 0x3c, 0xe2, 0xf8, 0x9c, 0xfa, 0xc4, 0xb7, 0x28, 0xf6, 0x48, 0xd8,
+	0x73, 0x7c, 0x2c, 0x1d, 0x36, 0x52, 0xd2, 0xad, 0xb2, 0x76, 0xeb, 0x5f, 0x13, 0x6e, 0xe9, 0xe8,
+	0xa8, 0x3f, 0x1b, 0x91, 0x7d, 0x52, 0xe1, 0x4f, 0x03, 0x1a, 0x95, 0x1b, 0xd4, 0x32, 0x6f, 0x4e,
+	0xe0, 0x35, 0x25, 0x9c, 0x29, 0xd0, 0xd0, 0x43, 0x28, 0x78, 0xe4, 0x0c, 0xbb, 0x74, 0x48, 0x43,
+	0xd2, 0xa3, 0x46, 0x3a, 0x16, 0x7a, 0xde, 0x23, 0x67, 0x7b, 0x1a, 0x02, 0x7d, 0x09, 0x25, 0x97,
+	0x70, 0x1a, 0x71, 0x6c, 0x0d, 0xbc, 0x81, 0x4b, 0xb8, 0x33, 0xa4, 0x38, 0x08, 0xa9, 0xe7, 0x0c,
+	0x3c, 0x7c, 0x1c, 0x12, 0x4b, 0x84, 0xdd, 0x98, 0x8e, 0x65, 0x68, 0x55, 0x21, 0xd7, 0xc7, 0xc0,
+	0x6d, 0x85, 0xbb, 0xa3, 0x61, 0xd1, 0x63, 0x40, 0xf4, 0xcc, 0xea, 0x13, 0xbf, 0x47, 0xf1, 0x31,
+	0xa5, 0x3a, 0x66, 0x99, 0x58, 0xc6, 0x8a, 0x23, 0xa4, 0x1d, 0x4a, 0x55, 0xb4, 0x7a, 0x60, 0x50,
+	0x8b, 0x45, 0x4f, 0x23, 0x4e, 0x3d, 0x7c, 0x3c, 0xf0, 0xed, 0x09, 0x1b, 0x33, 0xb1, 0x6c, 0x2c,
+	0x8d, 0xf1, 0x76, 0x06, 0xbe, 0x3d, 0x36, 0xd4, 0x85, 0x25, 0xd7, 0x79, 0x32, 0x70, 0x6c, 0xb1,
+	0xf2, 0x27, 0xac, 0x64, 0x63, 0x59, 0xb9, 0x31, 0x01, 0x36, 0xb6, 0xf1, 0x39, 0x2c, 0x07, 0x24,
+	0xe4, 0x0e, 0x71, 0xf1, 0xa4, 0x2d, 0x65, 0x27, 0x17, 0xcb, 0xce, 0xdf, 0x34, 0xe0, 0xde, 0x39,
+	0x9e, 0xb2, 0xb5, 0x09, 0x4b, 0x22, 0x5c, 0x8e, 0xdf, 0x13, 0xf8, 0x14, 0xd3, 0x80, 0x59, 0x7d,
+	0xec, 0xd8, 0x06, 0x08, 0x3b, 0x26, 0xd2, 0x42, 0x93, 0x70, 0xba, 0x2d, 0x44, 0xbb, 0x36, 0x3a,
+	0x84, 0x45, 0x7e, 0x4a, 0x02, 0xec, 0x32, 0x76, 0xd2, 0x25, 0xd6, 0x09, 0x3e, 0x75, 0x7c, 0x9b,
+	0x9d, 0x1a, 0xf9, 0xb5, 0xc4, 0x7a, 0x7e, 0x6b, 0xb9, 0xac, 0x0a, 0xba, 0x3c, 0x2a, 0xe8, 0x72,
+	0x43, 0x17, 0x74, 0x2d, 0x2b, 0x9c, 0xfe, 0xf6, 0xe5, 0x6a, 0xc2, 0x44, 0x02, 0x60, 0x4f, 0xeb,
+	0x1f, 0x49, 0x75, 0xb4, 0x0b, 0xc5, 0x20, 0xa4, 0x01, 0x71, 0x6c, 0xdc, 0x25, 0x36, 0xb6, 0x69,
+	0x97, 0x1b, 0x05, 0x0d, 0xa9, 0x19, 0x43, 0xd0, 0x4b, 0x59, 0xd3, 0x4b, 0xb9, 0xce, 0x1c, 0xbf,
+	0x96, 0x16, 0x90, 0xe6, 0x9c, 0x56, 0xac, 0x11, 0xbb, 0x41, 0xbb, 0x1c, 0x3d, 0x86, 0xa2, 0xa8,
+	0x9d, 0xc9, 0x8b, 0x19, 0xb3, 0x32, 0x6e, 0x5b, 0x1f, 0x16, 0x37, 0xe9, 0xec, 0x9c, 0x47, 0xce,
+	0x76, 0xce, 0xc3, 0x80, 0x1e, 0x41, 0x9e, 0x85, 0xc4, 0x72, 0x29, 0x96, 0x6c, 0x34, 0xff, 0x57,
+	0xd9, 0x08, 0x14, 0x9a, 0xf8, 0x2e, 0x6d, 0xc0, 0x82, 0x22, 0xbb, 0x3d, 0x12, 0xf1, 0x4f, 0x34,
+	0xe9, 0x4c, 0xd0, 0x51, 0xe2, 0x02, 0x1d, 0x95, 0x7e, 0x98, 0x86, 0x54, 0xb5, 0xd9, 0xfc, 0x08,
+	0xcc, 0x38, 0x32, 0x98, 0xbd, 0xc8, 0x7f, 0x0f, 0xa1, 0x20, 0x1e, 0x01, 0x87, 0x34, 0xa2, 0xe1,
+	0x90, 0x1a, 0xc9, 0x58, 0xd9, 0x98, 0x17, 0x18, 0xa6, 0x82, 0x40, 0x1d, 0x98, 0x7d, 0x32, 0x60,
+	0xfc, 0x1c, 0x33, 0x1e, 0x8f, 0x16, 0x24, 0xc8, 0x08, 0xb4, 0x09, 0x10, 0x3d, 0x09, 0x39, 0xb6,
+	0x69, 0xc0, 0xfb, 0x31, 0xb9, 0x33, 0x27, 0x10, 0x1a, 0x02, 0x00, 0x7d, 0x2a, 0x72, 0xd3, 0x11,
+	0x84, 0x3f, 0x70, 0xb9, 0x13, 0xb8, 0x0e, 0x0d, 0x63, 0xf2, 0xe4, 0xbc, 0xc4, 0x69, 0x8e, 0x61,
+	0x84, 0xa7, 0x9c, 0x71, 0x51, 0xea, 0xcc, 0xef, 0xc5, 0xe4, 0xc3, 0x9c, 0x44, 0xd8, 0x63, 0x7e,
+	0x0f, 0xb5, 0x20, 0xaf, 0xe0, 0xa2, 0x3e, 0x0b, 0x79, 0x4c, 0xee, 0x53, 0x1e, 0x75, 0x04, 0x02,
+	0xfa, 0x0c, 0x8a, 0x11, 0xe5, 0xdc, 0xa5, 0x1e, 0xf5, 0x39, 0x96, 0xde, 0x1b, 0xb9, 0xd8, 0xb5,
+	0x34, 0x7f, 0x8e, 0xd5, 0x16, 0x50, 0xa5, 0x1f, 0xd3, 0x90, 0x6d, 0xb3, 0xc8, 0x91, 0x3d, 0xe2,
+	0x9f, 0x30, 0xc7, 0x43, 0x62, 0xd3, 0x10, 0x13, 0xdb, 0x0e, 0x69, 0x14, 0xa9, 0x84, 0x36, 0x67,
+	0xd5, 0x6e, 0x55, 0x6d, 0x8e, 0xb3, 0x3d, 0x79, 0x3d, 0xd9, 0x5e, 0x83, 0x74, 0xe4, 0x7c, 0x11,
+	0x37, 0xef, 0xa4, 0x2e, 0xda, 0x81, 0x8c, 0x9a, 0x05, 0x62, 0xe6, 0x9a, 0xd6, 0x16, 0xc5, 0xc0,
+	0x02, 0xea, 0x63, 0x9f, 0x89, 0x80, 0x10, 0x37, 0x66, 0x96, 0x15, 0x04, 0xc8, 0xbe, 0xc6, 0x78,
+	0xcf, 0xbe, 0x9f, 0xf9, 0x38, 0x7d, 0xff, 0x1e, 0x2c, 0xbb, 0x24, 0xe2, 0x78, 0x10, 0xd8, 0x84,
+	0x53, 0x1b, 0x77, 0x5d, 0x66, 0x9d, 0x60, 0x7f, 0xe0, 0x75, 0x69, 0x28, 0xd3, 0x33, 0x65, 0xde,
+	0x14, 0x07, 0x0e, 0x95, 0xbc, 0x26, 0xc4, 0xfb, 0x52, 0x8a, 0xee, 0x42, 0x5e, 0x0f, 0x58, 0x1e,
+	0xb3, 0xa9, 0xee, 0xb0, 0x48, 0x3b, 0x08, 0x6a, 0x58, 0x6a, 0x32, 0x9b, 0x9a, 0xe0, 0x8d, 0xbf,
+	0x4b, 0x04, 0xe6, 0x35, 0x09, 0x74, 0x7c, 0x12, 0x44, 0x7d, 0xc6, 0xd1, 0xbf, 0x21, 0x45, 0x3c,
+	0x4f, 0xe6, 0x52, 0x7e, 0xeb, 0x46, 0xf9, 0xe2, 0x44, 0x5b, 0xae, 0x36, 0x9b, 0xba, 0x8d, 0x88,
+	0x53, 0xe8, 0x36, 0x14, 0xb8, 0xe3, 0xd1, 0x88, 0x13, 0x2f, 0xc0, 0x5e, 0x24, 0x93, 0x2c, 0x65,
+	0xe6, 0xc7, 0x7b, 0xcd, 0xa8, 0xf4, 0x75, 0x02, 0x66, 0x1b, 0xfb, 0x66, 0xd5, 0x75, 0x99, 0x25,
+	0x3b, 0x1b, 0x5a, 0x84, 0x69, 0xd9, 0x38, 0x35, 0x3f, 0xab, 0x05, 0xb2, 0x20, 0x43, 0x3c, 0x36,
+	0xf0, 0xb9, 0x91, 0x5c, 0x4b, 0xbd, 0xbb, 0x8f, 0xfd, 0x57, 0x38, 0xf0, 0xfd, 0xcb, 0xd5, 0xf5,
+	0xf7, 0x08, 0xbb, 0x50, 0x88, 0x4c, 0x0d, 0x5d, 0xfa, 0x2e, 0x01, 0xc5, 0x43, 0xdf, 0x62, 0x43,
+	0x1a, 0xd2, 0x71, 0x03, 0xbc, 0xe6, 0x7e, 0xb0, 0x33, 0x71, 0x91, 0x0f, 0x4d, 0x92, 0x5d, 0x9f,
+	0x8f, 0x7d, 0xfd, 0x3d, 0x09, 0x4b, 0xd5, 0x40, 0xf0, 0x9e, 0xad, 0x1b, 0x6a, 0x9b, 0x3c, 0x15,
+	0x5c, 0x70, 0xdd, 0x0e, 0xdf, 0x84, 0x8c, 0xa2, 0x0c, 0xe5, 0xb0, 0xa9, 0x57, 0xe8, 0x01, 0xcc,
+	0x04, 0xca, 0x62, 0xcc, 0x6a, 0x1f, 0xa9, 0x23, 0x1f, 0xfe, 0xfe, 0xae, 0x62, 0x8a, 0xc7, 0x02,
+	0xcb, 0xd6, 0x9f, 0x96, 0xd1, 0x6d, 0x28, 0xa8, 0xca, 0xe9, 0x53, 0xa7, 0xd7, 0xe7, 0x92, 0x17,
+	0x52, 0x66, 0x5e, 0xee, 0x3d, 0x90, 0x5b, 0x97, 0x32, 0x37, 0x73, 0x39, 0x73, 0xbf, 0x4a, 0xc3,
+	0xc2, 0xe4, 0x08, 0x48, 0x2d, 0x16, 0xda, 0xd7, 0x1d, 0xfc, 0xcb, 0x2c, 0x9e, 0x7c, 0x1b, 0x8b,
+	0x6f, 0x00, 0x1a, 0x4d, 0xb7, 0xec, 0xfc, 0xa8, 0x7c, 0x16, 0x73, 0xe1, 0x5c, 0x32, 0x3a, 0x7e,
+	0x04, 0xf3, 0xa3, 0x4d, 0x6a, 0x63, 0x49, 0xd8, 0xf1, 0x82, 0x3c, 0x77, 0x0e, 0xd3, 0x11, 0xd4,
+	0xdd, 0x80, 0x69, 0xd5, 0xd5, 0xe2, 0x51, 0xad, 0x52, 0x46, 0xff, 0x83, 0xec, 0x78, 0x6a, 0xcd,
+	0xbc, 0xdf, 0xd4, 0x3a, 0xd3, 0xd5, 0xd5, 0xfa, 0xe6, 0xdb, 0xce, 0x5c, 0xfd, 0xb6, 0xd9, 0x4b,
+	0x6f, 0x8b, 0xfe, 0x03, 0x99, 0x90, 0x92, 0x88, 0xf9, 0xba, 0x3d, 0x2f, 0xea, 0x8b, 0x14, 0xea,
+	0xf2, 0xa7, 0x92, 0x29, 0x65, 0xa6, 0x3e, 0x53, 0xfa, 0x2d, 0x09, 0x0b, 0xba, 0x06, 0x3b, 0xe3,
+	0x96, 0x7c, 0xdd, 0x99, 0x20, 0xc7, 0xa6, 0x37, 0x2a, 0x23, 0x19, 0x77, 0x6c, 0xba, 0x58, 0x0f,
+	0x57, 0xd4, 0x5f, 0xea, 0x63, 0xd7, 0x5f, 0xfa, 0xea, 0x37, 0x9a, 0xbe, 0xf4, 0x46, 0x77, 0xfe,
+	0x0f, 0xb9, 0x86, 0x13, 0x52, 0x05, 0xb9, 0x0c, 0x4b, 0x8d, 0x5d, 0x73, 0xbb, 0x7e, 0xb0, 0xdb,
+	0xda, 0xc7, 0x87, 0xfb, 0x9d, 0xf6, 0x76, 0x7d, 0x77, 0x67, 0x77, 0xbb, 0x51, 0x9c, 0x42, 0x59,
+	0x48, 0xef, 0xb5, 0xf6, 0xef, 0x17, 0x13, 0x28, 0x07, 0xd3, 0x9d, 0x07, 0x2d, 0xf3, 0xa0, 0x98,
+	0xbc, 0xd3, 0x83, 0xb9, 0x83, 0x53, 0x12, 0xd4, 0x89, 0x6b, 0xb5, 0x02, 0x89, 0xb0, 0x06, 0xff,
+	0x38, 0x38, 0xaa, 0xb6, 0x71, 0xbd, 0xba, 0x57, 0xc7, 0xad, 0xf6, 0xdb, 0x81, 0x3a, 0xed, 0xd6,
+	0x41, 0x31, 0x81, 0x16, 0xa1, 0xf8, 0xf0, 0xb0, 0x75, 0xb0, 0x8d, 0xab, 0x9d, 0xce, 0xf6, 0x01,
+	0xee, 0x1c, 0x55, 0xdb, 0xc5, 0x24, 0xba, 0x01, 0xf3, 0xb5, 0x6a, 0xe7, 0xc2, 0x66, 0xaa, 0x76,
+	0xff, 0xd9, 0xab, 0x95, 0xc4, 0xf3, 0x57, 0x2b, 0x89, 0x5f, 0x5e, 0xad, 0x24, 0xbe, 0x79, 0xbd,
+	0x32, 0xf5, 0xfc, 0xf5, 0xca, 0xd4, 0x4f, 0xaf, 0x57, 0xa6, 0x1e, 0x6d, 0x5c, 0x95, 0x09, 0xa3,
+	0x7f, 0x21, 0xc9, 0x98, 0x76, 0x33, 0xf2, 0x37, 0xe0, 0xdd, 0x3f, 0x06, 0x00, 0x5b, 0x38, 0x7b,
+	0xca, 0x61, 0x12, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -903,6 +1181,16 @@ func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MarginMode.Size()
+		i -= size
+		if _, err := m.MarginMode.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.LastUpdatedBlockNumber != 0 {
 		i = encodeVarintState(dAtA, i, uint64(m.LastUpdatedBlockNumber))
 		i--
@@ -1048,85 +1336,358 @@ func (m *DNRAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UncoveredBadDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UncoveredBadDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UncoveredBadDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.Enabled {
-		n += 2
-	}
-	l = m.MaintenanceMarginRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.MaxLeverage.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LatestCumulativePremiumFraction.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.ExchangeFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.EcosystemFundFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LiquidationFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.PartialLiquidationRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = len(m.FundingRateEpochId)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow)
-	n += 1 + l + sovState(uint64(l))
-	l = m.PrepaidBadDebt.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.MaxFundingRate.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovState(uint64(m.Version))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	l = m.OraclePair.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MarketLastVersion) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovState(uint64(m.Version))
+func (m *AppliedFundingPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AMM) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AppliedFundingPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppliedFundingPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.BaseReserve.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.QuoteReserve.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.SqrtDepth.Size()
+	if m.TimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.CumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Payment.Size()
+		i -= size
+		if _, err := m.Payment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reason.Size()
+		i -= size
+		if _, err := m.Reason.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.TimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LiquidatedSize.Size()
+		i -= size
+		if _, err := m.LiquidatedSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.CumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PremiumFraction.Size()
+		i -= size
+		if _, err := m.PremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LatestCumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ExchangeFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.EcosystemFundFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LiquidationFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PartialLiquidationRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.FundingRateEpochId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow)
+	n += 1 + l + sovState(uint64(l))
+	l = m.PrepaidBadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxFundingRate.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovState(uint64(m.Version))
+	}
+	l = m.OraclePair.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *MarketLastVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovState(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *AMM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BaseReserve.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.QuoteReserve.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.SqrtDepth.Size()
 	n += 1 + l + sovState(uint64(l))
 	l = m.PriceMultiplier.Size()
 	n += 1 + l + sovState(uint64(l))
@@ -1165,6 +1726,8 @@ func (m *Position) Size() (n int) {
 	if m.LastUpdatedBlockNumber != 0 {
 		n += 1 + sovState(uint64(m.LastUpdatedBlockNumber))
 	}
+	l = m.MarginMode.Size()
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -1179,34 +1742,722 @@ func (m *ReserveSnapshot) Size() (n int) {
 	if m.TimestampMs != 0 {
 		n += 1 + sovState(uint64(m.TimestampMs))
 	}
-	return n
-}
+	return n
+}
+
+func (m *DNRAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovState(uint64(m.Epoch))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UncoveredBadDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *AppliedFundingPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Payment.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovState(uint64(m.TimestampMs))
+	}
+	return n
+}
+
+func (m *LiquidationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.LiquidatedSize.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovState(uint64(m.TimestampMs))
+	}
+	l = m.Reason.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *FundingSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovState(uint64(m.TimestampMs))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateEpochId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingRateEpochId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidBadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrepaidBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OraclePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *DNRAllocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovState(uint64(m.Epoch))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovState(uint64(l))
+	return nil
+}
+func (m *MarketLastVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketLastVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketLastVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *AMM) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1229,10 +2480,10 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: AMM: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AMM: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1270,10 +2521,10 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1283,15 +2534,29 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1319,13 +2584,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtDepth", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1353,13 +2618,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SqrtDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1387,13 +2652,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLong", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1421,13 +2686,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1455,13 +2720,32 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1489,13 +2773,63 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1523,13 +2857,45 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateEpochId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1557,13 +2923,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingRateEpochId = string(dAtA[iNdEx:postIndex])
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1573,30 +2941,31 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidBadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1606,28 +2975,29 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrepaidBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFundingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1655,15 +3025,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBlockNumber", wireType)
 			}
-			m.Version = 0
+			m.LastUpdatedBlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1673,14 +3043,14 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.LastUpdatedBlockNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OraclePair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginMode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1708,7 +3078,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OraclePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarginMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1733,7 +3103,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketLastVersion) Unmarshal(dAtA []byte) error {
+func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1756,17 +3126,50 @@ func (m *MarketLastVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketLastVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketLastVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
 			}
-			m.Version = 0
+			m.TimestampMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1776,7 +3179,7 @@ func (m *MarketLastVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.TimestampMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1802,7 +3205,7 @@ func (m *MarketLastVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AMM) Unmarshal(dAtA []byte) error {
+func (m *DNRAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,17 +3228,36 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AMM: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNRAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AMM: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNRAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1845,29 +3267,79 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UncoveredBadDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UncoveredBadDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UncoveredBadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1895,13 +3367,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1929,13 +3401,63 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppliedFundingPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppliedFundingPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppliedFundingPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SqrtDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1963,13 +3485,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SqrtDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1997,13 +3519,11 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLong", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2031,13 +3551,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2065,15 +3585,15 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Version = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2083,16 +3603,16 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
 			}
-			var stringLen uint64
+			m.TimestampMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2102,26 +3622,11 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimestampMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -2143,7 +3648,7 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Position) Unmarshal(dAtA []byte) error {
+func (m *LiquidationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2166,15 +3671,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2202,11 +3707,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2234,13 +3741,11 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2268,13 +3773,11 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatedSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2302,13 +3805,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidatedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2336,15 +3839,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2354,31 +3857,30 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.LastUpdatedBlockNumber = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2388,11 +3890,64 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdatedBlockNumber |= int64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reason.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -2414,7 +3969,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
+func (m *FundingSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2437,17 +3992,17 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundingSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundingSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2457,30 +4012,31 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumFraction", wireType)
 			}
-			m.TimestampMs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2490,66 +4046,65 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimestampMs |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DNRAllocation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			if err := m.PremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DNRAllocation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNRAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Epoch = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2559,16 +4114,16 @@ func (m *DNRAllocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
 			}
-			var msglen int
+			m.TimestampMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2578,26 +4133,11 @@ func (m *DNRAllocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TimestampMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
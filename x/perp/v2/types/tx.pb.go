@@ -295,6 +295,151 @@ func (m *MsgAddMarginResponse) GetPosition() *Position {
 	return nil
 }
 
+// MsgAddMarginBatch: Msg to add margin to several of the sender's positions at
+// once. Either every position is topped up or none is.
+type MsgAddMarginBatch struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// margins: the margin to add for each pair. Pairs must be unique.
+	Margins []MsgAddMarginBatch_Margin `protobuf:"bytes,2,rep,name=margins,proto3" json:"margins"`
+}
+
+func (m *MsgAddMarginBatch) Reset()         { *m = MsgAddMarginBatch{} }
+func (m *MsgAddMarginBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgAddMarginBatch) ProtoMessage()    {}
+func (*MsgAddMarginBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{5}
+}
+func (m *MsgAddMarginBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddMarginBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddMarginBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddMarginBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddMarginBatch.Merge(m, src)
+}
+func (m *MsgAddMarginBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddMarginBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddMarginBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddMarginBatch proto.InternalMessageInfo
+
+func (m *MsgAddMarginBatch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAddMarginBatch) GetMargins() []MsgAddMarginBatch_Margin {
+	if m != nil {
+		return m.Margins
+	}
+	return nil
+}
+
+type MsgAddMarginBatch_Margin struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Margin types.Coin                                        `protobuf:"bytes,2,opt,name=margin,proto3" json:"margin"`
+}
+
+func (m *MsgAddMarginBatch_Margin) Reset()         { *m = MsgAddMarginBatch_Margin{} }
+func (m *MsgAddMarginBatch_Margin) String() string { return proto.CompactTextString(m) }
+func (*MsgAddMarginBatch_Margin) ProtoMessage()    {}
+func (*MsgAddMarginBatch_Margin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{5, 0}
+}
+func (m *MsgAddMarginBatch_Margin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddMarginBatch_Margin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddMarginBatch_Margin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddMarginBatch_Margin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddMarginBatch_Margin.Merge(m, src)
+}
+func (m *MsgAddMarginBatch_Margin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddMarginBatch_Margin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddMarginBatch_Margin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddMarginBatch_Margin proto.InternalMessageInfo
+
+func (m *MsgAddMarginBatch_Margin) GetMargin() types.Coin {
+	if m != nil {
+		return m.Margin
+	}
+	return types.Coin{}
+}
+
+type MsgAddMarginBatchResponse struct {
+	// responses: the response for each position, in the order of the margins
+	Responses []MsgAddMarginResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses"`
+}
+
+func (m *MsgAddMarginBatchResponse) Reset()         { *m = MsgAddMarginBatchResponse{} }
+func (m *MsgAddMarginBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddMarginBatchResponse) ProtoMessage()    {}
+func (*MsgAddMarginBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{6}
+}
+func (m *MsgAddMarginBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddMarginBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddMarginBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddMarginBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddMarginBatchResponse.Merge(m, src)
+}
+func (m *MsgAddMarginBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddMarginBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddMarginBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddMarginBatchResponse proto.InternalMessageInfo
+
+func (m *MsgAddMarginBatchResponse) GetResponses() []MsgAddMarginResponse {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
 type MsgMultiLiquidate struct {
 	Sender       string                           `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Liquidations []*MsgMultiLiquidate_Liquidation `protobuf:"bytes,2,rep,name=liquidations,proto3" json:"liquidations,omitempty"`
@@ -304,7 +449,7 @@ func (m *MsgMultiLiquidate) Reset()         { *m = MsgMultiLiquidate{} }
 func (m *MsgMultiLiquidate) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiLiquidate) ProtoMessage()    {}
 func (*MsgMultiLiquidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{5}
+	return fileDescriptor_b95cda40bf0a0f91, []int{7}
 }
 func (m *MsgMultiLiquidate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +501,7 @@ func (m *MsgMultiLiquidate_Liquidation) Reset()         { *m = MsgMultiLiquidate
 func (m *MsgMultiLiquidate_Liquidation) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiLiquidate_Liquidation) ProtoMessage()    {}
 func (*MsgMultiLiquidate_Liquidation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{5, 0}
+	return fileDescriptor_b95cda40bf0a0f91, []int{7, 0}
 }
 func (m *MsgMultiLiquidate_Liquidation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +545,7 @@ func (m *MsgMultiLiquidateResponse) Reset()         { *m = MsgMultiLiquidateResp
 func (m *MsgMultiLiquidateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiLiquidateResponse) ProtoMessage()    {}
 func (*MsgMultiLiquidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{6}
+	return fileDescriptor_b95cda40bf0a0f91, []int{8}
 }
 func (m *MsgMultiLiquidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +598,7 @@ func (m *MsgMultiLiquidateResponse_LiquidationResponse) String() string {
 }
 func (*MsgMultiLiquidateResponse_LiquidationResponse) ProtoMessage() {}
 func (*MsgMultiLiquidateResponse_LiquidationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{6, 0}
+	return fileDescriptor_b95cda40bf0a0f91, []int{8, 0}
 }
 func (m *MsgMultiLiquidateResponse_LiquidationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +675,7 @@ func (m *MsgMarketOrder) Reset()         { *m = MsgMarketOrder{} }
 func (m *MsgMarketOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketOrder) ProtoMessage()    {}
 func (*MsgMarketOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{7}
+	return fileDescriptor_b95cda40bf0a0f91, []int{9}
 }
 func (m *MsgMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +745,7 @@ func (m *MsgMarketOrderResponse) Reset()         { *m = MsgMarketOrderResponse{}
 func (m *MsgMarketOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketOrderResponse) ProtoMessage()    {}
 func (*MsgMarketOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{8}
+	return fileDescriptor_b95cda40bf0a0f91, []int{10}
 }
 func (m *MsgMarketOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +790,7 @@ func (m *MsgClosePosition) Reset()         { *m = MsgClosePosition{} }
 func (m *MsgClosePosition) String() string { return proto.CompactTextString(m) }
 func (*MsgClosePosition) ProtoMessage()    {}
 func (*MsgClosePosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{9}
+	return fileDescriptor_b95cda40bf0a0f91, []int{11}
 }
 func (m *MsgClosePosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,7 +846,7 @@ func (m *MsgClosePositionResponse) Reset()         { *m = MsgClosePositionRespon
 func (m *MsgClosePositionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClosePositionResponse) ProtoMessage()    {}
 func (*MsgClosePositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{10}
+	return fileDescriptor_b95cda40bf0a0f91, []int{12}
 }
 func (m *MsgClosePositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -740,7 +885,7 @@ func (m *MsgPartialClose) Reset()         { *m = MsgPartialClose{} }
 func (m *MsgPartialClose) String() string { return proto.CompactTextString(m) }
 func (*MsgPartialClose) ProtoMessage()    {}
 func (*MsgPartialClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{11}
+	return fileDescriptor_b95cda40bf0a0f91, []int{13}
 }
 func (m *MsgPartialClose) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +941,7 @@ func (m *MsgPartialCloseResponse) Reset()         { *m = MsgPartialCloseResponse
 func (m *MsgPartialCloseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPartialCloseResponse) ProtoMessage()    {}
 func (*MsgPartialCloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{12}
+	return fileDescriptor_b95cda40bf0a0f91, []int{14}
 }
 func (m *MsgPartialCloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +980,7 @@ func (m *MsgDonateToEcosystemFund) Reset()         { *m = MsgDonateToEcosystemFu
 func (m *MsgDonateToEcosystemFund) String() string { return proto.CompactTextString(m) }
 func (*MsgDonateToEcosystemFund) ProtoMessage()    {}
 func (*MsgDonateToEcosystemFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{13}
+	return fileDescriptor_b95cda40bf0a0f91, []int{15}
 }
 func (m *MsgDonateToEcosystemFund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -885,7 +1030,7 @@ func (m *MsgDonateToEcosystemFundResponse) Reset()         { *m = MsgDonateToEco
 func (m *MsgDonateToEcosystemFundResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDonateToEcosystemFundResponse) ProtoMessage()    {}
 func (*MsgDonateToEcosystemFundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{14}
+	return fileDescriptor_b95cda40bf0a0f91, []int{16}
 }
 func (m *MsgDonateToEcosystemFundResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1070,7 @@ func (m *MsgChangeCollateralDenom) Reset()         { *m = MsgChangeCollateralDen
 func (m *MsgChangeCollateralDenom) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeCollateralDenom) ProtoMessage()    {}
 func (*MsgChangeCollateralDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{15}
+	return fileDescriptor_b95cda40bf0a0f91, []int{17}
 }
 func (m *MsgChangeCollateralDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1120,7 @@ func (m *MsgChangeCollateralDenomResponse) Reset()         { *m = MsgChangeColla
 func (m *MsgChangeCollateralDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeCollateralDenomResponse) ProtoMessage()    {}
 func (*MsgChangeCollateralDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{16}
+	return fileDescriptor_b95cda40bf0a0f91, []int{18}
 }
 func (m *MsgChangeCollateralDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,7 +1160,7 @@ func (m *MsgAllocateEpochRebates) Reset()         { *m = MsgAllocateEpochRebates
 func (m *MsgAllocateEpochRebates) String() string { return proto.CompactTextString(m) }
 func (*MsgAllocateEpochRebates) ProtoMessage()    {}
 func (*MsgAllocateEpochRebates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{17}
+	return fileDescriptor_b95cda40bf0a0f91, []int{19}
 }
 func (m *MsgAllocateEpochRebates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,7 +1211,7 @@ func (m *MsgAllocateEpochRebatesResponse) Reset()         { *m = MsgAllocateEpoc
 func (m *MsgAllocateEpochRebatesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAllocateEpochRebatesResponse) ProtoMessage()    {}
 func (*MsgAllocateEpochRebatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{18}
+	return fileDescriptor_b95cda40bf0a0f91, []int{20}
 }
 func (m *MsgAllocateEpochRebatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1112,7 +1257,7 @@ func (m *MsgWithdrawEpochRebates) Reset()         { *m = MsgWithdrawEpochRebates
 func (m *MsgWithdrawEpochRebates) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawEpochRebates) ProtoMessage()    {}
 func (*MsgWithdrawEpochRebates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{19}
+	return fileDescriptor_b95cda40bf0a0f91, []int{21}
 }
 func (m *MsgWithdrawEpochRebates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,7 +1308,7 @@ func (m *MsgWithdrawEpochRebatesResponse) Reset()         { *m = MsgWithdrawEpoc
 func (m *MsgWithdrawEpochRebatesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawEpochRebatesResponse) ProtoMessage()    {}
 func (*MsgWithdrawEpochRebatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{20}
+	return fileDescriptor_b95cda40bf0a0f91, []int{22}
 }
 func (m *MsgWithdrawEpochRebatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1211,7 +1356,7 @@ func (m *MsgShiftPegMultiplier) Reset()         { *m = MsgShiftPegMultiplier{} }
 func (m *MsgShiftPegMultiplier) String() string { return proto.CompactTextString(m) }
 func (*MsgShiftPegMultiplier) ProtoMessage()    {}
 func (*MsgShiftPegMultiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{21}
+	return fileDescriptor_b95cda40bf0a0f91, []int{23}
 }
 func (m *MsgShiftPegMultiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,7 +1399,7 @@ func (m *MsgShiftPegMultiplierResponse) Reset()         { *m = MsgShiftPegMultip
 func (m *MsgShiftPegMultiplierResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgShiftPegMultiplierResponse) ProtoMessage()    {}
 func (*MsgShiftPegMultiplierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{22}
+	return fileDescriptor_b95cda40bf0a0f91, []int{24}
 }
 func (m *MsgShiftPegMultiplierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1295,7 +1440,7 @@ func (m *MsgShiftSwapInvariant) Reset()         { *m = MsgShiftSwapInvariant{} }
 func (m *MsgShiftSwapInvariant) String() string { return proto.CompactTextString(m) }
 func (*MsgShiftSwapInvariant) ProtoMessage()    {}
 func (*MsgShiftSwapInvariant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{23}
+	return fileDescriptor_b95cda40bf0a0f91, []int{25}
 }
 func (m *MsgShiftSwapInvariant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1483,7 @@ func (m *MsgShiftSwapInvariantResponse) Reset()         { *m = MsgShiftSwapInvar
 func (m *MsgShiftSwapInvariantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgShiftSwapInvariantResponse) ProtoMessage()    {}
 func (*MsgShiftSwapInvariantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{24}
+	return fileDescriptor_b95cda40bf0a0f91, []int{26}
 }
 func (m *MsgShiftSwapInvariantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1381,7 +1526,7 @@ func (m *MsgWithdrawFromPerpFund) Reset()         { *m = MsgWithdrawFromPerpFund
 func (m *MsgWithdrawFromPerpFund) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawFromPerpFund) ProtoMessage()    {}
 func (*MsgWithdrawFromPerpFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{25}
+	return fileDescriptor_b95cda40bf0a0f91, []int{27}
 }
 func (m *MsgWithdrawFromPerpFund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1438,7 +1583,7 @@ func (m *MsgWithdrawFromPerpFundResponse) Reset()         { *m = MsgWithdrawFrom
 func (m *MsgWithdrawFromPerpFundResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawFromPerpFundResponse) ProtoMessage()    {}
 func (*MsgWithdrawFromPerpFundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{26}
+	return fileDescriptor_b95cda40bf0a0f91, []int{28}
 }
 func (m *MsgWithdrawFromPerpFundResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1478,7 +1623,7 @@ func (m *MsgCloseMarket) Reset()         { *m = MsgCloseMarket{} }
 func (m *MsgCloseMarket) String() string { return proto.CompactTextString(m) }
 func (*MsgCloseMarket) ProtoMessage()    {}
 func (*MsgCloseMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{27}
+	return fileDescriptor_b95cda40bf0a0f91, []int{29}
 }
 func (m *MsgCloseMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1521,7 +1666,7 @@ func (m *MsgCloseMarketResponse) Reset()         { *m = MsgCloseMarketResponse{}
 func (m *MsgCloseMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCloseMarketResponse) ProtoMessage()    {}
 func (*MsgCloseMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b95cda40bf0a0f91, []int{28}
+	return fileDescriptor_b95cda40bf0a0f91, []int{30}
 }
 func (m *MsgCloseMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1550,2723 +1695,11595 @@ func (m *MsgCloseMarketResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCloseMarketResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSettlePosition)(nil), "nibiru.perp.v2.MsgSettlePosition")
-	proto.RegisterType((*MsgRemoveMargin)(nil), "nibiru.perp.v2.MsgRemoveMargin")
-	proto.RegisterType((*MsgRemoveMarginResponse)(nil), "nibiru.perp.v2.MsgRemoveMarginResponse")
-	proto.RegisterType((*MsgAddMargin)(nil), "nibiru.perp.v2.MsgAddMargin")
-	proto.RegisterType((*MsgAddMarginResponse)(nil), "nibiru.perp.v2.MsgAddMarginResponse")
-	proto.RegisterType((*MsgMultiLiquidate)(nil), "nibiru.perp.v2.MsgMultiLiquidate")
-	proto.RegisterType((*MsgMultiLiquidate_Liquidation)(nil), "nibiru.perp.v2.MsgMultiLiquidate.Liquidation")
-	proto.RegisterType((*MsgMultiLiquidateResponse)(nil), "nibiru.perp.v2.MsgMultiLiquidateResponse")
-	proto.RegisterType((*MsgMultiLiquidateResponse_LiquidationResponse)(nil), "nibiru.perp.v2.MsgMultiLiquidateResponse.LiquidationResponse")
-	proto.RegisterType((*MsgMarketOrder)(nil), "nibiru.perp.v2.MsgMarketOrder")
-	proto.RegisterType((*MsgMarketOrderResponse)(nil), "nibiru.perp.v2.MsgMarketOrderResponse")
-	proto.RegisterType((*MsgClosePosition)(nil), "nibiru.perp.v2.MsgClosePosition")
-	proto.RegisterType((*MsgClosePositionResponse)(nil), "nibiru.perp.v2.MsgClosePositionResponse")
-	proto.RegisterType((*MsgPartialClose)(nil), "nibiru.perp.v2.MsgPartialClose")
-	proto.RegisterType((*MsgPartialCloseResponse)(nil), "nibiru.perp.v2.MsgPartialCloseResponse")
-	proto.RegisterType((*MsgDonateToEcosystemFund)(nil), "nibiru.perp.v2.MsgDonateToEcosystemFund")
-	proto.RegisterType((*MsgDonateToEcosystemFundResponse)(nil), "nibiru.perp.v2.MsgDonateToEcosystemFundResponse")
-	proto.RegisterType((*MsgChangeCollateralDenom)(nil), "nibiru.perp.v2.MsgChangeCollateralDenom")
-	proto.RegisterType((*MsgChangeCollateralDenomResponse)(nil), "nibiru.perp.v2.MsgChangeCollateralDenomResponse")
-	proto.RegisterType((*MsgAllocateEpochRebates)(nil), "nibiru.perp.v2.MsgAllocateEpochRebates")
-	proto.RegisterType((*MsgAllocateEpochRebatesResponse)(nil), "nibiru.perp.v2.MsgAllocateEpochRebatesResponse")
-	proto.RegisterType((*MsgWithdrawEpochRebates)(nil), "nibiru.perp.v2.MsgWithdrawEpochRebates")
-	proto.RegisterType((*MsgWithdrawEpochRebatesResponse)(nil), "nibiru.perp.v2.MsgWithdrawEpochRebatesResponse")
-	proto.RegisterType((*MsgShiftPegMultiplier)(nil), "nibiru.perp.v2.MsgShiftPegMultiplier")
-	proto.RegisterType((*MsgShiftPegMultiplierResponse)(nil), "nibiru.perp.v2.MsgShiftPegMultiplierResponse")
-	proto.RegisterType((*MsgShiftSwapInvariant)(nil), "nibiru.perp.v2.MsgShiftSwapInvariant")
-	proto.RegisterType((*MsgShiftSwapInvariantResponse)(nil), "nibiru.perp.v2.MsgShiftSwapInvariantResponse")
-	proto.RegisterType((*MsgWithdrawFromPerpFund)(nil), "nibiru.perp.v2.MsgWithdrawFromPerpFund")
-	proto.RegisterType((*MsgWithdrawFromPerpFundResponse)(nil), "nibiru.perp.v2.MsgWithdrawFromPerpFundResponse")
-	proto.RegisterType((*MsgCloseMarket)(nil), "nibiru.perp.v2.MsgCloseMarket")
-	proto.RegisterType((*MsgCloseMarketResponse)(nil), "nibiru.perp.v2.MsgCloseMarketResponse")
+// MsgChangeMinSnapshotIntervalMs: Changes the minimum time between two
+// reserve snapshots of a pair. Zero stores a snapshot every block.
+// [SUDO] Only callable by sudoers.
+type MsgChangeMinSnapshotIntervalMs struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	IntervalMs uint64 `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
 }
 
-func init() { proto.RegisterFile("nibiru/perp/v2/tx.proto", fileDescriptor_b95cda40bf0a0f91) }
-
-var fileDescriptor_b95cda40bf0a0f91 = []byte{
-	// 1686 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x6f, 0x1b, 0x47,
-	0x12, 0xe6, 0x88, 0x14, 0x25, 0x95, 0x64, 0x3d, 0xc6, 0xb2, 0x44, 0x73, 0xbd, 0x94, 0x3c, 0xd8,
-	0xf5, 0x6a, 0x0f, 0x22, 0x6d, 0xed, 0x02, 0x8b, 0x5d, 0x60, 0x77, 0xa1, 0x87, 0x15, 0x38, 0x30,
-	0x6d, 0x7a, 0x64, 0xd8, 0x81, 0xe3, 0x60, 0xdc, 0xe2, 0xb4, 0x46, 0x03, 0x0f, 0xbb, 0xe9, 0x99,
-	0x26, 0x29, 0x39, 0xb7, 0x1c, 0x73, 0xca, 0x21, 0x87, 0x00, 0x01, 0x72, 0x0b, 0x10, 0xe4, 0x10,
-	0x20, 0x87, 0x24, 0x97, 0xfc, 0x00, 0x1f, 0x7d, 0x0c, 0x82, 0xc0, 0x09, 0xac, 0x4b, 0xae, 0x31,
-	0xf2, 0x03, 0x82, 0xee, 0x79, 0x70, 0x86, 0x6e, 0x52, 0x14, 0x23, 0x13, 0x48, 0x90, 0x93, 0xd4,
-	0xd3, 0xd5, 0x5f, 0xd5, 0x57, 0x55, 0xdd, 0x55, 0xdd, 0x84, 0x45, 0x62, 0xef, 0xda, 0x6e, 0xa3,
-	0x54, 0xc7, 0x6e, 0xbd, 0xd4, 0x5c, 0x2b, 0xb1, 0x83, 0x62, 0xdd, 0xa5, 0x8c, 0xaa, 0xd3, 0xfe,
-	0x44, 0x91, 0x4f, 0x14, 0x9b, 0x6b, 0xf9, 0x0b, 0x16, 0xa5, 0x96, 0x83, 0x4b, 0xa8, 0x6e, 0x97,
-	0x10, 0x21, 0x94, 0x21, 0x66, 0x53, 0xe2, 0xf9, 0xd2, 0xf9, 0x42, 0x95, 0x7a, 0x35, 0xea, 0x95,
-	0x76, 0x91, 0x87, 0x4b, 0xcd, 0x2b, 0xbb, 0x98, 0xa1, 0x2b, 0xa5, 0x2a, 0xb5, 0x49, 0x30, 0x3f,
-	0x6f, 0x51, 0x8b, 0x8a, 0x7f, 0x4b, 0xfc, 0xbf, 0xe0, 0x6b, 0xbe, 0x43, 0xb9, 0xc7, 0x10, 0xc3,
-	0xfe, 0x9c, 0xf6, 0xbe, 0x02, 0x73, 0x65, 0xcf, 0xda, 0xc1, 0x8c, 0x39, 0xb8, 0x42, 0x3d, 0x9b,
-	0xab, 0x53, 0x17, 0x20, 0xeb, 0x61, 0x62, 0x62, 0x37, 0xa7, 0x2c, 0x2b, 0x2b, 0x13, 0x7a, 0x30,
-	0x52, 0xcb, 0x90, 0xa9, 0x23, 0xdb, 0xcd, 0x8d, 0xf0, 0xaf, 0x1b, 0xff, 0x7e, 0xf2, 0x6c, 0x29,
-	0xf5, 0xed, 0xb3, 0xa5, 0x2b, 0x96, 0xcd, 0xf6, 0x1b, 0xbb, 0xc5, 0x2a, 0xad, 0x95, 0x6e, 0x08,
-	0x55, 0x9b, 0xfb, 0xc8, 0x26, 0xa5, 0x40, 0xed, 0x41, 0xa9, 0x4a, 0x6b, 0x35, 0x4a, 0x4a, 0xc8,
-	0xf3, 0x30, 0x2b, 0x56, 0x90, 0xed, 0xea, 0x02, 0x46, 0xcd, 0xc1, 0x58, 0x13, 0xbb, 0x9e, 0x4d,
-	0x49, 0x2e, 0xbd, 0xac, 0xac, 0x64, 0xf4, 0x70, 0xa8, 0x7d, 0xae, 0xc0, 0x4c, 0xd9, 0xb3, 0x74,
-	0x5c, 0xa3, 0x4d, 0x5c, 0x46, 0xae, 0x65, 0x0f, 0xcd, 0xa8, 0x7f, 0x41, 0xb6, 0x26, 0x14, 0x0a,
-	0x9b, 0x26, 0xd7, 0xce, 0x17, 0x7d, 0xa7, 0x17, 0xb9, 0xd3, 0x8b, 0x81, 0xd3, 0x8b, 0x9b, 0xd4,
-	0x26, 0x1b, 0x19, 0xae, 0x4b, 0x0f, 0xc4, 0xb5, 0x1f, 0x15, 0x58, 0xec, 0xb0, 0x59, 0xc7, 0x5e,
-	0x9d, 0x12, 0x0f, 0xab, 0xff, 0x03, 0xf0, 0xa5, 0x0c, 0xda, 0x60, 0xc2, 0xfe, 0x3e, 0x80, 0x27,
-	0xfc, 0x25, 0x37, 0x1b, 0x4c, 0xbd, 0x0b, 0x33, 0x7b, 0x0d, 0x62, 0xda, 0xc4, 0x32, 0xea, 0xe8,
-	0xb0, 0x86, 0x09, 0x0b, 0xe8, 0x16, 0x03, 0xba, 0x97, 0x62, 0x74, 0x83, 0x24, 0xf1, 0xff, 0xac,
-	0x7a, 0xe6, 0xc3, 0x12, 0x3b, 0xac, 0x63, 0xaf, 0xb8, 0x85, 0xab, 0xfa, 0x74, 0x00, 0x53, 0xf1,
-	0x51, 0xd4, 0x7f, 0xc2, 0x78, 0x3d, 0x88, 0x7a, 0xc0, 0x37, 0x57, 0x4c, 0xa6, 0x64, 0x31, 0xcc,
-	0x0a, 0x3d, 0x92, 0xd4, 0x3e, 0x53, 0x60, 0xaa, 0xec, 0x59, 0xeb, 0xa6, 0xf9, 0x1b, 0x89, 0xcd,
-	0xc7, 0x0a, 0xcc, 0xc7, 0x0d, 0x8e, 0x02, 0x23, 0x71, 0xac, 0x72, 0xea, 0x8e, 0x1d, 0xe9, 0xdb,
-	0xb1, 0x3f, 0xfb, 0xdb, 0xb1, 0xdc, 0x70, 0x98, 0x7d, 0xdd, 0x7e, 0xd4, 0xb0, 0x4d, 0xc4, 0x70,
-	0x57, 0xef, 0xde, 0x82, 0x29, 0x27, 0x10, 0xe2, 0x87, 0x44, 0x6e, 0x64, 0x39, 0xbd, 0x32, 0xb9,
-	0xb6, 0xda, 0xa9, 0xe7, 0x25, 0xc0, 0xe2, 0xf5, 0xf6, 0x2a, 0x3d, 0x01, 0x91, 0x67, 0x30, 0x19,
-	0x9b, 0x8c, 0xe2, 0xa7, 0x9c, 0x4e, 0xfc, 0x16, 0x20, 0xcb, 0x5c, 0xc4, 0x89, 0x8c, 0xf8, 0x44,
-	0xfc, 0x91, 0xf6, 0x65, 0x1a, 0xce, 0xbf, 0x64, 0x65, 0x14, 0x23, 0xd4, 0x41, 0x53, 0x11, 0x34,
-	0xff, 0x7b, 0x2c, 0xcd, 0x10, 0x20, 0x41, 0x37, 0xf8, 0xd6, 0x41, 0xfb, 0x8b, 0x11, 0x38, 0x2b,
-	0x91, 0xe2, 0x27, 0x94, 0xd7, 0xa8, 0x56, 0xb1, 0xe7, 0x09, 0x17, 0x8c, 0xeb, 0xe1, 0x50, 0x9d,
-	0x87, 0x51, 0xec, 0xba, 0x34, 0x64, 0xe2, 0x0f, 0xd4, 0x6d, 0x98, 0x0e, 0x71, 0xa9, 0x6b, 0xec,
-	0x61, 0xdc, 0x5f, 0xa2, 0x2a, 0xfa, 0x99, 0xf6, 0xb2, 0x6d, 0x8c, 0xd5, 0xff, 0xc3, 0x24, 0xa7,
-	0x65, 0xe0, 0x3d, 0x01, 0x92, 0xe9, 0x0f, 0x64, 0x82, 0xaf, 0xb9, 0xba, 0xc7, 0x01, 0xda, 0x9e,
-	0x1e, 0x8d, 0x7b, 0x3a, 0x0a, 0x68, 0xf6, 0x54, 0x02, 0xaa, 0x7d, 0x95, 0x86, 0x69, 0xee, 0x77,
-	0xe4, 0x3e, 0xc4, 0xec, 0xa6, 0xcb, 0x35, 0x0c, 0xe9, 0x28, 0x58, 0x85, 0x8c, 0x67, 0x9b, 0xbe,
-	0x7f, 0xa7, 0xd7, 0xce, 0x77, 0x26, 0xc3, 0x96, 0xed, 0xe2, 0xaa, 0x08, 0xa5, 0x10, 0x53, 0xef,
-	0x83, 0xfa, 0xa8, 0x41, 0x19, 0x36, 0x04, 0x90, 0x81, 0x6a, 0xb4, 0x41, 0x98, 0xf0, 0xeb, 0xc9,
-	0xb6, 0xfa, 0x35, 0xc2, 0xf4, 0x59, 0x81, 0xb4, 0xce, 0x81, 0xd6, 0x05, 0x8e, 0xfa, 0x3a, 0x8c,
-	0x3b, 0xb8, 0x89, 0x5d, 0x64, 0x61, 0xdf, 0xdf, 0x27, 0x3e, 0x3e, 0xa2, 0xf5, 0x2a, 0x86, 0x45,
-	0x1e, 0xdf, 0x84, 0xa1, 0x86, 0x63, 0xd7, 0x6c, 0x16, 0x04, 0xed, 0xa4, 0xe6, 0xce, 0x73, 0xb8,
-	0x98, 0xb5, 0xd7, 0x39, 0x96, 0x76, 0x34, 0x0a, 0x0b, 0xc9, 0xc8, 0x45, 0x49, 0x1f, 0x3f, 0xba,
-	0x94, 0x7e, 0x8f, 0x2e, 0x75, 0x1f, 0x72, 0xf8, 0xa0, 0xba, 0x8f, 0x88, 0x85, 0x4d, 0x83, 0x50,
-	0xfe, 0x0d, 0x39, 0x46, 0x13, 0x39, 0x0d, 0x3c, 0x60, 0xad, 0x5a, 0x88, 0xf0, 0x6e, 0x04, 0x70,
-	0x77, 0x38, 0x9a, 0xba, 0x07, 0x8b, 0x6d, 0x4d, 0xa1, 0x7e, 0xc3, 0xb3, 0x1f, 0xfb, 0xd9, 0x70,
-	0x72, 0x45, 0xe7, 0x22, 0xb8, 0x90, 0xd7, 0x8e, 0xfd, 0x58, 0x5a, 0x1b, 0x32, 0xa7, 0x52, 0x1b,
-	0x6e, 0xc1, 0x94, 0x8b, 0x91, 0x63, 0x3f, 0xe6, 0xf6, 0x13, 0x67, 0xc0, 0x94, 0x99, 0x0c, 0x31,
-	0x2a, 0xc4, 0x51, 0x1f, 0xc0, 0x7c, 0x83, 0xc4, 0x41, 0x0d, 0xb4, 0xc7, 0xb0, 0x3b, 0x40, 0xca,
-	0x70, 0x68, 0xb5, 0x8d, 0x55, 0x21, 0xce, 0x3a, 0x47, 0x52, 0xef, 0xc0, 0x4c, 0xd0, 0xc2, 0x30,
-	0x6a, 0x34, 0x51, 0xc3, 0x61, 0xb9, 0xb1, 0x81, 0xc0, 0xcf, 0xf8, 0x30, 0xb7, 0xe9, 0x1d, 0x0e,
-	0xa2, 0xbe, 0x09, 0x73, 0x51, 0x0c, 0xc3, 0xb4, 0xc9, 0x8d, 0x0f, 0x84, 0x3c, 0x1b, 0x02, 0x85,
-	0xf9, 0xa2, 0x1d, 0xc2, 0x6c, 0xd9, 0xb3, 0x36, 0x1d, 0xea, 0x0d, 0xbb, 0xb9, 0xd5, 0x5e, 0xa4,
-	0x21, 0xd7, 0xa9, 0x3b, 0xda, 0x62, 0xbd, 0x36, 0x8b, 0x32, 0xac, 0xcd, 0x32, 0xf2, 0x8a, 0x37,
-	0x4b, 0xfa, 0x95, 0x6c, 0x96, 0xcc, 0xaf, 0xdf, 0x2c, 0x6f, 0xc0, 0x6c, 0x3b, 0x95, 0xe3, 0x65,
-	0xf2, 0xe4, 0xc6, 0x86, 0xb9, 0x7c, 0xdb, 0x6f, 0x64, 0xbe, 0xf6, 0xef, 0x2d, 0x15, 0xe4, 0x32,
-	0x1b, 0x39, 0x22, 0xf6, 0xc3, 0x2a, 0x88, 0x1b, 0xbc, 0x20, 0x0e, 0x7c, 0x04, 0x8a, 0xb5, 0xda,
-	0x4f, 0x69, 0x71, 0x85, 0x89, 0x9b, 0xff, 0x47, 0xca, 0xfe, 0xce, 0x53, 0xf6, 0x1d, 0x45, 0x9c,
-	0x53, 0x5b, 0x94, 0x20, 0x86, 0x6f, 0xd3, 0xab, 0x55, 0xea, 0x1d, 0x7a, 0x0c, 0xd7, 0xb6, 0x1b,
-	0xc4, 0xec, 0x9a, 0xbb, 0x37, 0x60, 0xdc, 0xe4, 0x0b, 0xda, 0xb7, 0x9b, 0x1e, 0xcd, 0xe9, 0x22,
-	0xb7, 0xf0, 0xc5, 0xb3, 0xa5, 0x99, 0x43, 0x54, 0x73, 0xfe, 0xa3, 0x85, 0x0b, 0x35, 0x3d, 0xc2,
-	0xd0, 0x34, 0x58, 0xee, 0x66, 0x43, 0x98, 0x80, 0xda, 0x4d, 0xff, 0x3c, 0x15, 0x81, 0xdc, 0xa4,
-	0x8e, 0x83, 0x18, 0x76, 0x91, 0xb3, 0x85, 0x09, 0xad, 0x75, 0xb5, 0xf3, 0x4f, 0x30, 0x41, 0x70,
-	0xcb, 0x30, 0xb9, 0x50, 0xd0, 0xa9, 0x8f, 0x13, 0xdc, 0x12, 0x8b, 0x02, 0xa5, 0x52, 0xc0, 0x48,
-	0xe9, 0x07, 0xfe, 0xa5, 0x7e, 0xdd, 0x71, 0x68, 0x15, 0x31, 0x7c, 0xb5, 0x4e, 0xab, 0xfb, 0x3a,
-	0xde, 0x45, 0x0c, 0x7b, 0x5d, 0x95, 0x62, 0x18, 0x73, 0x7d, 0x91, 0xe0, 0x46, 0xd6, 0xc3, 0x37,
-	0x97, 0xb9, 0x6f, 0x3e, 0xfd, 0x7e, 0x69, 0xa5, 0x8f, 0xe8, 0xf1, 0x05, 0x9e, 0x1e, 0x62, 0x6b,
-	0x1f, 0x29, 0xb0, 0xd4, 0xc5, 0xb4, 0x68, 0xd3, 0xbe, 0x0d, 0x67, 0x19, 0x65, 0xc8, 0x31, 0x30,
-	0x9f, 0x35, 0x42, 0xb3, 0x94, 0xd3, 0x37, 0x6b, 0x4e, 0xe8, 0x89, 0x1b, 0xa1, 0x5d, 0x13, 0xae,
-	0xbb, 0x6b, 0xb3, 0x7d, 0xd3, 0x45, 0xad, 0xbe, 0x5c, 0xb7, 0x00, 0x59, 0x61, 0xa9, 0xef, 0xb9,
-	0x8c, 0x1e, 0x8c, 0xb4, 0x0f, 0x7d, 0xae, 0x32, 0xac, 0x88, 0xeb, 0x01, 0xcc, 0xb5, 0x82, 0x79,
-	0xf2, 0x2a, 0x99, 0xce, 0x46, 0x5a, 0x42, 0xa2, 0x4f, 0x15, 0x38, 0x57, 0xf6, 0xac, 0x9d, 0x7d,
-	0x7b, 0x8f, 0x55, 0xb0, 0x7f, 0x0b, 0xad, 0x3b, 0xf6, 0xf0, 0x2e, 0x43, 0x15, 0x98, 0xe2, 0x69,
-	0x5e, 0xc7, 0x96, 0x51, 0xe3, 0x8d, 0xd9, 0x60, 0xc7, 0x18, 0x10, 0xdc, 0x0a, 0xcc, 0xd7, 0x96,
-	0xe0, 0xcf, 0x52, 0x46, 0xd1, 0xc6, 0xf8, 0x2e, 0xc6, 0x79, 0xa7, 0x85, 0xea, 0xd7, 0x48, 0x13,
-	0xb9, 0x36, 0x22, 0x6c, 0x58, 0x9c, 0xef, 0x83, 0xca, 0x39, 0x7b, 0x2d, 0x54, 0x37, 0xec, 0x50,
-	0xf9, 0x00, 0xcc, 0xc5, 0x8d, 0x8e, 0xe0, 0x56, 0x82, 0x44, 0x9c, 0x7f, 0x62, 0x22, 0xe2, 0xff,
-	0x89, 0x92, 0xc8, 0xee, 0x6d, 0x97, 0xd6, 0x2a, 0xd8, 0xad, 0xf7, 0x3c, 0x35, 0xb7, 0x21, 0x1b,
-	0x5c, 0x3c, 0x47, 0x06, 0x32, 0x33, 0x58, 0xad, 0xce, 0xc3, 0xa8, 0x7f, 0xa2, 0xa5, 0xfd, 0xb7,
-	0x07, 0x31, 0x50, 0x17, 0x61, 0x8c, 0x51, 0x03, 0x99, 0xa6, 0xeb, 0x17, 0x1c, 0x3d, 0xcb, 0xe8,
-	0xba, 0x69, 0xba, 0xda, 0xc5, 0xc4, 0xde, 0x89, 0x5b, 0x1a, 0xb1, 0x69, 0x89, 0x6b, 0xbc, 0x28,
-	0xf8, 0xfe, 0x8d, 0x70, 0x58, 0x5d, 0x72, 0x4e, 0xdc, 0x42, 0x63, 0x8a, 0x43, 0x93, 0xd6, 0xde,
-	0x9d, 0x82, 0x74, 0xd9, 0xb3, 0xd4, 0x7b, 0x30, 0x95, 0x78, 0x06, 0x5e, 0x92, 0xbc, 0xfb, 0xc4,
-	0x05, 0xf2, 0x7f, 0x3b, 0x46, 0x20, 0x22, 0x9d, 0x52, 0x6f, 0xc1, 0x44, 0xfb, 0x0d, 0xf3, 0x82,
-	0x64, 0x5d, 0x34, 0x9b, 0xff, 0x4b, 0xaf, 0xd9, 0x18, 0xe4, 0x03, 0x98, 0xee, 0x78, 0xbd, 0xbb,
-	0x78, 0xec, 0x43, 0x55, 0xfe, 0xef, 0x7d, 0xbf, 0x65, 0x69, 0x29, 0xf5, 0x2e, 0x4c, 0xc6, 0xdf,
-	0x5b, 0x0a, 0xb2, 0xb5, 0xed, 0xf9, 0xfc, 0xa5, 0xde, 0xf3, 0x31, 0xe0, 0xb7, 0xe0, 0x4c, 0xf2,
-	0xa6, 0xb4, 0x2c, 0x59, 0x9a, 0x90, 0xc8, 0xaf, 0x1c, 0x27, 0x11, 0x83, 0xbf, 0x07, 0x53, 0x89,
-	0xbe, 0x58, 0x16, 0xc8, 0xb8, 0x80, 0x34, 0x90, 0xb2, 0xd6, 0x54, 0x4b, 0xa9, 0x06, 0x4c, 0x77,
-	0xfc, 0x84, 0x21, 0xf3, 0x7a, 0x52, 0xe4, 0x44, 0xc6, 0x37, 0xe0, 0x9c, 0xbc, 0x43, 0x92, 0x81,
-	0x48, 0x25, 0xf3, 0x97, 0xfb, 0x95, 0x4c, 0xaa, 0x95, 0x37, 0x3c, 0x52, 0xdb, 0x65, 0x92, 0x52,
-	0xb5, 0xbd, 0x7b, 0x9e, 0x94, 0xea, 0xc2, 0xbc, 0xb4, 0xe3, 0x91, 0x45, 0x44, 0x26, 0x98, 0x2f,
-	0xf5, 0x29, 0x98, 0xd4, 0x29, 0x6d, 0x15, 0x64, 0x3a, 0x65, 0x82, 0x52, 0x9d, 0xbd, 0x1a, 0x06,
-	0x2d, 0xa5, 0x3a, 0xa0, 0x4a, 0x8a, 0xf6, 0x5f, 0x65, 0xa9, 0xf3, 0x92, 0x58, 0x7e, 0xb5, 0x2f,
-	0x31, 0x89, 0xb6, 0x64, 0xb9, 0xec, 0xaa, 0x2d, 0x21, 0xd6, 0x5d, 0x9b, 0xbc, 0x3c, 0x25, 0xfc,
-	0x99, 0x28, 0x4e, 0xbd, 0xfc, 0x19, 0x17, 0xec, 0xe9, 0x4f, 0x69, 0x11, 0x11, 0x47, 0x53, 0xbc,
-	0x86, 0x14, 0xba, 0x6d, 0x30, 0x7f, 0x5e, 0x7a, 0x34, 0x49, 0x4a, 0x81, 0x96, 0xda, 0x78, 0xed,
-	0xc9, 0xf3, 0x82, 0xf2, 0xf4, 0x79, 0x41, 0xf9, 0xe1, 0x79, 0x41, 0x79, 0xef, 0xa8, 0x90, 0x7a,
-	0x7a, 0x54, 0x48, 0x7d, 0x73, 0x54, 0x48, 0xdd, 0x5b, 0x3d, 0xae, 0xf2, 0x44, 0x3f, 0xb9, 0xf2,
-	0x32, 0xba, 0x9b, 0x15, 0x3f, 0x7b, 0xfe, 0xe3, 0x97, 0x00, 0x00, 0x00, 0xff, 0xff, 0x86, 0x40,
-	0x26, 0x57, 0x91, 0x1d, 0x00, 0x00,
+func (m *MsgChangeMinSnapshotIntervalMs) Reset()         { *m = MsgChangeMinSnapshotIntervalMs{} }
+func (m *MsgChangeMinSnapshotIntervalMs) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMinSnapshotIntervalMs) ProtoMessage()    {}
+func (*MsgChangeMinSnapshotIntervalMs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{31}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error)
-	AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error)
-	MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error)
-	MarketOrder(ctx context.Context, in *MsgMarketOrder, opts ...grpc.CallOption) (*MsgMarketOrderResponse, error)
-	ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error)
-	PartialClose(ctx context.Context, in *MsgPartialClose, opts ...grpc.CallOption) (*MsgPartialCloseResponse, error)
-	SettlePosition(ctx context.Context, in *MsgSettlePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error)
-	DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error)
-	// ChangeCollateralDenom: Updates the collateral denom. A denom is valid if it
-	// is possible to make an sdk.Coin using it. [SUDO] Only callable by sudoers.
-	ChangeCollateralDenom(ctx context.Context, in *MsgChangeCollateralDenom, opts ...grpc.CallOption) (*MsgChangeCollateralDenomResponse, error)
-	AllocateEpochRebates(ctx context.Context, in *MsgAllocateEpochRebates, opts ...grpc.CallOption) (*MsgAllocateEpochRebatesResponse, error)
-	WithdrawEpochRebates(ctx context.Context, in *MsgWithdrawEpochRebates, opts ...grpc.CallOption) (*MsgWithdrawEpochRebatesResponse, error)
-	// ShiftPegMultiplier: gRPC tx msg for changing a market's peg multiplier.
-	// [SUDO] Only callable by sudoers.
-	ShiftPegMultiplier(ctx context.Context, in *MsgShiftPegMultiplier, opts ...grpc.CallOption) (*MsgShiftPegMultiplierResponse, error)
-	// ShiftSwapInvariant: gRPC tx msg for changing a market's swap invariant.
-	// [SUDO] Only callable by sudoers.
-	ShiftSwapInvariant(ctx context.Context, in *MsgShiftSwapInvariant, opts ...grpc.CallOption) (*MsgShiftSwapInvariantResponse, error)
-	// WithdrawFromPerpFund: gRPC tx msg to withdraw from the perp fund module
-	// account. [SUDO] Only callable by sudoers.
-	WithdrawFromPerpFund(ctx context.Context, in *MsgWithdrawFromPerpFund, opts ...grpc.CallOption) (*MsgWithdrawFromPerpFundResponse, error)
-	// CloseMarket: gRPC tx msg for closing a market.
-	// [Admin] Only callable by sudoers.
-	CloseMarket(ctx context.Context, in *MsgCloseMarket, opts ...grpc.CallOption) (*MsgCloseMarketResponse, error)
+func (m *MsgChangeMinSnapshotIntervalMs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgChangeMinSnapshotIntervalMs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMinSnapshotIntervalMs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgChangeMinSnapshotIntervalMs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMinSnapshotIntervalMs.Merge(m, src)
+}
+func (m *MsgChangeMinSnapshotIntervalMs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMinSnapshotIntervalMs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMinSnapshotIntervalMs.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error) {
-	out := new(MsgRemoveMarginResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/RemoveMargin", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeMinSnapshotIntervalMs proto.InternalMessageInfo
+
+func (m *MsgChangeMinSnapshotIntervalMs) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error) {
-	out := new(MsgAddMarginResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/AddMargin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgChangeMinSnapshotIntervalMs) GetIntervalMs() uint64 {
+	if m != nil {
+		return m.IntervalMs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error) {
-	out := new(MsgMultiLiquidateResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/MultiLiquidate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgChangeMinSnapshotIntervalMsResponse struct {
 }
 
-func (c *msgClient) MarketOrder(ctx context.Context, in *MsgMarketOrder, opts ...grpc.CallOption) (*MsgMarketOrderResponse, error) {
-	out := new(MsgMarketOrderResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/MarketOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgChangeMinSnapshotIntervalMsResponse) Reset() {
+	*m = MsgChangeMinSnapshotIntervalMsResponse{}
 }
-
-func (c *msgClient) ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error) {
-	out := new(MsgClosePositionResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ClosePosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgChangeMinSnapshotIntervalMsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMinSnapshotIntervalMsResponse) ProtoMessage()    {}
+func (*MsgChangeMinSnapshotIntervalMsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{32}
+}
+func (m *MsgChangeMinSnapshotIntervalMsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMinSnapshotIntervalMsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMinSnapshotIntervalMsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgChangeMinSnapshotIntervalMsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMinSnapshotIntervalMsResponse.Merge(m, src)
+}
+func (m *MsgChangeMinSnapshotIntervalMsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMinSnapshotIntervalMsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMinSnapshotIntervalMsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) PartialClose(ctx context.Context, in *MsgPartialClose, opts ...grpc.CallOption) (*MsgPartialCloseResponse, error) {
-	out := new(MsgPartialCloseResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/PartialClose", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgChangeMinSnapshotIntervalMsResponse proto.InternalMessageInfo
+
+// MsgChangeSnapshotRetentionMs: Changes how long reserve snapshots are kept
+// before the EndBlocker prunes them. Zero disables pruning.
+// [SUDO] Only callable by sudoers.
+type MsgChangeSnapshotRetentionMs struct {
+	Sender      string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	RetentionMs uint64 `protobuf:"varint,2,opt,name=retention_ms,json=retentionMs,proto3" json:"retention_ms,omitempty"`
 }
 
-func (c *msgClient) SettlePosition(ctx context.Context, in *MsgSettlePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error) {
-	out := new(MsgClosePositionResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/SettlePosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgChangeSnapshotRetentionMs) Reset()         { *m = MsgChangeSnapshotRetentionMs{} }
+func (m *MsgChangeSnapshotRetentionMs) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeSnapshotRetentionMs) ProtoMessage()    {}
+func (*MsgChangeSnapshotRetentionMs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{33}
+}
+func (m *MsgChangeSnapshotRetentionMs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeSnapshotRetentionMs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeSnapshotRetentionMs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgChangeSnapshotRetentionMs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeSnapshotRetentionMs.Merge(m, src)
+}
+func (m *MsgChangeSnapshotRetentionMs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeSnapshotRetentionMs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeSnapshotRetentionMs.DiscardUnknown(m)
 }
 
-func (c *msgClient) DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error) {
-	out := new(MsgDonateToEcosystemFundResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/DonateToEcosystemFund", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeSnapshotRetentionMs proto.InternalMessageInfo
+
+func (m *MsgChangeSnapshotRetentionMs) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ChangeCollateralDenom(ctx context.Context, in *MsgChangeCollateralDenom, opts ...grpc.CallOption) (*MsgChangeCollateralDenomResponse, error) {
-	out := new(MsgChangeCollateralDenomResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeCollateralDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgChangeSnapshotRetentionMs) GetRetentionMs() uint64 {
+	if m != nil {
+		return m.RetentionMs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) AllocateEpochRebates(ctx context.Context, in *MsgAllocateEpochRebates, opts ...grpc.CallOption) (*MsgAllocateEpochRebatesResponse, error) {
-	out := new(MsgAllocateEpochRebatesResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/AllocateEpochRebates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgChangeSnapshotRetentionMsResponse struct {
 }
 
-func (c *msgClient) WithdrawEpochRebates(ctx context.Context, in *MsgWithdrawEpochRebates, opts ...grpc.CallOption) (*MsgWithdrawEpochRebatesResponse, error) {
-	out := new(MsgWithdrawEpochRebatesResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/WithdrawEpochRebates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgChangeSnapshotRetentionMsResponse) Reset()         { *m = MsgChangeSnapshotRetentionMsResponse{} }
+func (m *MsgChangeSnapshotRetentionMsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeSnapshotRetentionMsResponse) ProtoMessage()    {}
+func (*MsgChangeSnapshotRetentionMsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{34}
+}
+func (m *MsgChangeSnapshotRetentionMsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeSnapshotRetentionMsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeSnapshotRetentionMsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgChangeSnapshotRetentionMsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeSnapshotRetentionMsResponse.Merge(m, src)
+}
+func (m *MsgChangeSnapshotRetentionMsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeSnapshotRetentionMsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeSnapshotRetentionMsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ShiftPegMultiplier(ctx context.Context, in *MsgShiftPegMultiplier, opts ...grpc.CallOption) (*MsgShiftPegMultiplierResponse, error) {
-	out := new(MsgShiftPegMultiplierResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ShiftPegMultiplier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgChangeSnapshotRetentionMsResponse proto.InternalMessageInfo
+
+// MsgChangeLiquidationTwapLookbackMs: Changes the TWAP window used to value
+// positions when deciding whether they can be liquidated. Zero uses the TWAP
+// lookback window of each market. [SUDO] Only callable by sudoers.
+type MsgChangeLiquidationTwapLookbackMs struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	LookbackMs uint64 `protobuf:"varint,2,opt,name=lookback_ms,json=lookbackMs,proto3" json:"lookback_ms,omitempty"`
 }
 
-func (c *msgClient) ShiftSwapInvariant(ctx context.Context, in *MsgShiftSwapInvariant, opts ...grpc.CallOption) (*MsgShiftSwapInvariantResponse, error) {
-	out := new(MsgShiftSwapInvariantResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ShiftSwapInvariant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgChangeLiquidationTwapLookbackMs) Reset()         { *m = MsgChangeLiquidationTwapLookbackMs{} }
+func (m *MsgChangeLiquidationTwapLookbackMs) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeLiquidationTwapLookbackMs) ProtoMessage()    {}
+func (*MsgChangeLiquidationTwapLookbackMs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{35}
+}
+func (m *MsgChangeLiquidationTwapLookbackMs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeLiquidationTwapLookbackMs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeLiquidationTwapLookbackMs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgChangeLiquidationTwapLookbackMs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeLiquidationTwapLookbackMs.Merge(m, src)
+}
+func (m *MsgChangeLiquidationTwapLookbackMs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeLiquidationTwapLookbackMs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeLiquidationTwapLookbackMs.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawFromPerpFund(ctx context.Context, in *MsgWithdrawFromPerpFund, opts ...grpc.CallOption) (*MsgWithdrawFromPerpFundResponse, error) {
-	out := new(MsgWithdrawFromPerpFundResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/WithdrawFromPerpFund", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeLiquidationTwapLookbackMs proto.InternalMessageInfo
+
+func (m *MsgChangeLiquidationTwapLookbackMs) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CloseMarket(ctx context.Context, in *MsgCloseMarket, opts ...grpc.CallOption) (*MsgCloseMarketResponse, error) {
-	out := new(MsgCloseMarketResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/CloseMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgChangeLiquidationTwapLookbackMs) GetLookbackMs() uint64 {
+	if m != nil {
+		return m.LookbackMs
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	RemoveMargin(context.Context, *MsgRemoveMargin) (*MsgRemoveMarginResponse, error)
-	AddMargin(context.Context, *MsgAddMargin) (*MsgAddMarginResponse, error)
-	MultiLiquidate(context.Context, *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error)
-	MarketOrder(context.Context, *MsgMarketOrder) (*MsgMarketOrderResponse, error)
-	ClosePosition(context.Context, *MsgClosePosition) (*MsgClosePositionResponse, error)
-	PartialClose(context.Context, *MsgPartialClose) (*MsgPartialCloseResponse, error)
-	SettlePosition(context.Context, *MsgSettlePosition) (*MsgClosePositionResponse, error)
-	DonateToEcosystemFund(context.Context, *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error)
-	// ChangeCollateralDenom: Updates the collateral denom. A denom is valid if it
-	// is possible to make an sdk.Coin using it. [SUDO] Only callable by sudoers.
-	ChangeCollateralDenom(context.Context, *MsgChangeCollateralDenom) (*MsgChangeCollateralDenomResponse, error)
-	AllocateEpochRebates(context.Context, *MsgAllocateEpochRebates) (*MsgAllocateEpochRebatesResponse, error)
-	WithdrawEpochRebates(context.Context, *MsgWithdrawEpochRebates) (*MsgWithdrawEpochRebatesResponse, error)
-	// ShiftPegMultiplier: gRPC tx msg for changing a market's peg multiplier.
-	// [SUDO] Only callable by sudoers.
-	ShiftPegMultiplier(context.Context, *MsgShiftPegMultiplier) (*MsgShiftPegMultiplierResponse, error)
-	// ShiftSwapInvariant: gRPC tx msg for changing a market's swap invariant.
-	// [SUDO] Only callable by sudoers.
-	ShiftSwapInvariant(context.Context, *MsgShiftSwapInvariant) (*MsgShiftSwapInvariantResponse, error)
-	// WithdrawFromPerpFund: gRPC tx msg to withdraw from the perp fund module
-	// account. [SUDO] Only callable by sudoers.
-	WithdrawFromPerpFund(context.Context, *MsgWithdrawFromPerpFund) (*MsgWithdrawFromPerpFundResponse, error)
-	// CloseMarket: gRPC tx msg for closing a market.
-	// [Admin] Only callable by sudoers.
-	CloseMarket(context.Context, *MsgCloseMarket) (*MsgCloseMarketResponse, error)
+type MsgChangeLiquidationTwapLookbackMsResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) Reset() {
+	*m = MsgChangeLiquidationTwapLookbackMsResponse{}
 }
-
-func (*UnimplementedMsgServer) RemoveMargin(ctx context.Context, req *MsgRemoveMargin) (*MsgRemoveMarginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMargin not implemented")
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedMsgServer) AddMargin(ctx context.Context, req *MsgAddMargin) (*MsgAddMarginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMargin not implemented")
+func (*MsgChangeLiquidationTwapLookbackMsResponse) ProtoMessage() {}
+func (*MsgChangeLiquidationTwapLookbackMsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{36}
 }
-func (*UnimplementedMsgServer) MultiLiquidate(ctx context.Context, req *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiLiquidate not implemented")
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MarketOrder(ctx context.Context, req *MsgMarketOrder) (*MsgMarketOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketOrder not implemented")
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeLiquidationTwapLookbackMsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ClosePosition(ctx context.Context, req *MsgClosePosition) (*MsgClosePositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeLiquidationTwapLookbackMsResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) PartialClose(ctx context.Context, req *MsgPartialClose) (*MsgPartialCloseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PartialClose not implemented")
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SettlePosition(ctx context.Context, req *MsgSettlePosition) (*MsgClosePositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SettlePosition not implemented")
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeLiquidationTwapLookbackMsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DonateToEcosystemFund(ctx context.Context, req *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DonateToEcosystemFund not implemented")
+
+var xxx_messageInfo_MsgChangeLiquidationTwapLookbackMsResponse proto.InternalMessageInfo
+
+// MsgChangeLiquidatorRewardRatio: Changes the share of the liquidation fee
+// paid to the liquidator of a position. The rest goes to the perp fund.
+// [SUDO] Only callable by sudoers.
+type MsgChangeLiquidatorRewardRatio struct {
+	Sender                string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	LiquidatorRewardRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidator_reward_ratio,json=liquidatorRewardRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidator_reward_ratio"`
 }
-func (*UnimplementedMsgServer) ChangeCollateralDenom(ctx context.Context, req *MsgChangeCollateralDenom) (*MsgChangeCollateralDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeCollateralDenom not implemented")
+
+func (m *MsgChangeLiquidatorRewardRatio) Reset()         { *m = MsgChangeLiquidatorRewardRatio{} }
+func (m *MsgChangeLiquidatorRewardRatio) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeLiquidatorRewardRatio) ProtoMessage()    {}
+func (*MsgChangeLiquidatorRewardRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{37}
 }
-func (*UnimplementedMsgServer) AllocateEpochRebates(ctx context.Context, req *MsgAllocateEpochRebates) (*MsgAllocateEpochRebatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllocateEpochRebates not implemented")
+func (m *MsgChangeLiquidatorRewardRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WithdrawEpochRebates(ctx context.Context, req *MsgWithdrawEpochRebates) (*MsgWithdrawEpochRebatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEpochRebates not implemented")
+func (m *MsgChangeLiquidatorRewardRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeLiquidatorRewardRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ShiftPegMultiplier(ctx context.Context, req *MsgShiftPegMultiplier) (*MsgShiftPegMultiplierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShiftPegMultiplier not implemented")
+func (m *MsgChangeLiquidatorRewardRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeLiquidatorRewardRatio.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ShiftSwapInvariant(ctx context.Context, req *MsgShiftSwapInvariant) (*MsgShiftSwapInvariantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShiftSwapInvariant not implemented")
+func (m *MsgChangeLiquidatorRewardRatio) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) WithdrawFromPerpFund(ctx context.Context, req *MsgWithdrawFromPerpFund) (*MsgWithdrawFromPerpFundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromPerpFund not implemented")
+func (m *MsgChangeLiquidatorRewardRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeLiquidatorRewardRatio.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CloseMarket(ctx context.Context, req *MsgCloseMarket) (*MsgCloseMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseMarket not implemented")
+
+var xxx_messageInfo_MsgChangeLiquidatorRewardRatio proto.InternalMessageInfo
+
+func (m *MsgChangeLiquidatorRewardRatio) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+type MsgChangeLiquidatorRewardRatioResponse struct {
 }
 
-func _Msg_RemoveMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMargin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMargin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/RemoveMargin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMargin(ctx, req.(*MsgRemoveMargin))
+func (m *MsgChangeLiquidatorRewardRatioResponse) Reset() {
+	*m = MsgChangeLiquidatorRewardRatioResponse{}
+}
+func (m *MsgChangeLiquidatorRewardRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeLiquidatorRewardRatioResponse) ProtoMessage()    {}
+func (*MsgChangeLiquidatorRewardRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{38}
+}
+func (m *MsgChangeLiquidatorRewardRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeLiquidatorRewardRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeLiquidatorRewardRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgChangeLiquidatorRewardRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeLiquidatorRewardRatioResponse.Merge(m, src)
+}
+func (m *MsgChangeLiquidatorRewardRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeLiquidatorRewardRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeLiquidatorRewardRatioResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMargin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMargin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/AddMargin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMargin(ctx, req.(*MsgAddMargin))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgChangeLiquidatorRewardRatioResponse proto.InternalMessageInfo
+
+// MsgChangeFundingRateIntervalMs: Changes the interval at which the EndBlocker
+// settles funding of every market. Zero settles funding at the end of each
+// market's funding rate epoch. [SUDO] Only callable by sudoers.
+type MsgChangeFundingRateIntervalMs struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	IntervalMs uint64 `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
 }
 
-func _Msg_MultiLiquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiLiquidate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiLiquidate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/MultiLiquidate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiLiquidate(ctx, req.(*MsgMultiLiquidate))
+func (m *MsgChangeFundingRateIntervalMs) Reset()         { *m = MsgChangeFundingRateIntervalMs{} }
+func (m *MsgChangeFundingRateIntervalMs) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeFundingRateIntervalMs) ProtoMessage()    {}
+func (*MsgChangeFundingRateIntervalMs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{39}
+}
+func (m *MsgChangeFundingRateIntervalMs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeFundingRateIntervalMs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeFundingRateIntervalMs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgChangeFundingRateIntervalMs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeFundingRateIntervalMs.Merge(m, src)
+}
+func (m *MsgChangeFundingRateIntervalMs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeFundingRateIntervalMs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeFundingRateIntervalMs.DiscardUnknown(m)
 }
 
-func _Msg_MarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/MarketOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketOrder(ctx, req.(*MsgMarketOrder))
+var xxx_messageInfo_MsgChangeFundingRateIntervalMs proto.InternalMessageInfo
+
+func (m *MsgChangeFundingRateIntervalMs) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ClosePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClosePosition)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClosePosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/ClosePosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClosePosition(ctx, req.(*MsgClosePosition))
+func (m *MsgChangeFundingRateIntervalMs) GetIntervalMs() uint64 {
+	if m != nil {
+		return m.IntervalMs
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_PartialClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPartialClose)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PartialClose(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/PartialClose",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PartialClose(ctx, req.(*MsgPartialClose))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgChangeFundingRateIntervalMsResponse struct {
 }
 
-func _Msg_SettlePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSettlePosition)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SettlePosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/SettlePosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SettlePosition(ctx, req.(*MsgSettlePosition))
+func (m *MsgChangeFundingRateIntervalMsResponse) Reset() {
+	*m = MsgChangeFundingRateIntervalMsResponse{}
+}
+func (m *MsgChangeFundingRateIntervalMsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeFundingRateIntervalMsResponse) ProtoMessage()    {}
+func (*MsgChangeFundingRateIntervalMsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{40}
+}
+func (m *MsgChangeFundingRateIntervalMsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeFundingRateIntervalMsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeFundingRateIntervalMsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgChangeFundingRateIntervalMsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeFundingRateIntervalMsResponse.Merge(m, src)
+}
+func (m *MsgChangeFundingRateIntervalMsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeFundingRateIntervalMsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeFundingRateIntervalMsResponse.DiscardUnknown(m)
 }
 
-func _Msg_DonateToEcosystemFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDonateToEcosystemFund)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DonateToEcosystemFund(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/DonateToEcosystemFund",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DonateToEcosystemFund(ctx, req.(*MsgDonateToEcosystemFund))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgChangeFundingRateIntervalMsResponse proto.InternalMessageInfo
+
+// MsgChangeMaxPairsPerBlock: Changes the maximum number of pairs the
+// EndBlocker processes per block. Zero processes every pair each block.
+// [SUDO] Only callable by sudoers.
+type MsgChangeMaxPairsPerBlock struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MaxPairs uint64 `protobuf:"varint,2,opt,name=max_pairs,json=maxPairs,proto3" json:"max_pairs,omitempty"`
 }
 
-func _Msg_ChangeCollateralDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeCollateralDenom)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeCollateralDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/ChangeCollateralDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeCollateralDenom(ctx, req.(*MsgChangeCollateralDenom))
+func (m *MsgChangeMaxPairsPerBlock) Reset()         { *m = MsgChangeMaxPairsPerBlock{} }
+func (m *MsgChangeMaxPairsPerBlock) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMaxPairsPerBlock) ProtoMessage()    {}
+func (*MsgChangeMaxPairsPerBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{41}
+}
+func (m *MsgChangeMaxPairsPerBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMaxPairsPerBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMaxPairsPerBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgChangeMaxPairsPerBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMaxPairsPerBlock.Merge(m, src)
+}
+func (m *MsgChangeMaxPairsPerBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMaxPairsPerBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMaxPairsPerBlock.DiscardUnknown(m)
 }
 
-func _Msg_AllocateEpochRebates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAllocateEpochRebates)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AllocateEpochRebates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/AllocateEpochRebates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AllocateEpochRebates(ctx, req.(*MsgAllocateEpochRebates))
+var xxx_messageInfo_MsgChangeMaxPairsPerBlock proto.InternalMessageInfo
+
+func (m *MsgChangeMaxPairsPerBlock) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WithdrawEpochRebates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawEpochRebates)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawEpochRebates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/WithdrawEpochRebates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawEpochRebates(ctx, req.(*MsgWithdrawEpochRebates))
+func (m *MsgChangeMaxPairsPerBlock) GetMaxPairs() uint64 {
+	if m != nil {
+		return m.MaxPairs
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ShiftPegMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgShiftPegMultiplier)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ShiftPegMultiplier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/ShiftPegMultiplier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ShiftPegMultiplier(ctx, req.(*MsgShiftPegMultiplier))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgChangeMaxPairsPerBlockResponse struct {
 }
 
-func _Msg_ShiftSwapInvariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgShiftSwapInvariant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ShiftSwapInvariant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/ShiftSwapInvariant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ShiftSwapInvariant(ctx, req.(*MsgShiftSwapInvariant))
+func (m *MsgChangeMaxPairsPerBlockResponse) Reset()         { *m = MsgChangeMaxPairsPerBlockResponse{} }
+func (m *MsgChangeMaxPairsPerBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMaxPairsPerBlockResponse) ProtoMessage()    {}
+func (*MsgChangeMaxPairsPerBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{42}
+}
+func (m *MsgChangeMaxPairsPerBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMaxPairsPerBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMaxPairsPerBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgChangeMaxPairsPerBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMaxPairsPerBlockResponse.Merge(m, src)
+}
+func (m *MsgChangeMaxPairsPerBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMaxPairsPerBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMaxPairsPerBlockResponse.DiscardUnknown(m)
 }
 
-func _Msg_WithdrawFromPerpFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawFromPerpFund)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawFromPerpFund(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/WithdrawFromPerpFund",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawFromPerpFund(ctx, req.(*MsgWithdrawFromPerpFund))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgChangeMaxPairsPerBlockResponse proto.InternalMessageInfo
+
+// MsgChangeImbalanceFeeRatio: Changes the fee ratio charged on the open
+// interest imbalance that trades on a market add. Zero disables the fee.
+// [SUDO] Only callable by sudoers.
+type MsgChangeImbalanceFeeRatio struct {
+	Sender            string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair              github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	ImbalanceFeeRatio github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,3,opt,name=imbalance_fee_ratio,json=imbalanceFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"imbalance_fee_ratio"`
 }
 
-func _Msg_CloseMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseMarket)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseMarket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/CloseMarket",
+func (m *MsgChangeImbalanceFeeRatio) Reset()         { *m = MsgChangeImbalanceFeeRatio{} }
+func (m *MsgChangeImbalanceFeeRatio) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeImbalanceFeeRatio) ProtoMessage()    {}
+func (*MsgChangeImbalanceFeeRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{43}
+}
+func (m *MsgChangeImbalanceFeeRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeImbalanceFeeRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeImbalanceFeeRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseMarket(ctx, req.(*MsgCloseMarket))
+}
+func (m *MsgChangeImbalanceFeeRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeImbalanceFeeRatio.Merge(m, src)
+}
+func (m *MsgChangeImbalanceFeeRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeImbalanceFeeRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeImbalanceFeeRatio.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeImbalanceFeeRatio proto.InternalMessageInfo
+
+func (m *MsgChangeImbalanceFeeRatio) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.perp.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RemoveMargin",
-			Handler:    _Msg_RemoveMargin_Handler,
-		},
-		{
-			MethodName: "AddMargin",
-			Handler:    _Msg_AddMargin_Handler,
-		},
-		{
-			MethodName: "MultiLiquidate",
-			Handler:    _Msg_MultiLiquidate_Handler,
-		},
-		{
-			MethodName: "MarketOrder",
-			Handler:    _Msg_MarketOrder_Handler,
-		},
-		{
-			MethodName: "ClosePosition",
-			Handler:    _Msg_ClosePosition_Handler,
-		},
-		{
-			MethodName: "PartialClose",
-			Handler:    _Msg_PartialClose_Handler,
-		},
-		{
-			MethodName: "SettlePosition",
-			Handler:    _Msg_SettlePosition_Handler,
-		},
-		{
-			MethodName: "DonateToEcosystemFund",
-			Handler:    _Msg_DonateToEcosystemFund_Handler,
-		},
-		{
-			MethodName: "ChangeCollateralDenom",
-			Handler:    _Msg_ChangeCollateralDenom_Handler,
-		},
-		{
-			MethodName: "AllocateEpochRebates",
-			Handler:    _Msg_AllocateEpochRebates_Handler,
-		},
-		{
-			MethodName: "WithdrawEpochRebates",
-			Handler:    _Msg_WithdrawEpochRebates_Handler,
-		},
-		{
-			MethodName: "ShiftPegMultiplier",
-			Handler:    _Msg_ShiftPegMultiplier_Handler,
-		},
-		{
-			MethodName: "ShiftSwapInvariant",
-			Handler:    _Msg_ShiftSwapInvariant_Handler,
-		},
-		{
-			MethodName: "WithdrawFromPerpFund",
-			Handler:    _Msg_WithdrawFromPerpFund_Handler,
-		},
-		{
-			MethodName: "CloseMarket",
-			Handler:    _Msg_CloseMarket_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nibiru/perp/v2/tx.proto",
+type MsgChangeImbalanceFeeRatioResponse struct {
 }
 
-func (m *MsgSettlePosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChangeImbalanceFeeRatioResponse) Reset()         { *m = MsgChangeImbalanceFeeRatioResponse{} }
+func (m *MsgChangeImbalanceFeeRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeImbalanceFeeRatioResponse) ProtoMessage()    {}
+func (*MsgChangeImbalanceFeeRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{44}
+}
+func (m *MsgChangeImbalanceFeeRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeImbalanceFeeRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeImbalanceFeeRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgChangeImbalanceFeeRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeImbalanceFeeRatioResponse.Merge(m, src)
+}
+func (m *MsgChangeImbalanceFeeRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeImbalanceFeeRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeImbalanceFeeRatioResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSettlePosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChangeImbalanceFeeRatioResponse proto.InternalMessageInfo
+
+// MsgChangeOpenInterestCap: Changes the max notional open interest of each
+// side of a market. Zero leaves the market uncapped.
+// [SUDO] Only callable by sudoers.
+type MsgChangeOpenInterestCap struct {
+	Sender          string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair            github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	OpenInterestCap github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,3,opt,name=open_interest_cap,json=openInterestCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_interest_cap"`
 }
 
-func (m *MsgSettlePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChangeOpenInterestCap) Reset()         { *m = MsgChangeOpenInterestCap{} }
+func (m *MsgChangeOpenInterestCap) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeOpenInterestCap) ProtoMessage()    {}
+func (*MsgChangeOpenInterestCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{45}
+}
+func (m *MsgChangeOpenInterestCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeOpenInterestCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeOpenInterestCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgChangeOpenInterestCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeOpenInterestCap.Merge(m, src)
+}
+func (m *MsgChangeOpenInterestCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeOpenInterestCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeOpenInterestCap.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveMargin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeOpenInterestCap proto.InternalMessageInfo
+
+func (m *MsgChangeOpenInterestCap) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRemoveMargin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChangeOpenInterestCapResponse struct {
 }
 
-func (m *MsgRemoveMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgChangeOpenInterestCapResponse) Reset()         { *m = MsgChangeOpenInterestCapResponse{} }
+func (m *MsgChangeOpenInterestCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeOpenInterestCapResponse) ProtoMessage()    {}
+func (*MsgChangeOpenInterestCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{46}
+}
+func (m *MsgChangeOpenInterestCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeOpenInterestCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeOpenInterestCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRemoveMarginResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgChangeOpenInterestCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeOpenInterestCapResponse.Merge(m, src)
+}
+func (m *MsgChangeOpenInterestCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeOpenInterestCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeOpenInterestCapResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveMarginResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChangeOpenInterestCapResponse proto.InternalMessageInfo
+
+// MsgChangeFluctuationLimitRatio: Changes the max relative move of the mark
+// price of a market from its latest reserve snapshot that a swap may cause.
+// Zero disables the limit. [SUDO] Only callable by sudoers.
+type MsgChangeFluctuationLimitRatio struct {
+	Sender                string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair                  github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	FluctuationLimitRatio github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,3,opt,name=fluctuation_limit_ratio,json=fluctuationLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fluctuation_limit_ratio"`
 }
 
-func (m *MsgRemoveMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.MarginOut.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgChangeFluctuationLimitRatio) Reset()         { *m = MsgChangeFluctuationLimitRatio{} }
+func (m *MsgChangeFluctuationLimitRatio) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeFluctuationLimitRatio) ProtoMessage()    {}
+func (*MsgChangeFluctuationLimitRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{47}
+}
+func (m *MsgChangeFluctuationLimitRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeFluctuationLimitRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeFluctuationLimitRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgChangeFluctuationLimitRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeFluctuationLimitRatio.Merge(m, src)
+}
+func (m *MsgChangeFluctuationLimitRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeFluctuationLimitRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeFluctuationLimitRatio.DiscardUnknown(m)
 }
 
-func (m *MsgAddMargin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeFluctuationLimitRatio proto.InternalMessageInfo
+
+func (m *MsgChangeFluctuationLimitRatio) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddMargin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChangeFluctuationLimitRatioResponse struct {
 }
 
-func (m *MsgAddMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgChangeFluctuationLimitRatioResponse) Reset() {
+	*m = MsgChangeFluctuationLimitRatioResponse{}
+}
+func (m *MsgChangeFluctuationLimitRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeFluctuationLimitRatioResponse) ProtoMessage()    {}
+func (*MsgChangeFluctuationLimitRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{48}
+}
+func (m *MsgChangeFluctuationLimitRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeFluctuationLimitRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeFluctuationLimitRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddMarginResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgChangeFluctuationLimitRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeFluctuationLimitRatioResponse.Merge(m, src)
+}
+func (m *MsgChangeFluctuationLimitRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeFluctuationLimitRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeFluctuationLimitRatioResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarginResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChangeFluctuationLimitRatioResponse proto.InternalMessageInfo
+
+// MsgChangeTradeLimitRatio: Changes the max share of the base or quote reserve
+// of a market that a single swap may trade. Zero disables the limit.
+// [SUDO] Only callable by sudoers.
+type MsgChangeTradeLimitRatio struct {
+	Sender          string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair            github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	TradeLimitRatio github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,3,opt,name=trade_limit_ratio,json=tradeLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trade_limit_ratio"`
 }
 
-func (m *MsgAddMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChangeTradeLimitRatio) Reset()         { *m = MsgChangeTradeLimitRatio{} }
+func (m *MsgChangeTradeLimitRatio) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeTradeLimitRatio) ProtoMessage()    {}
+func (*MsgChangeTradeLimitRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{49}
+}
+func (m *MsgChangeTradeLimitRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeTradeLimitRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeTradeLimitRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgChangeTradeLimitRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeTradeLimitRatio.Merge(m, src)
+}
+func (m *MsgChangeTradeLimitRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeTradeLimitRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeTradeLimitRatio.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeTradeLimitRatio proto.InternalMessageInfo
+
+func (m *MsgChangeTradeLimitRatio) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMultiLiquidate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChangeTradeLimitRatioResponse struct {
 }
 
-func (m *MsgMultiLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidations) > 0 {
-		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgChangeTradeLimitRatioResponse) Reset()         { *m = MsgChangeTradeLimitRatioResponse{} }
+func (m *MsgChangeTradeLimitRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeTradeLimitRatioResponse) ProtoMessage()    {}
+func (*MsgChangeTradeLimitRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{50}
+}
+func (m *MsgChangeTradeLimitRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeTradeLimitRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeTradeLimitRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgMultiLiquidate_Liquidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgChangeTradeLimitRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeTradeLimitRatioResponse.Merge(m, src)
+}
+func (m *MsgChangeTradeLimitRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeTradeLimitRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeTradeLimitRatioResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidate_Liquidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChangeTradeLimitRatioResponse proto.InternalMessageInfo
+
+// MsgChangeSideTradeLimitRatios: Changes the trade limit ratios of long and
+// short swaps of a market. An unset ratio removes the override of its side,
+// which then uses the symmetric trade limit ratio.
+// [SUDO] Only callable by sudoers.
+type MsgChangeSideTradeLimitRatios struct {
+	Sender string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// trade limit ratio of long swaps, unset to remove the override
+	LongTradeLimitRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=long_trade_limit_ratio,json=longTradeLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"long_trade_limit_ratio,omitempty"`
+	// trade limit ratio of short swaps, unset to remove the override
+	ShortTradeLimitRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=short_trade_limit_ratio,json=shortTradeLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"short_trade_limit_ratio,omitempty"`
 }
 
-func (m *MsgMultiLiquidate_Liquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChangeSideTradeLimitRatios) Reset()         { *m = MsgChangeSideTradeLimitRatios{} }
+func (m *MsgChangeSideTradeLimitRatios) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeSideTradeLimitRatios) ProtoMessage()    {}
+func (*MsgChangeSideTradeLimitRatios) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{51}
+}
+func (m *MsgChangeSideTradeLimitRatios) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeSideTradeLimitRatios) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeSideTradeLimitRatios.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgChangeSideTradeLimitRatios) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeSideTradeLimitRatios.Merge(m, src)
+}
+func (m *MsgChangeSideTradeLimitRatios) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeSideTradeLimitRatios) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeSideTradeLimitRatios.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeSideTradeLimitRatios proto.InternalMessageInfo
+
+func (m *MsgChangeSideTradeLimitRatios) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMultiLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChangeSideTradeLimitRatiosResponse struct {
 }
 
-func (m *MsgMultiLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidations) > 0 {
-		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgChangeSideTradeLimitRatiosResponse) Reset()         { *m = MsgChangeSideTradeLimitRatiosResponse{} }
+func (m *MsgChangeSideTradeLimitRatiosResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeSideTradeLimitRatiosResponse) ProtoMessage()    {}
+func (*MsgChangeSideTradeLimitRatiosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{52}
+}
+func (m *MsgChangeSideTradeLimitRatiosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeSideTradeLimitRatiosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeSideTradeLimitRatiosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgChangeSideTradeLimitRatiosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeSideTradeLimitRatiosResponse.Merge(m, src)
+}
+func (m *MsgChangeSideTradeLimitRatiosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeSideTradeLimitRatiosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeSideTradeLimitRatiosResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChangeSideTradeLimitRatiosResponse proto.InternalMessageInfo
+
+// MsgChangeCloseAtOracle: Sets whether positions of a market are closed at
+// the oracle price instead of the mark price. The oracle price is bounded to
+// within max_oracle_spread_ratio of the mark price. Turning it off keeps the
+// max oracle spread ratio. [SUDO] Only callable by sudoers.
+type MsgChangeCloseAtOracle struct {
+	Sender        string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair          github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	CloseAtOracle bool                                              `protobuf:"varint,3,opt,name=close_at_oracle,json=closeAtOracle,proto3" json:"close_at_oracle,omitempty"`
+	// ignored when close_at_oracle is false
+	MaxOracleSpreadRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_oracle_spread_ratio,json=maxOracleSpreadRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_oracle_spread_ratio"`
 }
 
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChangeCloseAtOracle) Reset()         { *m = MsgChangeCloseAtOracle{} }
+func (m *MsgChangeCloseAtOracle) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeCloseAtOracle) ProtoMessage()    {}
+func (*MsgChangeCloseAtOracle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{53}
+}
+func (m *MsgChangeCloseAtOracle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeCloseAtOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeCloseAtOracle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *MsgChangeCloseAtOracle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeCloseAtOracle.Merge(m, src)
+}
+func (m *MsgChangeCloseAtOracle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeCloseAtOracle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeCloseAtOracle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeCloseAtOracle proto.InternalMessageInfo
+
+func (m *MsgChangeCloseAtOracle) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if m.PerpEfFee != nil {
-		{
-			size, err := m.PerpEfFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *MsgChangeCloseAtOracle) GetCloseAtOracle() bool {
+	if m != nil {
+		return m.CloseAtOracle
 	}
-	if m.LiquidatorFee != nil {
-		{
-			size, err := m.LiquidatorFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	return false
+}
+
+type MsgChangeCloseAtOracleResponse struct {
+}
+
+func (m *MsgChangeCloseAtOracleResponse) Reset()         { *m = MsgChangeCloseAtOracleResponse{} }
+func (m *MsgChangeCloseAtOracleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeCloseAtOracleResponse) ProtoMessage()    {}
+func (*MsgChangeCloseAtOracleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{54}
+}
+func (m *MsgChangeCloseAtOracleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeCloseAtOracleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeCloseAtOracleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *MsgChangeCloseAtOracleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeCloseAtOracleResponse.Merge(m, src)
+}
+func (m *MsgChangeCloseAtOracleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeCloseAtOracleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeCloseAtOracleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeCloseAtOracleResponse proto.InternalMessageInfo
+
+// MsgChangeMaxOracleSpreadRatio: Sets the max relative spread of the oracle
+// price from the mark price of a market. It bounds the oracle price positions
+// are closed at, and the mark price of spread limited swaps.
+// [SUDO] Only callable by sudoers.
+type MsgChangeMaxOracleSpreadRatio struct {
+	Sender string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// zero removes the ratio
+	MaxOracleSpreadRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_oracle_spread_ratio,json=maxOracleSpreadRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_oracle_spread_ratio"`
+}
+
+func (m *MsgChangeMaxOracleSpreadRatio) Reset()         { *m = MsgChangeMaxOracleSpreadRatio{} }
+func (m *MsgChangeMaxOracleSpreadRatio) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMaxOracleSpreadRatio) ProtoMessage()    {}
+func (*MsgChangeMaxOracleSpreadRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{55}
+}
+func (m *MsgChangeMaxOracleSpreadRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMaxOracleSpreadRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMaxOracleSpreadRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgChangeMaxOracleSpreadRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMaxOracleSpreadRatio.Merge(m, src)
+}
+func (m *MsgChangeMaxOracleSpreadRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMaxOracleSpreadRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMaxOracleSpreadRatio.DiscardUnknown(m)
 }
 
-func (m *MsgMarketOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeMaxOracleSpreadRatio proto.InternalMessageInfo
+
+func (m *MsgChangeMaxOracleSpreadRatio) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMarketOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChangeMaxOracleSpreadRatioResponse struct {
 }
 
-func (m *MsgMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BaseAssetAmountLimit.Size()
-		i -= size
-		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Leverage.Size()
-		i -= size
-		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChangeMaxOracleSpreadRatioResponse) Reset()         { *m = MsgChangeMaxOracleSpreadRatioResponse{} }
+func (m *MsgChangeMaxOracleSpreadRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMaxOracleSpreadRatioResponse) ProtoMessage()    {}
+func (*MsgChangeMaxOracleSpreadRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{56}
+}
+func (m *MsgChangeMaxOracleSpreadRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMaxOracleSpreadRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMaxOracleSpreadRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.QuoteAssetAmount.Size()
-		i -= size
-		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Side != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Side))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgChangeMaxOracleSpreadRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMaxOracleSpreadRatioResponse.Merge(m, src)
+}
+func (m *MsgChangeMaxOracleSpreadRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMaxOracleSpreadRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMaxOracleSpreadRatioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeMaxOracleSpreadRatioResponse proto.InternalMessageInfo
+
+// MsgChangeSpreadLimitedSwaps: Sets whether swaps of a market are rejected
+// while its mark price is further than its max oracle spread ratio from the
+// oracle price. [SUDO] Only callable by sudoers.
+type MsgChangeSpreadLimitedSwaps struct {
+	Sender        string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair          github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	SpreadLimited bool                                              `protobuf:"varint,3,opt,name=spread_limited,json=spreadLimited,proto3" json:"spread_limited,omitempty"`
+}
+
+func (m *MsgChangeSpreadLimitedSwaps) Reset()         { *m = MsgChangeSpreadLimitedSwaps{} }
+func (m *MsgChangeSpreadLimitedSwaps) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeSpreadLimitedSwaps) ProtoMessage()    {}
+func (*MsgChangeSpreadLimitedSwaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{57}
+}
+func (m *MsgChangeSpreadLimitedSwaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeSpreadLimitedSwaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeSpreadLimitedSwaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgChangeSpreadLimitedSwaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeSpreadLimitedSwaps.Merge(m, src)
+}
+func (m *MsgChangeSpreadLimitedSwaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeSpreadLimitedSwaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeSpreadLimitedSwaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeSpreadLimitedSwaps proto.InternalMessageInfo
+
+func (m *MsgChangeSpreadLimitedSwaps) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgMarketOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChangeSpreadLimitedSwaps) GetSpreadLimited() bool {
+	if m != nil {
+		return m.SpreadLimited
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChangeSpreadLimitedSwapsResponse struct {
 }
 
-func (m *MsgMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MarginToVault.Size()
-		i -= size
-		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.UnrealizedPnlAfter.Size()
-		i -= size
-		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChangeSpreadLimitedSwapsResponse) Reset()         { *m = MsgChangeSpreadLimitedSwapsResponse{} }
+func (m *MsgChangeSpreadLimitedSwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeSpreadLimitedSwapsResponse) ProtoMessage()    {}
+func (*MsgChangeSpreadLimitedSwapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{58}
+}
+func (m *MsgChangeSpreadLimitedSwapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeSpreadLimitedSwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeSpreadLimitedSwapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ExchangedNotionalValue.Size()
-		i -= size
-		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgChangeSpreadLimitedSwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeSpreadLimitedSwapsResponse.Merge(m, src)
+}
+func (m *MsgChangeSpreadLimitedSwapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeSpreadLimitedSwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeSpreadLimitedSwapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeSpreadLimitedSwapsResponse proto.InternalMessageInfo
+
+// MsgChangeMarketPaused: Sets whether trading on a market is halted. Queries
+// and liquidations keep working on a paused market.
+// [SUDO] Only callable by sudoers.
+type MsgChangeMarketPaused struct {
+	Sender string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Paused bool                                              `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgChangeMarketPaused) Reset()         { *m = MsgChangeMarketPaused{} }
+func (m *MsgChangeMarketPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMarketPaused) ProtoMessage()    {}
+func (*MsgChangeMarketPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{59}
+}
+func (m *MsgChangeMarketPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMarketPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMarketPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgChangeMarketPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMarketPaused.Merge(m, src)
+}
+func (m *MsgChangeMarketPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMarketPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMarketPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeMarketPaused proto.InternalMessageInfo
+
+func (m *MsgChangeMarketPaused) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClosePosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChangeMarketPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgClosePosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChangeMarketPausedResponse struct {
 }
 
-func (m *MsgClosePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChangeMarketPausedResponse) Reset()         { *m = MsgChangeMarketPausedResponse{} }
+func (m *MsgChangeMarketPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMarketPausedResponse) ProtoMessage()    {}
+func (*MsgChangeMarketPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{60}
+}
+func (m *MsgChangeMarketPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMarketPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMarketPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgClosePositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgChangeMarketPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMarketPausedResponse.Merge(m, src)
+}
+func (m *MsgChangeMarketPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMarketPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMarketPausedResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClosePositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChangeMarketPausedResponse proto.InternalMessageInfo
+
+// MsgChangeMinPositionQuote: Changes the minimum notional a position may keep
+// after a partial close or a reducing market order. Smaller residuals are
+// closed entirely. Zero disables the threshold. [SUDO] Only callable by
+// sudoers.
+type MsgChangeMinPositionQuote struct {
+	Sender           string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MinPositionQuote github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_position_quote,json=minPositionQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_position_quote"`
 }
 
-func (m *MsgClosePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MarginToTrader.Size()
-		i -= size
-		if _, err := m.MarginToTrader.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ExchangedNotionalValue.Size()
-		i -= size
-		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChangeMinPositionQuote) Reset()         { *m = MsgChangeMinPositionQuote{} }
+func (m *MsgChangeMinPositionQuote) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMinPositionQuote) ProtoMessage()    {}
+func (*MsgChangeMinPositionQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{61}
+}
+func (m *MsgChangeMinPositionQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMinPositionQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMinPositionQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgChangeMinPositionQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMinPositionQuote.Merge(m, src)
+}
+func (m *MsgChangeMinPositionQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMinPositionQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMinPositionQuote.DiscardUnknown(m)
 }
 
-func (m *MsgPartialClose) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeMinPositionQuote proto.InternalMessageInfo
+
+func (m *MsgChangeMinPositionQuote) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPartialClose) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChangeMinPositionQuoteResponse struct {
 }
 
-func (m *MsgPartialClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Size_.Size()
-		i -= size
-		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChangeMinPositionQuoteResponse) Reset()         { *m = MsgChangeMinPositionQuoteResponse{} }
+func (m *MsgChangeMinPositionQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeMinPositionQuoteResponse) ProtoMessage()    {}
+func (*MsgChangeMinPositionQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{62}
+}
+func (m *MsgChangeMinPositionQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeMinPositionQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeMinPositionQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgPartialCloseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgChangeMinPositionQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeMinPositionQuoteResponse.Merge(m, src)
+}
+func (m *MsgChangeMinPositionQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeMinPositionQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeMinPositionQuoteResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPartialCloseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChangeMinPositionQuoteResponse proto.InternalMessageInfo
+
+// MsgAddCollateralDenom: Whitelists denom as secondary collateral that can be
+// posted as margin. Its value in units of the primary collateral is given by
+// the oracle price of oracle_pair. [SUDO] Only callable by sudoers.
+type MsgAddCollateralDenom struct {
+	Sender     string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom      string                                            `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	OraclePair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,opt,name=oracle_pair,json=oraclePair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"oracle_pair"`
 }
 
-func (m *MsgPartialCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MarginToTrader.Size()
-		i -= size
-		if _, err := m.MarginToTrader.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgAddCollateralDenom) Reset()         { *m = MsgAddCollateralDenom{} }
+func (m *MsgAddCollateralDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCollateralDenom) ProtoMessage()    {}
+func (*MsgAddCollateralDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{63}
+}
+func (m *MsgAddCollateralDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCollateralDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCollateralDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ExchangedNotionalValue.Size()
-		i -= size
-		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgAddCollateralDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCollateralDenom.Merge(m, src)
+}
+func (m *MsgAddCollateralDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCollateralDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCollateralDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCollateralDenom proto.InternalMessageInfo
+
+func (m *MsgAddCollateralDenom) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDonateToEcosystemFund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddCollateralDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDonateToEcosystemFund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAddCollateralDenomResponse struct {
 }
 
-func (m *MsgDonateToEcosystemFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Donation.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAddCollateralDenomResponse) Reset()         { *m = MsgAddCollateralDenomResponse{} }
+func (m *MsgAddCollateralDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCollateralDenomResponse) ProtoMessage()    {}
+func (*MsgAddCollateralDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{64}
+}
+func (m *MsgAddCollateralDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCollateralDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCollateralDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDonateToEcosystemFundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddCollateralDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCollateralDenomResponse.Merge(m, src)
 }
-
-func (m *MsgDonateToEcosystemFundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddCollateralDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCollateralDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCollateralDenomResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDonateToEcosystemFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgAddCollateralDenomResponse proto.InternalMessageInfo
+
+// MsgRemoveCollateralDenom: Removes denom from the secondary collateral
+// whitelist. Margin already posted in it is unaffected.
+// [SUDO] Only callable by sudoers.
+type MsgRemoveCollateralDenom struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgChangeCollateralDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveCollateralDenom) Reset()         { *m = MsgRemoveCollateralDenom{} }
+func (m *MsgRemoveCollateralDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCollateralDenom) ProtoMessage()    {}
+func (*MsgRemoveCollateralDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{65}
+}
+func (m *MsgRemoveCollateralDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCollateralDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCollateralDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgChangeCollateralDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveCollateralDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCollateralDenom.Merge(m, src)
+}
+func (m *MsgRemoveCollateralDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCollateralDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCollateralDenom.DiscardUnknown(m)
 }
 
-func (m *MsgChangeCollateralDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewDenom) > 0 {
-		i -= len(m.NewDenom)
-		copy(dAtA[i:], m.NewDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRemoveCollateralDenom proto.InternalMessageInfo
+
+func (m *MsgRemoveCollateralDenom) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgChangeCollateralDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveCollateralDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgChangeCollateralDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRemoveCollateralDenomResponse struct {
 }
 
-func (m *MsgChangeCollateralDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveCollateralDenomResponse) Reset()         { *m = MsgRemoveCollateralDenomResponse{} }
+func (m *MsgRemoveCollateralDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCollateralDenomResponse) ProtoMessage()    {}
+func (*MsgRemoveCollateralDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{66}
 }
-
-func (m *MsgAllocateEpochRebates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveCollateralDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCollateralDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCollateralDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveCollateralDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCollateralDenomResponse.Merge(m, src)
+}
+func (m *MsgRemoveCollateralDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCollateralDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCollateralDenomResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAllocateEpochRebates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveCollateralDenomResponse proto.InternalMessageInfo
+
+// MsgSetFundingTopUp: Opts the sender's position in pair in or out of funding
+// top-ups. While opted in, the funding owed by the position is paid from the
+// sender's collateral balance when the position is liquidated, before it
+// reduces the position margin.
+type MsgSetFundingTopUp struct {
+	Sender  string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair    github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Enabled bool                                              `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgAllocateEpochRebates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rebates) > 0 {
-		for iNdEx := len(m.Rebates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetFundingTopUp) Reset()         { *m = MsgSetFundingTopUp{} }
+func (m *MsgSetFundingTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFundingTopUp) ProtoMessage()    {}
+func (*MsgSetFundingTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{67}
+}
+func (m *MsgSetFundingTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFundingTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFundingTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAllocateEpochRebatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetFundingTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFundingTopUp.Merge(m, src)
 }
-
-func (m *MsgAllocateEpochRebatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFundingTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFundingTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFundingTopUp.DiscardUnknown(m)
 }
 
-func (m *MsgAllocateEpochRebatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalEpochRebates) > 0 {
-		for iNdEx := len(m.TotalEpochRebates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalEpochRebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_MsgSetFundingTopUp proto.InternalMessageInfo
+
+func (m *MsgSetFundingTopUp) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawEpochRebates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFundingTopUp) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgWithdrawEpochRebates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetFundingTopUpResponse struct {
 }
 
-func (m *MsgWithdrawEpochRebates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Epochs) > 0 {
-		dAtA11 := make([]byte, len(m.Epochs)*10)
-		var j10 int
-		for _, num := range m.Epochs {
-			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j10++
-			}
-			dAtA11[j10] = uint8(num)
-			j10++
+func (m *MsgSetFundingTopUpResponse) Reset()         { *m = MsgSetFundingTopUpResponse{} }
+func (m *MsgSetFundingTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFundingTopUpResponse) ProtoMessage()    {}
+func (*MsgSetFundingTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{68}
+}
+func (m *MsgSetFundingTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFundingTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFundingTopUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintTx(dAtA, i, uint64(j10))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgWithdrawEpochRebatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetFundingTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFundingTopUpResponse.Merge(m, src)
+}
+func (m *MsgSetFundingTopUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFundingTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFundingTopUpResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawEpochRebatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetFundingTopUpResponse proto.InternalMessageInfo
+
+// MsgSetMarginMode: Sets the margin mode of the sender's position in pair.
+// Positions are isolated by default. The free collateral of a cross margin
+// position above its initial margin ratio backs the margin removals of the
+// sender's other cross margin positions.
+type MsgSetMarginMode struct {
+	Sender string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// mode is either "isolated" or "cross".
+	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 }
 
-func (m *MsgWithdrawEpochRebatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WithdrawnRebates) > 0 {
-		for iNdEx := len(m.WithdrawnRebates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithdrawnRebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgSetMarginMode) Reset()         { *m = MsgSetMarginMode{} }
+func (m *MsgSetMarginMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginMode) ProtoMessage()    {}
+func (*MsgSetMarginMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{69}
+}
+func (m *MsgSetMarginMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarginMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarginMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgShiftPegMultiplier) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetMarginMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginMode.Merge(m, src)
 }
-
-func (m *MsgShiftPegMultiplier) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetMarginMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarginMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginMode.DiscardUnknown(m)
 }
 
-func (m *MsgShiftPegMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NewPegMult.Size()
-		i -= size
-		if _, err := m.NewPegMult.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSetMarginMode proto.InternalMessageInfo
+
+func (m *MsgSetMarginMode) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgShiftPegMultiplierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMarginMode) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgShiftPegMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetMarginModeResponse struct {
 }
 
-func (m *MsgShiftPegMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetMarginModeResponse) Reset()         { *m = MsgSetMarginModeResponse{} }
+func (m *MsgSetMarginModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginModeResponse) ProtoMessage()    {}
+func (*MsgSetMarginModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{70}
 }
-
-func (m *MsgShiftSwapInvariant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMarginModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarginModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarginModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetMarginModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginModeResponse.Merge(m, src)
+}
+func (m *MsgSetMarginModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarginModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginModeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgShiftSwapInvariant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetMarginModeResponse proto.InternalMessageInfo
+
+// MsgClampMarkToOracleBand: Moves the mark price of pair back toward the band
+// of its max oracle spread ratio around the oracle price, within the
+// fluctuation limit ratio of the pair per block. The inventory cost is settled
+// between the perp fund and the vault. [SUDO] Only callable by sudoers.
+type MsgClampMarkToOracleBand struct {
+	Sender string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
 }
 
-func (m *MsgShiftSwapInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NewSwapInvariant.Size()
-		i -= size
-		if _, err := m.NewSwapInvariant.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgClampMarkToOracleBand) Reset()         { *m = MsgClampMarkToOracleBand{} }
+func (m *MsgClampMarkToOracleBand) String() string { return proto.CompactTextString(m) }
+func (*MsgClampMarkToOracleBand) ProtoMessage()    {}
+func (*MsgClampMarkToOracleBand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{71}
+}
+func (m *MsgClampMarkToOracleBand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClampMarkToOracleBand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClampMarkToOracleBand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgShiftSwapInvariantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClampMarkToOracleBand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClampMarkToOracleBand.Merge(m, src)
 }
-
-func (m *MsgShiftSwapInvariantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClampMarkToOracleBand) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgShiftSwapInvariantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClampMarkToOracleBand) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClampMarkToOracleBand.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawFromPerpFund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClampMarkToOracleBand proto.InternalMessageInfo
+
+func (m *MsgClampMarkToOracleBand) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawFromPerpFund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgClampMarkToOracleBandResponse struct {
+	// inventory cost paid by the perp fund, negative if paid by the vault
+	Cost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=cost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cost"`
 }
 
-func (m *MsgWithdrawFromPerpFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToAddr) > 0 {
-		i -= len(m.ToAddr)
-		copy(dAtA[i:], m.ToAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddr)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgClampMarkToOracleBandResponse) Reset()         { *m = MsgClampMarkToOracleBandResponse{} }
+func (m *MsgClampMarkToOracleBandResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClampMarkToOracleBandResponse) ProtoMessage()    {}
+func (*MsgClampMarkToOracleBandResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95cda40bf0a0f91, []int{72}
+}
+func (m *MsgClampMarkToOracleBandResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClampMarkToOracleBandResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClampMarkToOracleBandResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgWithdrawFromPerpFundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClampMarkToOracleBandResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClampMarkToOracleBandResponse.Merge(m, src)
 }
-
-func (m *MsgWithdrawFromPerpFundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClampMarkToOracleBandResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClampMarkToOracleBandResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClampMarkToOracleBandResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawFromPerpFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgClampMarkToOracleBandResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSettlePosition)(nil), "nibiru.perp.v2.MsgSettlePosition")
+	proto.RegisterType((*MsgRemoveMargin)(nil), "nibiru.perp.v2.MsgRemoveMargin")
+	proto.RegisterType((*MsgRemoveMarginResponse)(nil), "nibiru.perp.v2.MsgRemoveMarginResponse")
+	proto.RegisterType((*MsgAddMargin)(nil), "nibiru.perp.v2.MsgAddMargin")
+	proto.RegisterType((*MsgAddMarginResponse)(nil), "nibiru.perp.v2.MsgAddMarginResponse")
+	proto.RegisterType((*MsgAddMarginBatch)(nil), "nibiru.perp.v2.MsgAddMarginBatch")
+	proto.RegisterType((*MsgAddMarginBatch_Margin)(nil), "nibiru.perp.v2.MsgAddMarginBatch.Margin")
+	proto.RegisterType((*MsgAddMarginBatchResponse)(nil), "nibiru.perp.v2.MsgAddMarginBatchResponse")
+	proto.RegisterType((*MsgMultiLiquidate)(nil), "nibiru.perp.v2.MsgMultiLiquidate")
+	proto.RegisterType((*MsgMultiLiquidate_Liquidation)(nil), "nibiru.perp.v2.MsgMultiLiquidate.Liquidation")
+	proto.RegisterType((*MsgMultiLiquidateResponse)(nil), "nibiru.perp.v2.MsgMultiLiquidateResponse")
+	proto.RegisterType((*MsgMultiLiquidateResponse_LiquidationResponse)(nil), "nibiru.perp.v2.MsgMultiLiquidateResponse.LiquidationResponse")
+	proto.RegisterType((*MsgMarketOrder)(nil), "nibiru.perp.v2.MsgMarketOrder")
+	proto.RegisterType((*MsgMarketOrderResponse)(nil), "nibiru.perp.v2.MsgMarketOrderResponse")
+	proto.RegisterType((*MsgClosePosition)(nil), "nibiru.perp.v2.MsgClosePosition")
+	proto.RegisterType((*MsgClosePositionResponse)(nil), "nibiru.perp.v2.MsgClosePositionResponse")
+	proto.RegisterType((*MsgPartialClose)(nil), "nibiru.perp.v2.MsgPartialClose")
+	proto.RegisterType((*MsgPartialCloseResponse)(nil), "nibiru.perp.v2.MsgPartialCloseResponse")
+	proto.RegisterType((*MsgDonateToEcosystemFund)(nil), "nibiru.perp.v2.MsgDonateToEcosystemFund")
+	proto.RegisterType((*MsgDonateToEcosystemFundResponse)(nil), "nibiru.perp.v2.MsgDonateToEcosystemFundResponse")
+	proto.RegisterType((*MsgChangeCollateralDenom)(nil), "nibiru.perp.v2.MsgChangeCollateralDenom")
+	proto.RegisterType((*MsgChangeCollateralDenomResponse)(nil), "nibiru.perp.v2.MsgChangeCollateralDenomResponse")
+	proto.RegisterType((*MsgAllocateEpochRebates)(nil), "nibiru.perp.v2.MsgAllocateEpochRebates")
+	proto.RegisterType((*MsgAllocateEpochRebatesResponse)(nil), "nibiru.perp.v2.MsgAllocateEpochRebatesResponse")
+	proto.RegisterType((*MsgWithdrawEpochRebates)(nil), "nibiru.perp.v2.MsgWithdrawEpochRebates")
+	proto.RegisterType((*MsgWithdrawEpochRebatesResponse)(nil), "nibiru.perp.v2.MsgWithdrawEpochRebatesResponse")
+	proto.RegisterType((*MsgShiftPegMultiplier)(nil), "nibiru.perp.v2.MsgShiftPegMultiplier")
+	proto.RegisterType((*MsgShiftPegMultiplierResponse)(nil), "nibiru.perp.v2.MsgShiftPegMultiplierResponse")
+	proto.RegisterType((*MsgShiftSwapInvariant)(nil), "nibiru.perp.v2.MsgShiftSwapInvariant")
+	proto.RegisterType((*MsgShiftSwapInvariantResponse)(nil), "nibiru.perp.v2.MsgShiftSwapInvariantResponse")
+	proto.RegisterType((*MsgWithdrawFromPerpFund)(nil), "nibiru.perp.v2.MsgWithdrawFromPerpFund")
+	proto.RegisterType((*MsgWithdrawFromPerpFundResponse)(nil), "nibiru.perp.v2.MsgWithdrawFromPerpFundResponse")
+	proto.RegisterType((*MsgCloseMarket)(nil), "nibiru.perp.v2.MsgCloseMarket")
+	proto.RegisterType((*MsgCloseMarketResponse)(nil), "nibiru.perp.v2.MsgCloseMarketResponse")
+	proto.RegisterType((*MsgChangeMinSnapshotIntervalMs)(nil), "nibiru.perp.v2.MsgChangeMinSnapshotIntervalMs")
+	proto.RegisterType((*MsgChangeMinSnapshotIntervalMsResponse)(nil), "nibiru.perp.v2.MsgChangeMinSnapshotIntervalMsResponse")
+	proto.RegisterType((*MsgChangeSnapshotRetentionMs)(nil), "nibiru.perp.v2.MsgChangeSnapshotRetentionMs")
+	proto.RegisterType((*MsgChangeSnapshotRetentionMsResponse)(nil), "nibiru.perp.v2.MsgChangeSnapshotRetentionMsResponse")
+	proto.RegisterType((*MsgChangeLiquidationTwapLookbackMs)(nil), "nibiru.perp.v2.MsgChangeLiquidationTwapLookbackMs")
+	proto.RegisterType((*MsgChangeLiquidationTwapLookbackMsResponse)(nil), "nibiru.perp.v2.MsgChangeLiquidationTwapLookbackMsResponse")
+	proto.RegisterType((*MsgChangeLiquidatorRewardRatio)(nil), "nibiru.perp.v2.MsgChangeLiquidatorRewardRatio")
+	proto.RegisterType((*MsgChangeLiquidatorRewardRatioResponse)(nil), "nibiru.perp.v2.MsgChangeLiquidatorRewardRatioResponse")
+	proto.RegisterType((*MsgChangeFundingRateIntervalMs)(nil), "nibiru.perp.v2.MsgChangeFundingRateIntervalMs")
+	proto.RegisterType((*MsgChangeFundingRateIntervalMsResponse)(nil), "nibiru.perp.v2.MsgChangeFundingRateIntervalMsResponse")
+	proto.RegisterType((*MsgChangeMaxPairsPerBlock)(nil), "nibiru.perp.v2.MsgChangeMaxPairsPerBlock")
+	proto.RegisterType((*MsgChangeMaxPairsPerBlockResponse)(nil), "nibiru.perp.v2.MsgChangeMaxPairsPerBlockResponse")
+	proto.RegisterType((*MsgChangeImbalanceFeeRatio)(nil), "nibiru.perp.v2.MsgChangeImbalanceFeeRatio")
+	proto.RegisterType((*MsgChangeImbalanceFeeRatioResponse)(nil), "nibiru.perp.v2.MsgChangeImbalanceFeeRatioResponse")
+	proto.RegisterType((*MsgChangeOpenInterestCap)(nil), "nibiru.perp.v2.MsgChangeOpenInterestCap")
+	proto.RegisterType((*MsgChangeOpenInterestCapResponse)(nil), "nibiru.perp.v2.MsgChangeOpenInterestCapResponse")
+	proto.RegisterType((*MsgChangeFluctuationLimitRatio)(nil), "nibiru.perp.v2.MsgChangeFluctuationLimitRatio")
+	proto.RegisterType((*MsgChangeFluctuationLimitRatioResponse)(nil), "nibiru.perp.v2.MsgChangeFluctuationLimitRatioResponse")
+	proto.RegisterType((*MsgChangeTradeLimitRatio)(nil), "nibiru.perp.v2.MsgChangeTradeLimitRatio")
+	proto.RegisterType((*MsgChangeTradeLimitRatioResponse)(nil), "nibiru.perp.v2.MsgChangeTradeLimitRatioResponse")
+	proto.RegisterType((*MsgChangeSideTradeLimitRatios)(nil), "nibiru.perp.v2.MsgChangeSideTradeLimitRatios")
+	proto.RegisterType((*MsgChangeSideTradeLimitRatiosResponse)(nil), "nibiru.perp.v2.MsgChangeSideTradeLimitRatiosResponse")
+	proto.RegisterType((*MsgChangeCloseAtOracle)(nil), "nibiru.perp.v2.MsgChangeCloseAtOracle")
+	proto.RegisterType((*MsgChangeCloseAtOracleResponse)(nil), "nibiru.perp.v2.MsgChangeCloseAtOracleResponse")
+	proto.RegisterType((*MsgChangeMaxOracleSpreadRatio)(nil), "nibiru.perp.v2.MsgChangeMaxOracleSpreadRatio")
+	proto.RegisterType((*MsgChangeMaxOracleSpreadRatioResponse)(nil), "nibiru.perp.v2.MsgChangeMaxOracleSpreadRatioResponse")
+	proto.RegisterType((*MsgChangeSpreadLimitedSwaps)(nil), "nibiru.perp.v2.MsgChangeSpreadLimitedSwaps")
+	proto.RegisterType((*MsgChangeSpreadLimitedSwapsResponse)(nil), "nibiru.perp.v2.MsgChangeSpreadLimitedSwapsResponse")
+	proto.RegisterType((*MsgChangeMarketPaused)(nil), "nibiru.perp.v2.MsgChangeMarketPaused")
+	proto.RegisterType((*MsgChangeMarketPausedResponse)(nil), "nibiru.perp.v2.MsgChangeMarketPausedResponse")
+	proto.RegisterType((*MsgChangeMinPositionQuote)(nil), "nibiru.perp.v2.MsgChangeMinPositionQuote")
+	proto.RegisterType((*MsgChangeMinPositionQuoteResponse)(nil), "nibiru.perp.v2.MsgChangeMinPositionQuoteResponse")
+	proto.RegisterType((*MsgAddCollateralDenom)(nil), "nibiru.perp.v2.MsgAddCollateralDenom")
+	proto.RegisterType((*MsgAddCollateralDenomResponse)(nil), "nibiru.perp.v2.MsgAddCollateralDenomResponse")
+	proto.RegisterType((*MsgRemoveCollateralDenom)(nil), "nibiru.perp.v2.MsgRemoveCollateralDenom")
+	proto.RegisterType((*MsgRemoveCollateralDenomResponse)(nil), "nibiru.perp.v2.MsgRemoveCollateralDenomResponse")
+	proto.RegisterType((*MsgSetFundingTopUp)(nil), "nibiru.perp.v2.MsgSetFundingTopUp")
+	proto.RegisterType((*MsgSetFundingTopUpResponse)(nil), "nibiru.perp.v2.MsgSetFundingTopUpResponse")
+	proto.RegisterType((*MsgSetMarginMode)(nil), "nibiru.perp.v2.MsgSetMarginMode")
+	proto.RegisterType((*MsgSetMarginModeResponse)(nil), "nibiru.perp.v2.MsgSetMarginModeResponse")
+	proto.RegisterType((*MsgClampMarkToOracleBand)(nil), "nibiru.perp.v2.MsgClampMarkToOracleBand")
+	proto.RegisterType((*MsgClampMarkToOracleBandResponse)(nil), "nibiru.perp.v2.MsgClampMarkToOracleBandResponse")
 }
 
-func (m *MsgCloseMarket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("nibiru/perp/v2/tx.proto", fileDescriptor_b95cda40bf0a0f91) }
+
+var fileDescriptor_b95cda40bf0a0f91 = []byte{
+	// 2863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
+	0xf9, 0xf6, 0xd8, 0x1b, 0xc7, 0x7e, 0x9d, 0xf8, 0x63, 0xe2, 0x78, 0x37, 0xd3, 0x74, 0xed, 0x4c,
+	0x1b, 0xd7, 0xc9, 0xaf, 0xde, 0x4d, 0xdc, 0x36, 0x3f, 0x51, 0x09, 0x90, 0x9d, 0xd4, 0x34, 0x28,
+	0xdb, 0x6c, 0xd6, 0xa1, 0x85, 0xd0, 0x32, 0x3d, 0xde, 0x39, 0xbb, 0x1e, 0x65, 0x76, 0xce, 0x74,
+	0xe6, 0xac, 0xed, 0xb4, 0x12, 0x52, 0x11, 0x12, 0xdc, 0x80, 0x8a, 0xf8, 0x10, 0x02, 0x09, 0xc1,
+	0x05, 0x12, 0x02, 0x09, 0x89, 0x0b, 0xe0, 0x86, 0x3f, 0xa0, 0xe2, 0xaa, 0x97, 0x08, 0x41, 0x41,
+	0xcd, 0x0d, 0x37, 0x08, 0x51, 0xf1, 0x07, 0xa0, 0x33, 0x1f, 0x67, 0x67, 0xc6, 0x67, 0x66, 0x67,
+	0x37, 0xce, 0x4a, 0x20, 0xae, 0xb2, 0xe3, 0x79, 0xce, 0xf3, 0xbe, 0xef, 0x73, 0xde, 0xf3, 0xf5,
+	0xce, 0x09, 0x14, 0x2d, 0x63, 0xd7, 0x70, 0xba, 0x55, 0x1b, 0x3b, 0x76, 0x75, 0x7f, 0xa3, 0x4a,
+	0x0f, 0x2b, 0xb6, 0x43, 0x28, 0x91, 0x67, 0xfd, 0x17, 0x15, 0xf6, 0xa2, 0xb2, 0xbf, 0xa1, 0x9c,
+	0x6f, 0x13, 0xd2, 0x36, 0x71, 0x15, 0xd9, 0x46, 0x15, 0x59, 0x16, 0xa1, 0x88, 0x1a, 0xc4, 0x72,
+	0x7d, 0xb4, 0x52, 0x6e, 0x12, 0xb7, 0x43, 0xdc, 0xea, 0x2e, 0x72, 0x71, 0x75, 0xff, 0xea, 0x2e,
+	0xa6, 0xe8, 0x6a, 0xb5, 0x49, 0x0c, 0x2b, 0x78, 0xbf, 0xd8, 0x26, 0x6d, 0xe2, 0xfd, 0xac, 0xb2,
+	0x5f, 0xc1, 0x5f, 0x95, 0x84, 0x71, 0x97, 0x22, 0x8a, 0xfd, 0x77, 0xea, 0x77, 0x24, 0x58, 0xa8,
+	0xb9, 0xed, 0x1d, 0x4c, 0xa9, 0x89, 0xeb, 0xc4, 0x35, 0x98, 0x39, 0x79, 0x09, 0x26, 0x5d, 0x6c,
+	0xe9, 0xd8, 0x29, 0x49, 0x2b, 0xd2, 0xda, 0x74, 0x23, 0x78, 0x92, 0x6b, 0x50, 0xb0, 0x91, 0xe1,
+	0x94, 0xc6, 0xd9, 0x5f, 0xb7, 0x3e, 0xf1, 0xfe, 0x87, 0xcb, 0x63, 0x7f, 0xfc, 0x70, 0xf9, 0x6a,
+	0xdb, 0xa0, 0x7b, 0xdd, 0xdd, 0x4a, 0x93, 0x74, 0xaa, 0xaf, 0x78, 0xa6, 0xae, 0xef, 0x21, 0xc3,
+	0xaa, 0x06, 0x66, 0x0f, 0xab, 0x4d, 0xd2, 0xe9, 0x10, 0xab, 0x8a, 0x5c, 0x17, 0xd3, 0x4a, 0x1d,
+	0x19, 0x4e, 0xc3, 0xa3, 0x91, 0x4b, 0x70, 0x72, 0x1f, 0x3b, 0xae, 0x41, 0xac, 0xd2, 0xc4, 0x8a,
+	0xb4, 0x56, 0x68, 0x84, 0x8f, 0xea, 0xaf, 0x24, 0x98, 0xab, 0xb9, 0xed, 0x06, 0xee, 0x90, 0x7d,
+	0x5c, 0x43, 0x4e, 0xdb, 0x18, 0x99, 0x53, 0xff, 0x0f, 0x93, 0x1d, 0xcf, 0xa0, 0xe7, 0xd3, 0xcc,
+	0xc6, 0xb9, 0x8a, 0x2f, 0x7a, 0x85, 0x89, 0x5e, 0x09, 0x44, 0xaf, 0x5c, 0x27, 0x86, 0xb5, 0x55,
+	0x60, 0xb6, 0x1a, 0x01, 0x5c, 0xfd, 0x9b, 0x04, 0xc5, 0x84, 0xcf, 0x0d, 0xec, 0xda, 0xc4, 0x72,
+	0xb1, 0xfc, 0x29, 0x00, 0x1f, 0xa5, 0x91, 0x2e, 0x2d, 0x49, 0xf9, 0x88, 0xa7, 0xfd, 0x26, 0xb7,
+	0xbb, 0x54, 0x7e, 0x0d, 0xe6, 0x5a, 0x5d, 0x4b, 0x37, 0xac, 0xb6, 0x66, 0xa3, 0x07, 0x1d, 0x6c,
+	0xd1, 0x20, 0xdc, 0x4a, 0x10, 0xee, 0x6a, 0x24, 0xdc, 0x20, 0x49, 0xfc, 0x7f, 0xd6, 0x5d, 0xfd,
+	0x7e, 0x95, 0x3e, 0xb0, 0xb1, 0x5b, 0xb9, 0x81, 0x9b, 0x8d, 0xd9, 0x80, 0xa6, 0xee, 0xb3, 0xc8,
+	0xcf, 0xc3, 0x94, 0x1d, 0xf4, 0x7a, 0x10, 0x6f, 0xa9, 0x12, 0x4f, 0xc9, 0x4a, 0x98, 0x15, 0x0d,
+	0x8e, 0x54, 0x7f, 0x29, 0xc1, 0xa9, 0x9a, 0xdb, 0xde, 0xd4, 0xf5, 0xff, 0x90, 0xbe, 0xf9, 0xa9,
+	0x04, 0x8b, 0x51, 0x87, 0x79, 0xc7, 0x08, 0x84, 0x95, 0x8e, 0x5d, 0xd8, 0xf1, 0xdc, 0xc2, 0x7e,
+	0x7d, 0x1c, 0x16, 0xa2, 0x7e, 0x6e, 0x21, 0xda, 0xdc, 0x4b, 0x55, 0xf7, 0x65, 0x38, 0xe9, 0xc7,
+	0xe7, 0x96, 0xc6, 0x57, 0x26, 0xd6, 0x66, 0x36, 0xd6, 0x92, 0x26, 0x8e, 0x70, 0x55, 0x82, 0xdf,
+	0xbe, 0x3c, 0x61, 0x73, 0xe5, 0x3d, 0x09, 0x26, 0x83, 0xae, 0x0c, 0xbb, 0x4c, 0x3a, 0xee, 0x2e,
+	0x1b, 0x1f, 0xac, 0xcb, 0x30, 0x9c, 0x3b, 0xe2, 0x3d, 0xef, 0xb6, 0x97, 0x61, 0xda, 0x09, 0x7e,
+	0xbb, 0x25, 0xc9, 0x8b, 0xfd, 0xe9, 0xac, 0xd8, 0xc3, 0x86, 0xe1, 0xc8, 0xe2, 0x8d, 0xd5, 0x7f,
+	0xf9, 0x13, 0x60, 0xad, 0x6b, 0x52, 0xe3, 0x96, 0xf1, 0x56, 0xd7, 0xd0, 0x11, 0xc5, 0xa9, 0x8a,
+	0xdf, 0x81, 0x53, 0x66, 0x00, 0x32, 0x08, 0x97, 0x7d, 0x5d, 0x60, 0x3a, 0x4e, 0x58, 0xb9, 0xd5,
+	0x6b, 0xd5, 0x88, 0x51, 0x28, 0x14, 0x66, 0x22, 0x2f, 0x8f, 0x5b, 0xfe, 0x25, 0x98, 0xa4, 0x0e,
+	0x62, 0x81, 0x8c, 0xfb, 0x81, 0xf8, 0x4f, 0xea, 0x6f, 0x26, 0xe0, 0xdc, 0x11, 0x2f, 0xb9, 0xbc,
+	0x28, 0x11, 0xa6, 0xaf, 0xf0, 0x27, 0xfb, 0x86, 0x19, 0x12, 0xc4, 0xc2, 0x0d, 0xfe, 0x96, 0x08,
+	0xfb, 0xd7, 0xe3, 0x70, 0x46, 0x80, 0x62, 0x6b, 0x82, 0xdb, 0x6d, 0x36, 0xb1, 0xeb, 0x7a, 0x12,
+	0x4c, 0x35, 0xc2, 0x47, 0x79, 0x11, 0x4e, 0x60, 0xc7, 0x21, 0x61, 0x24, 0xfe, 0x83, 0xbc, 0x0d,
+	0xb3, 0x21, 0x2f, 0x71, 0xb4, 0x16, 0xc6, 0xf9, 0xa6, 0x06, 0xa9, 0x71, 0xba, 0xd7, 0x6c, 0x1b,
+	0x63, 0xf9, 0xd3, 0x30, 0xc3, 0xc2, 0xd2, 0x70, 0xcb, 0x23, 0x29, 0xe4, 0x23, 0x99, 0x66, 0x6d,
+	0x5e, 0x6a, 0x31, 0x82, 0x9e, 0xd2, 0x27, 0xa2, 0x4a, 0xf3, 0x0e, 0x9d, 0x3c, 0x96, 0x0e, 0x55,
+	0x7f, 0x3b, 0x01, 0xb3, 0x4c, 0x77, 0xe4, 0xdc, 0xc7, 0xf4, 0xb6, 0xc3, 0x2c, 0x8c, 0x68, 0xf2,
+	0x5d, 0x87, 0x82, 0x6b, 0xe8, 0xbe, 0xbe, 0xb3, 0x1b, 0xe7, 0x92, 0xc9, 0x70, 0xc3, 0x70, 0x70,
+	0xd3, 0xeb, 0x4a, 0x0f, 0x26, 0xbf, 0x0e, 0xf2, 0x5b, 0x5d, 0x42, 0xb1, 0xe6, 0x11, 0x69, 0xa8,
+	0x43, 0xba, 0x16, 0x2d, 0x15, 0x06, 0x9e, 0x5c, 0x6f, 0x5a, 0xb4, 0x31, 0xef, 0x31, 0x6d, 0x32,
+	0xa2, 0x4d, 0x8f, 0x47, 0xfe, 0x2c, 0x4c, 0x99, 0x78, 0x1f, 0x3b, 0xa8, 0x8d, 0x4b, 0x27, 0x06,
+	0xe6, 0x64, 0x13, 0x36, 0x6f, 0x2f, 0x63, 0x28, 0xb2, 0xfe, 0x8d, 0x39, 0xaa, 0x99, 0x46, 0xc7,
+	0xa0, 0xa5, 0xc9, 0x81, 0xa9, 0x99, 0xbb, 0x8b, 0x8c, 0x2e, 0xe2, 0xed, 0x2d, 0xc6, 0xa5, 0x3e,
+	0x3c, 0x01, 0x4b, 0xf1, 0x9e, 0xe3, 0x49, 0x1f, 0x5d, 0x2c, 0xa4, 0xbc, 0x8b, 0x85, 0xbc, 0x07,
+	0x25, 0x7c, 0xd8, 0xdc, 0x43, 0x56, 0x1b, 0xeb, 0x9a, 0x45, 0xd8, 0xdf, 0x90, 0xa9, 0xed, 0x23,
+	0xb3, 0x8b, 0x87, 0xdc, 0x1d, 0x2c, 0x71, 0xbe, 0x57, 0x02, 0xba, 0x57, 0x19, 0x9b, 0xdc, 0x82,
+	0x62, 0xcf, 0x52, 0x68, 0x5f, 0x73, 0x8d, 0xb7, 0xfd, 0x6c, 0x18, 0xdc, 0xd0, 0x59, 0x4e, 0x17,
+	0xc6, 0xb5, 0x63, 0xbc, 0x2d, 0x5c, 0x8d, 0x0b, 0xc7, 0xb2, 0x1a, 0xdf, 0x81, 0x53, 0x0e, 0x46,
+	0xa6, 0xf1, 0x36, 0xf3, 0xdf, 0x32, 0x87, 0x4c, 0x99, 0x99, 0x90, 0xa3, 0x6e, 0x99, 0xf2, 0x9b,
+	0xb0, 0xd8, 0xb5, 0xa2, 0xa4, 0x1a, 0x6a, 0x51, 0xec, 0x94, 0x26, 0x87, 0xa2, 0x96, 0x7b, 0x5c,
+	0x75, 0xcb, 0xdc, 0x64, 0x4c, 0xf2, 0xab, 0x30, 0x17, 0x6c, 0x1a, 0x29, 0xd1, 0xf6, 0x51, 0xd7,
+	0xa4, 0xa5, 0x93, 0x43, 0x91, 0x9f, 0xf6, 0x69, 0xee, 0x92, 0x57, 0x19, 0x89, 0xfc, 0x45, 0x58,
+	0xe0, 0x7d, 0x18, 0xa6, 0x4d, 0x69, 0x6a, 0x28, 0xe6, 0xf9, 0x90, 0x28, 0xcc, 0x17, 0xf5, 0x01,
+	0xcc, 0xd7, 0xdc, 0xf6, 0x75, 0x93, 0xb8, 0xa3, 0x3e, 0x4e, 0xa8, 0x1f, 0x4f, 0x40, 0x29, 0x69,
+	0x9b, 0x0f, 0xb1, 0xac, 0xc1, 0x22, 0x8d, 0x6a, 0xb0, 0x8c, 0x3f, 0xe6, 0xc1, 0x32, 0xf1, 0x58,
+	0x06, 0x4b, 0xe1, 0xd1, 0x07, 0xcb, 0xe7, 0x61, 0xbe, 0x97, 0xca, 0xd1, 0x65, 0x72, 0x70, 0x67,
+	0xc3, 0x5c, 0xbe, 0xeb, 0x6f, 0x64, 0x7e, 0xe7, 0x9f, 0x14, 0xeb, 0xc8, 0xa1, 0x06, 0x32, 0xbd,
+	0xbe, 0x1f, 0xd5, 0x82, 0xb8, 0x05, 0x85, 0x47, 0x98, 0x02, 0xbd, 0xb6, 0xea, 0x3f, 0x27, 0xa0,
+	0x98, 0x70, 0xff, 0x7f, 0x29, 0xfb, 0x5f, 0x9e, 0xb2, 0x5f, 0x91, 0xbc, 0x79, 0xea, 0x06, 0xb1,
+	0x10, 0xc5, 0x77, 0xc9, 0x4b, 0x4d, 0xe2, 0x3e, 0x70, 0x29, 0xee, 0x6c, 0x77, 0x2d, 0x3d, 0x35,
+	0x77, 0x5f, 0x81, 0x29, 0x9d, 0x35, 0xe8, 0x9d, 0x27, 0x33, 0x36, 0xa7, 0x45, 0xe6, 0xe1, 0xc7,
+	0x1f, 0x2e, 0xcf, 0x3d, 0x40, 0x1d, 0xf3, 0x45, 0x35, 0x6c, 0xa8, 0x36, 0x38, 0x87, 0xaa, 0xc2,
+	0x4a, 0x9a, 0x0f, 0x61, 0x02, 0xaa, 0xb7, 0xfd, 0xf9, 0xd4, 0xeb, 0xc8, 0xeb, 0xc4, 0x34, 0x11,
+	0xc5, 0x0e, 0x32, 0x6f, 0x60, 0x8b, 0x74, 0x52, 0xfd, 0x7c, 0x02, 0xa6, 0x2d, 0x7c, 0xa0, 0xe9,
+	0x0c, 0x14, 0xec, 0xd4, 0xa7, 0x2c, 0x7c, 0xe0, 0x35, 0x0a, 0x8c, 0x0a, 0x09, 0xb9, 0xd1, 0xef,
+	0xfb, 0x65, 0x94, 0x4d, 0xd3, 0x24, 0x4d, 0x44, 0xf1, 0x4b, 0x36, 0x61, 0xe7, 0xbe, 0x5d, 0x44,
+	0xb1, 0x9b, 0x6a, 0x14, 0xc3, 0x49, 0xc7, 0x87, 0x04, 0x27, 0xb2, 0x0c, 0x6d, 0xae, 0x30, 0x6d,
+	0x7e, 0xfe, 0x97, 0xe5, 0xb5, 0x1c, 0xbd, 0xc7, 0x1a, 0xb8, 0x8d, 0x90, 0x5b, 0xfd, 0x91, 0x04,
+	0xcb, 0x29, 0xae, 0xf1, 0x41, 0xfb, 0x0e, 0x9c, 0xa1, 0x84, 0x22, 0x53, 0xc3, 0xec, 0xad, 0x16,
+	0xba, 0x25, 0x1d, 0xbf, 0x5b, 0x0b, 0x9e, 0x9d, 0xa8, 0x13, 0xea, 0x4d, 0x4f, 0xba, 0xd7, 0x0c,
+	0xba, 0xa7, 0x3b, 0xe8, 0x20, 0x97, 0x74, 0x4b, 0x30, 0xe9, 0x79, 0xea, 0x2b, 0x57, 0x68, 0x04,
+	0x4f, 0xea, 0x0f, 0xfd, 0x58, 0x45, 0x5c, 0x3c, 0xd6, 0x43, 0x58, 0x38, 0x08, 0xde, 0x5b, 0x8f,
+	0x33, 0xd2, 0x79, 0x6e, 0x25, 0x0c, 0xf4, 0x03, 0x09, 0xce, 0xb2, 0xb2, 0xe5, 0x9e, 0xd1, 0xa2,
+	0x75, 0xec, 0x9f, 0x42, 0x6d, 0xd3, 0x18, 0xdd, 0x61, 0xa8, 0x0e, 0xa7, 0x58, 0x9a, 0xdb, 0xb8,
+	0xad, 0x75, 0xba, 0xe6, 0xb0, 0xd3, 0x18, 0x58, 0xf8, 0x20, 0x70, 0x5f, 0x5d, 0x86, 0x27, 0x85,
+	0x11, 0xf1, 0x81, 0xf1, 0xa7, 0x48, 0xcc, 0x3b, 0x07, 0xc8, 0xbe, 0x69, 0xed, 0x23, 0xc7, 0x40,
+	0x16, 0x1d, 0x55, 0xcc, 0xaf, 0x83, 0xcc, 0x62, 0x76, 0x0f, 0x90, 0xad, 0x19, 0xa1, 0xf1, 0xd2,
+	0xc4, 0x50, 0x47, 0xa4, 0x79, 0x0b, 0x1f, 0xc4, 0x82, 0x88, 0xc6, 0x1f, 0x7b, 0xc1, 0xe3, 0xff,
+	0x99, 0x14, 0xcb, 0xee, 0x6d, 0x87, 0x74, 0xea, 0xd8, 0xb1, 0x33, 0x67, 0xcd, 0x6d, 0x98, 0x0c,
+	0x0e, 0x9e, 0xe3, 0x43, 0xb9, 0x19, 0xb4, 0x66, 0xb5, 0x07, 0x7f, 0x46, 0x9b, 0xf0, 0x6b, 0x0f,
+	0xde, 0x83, 0x5c, 0x84, 0x93, 0x94, 0x68, 0x48, 0xd7, 0x1d, 0x7f, 0xc1, 0x69, 0x4c, 0x52, 0xb2,
+	0xa9, 0xeb, 0x8e, 0x7a, 0x01, 0x96, 0x53, 0x3c, 0xe5, 0xd1, 0x1c, 0x78, 0xc7, 0x78, 0x6f, 0xc1,
+	0xf7, 0x4f, 0x84, 0xa3, 0xda, 0x25, 0x97, 0x60, 0x29, 0x6e, 0x98, 0xbb, 0xf4, 0x05, 0x28, 0xf3,
+	0xd9, 0xb9, 0x66, 0x58, 0x3b, 0x16, 0xb2, 0xdd, 0x3d, 0x42, 0x6f, 0x5a, 0x14, 0x3b, 0xfb, 0xc8,
+	0xac, 0xa5, 0x4f, 0x22, 0xcb, 0x30, 0x63, 0x04, 0x28, 0xad, 0xe3, 0x7a, 0x9e, 0x16, 0x1a, 0x60,
+	0xf0, 0x86, 0xea, 0x1a, 0xac, 0x66, 0x53, 0x47, 0x9c, 0x38, 0xcf, 0x91, 0x21, 0xac, 0x81, 0x29,
+	0xb6, 0xd8, 0xaa, 0x95, 0xe1, 0xc2, 0x05, 0xb6, 0x03, 0x08, 0x60, 0x3d, 0x1f, 0x66, 0x9c, 0x5e,
+	0x53, 0x75, 0x15, 0x9e, 0xce, 0xa2, 0xe6, 0x2e, 0xbc, 0x01, 0x2a, 0xc7, 0x45, 0x4a, 0x54, 0x77,
+	0x0f, 0x90, 0x7d, 0x8b, 0x90, 0xfb, 0xbb, 0xa8, 0x79, 0x3f, 0x5b, 0x0b, 0x33, 0x40, 0x45, 0xb4,
+	0x30, 0x79, 0x43, 0xf5, 0x59, 0xb8, 0xdc, 0x9f, 0x9e, 0x3b, 0xf3, 0x63, 0x09, 0xca, 0x47, 0xe0,
+	0xc4, 0x69, 0xe0, 0x03, 0xe4, 0xe8, 0x0d, 0xd6, 0x32, 0xd5, 0x93, 0x16, 0x14, 0x23, 0xa5, 0x31,
+	0xc7, 0x6b, 0xa1, 0x39, 0xac, 0xc9, 0xb0, 0xbb, 0x3a, 0x53, 0x64, 0x3f, 0xd6, 0xb9, 0x42, 0x0f,
+	0x85, 0x19, 0xb6, 0xed, 0xef, 0xe0, 0x1a, 0x88, 0xe2, 0xe3, 0xce, 0x30, 0x21, 0x35, 0x77, 0xa2,
+	0x0e, 0xe7, 0x38, 0xb2, 0x86, 0x0e, 0xd9, 0xd0, 0x70, 0xeb, 0xd8, 0xd9, 0x32, 0x49, 0xf3, 0x7e,
+	0xd6, 0xb6, 0xa6, 0x83, 0x0e, 0x35, 0x36, 0x82, 0x42, 0xeb, 0x53, 0x9d, 0xa0, 0xb1, 0xfa, 0x14,
+	0x5c, 0x48, 0x65, 0xe4, 0x66, 0x1f, 0x4a, 0xa0, 0x70, 0xd4, 0xcd, 0xce, 0x2e, 0x32, 0x91, 0xd5,
+	0xc4, 0xdb, 0x18, 0x67, 0x77, 0xe2, 0x31, 0xcf, 0xe1, 0x5f, 0x82, 0x33, 0x46, 0x68, 0x9b, 0x15,
+	0x3a, 0x83, 0x7c, 0x18, 0x6e, 0xf9, 0x5a, 0x30, 0x92, 0x61, 0xa8, 0x4f, 0x83, 0x9a, 0x1e, 0x24,
+	0xd7, 0xe2, 0xcf, 0x52, 0x64, 0x67, 0x79, 0xdb, 0xc6, 0x96, 0xd7, 0x4d, 0xd8, 0xa5, 0xd7, 0x91,
+	0x3d, 0x2a, 0x25, 0xee, 0xc1, 0x02, 0xb1, 0xb1, 0xa5, 0x19, 0x81, 0x69, 0xad, 0x89, 0xec, 0x21,
+	0x75, 0x98, 0x23, 0xf1, 0x10, 0x62, 0xfb, 0xdc, 0x44, 0x78, 0x5c, 0x83, 0x7f, 0x44, 0x07, 0xf6,
+	0xb6, 0xd9, 0x6d, 0xd2, 0xae, 0x37, 0x0f, 0x78, 0xc5, 0xc2, 0x91, 0xe6, 0x44, 0x0b, 0x8a, 0xad,
+	0x9e, 0x7d, 0xbf, 0xf2, 0xf9, 0x48, 0x79, 0x71, 0xb6, 0x25, 0x0a, 0x27, 0x3e, 0x44, 0x45, 0x08,
+	0x71, 0x7e, 0x78, 0xa7, 0xa6, 0xd1, 0xab, 0x72, 0x0f, 0x16, 0xbc, 0x53, 0xdf, 0x31, 0xe8, 0x31,
+	0x47, 0xe3, 0x21, 0xc4, 0xf2, 0x23, 0x11, 0x1e, 0xd7, 0xe0, 0xf7, 0xe3, 0xf0, 0x24, 0x07, 0xed,
+	0x18, 0x7a, 0x12, 0xe8, 0x8e, 0x4a, 0x08, 0x0d, 0x96, 0x4c, 0x62, 0xb5, 0xb5, 0x34, 0x35, 0x2e,
+	0x0f, 0xa0, 0xc4, 0x19, 0xc6, 0x94, 0xec, 0x50, 0x04, 0x45, 0x77, 0x8f, 0x38, 0x54, 0x60, 0xa1,
+	0x30, 0xb0, 0x85, 0x45, 0x8f, 0x2a, 0x61, 0x42, 0x7d, 0x06, 0x2e, 0x66, 0x6a, 0xc9, 0x55, 0xff,
+	0xe6, 0x38, 0x2c, 0x71, 0xa4, 0xb7, 0x49, 0xda, 0xa4, 0xb7, 0x1d, 0xd4, 0x34, 0x47, 0x56, 0x55,
+	0x5a, 0x85, 0xb9, 0x26, 0xb3, 0xab, 0x21, 0xaa, 0x11, 0xcf, 0xb2, 0xa7, 0xf3, 0x54, 0xe3, 0x74,
+	0x33, 0xe6, 0x0e, 0x86, 0x22, 0x5b, 0x91, 0x7c, 0x88, 0xe6, 0xda, 0x0e, 0x46, 0x7a, 0x4c, 0xb5,
+	0x41, 0xb3, 0x74, 0xb1, 0x83, 0x0e, 0x7d, 0xee, 0x1d, 0x8f, 0xcc, 0x57, 0x6e, 0x05, 0xca, 0x62,
+	0x3d, 0xb8, 0x64, 0x7f, 0x97, 0x22, 0x89, 0x5a, 0x13, 0x70, 0x8c, 0x4a, 0xb9, 0x0c, 0x45, 0x26,
+	0x8e, 0x51, 0x91, 0x68, 0x2e, 0x89, 0xc2, 0xe5, 0xc2, 0xfc, 0x42, 0x82, 0x27, 0x7a, 0x59, 0xe7,
+	0x01, 0xbc, 0x94, 0xc3, 0x3a, 0x3b, 0xe0, 0x8c, 0x6c, 0xfc, 0x5e, 0x84, 0xd9, 0x40, 0x0b, 0xd3,
+	0xb7, 0x1e, 0xe6, 0x93, 0x1b, 0x75, 0x49, 0xbd, 0x08, 0x4f, 0x65, 0x38, 0xcb, 0x83, 0xfa, 0x9e,
+	0x7f, 0x0a, 0x0d, 0xc3, 0x67, 0x07, 0x88, 0x3a, 0xea, 0xba, 0x58, 0x1f, 0x55, 0x38, 0x4b, 0x30,
+	0x69, 0x7b, 0x06, 0x83, 0x30, 0x82, 0xa7, 0xe0, 0xfc, 0x78, 0xd4, 0x2f, 0xee, 0xf9, 0xb7, 0xa4,
+	0xe8, 0xc6, 0xcf, 0xb0, 0xc2, 0xca, 0xe4, 0x1d, 0xf6, 0x6d, 0x31, 0xd5, 0xfb, 0xd7, 0x41, 0xee,
+	0x18, 0x56, 0xaf, 0x28, 0xea, 0x7d, 0x89, 0x1c, 0x72, 0xff, 0x3c, 0xdf, 0x49, 0x58, 0x8d, 0xef,
+	0x1c, 0x13, 0x2f, 0xb9, 0xe3, 0x3f, 0xf1, 0x25, 0xdf, 0xd4, 0xf5, 0xbc, 0x45, 0x38, 0x7e, 0x5c,
+	0x1d, 0x8f, 0x1e, 0x57, 0xef, 0xc1, 0x4c, 0x30, 0x36, 0xbc, 0xfe, 0x98, 0x78, 0xd4, 0xfe, 0x00,
+	0x9f, 0x8d, 0xfd, 0x0e, 0xd4, 0x3f, 0xea, 0x22, 0x0f, 0xe2, 0x65, 0x28, 0xf1, 0xcb, 0x51, 0x8f,
+	0x14, 0x46, 0xb0, 0x78, 0x0a, 0x99, 0xb8, 0xb5, 0xef, 0x4a, 0x20, 0xfb, 0xd7, 0xda, 0x82, 0xb3,
+	0xc0, 0x5d, 0x62, 0x7f, 0xce, 0x1e, 0xe1, 0xbd, 0x36, 0x6c, 0xa1, 0x5d, 0x93, 0xe7, 0x68, 0xf8,
+	0xa8, 0x9e, 0x07, 0xe5, 0xa8, 0x5b, 0xdc, 0xeb, 0x6f, 0x48, 0xde, 0xc7, 0xb3, 0x1d, 0x4c, 0xfd,
+	0x5b, 0x2b, 0x35, 0xa2, 0x8f, 0x6c, 0xd9, 0x91, 0xa1, 0xd0, 0x21, 0xc1, 0xd7, 0xfd, 0xe9, 0x86,
+	0xf7, 0x5b, 0x55, 0xa0, 0x94, 0x74, 0x87, 0xfb, 0xfa, 0x6e, 0xb0, 0x45, 0x33, 0x51, 0xc7, 0x66,
+	0xc3, 0xed, 0x2e, 0xf1, 0x27, 0xc2, 0x2d, 0x64, 0x8d, 0x6a, 0x2a, 0x50, 0x5b, 0xb0, 0x92, 0xe6,
+	0x02, 0xaf, 0x51, 0x6e, 0x41, 0xa1, 0x49, 0xdc, 0x61, 0x6e, 0x75, 0xb1, 0xfa, 0x8f, 0xd7, 0x76,
+	0xe3, 0x07, 0x17, 0x60, 0xa2, 0xe6, 0xb6, 0xe5, 0x7b, 0x70, 0x2a, 0x76, 0x23, 0x71, 0x59, 0x70,
+	0x21, 0x26, 0x0a, 0x50, 0x9e, 0xe9, 0x03, 0xe0, 0x6a, 0x8e, 0xc9, 0x77, 0x60, 0xba, 0x77, 0x9d,
+	0xee, 0x7c, 0xd6, 0x5d, 0x26, 0x25, 0xd7, 0x4d, 0x27, 0x75, 0x4c, 0x7e, 0x13, 0x66, 0x13, 0x17,
+	0xc9, 0x2e, 0xf4, 0xbd, 0x1f, 0xa6, 0x5c, 0xea, 0x0b, 0x89, 0x5b, 0x48, 0x5c, 0x9c, 0xba, 0xd0,
+	0xf7, 0x8e, 0x90, 0x72, 0xa9, 0x2f, 0x24, 0x62, 0xe1, 0x35, 0x98, 0x89, 0x5e, 0x75, 0x29, 0x8b,
+	0xda, 0xf6, 0xde, 0x2b, 0xab, 0xd9, 0xef, 0x23, 0xc4, 0x6f, 0xc0, 0xe9, 0xf8, 0x47, 0xea, 0x15,
+	0x41, 0xd3, 0x18, 0x42, 0x59, 0xeb, 0x87, 0x88, 0xd0, 0xdf, 0x83, 0x53, 0xb1, 0x4f, 0x92, 0xa2,
+	0x54, 0x89, 0x02, 0x94, 0x67, 0xfa, 0x00, 0x22, 0xdc, 0x1a, 0xcc, 0x26, 0xee, 0xeb, 0x8a, 0x54,
+	0x8f, 0x43, 0x06, 0x72, 0xbe, 0x0b, 0x67, 0xc5, 0x1f, 0xa7, 0x44, 0x24, 0x42, 0xa4, 0x72, 0x25,
+	0x2f, 0x32, 0x6e, 0x56, 0xfc, 0xad, 0x49, 0xe8, 0xbb, 0x08, 0xa9, 0x5c, 0xc9, 0x8b, 0x8c, 0x98,
+	0x75, 0x60, 0x51, 0xf8, 0xb1, 0x49, 0xd4, 0x23, 0x22, 0xa0, 0x52, 0xcd, 0x09, 0x8c, 0xdb, 0x14,
+	0x7e, 0xa5, 0x11, 0xd9, 0x14, 0x01, 0x95, 0x6a, 0x4e, 0x60, 0xc4, 0xa6, 0x09, 0xb2, 0xe0, 0x7b,
+	0xc9, 0x45, 0x51, 0xea, 0x1c, 0x81, 0x29, 0xeb, 0xb9, 0x60, 0x02, 0x6b, 0xf1, 0x2f, 0x15, 0xa9,
+	0xd6, 0x62, 0x30, 0x65, 0x3d, 0x17, 0x4c, 0xac, 0x67, 0xec, 0xbb, 0x40, 0x96, 0x9e, 0x51, 0xa0,
+	0x52, 0xcd, 0x09, 0x8c, 0x4f, 0x4d, 0xd1, 0xf2, 0x7d, 0x39, 0x6d, 0x80, 0xf9, 0xef, 0x95, 0xd5,
+	0xec, 0xf7, 0x11, 0xe2, 0xaf, 0x49, 0xf0, 0x44, 0x56, 0x15, 0xbe, 0x92, 0x9a, 0xe4, 0x42, 0xbc,
+	0x72, 0x6d, 0x30, 0x7c, 0xc4, 0x93, 0x77, 0x25, 0x38, 0x97, 0x5e, 0x8a, 0x7f, 0x36, 0x95, 0x57,
+	0x80, 0x56, 0x9e, 0x1f, 0x04, 0x1d, 0xf1, 0xe1, 0xdb, 0x12, 0x2c, 0xf7, 0xab, 0xc5, 0x6f, 0xa4,
+	0x72, 0xa7, 0xb6, 0x51, 0x5e, 0x1c, 0xbc, 0x8d, 0xb0, 0x8f, 0xc4, 0x35, 0xf9, 0x4a, 0x5f, 0xf6,
+	0x18, 0x5e, 0xb9, 0x36, 0x18, 0x5e, 0xe8, 0x89, 0xb8, 0xa2, 0x9e, 0xee, 0x89, 0x10, 0xaf, 0x5c,
+	0x1b, 0x0c, 0x1f, 0xf1, 0xe4, 0x10, 0x96, 0x52, 0xaa, 0xea, 0x97, 0xd2, 0x33, 0x30, 0x01, 0x55,
+	0xae, 0xe6, 0x86, 0x46, 0x2c, 0xbf, 0x03, 0xc5, 0xb4, 0xba, 0xfa, 0xe5, 0x54, 0xbe, 0x23, 0x58,
+	0x65, 0x23, 0x3f, 0x56, 0xb4, 0x6c, 0x25, 0x0b, 0xd9, 0xe9, 0xcb, 0x56, 0x02, 0xa9, 0x5c, 0xc9,
+	0x8b, 0x14, 0xf7, 0xbb, 0xb0, 0x78, 0x9c, 0xd1, 0xef, 0x22, 0xbc, 0x72, 0x6d, 0x30, 0xbc, 0x48,
+	0x80, 0x64, 0x61, 0x2f, 0x5d, 0x80, 0x04, 0x52, 0xb9, 0x92, 0x17, 0x19, 0x31, 0xfb, 0x55, 0x09,
+	0x94, 0x8c, 0xea, 0xe8, 0x7a, 0xfa, 0x7c, 0x23, 0x80, 0x2b, 0x2f, 0x0c, 0x04, 0x8f, 0xb8, 0x41,
+	0xe0, 0x8c, 0xa8, 0x5a, 0xb8, 0x9a, 0xbe, 0x13, 0x89, 0xe2, 0x94, 0x4a, 0x3e, 0x9c, 0x30, 0x6e,
+	0x61, 0xb1, 0x6d, 0x3d, 0x6b, 0x00, 0x1d, 0x81, 0x2b, 0x2f, 0x0c, 0x04, 0x8f, 0xb8, 0xf1, 0x65,
+	0x28, 0xa5, 0x56, 0xb6, 0xfe, 0x2f, 0x5d, 0xcc, 0x23, 0x60, 0xe5, 0xb9, 0x01, 0xc0, 0xf1, 0x0d,
+	0x86, 0xa0, 0x08, 0x75, 0x31, 0x23, 0x9c, 0x1e, 0x4c, 0x59, 0xcf, 0x05, 0x13, 0xce, 0x6d, 0xc9,
+	0xc2, 0xd1, 0xa5, 0xac, 0xd5, 0x35, 0x06, 0x55, 0xae, 0xe6, 0x86, 0xc6, 0xe3, 0x14, 0x54, 0x7e,
+	0x2e, 0x8a, 0x8f, 0x69, 0xc9, 0xfd, 0xf0, 0x7a, 0x2e, 0x58, 0x7c, 0x2c, 0x8b, 0x6b, 0x34, 0x6b,
+	0xa9, 0x47, 0xd9, 0x3c, 0x7b, 0xf0, 0xec, 0x6a, 0xcd, 0x98, 0xdc, 0x84, 0xb9, 0x64, 0xad, 0x46,
+	0x15, 0x9f, 0x69, 0xa2, 0x18, 0xe5, 0x72, 0x7f, 0x4c, 0xfc, 0xc8, 0x17, 0x2f, 0xad, 0xac, 0x88,
+	0x9b, 0xf7, 0x10, 0xca, 0x5a, 0x3f, 0x44, 0x62, 0x1a, 0x14, 0x56, 0x43, 0xc4, 0x47, 0x2f, 0x01,
+	0x52, 0xb9, 0x92, 0x17, 0xd9, 0x33, 0xbb, 0xf5, 0x99, 0xf7, 0x3f, 0x2a, 0x4b, 0x1f, 0x7c, 0x54,
+	0x96, 0xfe, 0xfa, 0x51, 0x59, 0x7a, 0xef, 0x61, 0x79, 0xec, 0x83, 0x87, 0xe5, 0xb1, 0x3f, 0x3c,
+	0x2c, 0x8f, 0xdd, 0x5b, 0xef, 0x57, 0x57, 0xe1, 0xff, 0x1b, 0x95, 0xd5, 0x3b, 0x76, 0x27, 0xbd,
+	0xff, 0x11, 0xfa, 0xdc, 0xbf, 0x07, 0x00, 0xc4, 0xd9, 0xa2, 0x52, 0xac, 0x3a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error)
+	AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error)
+	AddMarginBatch(ctx context.Context, in *MsgAddMarginBatch, opts ...grpc.CallOption) (*MsgAddMarginBatchResponse, error)
+	MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error)
+	MarketOrder(ctx context.Context, in *MsgMarketOrder, opts ...grpc.CallOption) (*MsgMarketOrderResponse, error)
+	ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error)
+	PartialClose(ctx context.Context, in *MsgPartialClose, opts ...grpc.CallOption) (*MsgPartialCloseResponse, error)
+	SettlePosition(ctx context.Context, in *MsgSettlePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error)
+	DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error)
+	// ChangeCollateralDenom: Updates the collateral denom. A denom is valid if it
+	// is possible to make an sdk.Coin using it. [SUDO] Only callable by sudoers.
+	ChangeCollateralDenom(ctx context.Context, in *MsgChangeCollateralDenom, opts ...grpc.CallOption) (*MsgChangeCollateralDenomResponse, error)
+	AllocateEpochRebates(ctx context.Context, in *MsgAllocateEpochRebates, opts ...grpc.CallOption) (*MsgAllocateEpochRebatesResponse, error)
+	WithdrawEpochRebates(ctx context.Context, in *MsgWithdrawEpochRebates, opts ...grpc.CallOption) (*MsgWithdrawEpochRebatesResponse, error)
+	// ShiftPegMultiplier: gRPC tx msg for changing a market's peg multiplier.
+	// [SUDO] Only callable by sudoers.
+	ShiftPegMultiplier(ctx context.Context, in *MsgShiftPegMultiplier, opts ...grpc.CallOption) (*MsgShiftPegMultiplierResponse, error)
+	// ShiftSwapInvariant: gRPC tx msg for changing a market's swap invariant.
+	// [SUDO] Only callable by sudoers.
+	ShiftSwapInvariant(ctx context.Context, in *MsgShiftSwapInvariant, opts ...grpc.CallOption) (*MsgShiftSwapInvariantResponse, error)
+	// WithdrawFromPerpFund: gRPC tx msg to withdraw from the perp fund module
+	// account. [SUDO] Only callable by sudoers.
+	WithdrawFromPerpFund(ctx context.Context, in *MsgWithdrawFromPerpFund, opts ...grpc.CallOption) (*MsgWithdrawFromPerpFundResponse, error)
+	// CloseMarket: gRPC tx msg for closing a market.
+	// [Admin] Only callable by sudoers.
+	CloseMarket(ctx context.Context, in *MsgCloseMarket, opts ...grpc.CallOption) (*MsgCloseMarketResponse, error)
+	// ChangeMinSnapshotIntervalMs: gRPC tx msg for changing the minimum time
+	// between two reserve snapshots of a pair. [SUDO] Only callable by sudoers.
+	ChangeMinSnapshotIntervalMs(ctx context.Context, in *MsgChangeMinSnapshotIntervalMs, opts ...grpc.CallOption) (*MsgChangeMinSnapshotIntervalMsResponse, error)
+	// ChangeSnapshotRetentionMs: gRPC tx msg for changing how long reserve
+	// snapshots are kept before being pruned. [SUDO] Only callable by sudoers.
+	ChangeSnapshotRetentionMs(ctx context.Context, in *MsgChangeSnapshotRetentionMs, opts ...grpc.CallOption) (*MsgChangeSnapshotRetentionMsResponse, error)
+	// ChangeLiquidationTwapLookbackMs: gRPC tx msg for changing the TWAP window
+	// used to value positions for liquidation. [SUDO] Only callable by sudoers.
+	ChangeLiquidationTwapLookbackMs(ctx context.Context, in *MsgChangeLiquidationTwapLookbackMs, opts ...grpc.CallOption) (*MsgChangeLiquidationTwapLookbackMsResponse, error)
+	// ChangeLiquidatorRewardRatio: gRPC tx msg for changing the share of the
+	// liquidation fee paid to liquidators. [SUDO] Only callable by sudoers.
+	ChangeLiquidatorRewardRatio(ctx context.Context, in *MsgChangeLiquidatorRewardRatio, opts ...grpc.CallOption) (*MsgChangeLiquidatorRewardRatioResponse, error)
+	// ChangeFundingRateIntervalMs: gRPC tx msg for changing the interval at
+	// which funding is settled. [SUDO] Only callable by sudoers.
+	ChangeFundingRateIntervalMs(ctx context.Context, in *MsgChangeFundingRateIntervalMs, opts ...grpc.CallOption) (*MsgChangeFundingRateIntervalMsResponse, error)
+	// ChangeMaxPairsPerBlock: gRPC tx msg for changing the maximum number of
+	// pairs the EndBlocker processes per block. [SUDO] Only callable by sudoers.
+	ChangeMaxPairsPerBlock(ctx context.Context, in *MsgChangeMaxPairsPerBlock, opts ...grpc.CallOption) (*MsgChangeMaxPairsPerBlockResponse, error)
+	// ChangeImbalanceFeeRatio: gRPC tx msg for changing the fee ratio charged on
+	// the open interest imbalance of a market. [SUDO] Only callable by sudoers.
+	ChangeImbalanceFeeRatio(ctx context.Context, in *MsgChangeImbalanceFeeRatio, opts ...grpc.CallOption) (*MsgChangeImbalanceFeeRatioResponse, error)
+	// ChangeOpenInterestCap: gRPC tx msg for changing the max notional open
+	// interest of each side of a market. [SUDO] Only callable by sudoers.
+	ChangeOpenInterestCap(ctx context.Context, in *MsgChangeOpenInterestCap, opts ...grpc.CallOption) (*MsgChangeOpenInterestCapResponse, error)
+	// ChangeFluctuationLimitRatio: gRPC tx msg for changing the max move of the
+	// mark price a swap may cause. [SUDO] Only callable by sudoers.
+	ChangeFluctuationLimitRatio(ctx context.Context, in *MsgChangeFluctuationLimitRatio, opts ...grpc.CallOption) (*MsgChangeFluctuationLimitRatioResponse, error)
+	// ChangeTradeLimitRatio: gRPC tx msg for changing the max share of a reserve
+	// a single swap may trade. [SUDO] Only callable by sudoers.
+	ChangeTradeLimitRatio(ctx context.Context, in *MsgChangeTradeLimitRatio, opts ...grpc.CallOption) (*MsgChangeTradeLimitRatioResponse, error)
+	// ChangeSideTradeLimitRatios: gRPC tx msg for changing the trade limit
+	// ratios of long and short swaps of a market. [SUDO] Only callable by sudoers.
+	ChangeSideTradeLimitRatios(ctx context.Context, in *MsgChangeSideTradeLimitRatios, opts ...grpc.CallOption) (*MsgChangeSideTradeLimitRatiosResponse, error)
+	// ChangeCloseAtOracle: gRPC tx msg for setting whether positions of a market
+	// are closed at the oracle price. [SUDO] Only callable by sudoers.
+	ChangeCloseAtOracle(ctx context.Context, in *MsgChangeCloseAtOracle, opts ...grpc.CallOption) (*MsgChangeCloseAtOracleResponse, error)
+	// ChangeMaxOracleSpreadRatio: gRPC tx msg for changing the max spread of
+	// the oracle price from the mark price of a market. [SUDO] Only callable by
+	// sudoers.
+	ChangeMaxOracleSpreadRatio(ctx context.Context, in *MsgChangeMaxOracleSpreadRatio, opts ...grpc.CallOption) (*MsgChangeMaxOracleSpreadRatioResponse, error)
+	// ChangeSpreadLimitedSwaps: gRPC tx msg for setting whether swaps of a
+	// market are rejected while its mark price is over its oracle spread.
+	// [SUDO] Only callable by sudoers.
+	ChangeSpreadLimitedSwaps(ctx context.Context, in *MsgChangeSpreadLimitedSwaps, opts ...grpc.CallOption) (*MsgChangeSpreadLimitedSwapsResponse, error)
+	// ChangeMarketPaused: gRPC tx msg for halting or resuming trading on a
+	// market. [SUDO] Only callable by sudoers.
+	ChangeMarketPaused(ctx context.Context, in *MsgChangeMarketPaused, opts ...grpc.CallOption) (*MsgChangeMarketPausedResponse, error)
+	// ChangeMinPositionQuote: gRPC tx msg for changing the minimum notional a
+	// partially closed position may keep. [SUDO] Only callable by sudoers.
+	ChangeMinPositionQuote(ctx context.Context, in *MsgChangeMinPositionQuote, opts ...grpc.CallOption) (*MsgChangeMinPositionQuoteResponse, error)
+	// AddCollateralDenom: gRPC tx msg for whitelisting a secondary collateral
+	// denom. [SUDO] Only callable by sudoers.
+	AddCollateralDenom(ctx context.Context, in *MsgAddCollateralDenom, opts ...grpc.CallOption) (*MsgAddCollateralDenomResponse, error)
+	// RemoveCollateralDenom: gRPC tx msg for removing a secondary collateral
+	// denom from the whitelist. [SUDO] Only callable by sudoers.
+	RemoveCollateralDenom(ctx context.Context, in *MsgRemoveCollateralDenom, opts ...grpc.CallOption) (*MsgRemoveCollateralDenomResponse, error)
+	// SetFundingTopUp: gRPC tx msg for opting a position in or out of funding
+	// top-ups.
+	SetFundingTopUp(ctx context.Context, in *MsgSetFundingTopUp, opts ...grpc.CallOption) (*MsgSetFundingTopUpResponse, error)
+	// SetMarginMode: gRPC tx msg for switching a position between isolated and
+	// cross margin.
+	SetMarginMode(ctx context.Context, in *MsgSetMarginMode, opts ...grpc.CallOption) (*MsgSetMarginModeResponse, error)
+	// ClampMarkToOracleBand: gRPC tx msg for moving the mark price of a market
+	// back toward the band of its max oracle spread ratio around the oracle
+	// price. [SUDO] Only callable by sudoers.
+	ClampMarkToOracleBand(ctx context.Context, in *MsgClampMarkToOracleBand, opts ...grpc.CallOption) (*MsgClampMarkToOracleBandResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error) {
+	out := new(MsgRemoveMarginResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/RemoveMargin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCloseMarket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error) {
+	out := new(MsgAddMarginResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/AddMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCloseMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AddMarginBatch(ctx context.Context, in *MsgAddMarginBatch, opts ...grpc.CallOption) (*MsgAddMarginBatchResponse, error) {
+	out := new(MsgAddMarginBatchResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/AddMarginBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCloseMarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error) {
+	out := new(MsgMultiLiquidateResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/MultiLiquidate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCloseMarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketOrder(ctx context.Context, in *MsgMarketOrder, opts ...grpc.CallOption) (*MsgMarketOrderResponse, error) {
+	out := new(MsgMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/MarketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCloseMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error) {
+	out := new(MsgClosePositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ClosePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) PartialClose(ctx context.Context, in *MsgPartialClose, opts ...grpc.CallOption) (*MsgPartialCloseResponse, error) {
+	out := new(MsgPartialCloseResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/PartialClose", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgSettlePosition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
+
+func (c *msgClient) SettlePosition(ctx context.Context, in *MsgSettlePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error) {
+	out := new(MsgClosePositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/SettlePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveMargin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error) {
+	out := new(MsgDonateToEcosystemFundResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/DonateToEcosystemFund", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveMarginResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MarginOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) ChangeCollateralDenom(ctx context.Context, in *MsgChangeCollateralDenom, opts ...grpc.CallOption) (*MsgChangeCollateralDenomResponse, error) {
+	out := new(MsgChangeCollateralDenomResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeCollateralDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAddMargin) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AllocateEpochRebates(ctx context.Context, in *MsgAllocateEpochRebates, opts ...grpc.CallOption) (*MsgAllocateEpochRebatesResponse, error) {
+	out := new(MsgAllocateEpochRebatesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/AllocateEpochRebates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgAddMarginResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) WithdrawEpochRebates(ctx context.Context, in *MsgWithdrawEpochRebates, opts ...grpc.CallOption) (*MsgWithdrawEpochRebatesResponse, error) {
+	out := new(MsgWithdrawEpochRebatesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/WithdrawEpochRebates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiLiquidate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) ShiftPegMultiplier(ctx context.Context, in *MsgShiftPegMultiplier, opts ...grpc.CallOption) (*MsgShiftPegMultiplierResponse, error) {
+	out := new(MsgShiftPegMultiplierResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ShiftPegMultiplier", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Liquidations) > 0 {
-		for _, e := range m.Liquidations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) ShiftSwapInvariant(ctx context.Context, in *MsgShiftSwapInvariant, opts ...grpc.CallOption) (*MsgShiftSwapInvariantResponse, error) {
+	out := new(MsgShiftSwapInvariantResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ShiftSwapInvariant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiLiquidate_Liquidation) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) WithdrawFromPerpFund(ctx context.Context, in *MsgWithdrawFromPerpFund, opts ...grpc.CallOption) (*MsgWithdrawFromPerpFundResponse, error) {
+	out := new(MsgWithdrawFromPerpFundResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/WithdrawFromPerpFund", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CloseMarket(ctx context.Context, in *MsgCloseMarket, opts ...grpc.CallOption) (*MsgCloseMarketResponse, error) {
+	out := new(MsgCloseMarketResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/CloseMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiLiquidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ChangeMinSnapshotIntervalMs(ctx context.Context, in *MsgChangeMinSnapshotIntervalMs, opts ...grpc.CallOption) (*MsgChangeMinSnapshotIntervalMsResponse, error) {
+	out := new(MsgChangeMinSnapshotIntervalMsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeMinSnapshotIntervalMs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Liquidations) > 0 {
-		for _, e := range m.Liquidations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) ChangeSnapshotRetentionMs(ctx context.Context, in *MsgChangeSnapshotRetentionMs, opts ...grpc.CallOption) (*MsgChangeSnapshotRetentionMsResponse, error) {
+	out := new(MsgChangeSnapshotRetentionMsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeSnapshotRetentionMs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ChangeLiquidationTwapLookbackMs(ctx context.Context, in *MsgChangeLiquidationTwapLookbackMs, opts ...grpc.CallOption) (*MsgChangeLiquidationTwapLookbackMsResponse, error) {
+	out := new(MsgChangeLiquidationTwapLookbackMsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeLiquidationTwapLookbackMs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	return out, nil
+}
+
+func (c *msgClient) ChangeLiquidatorRewardRatio(ctx context.Context, in *MsgChangeLiquidatorRewardRatio, opts ...grpc.CallOption) (*MsgChangeLiquidatorRewardRatioResponse, error) {
+	out := new(MsgChangeLiquidatorRewardRatioResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeLiquidatorRewardRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ChangeFundingRateIntervalMs(ctx context.Context, in *MsgChangeFundingRateIntervalMs, opts ...grpc.CallOption) (*MsgChangeFundingRateIntervalMsResponse, error) {
+	out := new(MsgChangeFundingRateIntervalMsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeFundingRateIntervalMs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.LiquidatorFee != nil {
-		l = m.LiquidatorFee.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ChangeMaxPairsPerBlock(ctx context.Context, in *MsgChangeMaxPairsPerBlock, opts ...grpc.CallOption) (*MsgChangeMaxPairsPerBlockResponse, error) {
+	out := new(MsgChangeMaxPairsPerBlockResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeMaxPairsPerBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PerpEfFee != nil {
-		l = m.PerpEfFee.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ChangeImbalanceFeeRatio(ctx context.Context, in *MsgChangeImbalanceFeeRatio, opts ...grpc.CallOption) (*MsgChangeImbalanceFeeRatioResponse, error) {
+	out := new(MsgChangeImbalanceFeeRatioResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeImbalanceFeeRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ChangeOpenInterestCap(ctx context.Context, in *MsgChangeOpenInterestCap, opts ...grpc.CallOption) (*MsgChangeOpenInterestCapResponse, error) {
+	out := new(MsgChangeOpenInterestCapResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeOpenInterestCap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ChangeFluctuationLimitRatio(ctx context.Context, in *MsgChangeFluctuationLimitRatio, opts ...grpc.CallOption) (*MsgChangeFluctuationLimitRatioResponse, error) {
+	out := new(MsgChangeFluctuationLimitRatioResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeFluctuationLimitRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ChangeTradeLimitRatio(ctx context.Context, in *MsgChangeTradeLimitRatio, opts ...grpc.CallOption) (*MsgChangeTradeLimitRatioResponse, error) {
+	out := new(MsgChangeTradeLimitRatioResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeTradeLimitRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Side != 0 {
-		n += 1 + sovTx(uint64(m.Side))
+	return out, nil
+}
+
+func (c *msgClient) ChangeSideTradeLimitRatios(ctx context.Context, in *MsgChangeSideTradeLimitRatios, opts ...grpc.CallOption) (*MsgChangeSideTradeLimitRatiosResponse, error) {
+	out := new(MsgChangeSideTradeLimitRatiosResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeSideTradeLimitRatios", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.QuoteAssetAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Leverage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.BaseAssetAmountLimit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgMarketOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ChangeCloseAtOracle(ctx context.Context, in *MsgChangeCloseAtOracle, opts ...grpc.CallOption) (*MsgChangeCloseAtOracleResponse, error) {
+	out := new(MsgChangeCloseAtOracleResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeCloseAtOracle", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ChangeMaxOracleSpreadRatio(ctx context.Context, in *MsgChangeMaxOracleSpreadRatio, opts ...grpc.CallOption) (*MsgChangeMaxOracleSpreadRatioResponse, error) {
+	out := new(MsgChangeMaxOracleSpreadRatioResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeMaxOracleSpreadRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.ExchangedNotionalValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.UnrealizedPnlAfter.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MarginToVault.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgClosePosition) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ChangeSpreadLimitedSwaps(ctx context.Context, in *MsgChangeSpreadLimitedSwaps, opts ...grpc.CallOption) (*MsgChangeSpreadLimitedSwapsResponse, error) {
+	out := new(MsgChangeSpreadLimitedSwapsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeSpreadLimitedSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ChangeMarketPaused(ctx context.Context, in *MsgChangeMarketPaused, opts ...grpc.CallOption) (*MsgChangeMarketPausedResponse, error) {
+	out := new(MsgChangeMarketPausedResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeMarketPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgClosePositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ChangeMinPositionQuote(ctx context.Context, in *MsgChangeMinPositionQuote, opts ...grpc.CallOption) (*MsgChangeMinPositionQuoteResponse, error) {
+	out := new(MsgChangeMinPositionQuoteResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ChangeMinPositionQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ExchangedNotionalValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MarginToTrader.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgPartialClose) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AddCollateralDenom(ctx context.Context, in *MsgAddCollateralDenom, opts ...grpc.CallOption) (*MsgAddCollateralDenomResponse, error) {
+	out := new(MsgAddCollateralDenomResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/AddCollateralDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Size_.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgPartialCloseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveCollateralDenom(ctx context.Context, in *MsgRemoveCollateralDenom, opts ...grpc.CallOption) (*MsgRemoveCollateralDenomResponse, error) {
+	out := new(MsgRemoveCollateralDenomResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/RemoveCollateralDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ExchangedNotionalValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MarginToTrader.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgDonateToEcosystemFund) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SetFundingTopUp(ctx context.Context, in *MsgSetFundingTopUp, opts ...grpc.CallOption) (*MsgSetFundingTopUpResponse, error) {
+	out := new(MsgSetFundingTopUpResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/SetFundingTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Donation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgDonateToEcosystemFundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetMarginMode(ctx context.Context, in *MsgSetMarginMode, opts ...grpc.CallOption) (*MsgSetMarginModeResponse, error) {
+	out := new(MsgSetMarginModeResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/SetMarginMode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgChangeCollateralDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) ClampMarkToOracleBand(ctx context.Context, in *MsgClampMarkToOracleBand, opts ...grpc.CallOption) (*MsgClampMarkToOracleBandResponse, error) {
+	out := new(MsgClampMarkToOracleBandResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ClampMarkToOracleBand", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgChangeCollateralDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RemoveMargin(context.Context, *MsgRemoveMargin) (*MsgRemoveMarginResponse, error)
+	AddMargin(context.Context, *MsgAddMargin) (*MsgAddMarginResponse, error)
+	AddMarginBatch(context.Context, *MsgAddMarginBatch) (*MsgAddMarginBatchResponse, error)
+	MultiLiquidate(context.Context, *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error)
+	MarketOrder(context.Context, *MsgMarketOrder) (*MsgMarketOrderResponse, error)
+	ClosePosition(context.Context, *MsgClosePosition) (*MsgClosePositionResponse, error)
+	PartialClose(context.Context, *MsgPartialClose) (*MsgPartialCloseResponse, error)
+	SettlePosition(context.Context, *MsgSettlePosition) (*MsgClosePositionResponse, error)
+	DonateToEcosystemFund(context.Context, *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error)
+	// ChangeCollateralDenom: Updates the collateral denom. A denom is valid if it
+	// is possible to make an sdk.Coin using it. [SUDO] Only callable by sudoers.
+	ChangeCollateralDenom(context.Context, *MsgChangeCollateralDenom) (*MsgChangeCollateralDenomResponse, error)
+	AllocateEpochRebates(context.Context, *MsgAllocateEpochRebates) (*MsgAllocateEpochRebatesResponse, error)
+	WithdrawEpochRebates(context.Context, *MsgWithdrawEpochRebates) (*MsgWithdrawEpochRebatesResponse, error)
+	// ShiftPegMultiplier: gRPC tx msg for changing a market's peg multiplier.
+	// [SUDO] Only callable by sudoers.
+	ShiftPegMultiplier(context.Context, *MsgShiftPegMultiplier) (*MsgShiftPegMultiplierResponse, error)
+	// ShiftSwapInvariant: gRPC tx msg for changing a market's swap invariant.
+	// [SUDO] Only callable by sudoers.
+	ShiftSwapInvariant(context.Context, *MsgShiftSwapInvariant) (*MsgShiftSwapInvariantResponse, error)
+	// WithdrawFromPerpFund: gRPC tx msg to withdraw from the perp fund module
+	// account. [SUDO] Only callable by sudoers.
+	WithdrawFromPerpFund(context.Context, *MsgWithdrawFromPerpFund) (*MsgWithdrawFromPerpFundResponse, error)
+	// CloseMarket: gRPC tx msg for closing a market.
+	// [Admin] Only callable by sudoers.
+	CloseMarket(context.Context, *MsgCloseMarket) (*MsgCloseMarketResponse, error)
+	// ChangeMinSnapshotIntervalMs: gRPC tx msg for changing the minimum time
+	// between two reserve snapshots of a pair. [SUDO] Only callable by sudoers.
+	ChangeMinSnapshotIntervalMs(context.Context, *MsgChangeMinSnapshotIntervalMs) (*MsgChangeMinSnapshotIntervalMsResponse, error)
+	// ChangeSnapshotRetentionMs: gRPC tx msg for changing how long reserve
+	// snapshots are kept before being pruned. [SUDO] Only callable by sudoers.
+	ChangeSnapshotRetentionMs(context.Context, *MsgChangeSnapshotRetentionMs) (*MsgChangeSnapshotRetentionMsResponse, error)
+	// ChangeLiquidationTwapLookbackMs: gRPC tx msg for changing the TWAP window
+	// used to value positions for liquidation. [SUDO] Only callable by sudoers.
+	ChangeLiquidationTwapLookbackMs(context.Context, *MsgChangeLiquidationTwapLookbackMs) (*MsgChangeLiquidationTwapLookbackMsResponse, error)
+	// ChangeLiquidatorRewardRatio: gRPC tx msg for changing the share of the
+	// liquidation fee paid to liquidators. [SUDO] Only callable by sudoers.
+	ChangeLiquidatorRewardRatio(context.Context, *MsgChangeLiquidatorRewardRatio) (*MsgChangeLiquidatorRewardRatioResponse, error)
+	// ChangeFundingRateIntervalMs: gRPC tx msg for changing the interval at
+	// which funding is settled. [SUDO] Only callable by sudoers.
+	ChangeFundingRateIntervalMs(context.Context, *MsgChangeFundingRateIntervalMs) (*MsgChangeFundingRateIntervalMsResponse, error)
+	// ChangeMaxPairsPerBlock: gRPC tx msg for changing the maximum number of
+	// pairs the EndBlocker processes per block. [SUDO] Only callable by sudoers.
+	ChangeMaxPairsPerBlock(context.Context, *MsgChangeMaxPairsPerBlock) (*MsgChangeMaxPairsPerBlockResponse, error)
+	// ChangeImbalanceFeeRatio: gRPC tx msg for changing the fee ratio charged on
+	// the open interest imbalance of a market. [SUDO] Only callable by sudoers.
+	ChangeImbalanceFeeRatio(context.Context, *MsgChangeImbalanceFeeRatio) (*MsgChangeImbalanceFeeRatioResponse, error)
+	// ChangeOpenInterestCap: gRPC tx msg for changing the max notional open
+	// interest of each side of a market. [SUDO] Only callable by sudoers.
+	ChangeOpenInterestCap(context.Context, *MsgChangeOpenInterestCap) (*MsgChangeOpenInterestCapResponse, error)
+	// ChangeFluctuationLimitRatio: gRPC tx msg for changing the max move of the
+	// mark price a swap may cause. [SUDO] Only callable by sudoers.
+	ChangeFluctuationLimitRatio(context.Context, *MsgChangeFluctuationLimitRatio) (*MsgChangeFluctuationLimitRatioResponse, error)
+	// ChangeTradeLimitRatio: gRPC tx msg for changing the max share of a reserve
+	// a single swap may trade. [SUDO] Only callable by sudoers.
+	ChangeTradeLimitRatio(context.Context, *MsgChangeTradeLimitRatio) (*MsgChangeTradeLimitRatioResponse, error)
+	// ChangeSideTradeLimitRatios: gRPC tx msg for changing the trade limit
+	// ratios of long and short swaps of a market. [SUDO] Only callable by sudoers.
+	ChangeSideTradeLimitRatios(context.Context, *MsgChangeSideTradeLimitRatios) (*MsgChangeSideTradeLimitRatiosResponse, error)
+	// ChangeCloseAtOracle: gRPC tx msg for setting whether positions of a market
+	// are closed at the oracle price. [SUDO] Only callable by sudoers.
+	ChangeCloseAtOracle(context.Context, *MsgChangeCloseAtOracle) (*MsgChangeCloseAtOracleResponse, error)
+	// ChangeMaxOracleSpreadRatio: gRPC tx msg for changing the max spread of
+	// the oracle price from the mark price of a market. [SUDO] Only callable by
+	// sudoers.
+	ChangeMaxOracleSpreadRatio(context.Context, *MsgChangeMaxOracleSpreadRatio) (*MsgChangeMaxOracleSpreadRatioResponse, error)
+	// ChangeSpreadLimitedSwaps: gRPC tx msg for setting whether swaps of a
+	// market are rejected while its mark price is over its oracle spread.
+	// [SUDO] Only callable by sudoers.
+	ChangeSpreadLimitedSwaps(context.Context, *MsgChangeSpreadLimitedSwaps) (*MsgChangeSpreadLimitedSwapsResponse, error)
+	// ChangeMarketPaused: gRPC tx msg for halting or resuming trading on a
+	// market. [SUDO] Only callable by sudoers.
+	ChangeMarketPaused(context.Context, *MsgChangeMarketPaused) (*MsgChangeMarketPausedResponse, error)
+	// ChangeMinPositionQuote: gRPC tx msg for changing the minimum notional a
+	// partially closed position may keep. [SUDO] Only callable by sudoers.
+	ChangeMinPositionQuote(context.Context, *MsgChangeMinPositionQuote) (*MsgChangeMinPositionQuoteResponse, error)
+	// AddCollateralDenom: gRPC tx msg for whitelisting a secondary collateral
+	// denom. [SUDO] Only callable by sudoers.
+	AddCollateralDenom(context.Context, *MsgAddCollateralDenom) (*MsgAddCollateralDenomResponse, error)
+	// RemoveCollateralDenom: gRPC tx msg for removing a secondary collateral
+	// denom from the whitelist. [SUDO] Only callable by sudoers.
+	RemoveCollateralDenom(context.Context, *MsgRemoveCollateralDenom) (*MsgRemoveCollateralDenomResponse, error)
+	// SetFundingTopUp: gRPC tx msg for opting a position in or out of funding
+	// top-ups.
+	SetFundingTopUp(context.Context, *MsgSetFundingTopUp) (*MsgSetFundingTopUpResponse, error)
+	// SetMarginMode: gRPC tx msg for switching a position between isolated and
+	// cross margin.
+	SetMarginMode(context.Context, *MsgSetMarginMode) (*MsgSetMarginModeResponse, error)
+	// ClampMarkToOracleBand: gRPC tx msg for moving the mark price of a market
+	// back toward the band of its max oracle spread ratio around the oracle
+	// price. [SUDO] Only callable by sudoers.
+	ClampMarkToOracleBand(context.Context, *MsgClampMarkToOracleBand) (*MsgClampMarkToOracleBandResponse, error)
 }
 
-func (m *MsgAllocateEpochRebates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Rebates) > 0 {
-		for _, e := range m.Rebates {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgAllocateEpochRebatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedMsgServer) RemoveMargin(ctx context.Context, req *MsgRemoveMargin) (*MsgRemoveMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMargin not implemented")
+}
+func (*UnimplementedMsgServer) AddMargin(ctx context.Context, req *MsgAddMargin) (*MsgAddMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMargin not implemented")
+}
+func (*UnimplementedMsgServer) AddMarginBatch(ctx context.Context, req *MsgAddMarginBatch) (*MsgAddMarginBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMarginBatch not implemented")
+}
+func (*UnimplementedMsgServer) MultiLiquidate(ctx context.Context, req *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiLiquidate not implemented")
+}
+func (*UnimplementedMsgServer) MarketOrder(ctx context.Context, req *MsgMarketOrder) (*MsgMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketOrder not implemented")
+}
+func (*UnimplementedMsgServer) ClosePosition(ctx context.Context, req *MsgClosePosition) (*MsgClosePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
+}
+func (*UnimplementedMsgServer) PartialClose(ctx context.Context, req *MsgPartialClose) (*MsgPartialCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialClose not implemented")
+}
+func (*UnimplementedMsgServer) SettlePosition(ctx context.Context, req *MsgSettlePosition) (*MsgClosePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlePosition not implemented")
+}
+func (*UnimplementedMsgServer) DonateToEcosystemFund(ctx context.Context, req *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DonateToEcosystemFund not implemented")
+}
+func (*UnimplementedMsgServer) ChangeCollateralDenom(ctx context.Context, req *MsgChangeCollateralDenom) (*MsgChangeCollateralDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCollateralDenom not implemented")
+}
+func (*UnimplementedMsgServer) AllocateEpochRebates(ctx context.Context, req *MsgAllocateEpochRebates) (*MsgAllocateEpochRebatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateEpochRebates not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawEpochRebates(ctx context.Context, req *MsgWithdrawEpochRebates) (*MsgWithdrawEpochRebatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEpochRebates not implemented")
+}
+func (*UnimplementedMsgServer) ShiftPegMultiplier(ctx context.Context, req *MsgShiftPegMultiplier) (*MsgShiftPegMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShiftPegMultiplier not implemented")
+}
+func (*UnimplementedMsgServer) ShiftSwapInvariant(ctx context.Context, req *MsgShiftSwapInvariant) (*MsgShiftSwapInvariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShiftSwapInvariant not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFromPerpFund(ctx context.Context, req *MsgWithdrawFromPerpFund) (*MsgWithdrawFromPerpFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromPerpFund not implemented")
+}
+func (*UnimplementedMsgServer) CloseMarket(ctx context.Context, req *MsgCloseMarket) (*MsgCloseMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseMarket not implemented")
+}
+func (*UnimplementedMsgServer) ChangeMinSnapshotIntervalMs(ctx context.Context, req *MsgChangeMinSnapshotIntervalMs) (*MsgChangeMinSnapshotIntervalMsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMinSnapshotIntervalMs not implemented")
+}
+func (*UnimplementedMsgServer) ChangeSnapshotRetentionMs(ctx context.Context, req *MsgChangeSnapshotRetentionMs) (*MsgChangeSnapshotRetentionMsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSnapshotRetentionMs not implemented")
+}
+func (*UnimplementedMsgServer) ChangeLiquidationTwapLookbackMs(ctx context.Context, req *MsgChangeLiquidationTwapLookbackMs) (*MsgChangeLiquidationTwapLookbackMsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeLiquidationTwapLookbackMs not implemented")
+}
+func (*UnimplementedMsgServer) ChangeLiquidatorRewardRatio(ctx context.Context, req *MsgChangeLiquidatorRewardRatio) (*MsgChangeLiquidatorRewardRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeLiquidatorRewardRatio not implemented")
+}
+func (*UnimplementedMsgServer) ChangeFundingRateIntervalMs(ctx context.Context, req *MsgChangeFundingRateIntervalMs) (*MsgChangeFundingRateIntervalMsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeFundingRateIntervalMs not implemented")
+}
+func (*UnimplementedMsgServer) ChangeMaxPairsPerBlock(ctx context.Context, req *MsgChangeMaxPairsPerBlock) (*MsgChangeMaxPairsPerBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMaxPairsPerBlock not implemented")
+}
+func (*UnimplementedMsgServer) ChangeImbalanceFeeRatio(ctx context.Context, req *MsgChangeImbalanceFeeRatio) (*MsgChangeImbalanceFeeRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeImbalanceFeeRatio not implemented")
+}
+func (*UnimplementedMsgServer) ChangeOpenInterestCap(ctx context.Context, req *MsgChangeOpenInterestCap) (*MsgChangeOpenInterestCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeOpenInterestCap not implemented")
+}
+func (*UnimplementedMsgServer) ChangeFluctuationLimitRatio(ctx context.Context, req *MsgChangeFluctuationLimitRatio) (*MsgChangeFluctuationLimitRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeFluctuationLimitRatio not implemented")
+}
+func (*UnimplementedMsgServer) ChangeTradeLimitRatio(ctx context.Context, req *MsgChangeTradeLimitRatio) (*MsgChangeTradeLimitRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeTradeLimitRatio not implemented")
+}
+func (*UnimplementedMsgServer) ChangeSideTradeLimitRatios(ctx context.Context, req *MsgChangeSideTradeLimitRatios) (*MsgChangeSideTradeLimitRatiosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSideTradeLimitRatios not implemented")
+}
+func (*UnimplementedMsgServer) ChangeCloseAtOracle(ctx context.Context, req *MsgChangeCloseAtOracle) (*MsgChangeCloseAtOracleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCloseAtOracle not implemented")
+}
+func (*UnimplementedMsgServer) ChangeMaxOracleSpreadRatio(ctx context.Context, req *MsgChangeMaxOracleSpreadRatio) (*MsgChangeMaxOracleSpreadRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMaxOracleSpreadRatio not implemented")
+}
+func (*UnimplementedMsgServer) ChangeSpreadLimitedSwaps(ctx context.Context, req *MsgChangeSpreadLimitedSwaps) (*MsgChangeSpreadLimitedSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSpreadLimitedSwaps not implemented")
+}
+func (*UnimplementedMsgServer) ChangeMarketPaused(ctx context.Context, req *MsgChangeMarketPaused) (*MsgChangeMarketPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMarketPaused not implemented")
+}
+func (*UnimplementedMsgServer) ChangeMinPositionQuote(ctx context.Context, req *MsgChangeMinPositionQuote) (*MsgChangeMinPositionQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMinPositionQuote not implemented")
+}
+func (*UnimplementedMsgServer) AddCollateralDenom(ctx context.Context, req *MsgAddCollateralDenom) (*MsgAddCollateralDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollateralDenom not implemented")
+}
+func (*UnimplementedMsgServer) RemoveCollateralDenom(ctx context.Context, req *MsgRemoveCollateralDenom) (*MsgRemoveCollateralDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollateralDenom not implemented")
+}
+func (*UnimplementedMsgServer) SetFundingTopUp(ctx context.Context, req *MsgSetFundingTopUp) (*MsgSetFundingTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFundingTopUp not implemented")
+}
+func (*UnimplementedMsgServer) SetMarginMode(ctx context.Context, req *MsgSetMarginMode) (*MsgSetMarginModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarginMode not implemented")
+}
+func (*UnimplementedMsgServer) ClampMarkToOracleBand(ctx context.Context, req *MsgClampMarkToOracleBand) (*MsgClampMarkToOracleBandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClampMarkToOracleBand not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RemoveMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMargin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TotalEpochRebates) > 0 {
-		for _, e := range m.TotalEpochRebates {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMargin(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/RemoveMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMargin(ctx, req.(*MsgRemoveMargin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawEpochRebates) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMargin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddMargin(ctx, in)
 	}
-	if len(m.Epochs) > 0 {
-		l = 0
-		for _, e := range m.Epochs {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/AddMargin",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMargin(ctx, req.(*MsgAddMargin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawEpochRebatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddMarginBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMarginBatch)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.WithdrawnRebates) > 0 {
-		for _, e := range m.WithdrawnRebates {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMarginBatch(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/AddMarginBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMarginBatch(ctx, req.(*MsgAddMarginBatch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgShiftPegMultiplier) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MultiLiquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiLiquidate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).MultiLiquidate(ctx, in)
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.NewPegMult.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/MultiLiquidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiLiquidate(ctx, req.(*MsgMultiLiquidate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgShiftPegMultiplierResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MarketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/MarketOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketOrder(ctx, req.(*MsgMarketOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgShiftSwapInvariant) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClosePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClosePosition)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ClosePosition(ctx, in)
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.NewSwapInvariant.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ClosePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClosePosition(ctx, req.(*MsgClosePosition))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgShiftSwapInvariantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PartialClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPartialClose)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).PartialClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/PartialClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PartialClose(ctx, req.(*MsgPartialClose))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFromPerpFund) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SettlePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettlePosition)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SettlePosition(ctx, in)
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/SettlePosition",
 	}
-	l = len(m.ToAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettlePosition(ctx, req.(*MsgSettlePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DonateToEcosystemFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDonateToEcosystemFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DonateToEcosystemFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/DonateToEcosystemFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DonateToEcosystemFund(ctx, req.(*MsgDonateToEcosystemFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeCollateralDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeCollateralDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeCollateralDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeCollateralDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeCollateralDenom(ctx, req.(*MsgChangeCollateralDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AllocateEpochRebates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllocateEpochRebates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AllocateEpochRebates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/AllocateEpochRebates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AllocateEpochRebates(ctx, req.(*MsgAllocateEpochRebates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawEpochRebates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawEpochRebates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawEpochRebates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/WithdrawEpochRebates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawEpochRebates(ctx, req.(*MsgWithdrawEpochRebates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ShiftPegMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgShiftPegMultiplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ShiftPegMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ShiftPegMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ShiftPegMultiplier(ctx, req.(*MsgShiftPegMultiplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ShiftSwapInvariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgShiftSwapInvariant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ShiftSwapInvariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ShiftSwapInvariant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ShiftSwapInvariant(ctx, req.(*MsgShiftSwapInvariant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromPerpFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromPerpFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromPerpFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/WithdrawFromPerpFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromPerpFund(ctx, req.(*MsgWithdrawFromPerpFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/CloseMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseMarket(ctx, req.(*MsgCloseMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeMinSnapshotIntervalMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeMinSnapshotIntervalMs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeMinSnapshotIntervalMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeMinSnapshotIntervalMs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeMinSnapshotIntervalMs(ctx, req.(*MsgChangeMinSnapshotIntervalMs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeSnapshotRetentionMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeSnapshotRetentionMs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeSnapshotRetentionMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeSnapshotRetentionMs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeSnapshotRetentionMs(ctx, req.(*MsgChangeSnapshotRetentionMs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeLiquidationTwapLookbackMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeLiquidationTwapLookbackMs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeLiquidationTwapLookbackMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeLiquidationTwapLookbackMs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeLiquidationTwapLookbackMs(ctx, req.(*MsgChangeLiquidationTwapLookbackMs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeLiquidatorRewardRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeLiquidatorRewardRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeLiquidatorRewardRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeLiquidatorRewardRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeLiquidatorRewardRatio(ctx, req.(*MsgChangeLiquidatorRewardRatio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeFundingRateIntervalMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeFundingRateIntervalMs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeFundingRateIntervalMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeFundingRateIntervalMs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeFundingRateIntervalMs(ctx, req.(*MsgChangeFundingRateIntervalMs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeMaxPairsPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeMaxPairsPerBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeMaxPairsPerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeMaxPairsPerBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeMaxPairsPerBlock(ctx, req.(*MsgChangeMaxPairsPerBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeImbalanceFeeRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeImbalanceFeeRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeImbalanceFeeRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeImbalanceFeeRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeImbalanceFeeRatio(ctx, req.(*MsgChangeImbalanceFeeRatio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeOpenInterestCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeOpenInterestCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeOpenInterestCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeOpenInterestCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeOpenInterestCap(ctx, req.(*MsgChangeOpenInterestCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeFluctuationLimitRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeFluctuationLimitRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeFluctuationLimitRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeFluctuationLimitRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeFluctuationLimitRatio(ctx, req.(*MsgChangeFluctuationLimitRatio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeTradeLimitRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeTradeLimitRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeTradeLimitRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeTradeLimitRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeTradeLimitRatio(ctx, req.(*MsgChangeTradeLimitRatio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeSideTradeLimitRatios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeSideTradeLimitRatios)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeSideTradeLimitRatios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeSideTradeLimitRatios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeSideTradeLimitRatios(ctx, req.(*MsgChangeSideTradeLimitRatios))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeCloseAtOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeCloseAtOracle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeCloseAtOracle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeCloseAtOracle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeCloseAtOracle(ctx, req.(*MsgChangeCloseAtOracle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeMaxOracleSpreadRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeMaxOracleSpreadRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeMaxOracleSpreadRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeMaxOracleSpreadRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeMaxOracleSpreadRatio(ctx, req.(*MsgChangeMaxOracleSpreadRatio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeSpreadLimitedSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeSpreadLimitedSwaps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeSpreadLimitedSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeSpreadLimitedSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeSpreadLimitedSwaps(ctx, req.(*MsgChangeSpreadLimitedSwaps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeMarketPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeMarketPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeMarketPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeMarketPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeMarketPaused(ctx, req.(*MsgChangeMarketPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeMinPositionQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeMinPositionQuote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeMinPositionQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ChangeMinPositionQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeMinPositionQuote(ctx, req.(*MsgChangeMinPositionQuote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddCollateralDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCollateralDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCollateralDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/AddCollateralDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCollateralDenom(ctx, req.(*MsgAddCollateralDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveCollateralDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveCollateralDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveCollateralDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/RemoveCollateralDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveCollateralDenom(ctx, req.(*MsgRemoveCollateralDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFundingTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFundingTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFundingTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/SetFundingTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFundingTopUp(ctx, req.(*MsgSetFundingTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarginMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarginMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarginMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/SetMarginMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarginMode(ctx, req.(*MsgSetMarginMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClampMarkToOracleBand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClampMarkToOracleBand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClampMarkToOracleBand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ClampMarkToOracleBand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClampMarkToOracleBand(ctx, req.(*MsgClampMarkToOracleBand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.perp.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RemoveMargin",
+			Handler:    _Msg_RemoveMargin_Handler,
+		},
+		{
+			MethodName: "AddMargin",
+			Handler:    _Msg_AddMargin_Handler,
+		},
+		{
+			MethodName: "AddMarginBatch",
+			Handler:    _Msg_AddMarginBatch_Handler,
+		},
+		{
+			MethodName: "MultiLiquidate",
+			Handler:    _Msg_MultiLiquidate_Handler,
+		},
+		{
+			MethodName: "MarketOrder",
+			Handler:    _Msg_MarketOrder_Handler,
+		},
+		{
+			MethodName: "ClosePosition",
+			Handler:    _Msg_ClosePosition_Handler,
+		},
+		{
+			MethodName: "PartialClose",
+			Handler:    _Msg_PartialClose_Handler,
+		},
+		{
+			MethodName: "SettlePosition",
+			Handler:    _Msg_SettlePosition_Handler,
+		},
+		{
+			MethodName: "DonateToEcosystemFund",
+			Handler:    _Msg_DonateToEcosystemFund_Handler,
+		},
+		{
+			MethodName: "ChangeCollateralDenom",
+			Handler:    _Msg_ChangeCollateralDenom_Handler,
+		},
+		{
+			MethodName: "AllocateEpochRebates",
+			Handler:    _Msg_AllocateEpochRebates_Handler,
+		},
+		{
+			MethodName: "WithdrawEpochRebates",
+			Handler:    _Msg_WithdrawEpochRebates_Handler,
+		},
+		{
+			MethodName: "ShiftPegMultiplier",
+			Handler:    _Msg_ShiftPegMultiplier_Handler,
+		},
+		{
+			MethodName: "ShiftSwapInvariant",
+			Handler:    _Msg_ShiftSwapInvariant_Handler,
+		},
+		{
+			MethodName: "WithdrawFromPerpFund",
+			Handler:    _Msg_WithdrawFromPerpFund_Handler,
+		},
+		{
+			MethodName: "CloseMarket",
+			Handler:    _Msg_CloseMarket_Handler,
+		},
+		{
+			MethodName: "ChangeMinSnapshotIntervalMs",
+			Handler:    _Msg_ChangeMinSnapshotIntervalMs_Handler,
+		},
+		{
+			MethodName: "ChangeSnapshotRetentionMs",
+			Handler:    _Msg_ChangeSnapshotRetentionMs_Handler,
+		},
+		{
+			MethodName: "ChangeLiquidationTwapLookbackMs",
+			Handler:    _Msg_ChangeLiquidationTwapLookbackMs_Handler,
+		},
+		{
+			MethodName: "ChangeLiquidatorRewardRatio",
+			Handler:    _Msg_ChangeLiquidatorRewardRatio_Handler,
+		},
+		{
+			MethodName: "ChangeFundingRateIntervalMs",
+			Handler:    _Msg_ChangeFundingRateIntervalMs_Handler,
+		},
+		{
+			MethodName: "ChangeMaxPairsPerBlock",
+			Handler:    _Msg_ChangeMaxPairsPerBlock_Handler,
+		},
+		{
+			MethodName: "ChangeImbalanceFeeRatio",
+			Handler:    _Msg_ChangeImbalanceFeeRatio_Handler,
+		},
+		{
+			MethodName: "ChangeOpenInterestCap",
+			Handler:    _Msg_ChangeOpenInterestCap_Handler,
+		},
+		{
+			MethodName: "ChangeFluctuationLimitRatio",
+			Handler:    _Msg_ChangeFluctuationLimitRatio_Handler,
+		},
+		{
+			MethodName: "ChangeTradeLimitRatio",
+			Handler:    _Msg_ChangeTradeLimitRatio_Handler,
+		},
+		{
+			MethodName: "ChangeSideTradeLimitRatios",
+			Handler:    _Msg_ChangeSideTradeLimitRatios_Handler,
+		},
+		{
+			MethodName: "ChangeCloseAtOracle",
+			Handler:    _Msg_ChangeCloseAtOracle_Handler,
+		},
+		{
+			MethodName: "ChangeMaxOracleSpreadRatio",
+			Handler:    _Msg_ChangeMaxOracleSpreadRatio_Handler,
+		},
+		{
+			MethodName: "ChangeSpreadLimitedSwaps",
+			Handler:    _Msg_ChangeSpreadLimitedSwaps_Handler,
+		},
+		{
+			MethodName: "ChangeMarketPaused",
+			Handler:    _Msg_ChangeMarketPaused_Handler,
+		},
+		{
+			MethodName: "ChangeMinPositionQuote",
+			Handler:    _Msg_ChangeMinPositionQuote_Handler,
+		},
+		{
+			MethodName: "AddCollateralDenom",
+			Handler:    _Msg_AddCollateralDenom_Handler,
+		},
+		{
+			MethodName: "RemoveCollateralDenom",
+			Handler:    _Msg_RemoveCollateralDenom_Handler,
+		},
+		{
+			MethodName: "SetFundingTopUp",
+			Handler:    _Msg_SetFundingTopUp_Handler,
+		},
+		{
+			MethodName: "SetMarginMode",
+			Handler:    _Msg_SetMarginMode_Handler,
+		},
+		{
+			MethodName: "ClampMarkToOracleBand",
+			Handler:    _Msg_ClampMarkToOracleBand_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nibiru/perp/v2/tx.proto",
+}
+
+func (m *MsgSettlePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettlePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettlePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MarginOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarginBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarginBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarginBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Margins) > 0 {
+		for iNdEx := len(m.Margins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Margins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarginBatch_Margin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarginBatch_Margin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarginBatch_Margin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarginBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarginBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarginBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidations) > 0 {
+		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidate_Liquidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidate_Liquidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidate_Liquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidations) > 0 {
+		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PerpEfFee != nil {
+		{
+			size, err := m.PerpEfFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LiquidatorFee != nil {
+		{
+			size, err := m.LiquidatorFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Side != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MarginToVault.Size()
+		i -= size
+		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClosePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClosePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClosePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClosePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClosePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClosePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarginToTrader.Size()
+		i -= size
+		if _, err := m.MarginToTrader.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPartialClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Size_.Size()
+		i -= size
+		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPartialCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarginToTrader.Size()
+		i -= size
+		if _, err := m.MarginToTrader.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDonateToEcosystemFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDonateToEcosystemFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDonateToEcosystemFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Donation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDonateToEcosystemFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDonateToEcosystemFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDonateToEcosystemFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeCollateralDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeCollateralDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeCollateralDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewDenom) > 0 {
+		i -= len(m.NewDenom)
+		copy(dAtA[i:], m.NewDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeCollateralDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeCollateralDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeCollateralDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAllocateEpochRebates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAllocateEpochRebates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAllocateEpochRebates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rebates) > 0 {
+		for iNdEx := len(m.Rebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAllocateEpochRebatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAllocateEpochRebatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAllocateEpochRebatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalEpochRebates) > 0 {
+		for iNdEx := len(m.TotalEpochRebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEpochRebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEpochRebates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEpochRebates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEpochRebates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		dAtA12 := make([]byte, len(m.Epochs)*10)
+		var j11 int
+		for _, num := range m.Epochs {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEpochRebatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEpochRebatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEpochRebatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnRebates) > 0 {
+		for iNdEx := len(m.WithdrawnRebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnRebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgShiftPegMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgShiftPegMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgShiftPegMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NewPegMult.Size()
+		i -= size
+		if _, err := m.NewPegMult.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgShiftPegMultiplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgShiftPegMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgShiftPegMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgShiftSwapInvariant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgShiftSwapInvariant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgShiftSwapInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NewSwapInvariant.Size()
+		i -= size
+		if _, err := m.NewSwapInvariant.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgShiftSwapInvariantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgShiftSwapInvariantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgShiftSwapInvariantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromPerpFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromPerpFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromPerpFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddr) > 0 {
+		i -= len(m.ToAddr)
+		copy(dAtA[i:], m.ToAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromPerpFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromPerpFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromPerpFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMinSnapshotIntervalMs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMinSnapshotIntervalMs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMinSnapshotIntervalMs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IntervalMs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMinSnapshotIntervalMsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMinSnapshotIntervalMsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMinSnapshotIntervalMsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeSnapshotRetentionMs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeSnapshotRetentionMs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeSnapshotRetentionMs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetentionMs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RetentionMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeSnapshotRetentionMsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeSnapshotRetentionMsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeSnapshotRetentionMsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeLiquidationTwapLookbackMs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeLiquidationTwapLookbackMs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeLiquidationTwapLookbackMs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackMs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LookbackMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeLiquidatorRewardRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeLiquidatorRewardRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeLiquidatorRewardRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidatorRewardRatio.Size()
+		i -= size
+		if _, err := m.LiquidatorRewardRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeLiquidatorRewardRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeLiquidatorRewardRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeLiquidatorRewardRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeFundingRateIntervalMs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeFundingRateIntervalMs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeFundingRateIntervalMs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IntervalMs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeFundingRateIntervalMsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeFundingRateIntervalMsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeFundingRateIntervalMsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMaxPairsPerBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMaxPairsPerBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMaxPairsPerBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPairs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPairs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMaxPairsPerBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMaxPairsPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMaxPairsPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeImbalanceFeeRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeImbalanceFeeRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeImbalanceFeeRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ImbalanceFeeRatio.Size()
+		i -= size
+		if _, err := m.ImbalanceFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeImbalanceFeeRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeImbalanceFeeRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeImbalanceFeeRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeOpenInterestCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeOpenInterestCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeOpenInterestCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OpenInterestCap.Size()
+		i -= size
+		if _, err := m.OpenInterestCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeOpenInterestCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeOpenInterestCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeOpenInterestCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeFluctuationLimitRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeFluctuationLimitRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeFluctuationLimitRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FluctuationLimitRatio.Size()
+		i -= size
+		if _, err := m.FluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeFluctuationLimitRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeFluctuationLimitRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeFluctuationLimitRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeTradeLimitRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeTradeLimitRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeTradeLimitRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TradeLimitRatio.Size()
+		i -= size
+		if _, err := m.TradeLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeTradeLimitRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeTradeLimitRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeTradeLimitRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeSideTradeLimitRatios) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeSideTradeLimitRatios) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeSideTradeLimitRatios) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShortTradeLimitRatio != nil {
+		{
+			size := m.ShortTradeLimitRatio.Size()
+			i -= size
+			if _, err := m.ShortTradeLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LongTradeLimitRatio != nil {
+		{
+			size := m.LongTradeLimitRatio.Size()
+			i -= size
+			if _, err := m.LongTradeLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeSideTradeLimitRatiosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeSideTradeLimitRatiosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeSideTradeLimitRatiosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeCloseAtOracle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeCloseAtOracle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeCloseAtOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxOracleSpreadRatio.Size()
+		i -= size
+		if _, err := m.MaxOracleSpreadRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CloseAtOracle {
+		i--
+		if m.CloseAtOracle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeCloseAtOracleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeCloseAtOracleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeCloseAtOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMaxOracleSpreadRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMaxOracleSpreadRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMaxOracleSpreadRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxOracleSpreadRatio.Size()
+		i -= size
+		if _, err := m.MaxOracleSpreadRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMaxOracleSpreadRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMaxOracleSpreadRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMaxOracleSpreadRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeSpreadLimitedSwaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeSpreadLimitedSwaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeSpreadLimitedSwaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpreadLimited {
+		i--
+		if m.SpreadLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeSpreadLimitedSwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeSpreadLimitedSwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeSpreadLimitedSwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMarketPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMarketPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMarketPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMarketPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMarketPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMarketPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMinPositionQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMinPositionQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMinPositionQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinPositionQuote.Size()
+		i -= size
+		if _, err := m.MinPositionQuote.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeMinPositionQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeMinPositionQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeMinPositionQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCollateralDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCollateralDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCollateralDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OraclePair.Size()
+		i -= size
+		if _, err := m.OraclePair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCollateralDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCollateralDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCollateralDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCollateralDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCollateralDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCollateralDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCollateralDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCollateralDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCollateralDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFundingTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFundingTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFundingTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFundingTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFundingTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFundingTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarginMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarginMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarginMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarginModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarginModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarginModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClampMarkToOracleBand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClampMarkToOracleBand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClampMarkToOracleBand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClampMarkToOracleBandResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClampMarkToOracleBandResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClampMarkToOracleBandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSettlePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgRemoveMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarginOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddMarginBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Margins) > 0 {
+		for _, e := range m.Margins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddMarginBatch_Margin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddMarginBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Liquidations) > 0 {
+		for _, e := range m.Liquidations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidate_Liquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidations) > 0 {
+		for _, e := range m.Liquidations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LiquidatorFee != nil {
+		l = m.LiquidatorFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PerpEfFee != nil {
+		l = m.PerpEfFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Side != 0 {
+		n += 1 + sovTx(uint64(m.Side))
+	}
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MarginToVault.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClosePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClosePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MarginToTrader.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPartialClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Size_.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPartialCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MarginToTrader.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDonateToEcosystemFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Donation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDonateToEcosystemFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeCollateralDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeCollateralDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAllocateEpochRebates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Rebates) > 0 {
+		for _, e := range m.Rebates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAllocateEpochRebatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalEpochRebates) > 0 {
+		for _, e := range m.TotalEpochRebates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawEpochRebates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Epochs) > 0 {
+		l = 0
+		for _, e := range m.Epochs {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgWithdrawEpochRebatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WithdrawnRebates) > 0 {
+		for _, e := range m.WithdrawnRebates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgShiftPegMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.NewPegMult.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgShiftPegMultiplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgShiftSwapInvariant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.NewSwapInvariant.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgShiftSwapInvariantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFromPerpFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromPerpFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCloseMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeMinSnapshotIntervalMs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IntervalMs != 0 {
+		n += 1 + sovTx(uint64(m.IntervalMs))
+	}
+	return n
+}
+
+func (m *MsgChangeMinSnapshotIntervalMsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeSnapshotRetentionMs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RetentionMs != 0 {
+		n += 1 + sovTx(uint64(m.RetentionMs))
+	}
+	return n
+}
+
+func (m *MsgChangeSnapshotRetentionMsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeLiquidationTwapLookbackMs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LookbackMs != 0 {
+		n += 1 + sovTx(uint64(m.LookbackMs))
+	}
+	return n
+}
+
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeLiquidatorRewardRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LiquidatorRewardRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeLiquidatorRewardRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeFundingRateIntervalMs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IntervalMs != 0 {
+		n += 1 + sovTx(uint64(m.IntervalMs))
+	}
+	return n
+}
+
+func (m *MsgChangeFundingRateIntervalMsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeMaxPairsPerBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPairs != 0 {
+		n += 1 + sovTx(uint64(m.MaxPairs))
+	}
+	return n
+}
+
+func (m *MsgChangeMaxPairsPerBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeImbalanceFeeRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ImbalanceFeeRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeImbalanceFeeRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeOpenInterestCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.OpenInterestCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeOpenInterestCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeFluctuationLimitRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FluctuationLimitRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeFluctuationLimitRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeTradeLimitRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TradeLimitRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeTradeLimitRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeSideTradeLimitRatios) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LongTradeLimitRatio != nil {
+		l = m.LongTradeLimitRatio.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ShortTradeLimitRatio != nil {
+		l = m.ShortTradeLimitRatio.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeSideTradeLimitRatiosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeCloseAtOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CloseAtOracle {
+		n += 2
+	}
+	l = m.MaxOracleSpreadRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeCloseAtOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeMaxOracleSpreadRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxOracleSpreadRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeMaxOracleSpreadRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeSpreadLimitedSwaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SpreadLimited {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgChangeSpreadLimitedSwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeMarketPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgChangeMarketPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeMinPositionQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinPositionQuote.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeMinPositionQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddCollateralDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OraclePair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddCollateralDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveCollateralDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveCollateralDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFundingTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFundingTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMarginMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetMarginModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClampMarkToOracleBand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClampMarkToOracleBandResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cost.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSettlePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettlePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettlePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarginBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarginBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarginBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Margins = append(m.Margins, MsgAddMarginBatch_Margin{})
+			if err := m.Margins[len(m.Margins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarginBatch_Margin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Margin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Margin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarginBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarginBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarginBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, MsgAddMarginResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiLiquidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidate_Liquidation{})
+			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Liquidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Liquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidateResponse_LiquidationResponse{})
+			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidatorFee == nil {
+				m.LiquidatorFee = &types.Coin{}
+			}
+			if err := m.LiquidatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpEfFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PerpEfFee == nil {
+				m.PerpEfFee = &types.Coin{}
+			}
+			if err := m.PerpEfFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToVault", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginToVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClosePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClosePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClosePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClosePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToTrader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginToTrader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialClose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialClose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialClose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialCloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialCloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToTrader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginToTrader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDonateToEcosystemFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDonateToEcosystemFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Donation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Donation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeCollateralDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeCollateralDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeCollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeCollateralDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeCollateralDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeCollateralDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAllocateEpochRebates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAllocateEpochRebates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAllocateEpochRebates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rebates = append(m.Rebates, types.Coin{})
+			if err := m.Rebates[len(m.Rebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAllocateEpochRebatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAllocateEpochRebatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAllocateEpochRebatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEpochRebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEpochRebates = append(m.TotalEpochRebates, types.Coin{})
+			if err := m.TotalEpochRebates[len(m.TotalEpochRebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawEpochRebates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawEpochRebates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawEpochRebates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Epochs = append(m.Epochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Epochs) == 0 {
+					m.Epochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Epochs = append(m.Epochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawEpochRebatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawEpochRebatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawEpochRebatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnRebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnRebates = append(m.WithdrawnRebates, types.Coin{})
+			if err := m.WithdrawnRebates[len(m.WithdrawnRebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgShiftPegMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgShiftPegMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgShiftPegMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPegMult", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewPegMult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgWithdrawFromPerpFundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgCloseMarket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgShiftPegMultiplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgShiftPegMultiplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgShiftPegMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgCloseMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSettlePosition) Unmarshal(dAtA []byte) error {
+func (m *MsgShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4289,10 +13306,10 @@ func (m *MsgSettlePosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSettlePosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgShiftSwapInvariant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSettlePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgShiftSwapInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4362,10 +13379,10 @@ func (m *MsgSettlePosition) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSwapInvariant", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4375,11 +13392,26 @@ func (m *MsgSettlePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewSwapInvariant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4401,7 +13433,7 @@ func (m *MsgSettlePosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
+func (m *MsgShiftSwapInvariantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4424,10 +13456,60 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMargin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgShiftSwapInvariantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgShiftSwapInvariantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromPerpFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromPerpFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromPerpFund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4464,7 +13546,7 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4492,15 +13574,15 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4510,25 +13592,106 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromPerpFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromPerpFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromPerpFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4550,7 +13713,7 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseMarket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4573,17 +13736,17 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarginResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseMarket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseMarket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4593,28 +13756,27 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4642,46 +13804,60 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4703,7 +13879,7 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeMinSnapshotIntervalMs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4726,10 +13902,10 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMargin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeMinSnapshotIntervalMs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeMinSnapshotIntervalMs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4765,10 +13941,10 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalMs", wireType)
 			}
-			var stringLen uint64
+			m.IntervalMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4778,59 +13954,61 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IntervalMs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeMinSnapshotIntervalMsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeMinSnapshotIntervalMsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeMinSnapshotIntervalMsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4852,7 +14030,7 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeSnapshotRetentionMs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4875,15 +14053,15 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarginResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeSnapshotRetentionMs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeSnapshotRetentionMs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4911,15 +14089,13 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionMs", wireType)
 			}
-			var msglen int
+			m.RetentionMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4929,28 +14105,61 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RetentionMs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeSnapshotRetentionMsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeSnapshotRetentionMsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeSnapshotRetentionMsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4972,7 +14181,7 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeLiquidationTwapLookbackMs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4995,10 +14204,10 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeLiquidationTwapLookbackMs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeLiquidationTwapLookbackMs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5034,10 +14243,10 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackMs", wireType)
 			}
-			var msglen int
+			m.LookbackMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5047,26 +14256,61 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LookbackMs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidate_Liquidation{})
-			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeLiquidationTwapLookbackMsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeLiquidationTwapLookbackMsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeLiquidationTwapLookbackMsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5088,7 +14332,7 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeLiquidatorRewardRatio) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5111,15 +14355,15 @@ func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Liquidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeLiquidatorRewardRatio: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Liquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeLiquidatorRewardRatio: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5147,13 +14391,11 @@ func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorRewardRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5181,7 +14423,9 @@ func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
+			if err := m.LiquidatorRewardRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5204,7 +14448,7 @@ func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeLiquidatorRewardRatioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5227,46 +14471,12 @@ func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeLiquidatorRewardRatioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeLiquidatorRewardRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidateResponse_LiquidationResponse{})
-			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5288,7 +14498,7 @@ func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeFundingRateIntervalMs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5307,143 +14517,19 @@ func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) e
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LiquidatorFee == nil {
-				m.LiquidatorFee = &types.Coin{}
-			}
-			if err := m.LiquidatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpEfFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PerpEfFee == nil {
-				m.PerpEfFee = &types.Coin{}
-			}
-			if err := m.PerpEfFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeFundingRateIntervalMs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeFundingRateIntervalMs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5471,13 +14557,13 @@ func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalMs", wireType)
 			}
-			var stringLen uint64
+			m.IntervalMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5487,26 +14573,61 @@ func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IntervalMs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeFundingRateIntervalMsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeFundingRateIntervalMsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeFundingRateIntervalMsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5528,7 +14649,7 @@ func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *MsgMarketOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeMaxPairsPerBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5551,10 +14672,10 @@ func (m *MsgMarketOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeMaxPairsPerBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeMaxPairsPerBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5590,10 +14711,10 @@ func (m *MsgMarketOrder) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPairs", wireType)
 			}
-			var stringLen uint64
+			m.MaxPairs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5603,48 +14724,114 @@ func (m *MsgMarketOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxPairs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeMaxPairsPerBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeMaxPairsPerBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeMaxPairsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Side = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Side |= Direction(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeImbalanceFeeRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeImbalanceFeeRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeImbalanceFeeRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5672,13 +14859,11 @@ func (m *MsgMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5706,13 +14891,13 @@ func (m *MsgMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImbalanceFeeRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5740,7 +14925,7 @@ func (m *MsgMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ImbalanceFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5765,7 +14950,57 @@ func (m *MsgMarketOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeImbalanceFeeRatioResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeImbalanceFeeRatioResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeImbalanceFeeRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeOpenInterestCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5788,17 +15023,17 @@ func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeOpenInterestCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeOpenInterestCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5808,31 +15043,27 @@ func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5860,13 +15091,13 @@ func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5894,47 +15125,113 @@ func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenInterestCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeOpenInterestCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeOpenInterestCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeOpenInterestCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeFluctuationLimitRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeFluctuationLimitRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeFluctuationLimitRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5962,13 +15259,11 @@ func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5996,13 +15291,13 @@ func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginToVault", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6030,44 +15325,60 @@ func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginToVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeFluctuationLimitRatioResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeFluctuationLimitRatioResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeFluctuationLimitRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6089,7 +15400,7 @@ func (m *MsgMarketOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeTradeLimitRatio) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6112,15 +15423,47 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClosePosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeTradeLimitRatio: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClosePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeTradeLimitRatio: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6148,11 +15491,13 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6180,7 +15525,7 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6205,7 +15550,7 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeTradeLimitRatioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6228,15 +15573,65 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClosePositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeTradeLimitRatioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClosePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeTradeLimitRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeSideTradeLimitRatios) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeSideTradeLimitRatios: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeSideTradeLimitRatios: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6264,13 +15659,11 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6298,13 +15691,13 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LongTradeLimitRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6332,13 +15725,15 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LongTradeLimitRatio = &v
+			if err := m.LongTradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTradeLimitRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6366,44 +15761,62 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ShortTradeLimitRatio = &v
+			if err := m.ShortTradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginToTrader", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeSideTradeLimitRatiosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginToTrader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeSideTradeLimitRatiosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeSideTradeLimitRatiosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6425,7 +15838,7 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPartialClose) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeCloseAtOracle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6448,10 +15861,10 @@ func (m *MsgPartialClose) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPartialClose: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeCloseAtOracle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPartialClose: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeCloseAtOracle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6521,8 +15934,28 @@ func (m *MsgPartialClose) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseAtOracle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CloseAtOracle = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6550,7 +15983,7 @@ func (m *MsgPartialClose) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6575,7 +16008,7 @@ func (m *MsgPartialClose) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPartialCloseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeCloseAtOracleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6598,83 +16031,65 @@ func (m *MsgPartialCloseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPartialCloseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeCloseAtOracleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPartialCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeCloseAtOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeMaxOracleSpreadRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeMaxOracleSpreadRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeMaxOracleSpreadRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6702,13 +16117,11 @@ func (m *MsgPartialCloseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6736,13 +16149,13 @@ func (m *MsgPartialCloseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginToTrader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6770,7 +16183,7 @@ func (m *MsgPartialCloseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginToTrader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6795,7 +16208,7 @@ func (m *MsgPartialCloseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeMaxOracleSpreadRatioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6818,10 +16231,60 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFund: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeMaxOracleSpreadRatioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeMaxOracleSpreadRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeSpreadLimitedSwaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeSpreadLimitedSwaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeSpreadLimitedSwaps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6858,9 +16321,9 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Donation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6870,25 +16333,46 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Donation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadLimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpreadLimited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6910,7 +16394,7 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeSpreadLimitedSwapsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6933,10 +16417,10 @@ func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeSpreadLimitedSwapsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeSpreadLimitedSwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6960,7 +16444,7 @@ func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeCollateralDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeMarketPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6983,10 +16467,10 @@ func (m *MsgChangeCollateralDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeCollateralDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeMarketPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeCollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeMarketPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7023,7 +16507,7 @@ func (m *MsgChangeCollateralDenom) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7051,8 +16535,30 @@ func (m *MsgChangeCollateralDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7074,7 +16580,7 @@ func (m *MsgChangeCollateralDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeCollateralDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeMarketPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7097,10 +16603,10 @@ func (m *MsgChangeCollateralDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeCollateralDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeMarketPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeCollateralDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeMarketPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7124,7 +16630,7 @@ func (m *MsgChangeCollateralDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAllocateEpochRebates) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeMinPositionQuote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7147,10 +16653,10 @@ func (m *MsgAllocateEpochRebates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAllocateEpochRebates: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeMinPositionQuote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAllocateEpochRebates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeMinPositionQuote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7187,9 +16693,9 @@ func (m *MsgAllocateEpochRebates) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rebates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPositionQuote", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7199,23 +16705,23 @@ func (m *MsgAllocateEpochRebates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rebates = append(m.Rebates, types.Coin{})
-			if err := m.Rebates[len(m.Rebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinPositionQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7240,7 +16746,7 @@ func (m *MsgAllocateEpochRebates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAllocateEpochRebatesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeMinPositionQuoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7263,46 +16769,12 @@ func (m *MsgAllocateEpochRebatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAllocateEpochRebatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeMinPositionQuoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAllocateEpochRebatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeMinPositionQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalEpochRebates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalEpochRebates = append(m.TotalEpochRebates, types.Coin{})
-			if err := m.TotalEpochRebates[len(m.TotalEpochRebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7324,7 +16796,7 @@ func (m *MsgAllocateEpochRebatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawEpochRebates) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCollateralDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7347,10 +16819,10 @@ func (m *MsgWithdrawEpochRebates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawEpochRebates: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCollateralDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawEpochRebates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7386,81 +16858,71 @@ func (m *MsgWithdrawEpochRebates) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.Epochs = append(m.Epochs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Epochs) == 0 {
-					m.Epochs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Epochs = append(m.Epochs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OraclePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7482,7 +16944,7 @@ func (m *MsgWithdrawEpochRebates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawEpochRebatesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCollateralDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7505,46 +16967,12 @@ func (m *MsgWithdrawEpochRebatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawEpochRebatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCollateralDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawEpochRebatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCollateralDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnRebates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WithdrawnRebates = append(m.WithdrawnRebates, types.Coin{})
-			if err := m.WithdrawnRebates[len(m.WithdrawnRebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7566,7 +16994,7 @@ func (m *MsgWithdrawEpochRebatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgShiftPegMultiplier) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveCollateralDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7589,10 +17017,10 @@ func (m *MsgShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgShiftPegMultiplier: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveCollateralDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgShiftPegMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveCollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7629,41 +17057,7 @@ func (m *MsgShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPegMult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7691,9 +17085,7 @@ func (m *MsgShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewPegMult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7716,7 +17108,7 @@ func (m *MsgShiftPegMultiplier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgShiftPegMultiplierResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveCollateralDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7739,10 +17131,10 @@ func (m *MsgShiftPegMultiplierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgShiftPegMultiplierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveCollateralDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgShiftPegMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveCollateralDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7766,7 +17158,7 @@ func (m *MsgShiftPegMultiplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgShiftSwapInvariant) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFundingTopUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7789,10 +17181,10 @@ func (m *MsgShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgShiftSwapInvariant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFundingTopUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgShiftSwapInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFundingTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7862,10 +17254,10 @@ func (m *MsgShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSwapInvariant", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7875,26 +17267,12 @@ func (m *MsgShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewSwapInvariant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7916,7 +17294,7 @@ func (m *MsgShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgShiftSwapInvariantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFundingTopUpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7939,10 +17317,10 @@ func (m *MsgShiftSwapInvariantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgShiftSwapInvariantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFundingTopUpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgShiftSwapInvariantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFundingTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7966,7 +17344,7 @@ func (m *MsgShiftSwapInvariantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFromPerpFund) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarginMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7989,10 +17367,10 @@ func (m *MsgWithdrawFromPerpFund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromPerpFund: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMarginMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromPerpFund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMarginMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8029,7 +17407,7 @@ func (m *MsgWithdrawFromPerpFund) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8057,45 +17435,13 @@ func (m *MsgWithdrawFromPerpFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8123,7 +17469,7 @@ func (m *MsgWithdrawFromPerpFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddr = string(dAtA[iNdEx:postIndex])
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8146,7 +17492,7 @@ func (m *MsgWithdrawFromPerpFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFromPerpFundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarginModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8169,10 +17515,10 @@ func (m *MsgWithdrawFromPerpFundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromPerpFundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMarginModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromPerpFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMarginModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8196,7 +17542,7 @@ func (m *MsgWithdrawFromPerpFundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseMarket) Unmarshal(dAtA []byte) error {
+func (m *MsgClampMarkToOracleBand) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8219,10 +17565,10 @@ func (m *MsgCloseMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClampMarkToOracleBand: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClampMarkToOracleBand: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8312,7 +17658,7 @@ func (m *MsgCloseMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseMarketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClampMarkToOracleBandResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8335,12 +17681,46 @@ func (m *MsgCloseMarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseMarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClampMarkToOracleBandResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClampMarkToOracleBandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15,6 +15,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, pool := range genState.Pools {
 		k.SetPool(ctx, pool)
 	}
+
+	for _, allowlist := range genState.PoolAllowlists {
+		var addrs []sdk.AccAddress
+		for _, addr := range allowlist.Addresses {
+			addrs = append(addrs, sdk.MustAccAddressFromBech32(addr))
+		}
+		if err := k.AddToPoolAllowlist(ctx, allowlist.PoolId, addrs...); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the spot module's exported genesis.
@@ -23,5 +33,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.Pools = k.FetchAllPools(ctx)
 
+	for _, pool := range genesis.Pools {
+		addrs := k.GetPoolAllowlist(ctx, pool.Id)
+		if len(addrs) == 0 {
+			continue
+		}
+		allowlist := types.PoolAllowlist{PoolId: pool.Id}
+		for _, addr := range addrs {
+			allowlist.Addresses = append(allowlist.Addresses, addr.String())
+		}
+		genesis.PoolAllowlists = append(genesis.PoolAllowlists, allowlist)
+	}
+
 	return genesis
 }
@@ -40,12 +40,20 @@ func TestGenesis(t *testing.T) {
 				TotalShares: sdk.NewCoin("nibiru/pool/1", sdk.NewInt(100)),
 			},
 		},
+		PoolAllowlists: []types.PoolAllowlist{
+			{
+				PoolId:    1,
+				Addresses: []string{testutil.AccAddress().String()},
+			},
+		},
 	}
+	require.NoError(t, genesisState.Validate())
 
 	app, ctx := testapp.NewNibiruTestAppAndContext()
 	spot.InitGenesis(ctx, app.SpotKeeper, genesisState)
 	got := spot.ExportGenesis(ctx, app.SpotKeeper)
 	require.NotNil(t, got)
+	require.Equal(t, genesisState.PoolAllowlists, got.PoolAllowlists)
 
 	testutil.Fill(&genesisState)
 	testutil.Fill(got)
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+/*
+AddToPoolAllowlist Adds addresses to the allowlist of a pool. Once a pool has a
+non-empty allowlist, only the addresses on it can swap with or join the pool.
+Exiting the pool is always allowed. It does not check the caller; governance
+reaches it through MsgUpdatePoolAllowlist, which is gated by the module authority.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool id number
+  - addrs: the addresses to allow
+
+ret:
+  - err: error if the pool does not exist
+*/
+func (k Keeper) AddToPoolAllowlist(ctx sdk.Context, poolId uint64, addrs ...sdk.AccAddress) error {
+	if _, err := k.FetchPool(ctx, poolId); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range addrs {
+		store.Set(types.GetPoolAllowlistKey(poolId, addr), []byte{1})
+	}
+	return nil
+}
+
+/*
+RemoveFromPoolAllowlist Removes addresses from the allowlist of a pool. Removing
+every address makes the pool permissionless again. Like AddToPoolAllowlist, it is
+reached by governance through MsgUpdatePoolAllowlist.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool id number
+  - addrs: the addresses to remove
+*/
+func (k Keeper) RemoveFromPoolAllowlist(ctx sdk.Context, poolId uint64, addrs ...sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range addrs {
+		store.Delete(types.GetPoolAllowlistKey(poolId, addr))
+	}
+}
+
+/*
+GetPoolAllowlist Returns the addresses allowed to swap with and join a pool. An
+empty allowlist means that the pool is open to everyone.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool id number
+
+ret:
+  - addrs: the allowed addresses
+*/
+func (k Keeper) GetPoolAllowlist(ctx sdk.Context, poolId uint64) (addrs []sdk.AccAddress) {
+	prefix := types.GetPoolAllowlistPrefix(poolId)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, sdk.AccAddress(iterator.Key()[len(prefix):]))
+	}
+
+	return addrs
+}
+
+// checkPoolAllowlist returns an error if the pool has an allowlist that does
+// not contain the address.
+func (k Keeper) checkPoolAllowlist(ctx sdk.Context, poolId uint64, addr sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetPoolAllowlistKey(poolId, addr)) {
+		return nil
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolAllowlistPrefix(poolId))
+	defer iterator.Close()
+	if iterator.Valid() {
+		return types.ErrAddressNotAllowed.Wrapf("address %s in pool %d", addr, poolId)
+	}
+	return nil
+}
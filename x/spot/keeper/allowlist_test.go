@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/spot/keeper"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+func TestPoolAllowlist(t *testing.T) {
+	const shareDenom = "nibiru/pool/1"
+
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	pool := mock.SpotPool(
+		/*poolId=*/ 1,
+		/*assets=*/ sdk.NewCoins(
+			sdk.NewInt64Coin("bar", 100),
+			sdk.NewInt64Coin("foo", 100),
+		),
+		/*shares=*/ 100,
+	)
+	poolAddr := testutil.AccAddress()
+	pool.Address = poolAddr.String()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, poolAddr, pool.PoolBalances()))
+	app.SpotKeeper.SetPool(ctx, pool)
+
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	for _, addr := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(
+			sdk.NewInt64Coin("bar", 100),
+			sdk.NewInt64Coin("foo", 100),
+			sdk.NewInt64Coin(shareDenom, 10),
+		)))
+	}
+
+	t.Log("pools without an allowlist are open to everyone")
+	require.Empty(t, app.SpotKeeper.GetPoolAllowlist(ctx, 1))
+	_, err := app.SpotKeeper.SwapExactAmountIn(ctx, bob, 1, sdk.NewInt64Coin("foo", 10), "bar")
+	require.NoError(t, err)
+
+	t.Log("allowlist alice")
+	require.NoError(t, app.SpotKeeper.AddToPoolAllowlist(ctx, 1, alice))
+	require.Equal(t, []sdk.AccAddress{alice}, app.SpotKeeper.GetPoolAllowlist(ctx, 1))
+
+	t.Log("alice can swap and join")
+	_, err = app.SpotKeeper.SwapExactAmountIn(ctx, alice, 1, sdk.NewInt64Coin("foo", 10), "bar")
+	require.NoError(t, err)
+	_, _, _, err = app.SpotKeeper.JoinPool(ctx, alice, 1, sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 10),
+		sdk.NewInt64Coin("foo", 10),
+	), true)
+	require.NoError(t, err)
+
+	t.Log("bob can neither swap nor join")
+	_, err = app.SpotKeeper.SwapExactAmountIn(ctx, bob, 1, sdk.NewInt64Coin("foo", 10), "bar")
+	require.ErrorIs(t, err, types.ErrAddressNotAllowed)
+	_, _, _, err = app.SpotKeeper.JoinPool(ctx, bob, 1, sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 10),
+		sdk.NewInt64Coin("foo", 10),
+	), true)
+	require.ErrorIs(t, err, types.ErrAddressNotAllowed)
+
+	t.Log("bob can still exit")
+	tokensOut, err := app.SpotKeeper.ExitPool(ctx, bob, 1, sdk.NewInt64Coin(shareDenom, 10))
+	require.NoError(t, err)
+	require.False(t, tokensOut.IsZero())
+
+	t.Log("removing every address opens the pool again")
+	app.SpotKeeper.RemoveFromPoolAllowlist(ctx, 1, alice)
+	require.Empty(t, app.SpotKeeper.GetPoolAllowlist(ctx, 1))
+	_, err = app.SpotKeeper.SwapExactAmountIn(ctx, bob, 1, sdk.NewInt64Coin("foo", 10), "bar")
+	require.NoError(t, err)
+
+	t.Log("the allowlist of an unknown pool can't be set")
+	require.ErrorIs(t, app.SpotKeeper.AddToPoolAllowlist(ctx, 2, alice), types.ErrPoolNotFound)
+}
+
+func TestMsgUpdatePoolAllowlist(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	app.SpotKeeper.SetPool(ctx, mock.SpotPool(
+		/*poolId=*/ 1,
+		/*assets=*/ sdk.NewCoins(
+			sdk.NewInt64Coin("bar", 100),
+			sdk.NewInt64Coin("foo", 100),
+		),
+		/*shares=*/ 100,
+	))
+	msgServer := keeper.NewMsgServerImpl(app.SpotKeeper)
+	queryServer := keeper.NewQuerier(app.SpotKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	t.Log("only the governance authority can update the allowlist")
+	_, err := msgServer.UpdatePoolAllowlist(goCtx, &types.MsgUpdatePoolAllowlist{
+		Authority: alice.String(),
+		PoolId:    1,
+		Add:       []string{alice.String()},
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Empty(t, app.SpotKeeper.GetPoolAllowlist(ctx, 1))
+
+	t.Log("governance adds alice and bob")
+	_, err = msgServer.UpdatePoolAllowlist(goCtx, &types.MsgUpdatePoolAllowlist{
+		Authority: app.SpotKeeper.GetAuthority(),
+		PoolId:    1,
+		Add:       []string{alice.String(), bob.String()},
+	})
+	require.NoError(t, err)
+	resp, err := queryServer.PoolAllowlist(goCtx, &types.QueryPoolAllowlistRequest{PoolId: 1})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{alice.String(), bob.String()}, resp.Addresses)
+
+	t.Log("governance removes bob")
+	_, err = msgServer.UpdatePoolAllowlist(goCtx, &types.MsgUpdatePoolAllowlist{
+		Authority: app.SpotKeeper.GetAuthority(),
+		PoolId:    1,
+		Remove:    []string{bob.String()},
+	})
+	require.NoError(t, err)
+	resp, err = queryServer.PoolAllowlist(goCtx, &types.QueryPoolAllowlistRequest{PoolId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{alice.String()}, resp.Addresses)
+
+	t.Log("the allowlist of an unknown pool can't be updated or queried")
+	_, err = msgServer.UpdatePoolAllowlist(goCtx, &types.MsgUpdatePoolAllowlist{
+		Authority: app.SpotKeeper.GetAuthority(),
+		PoolId:    2,
+		Add:       []string{alice.String()},
+	})
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+	_, err = queryServer.PoolAllowlist(goCtx, &types.QueryPoolAllowlistRequest{PoolId: 2})
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+}
@@ -263,6 +263,29 @@ func (k queryServer) EstimateSwapExactAmountIn(
 	}, nil
 }
 
+// Estimates the amount of tokens returned given an exact amount of tokens to
+// swap through several pools in turn.
+func (k queryServer) EstimateMultiHop(
+	ctx context.Context, req *types.QueryEstimateMultiHopRequest,
+) (*types.QueryEstimateMultiHopResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tokenOut, effectivePrice, cumulativeFeeRatio, err := k.Keeper.EstimateMultiHop(
+		sdk.UnwrapSDKContext(ctx), req.Routes, req.TokenIn,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateMultiHopResponse{
+		TokenOut:           tokenOut,
+		EffectivePrice:     effectivePrice,
+		CumulativeFeeRatio: cumulativeFeeRatio,
+	}, nil
+}
+
 // Estimates the amount of tokens required to return the exact amount of
 // assets requested.
 func (k queryServer) EstimateSwapExactAmountOut(
@@ -332,3 +355,24 @@ func (k queryServer) EstimateExitExactAmountIn(
 func (k queryServer) EstimateExitExactAmountOut(context.Context, *types.QueryExitExactAmountOutRequest) (*types.QueryExitExactAmountOutResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "Not Implemented")
 }
+
+// Addresses allowed to swap with and join a pool.
+func (k queryServer) PoolAllowlist(goCtx context.Context, req *types.QueryPoolAllowlistRequest) (
+	*types.QueryPoolAllowlistResponse, error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.FetchPool(ctx, req.PoolId); err != nil {
+		return nil, err
+	}
+
+	addresses := []string{}
+	for _, addr := range k.GetPoolAllowlist(ctx, req.PoolId) {
+		addresses = append(addresses, addr.String())
+	}
+
+	return &types.QueryPoolAllowlistResponse{Addresses: addresses}, nil
+}
@@ -612,6 +612,47 @@ func TestQueryEstimateSwapExactAmountIn(t *testing.T) {
 	}
 }
 
+func TestQueryEstimateMultiHop(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	for poolId, poolDenoms := range [][2]string{{"foo", "bar"}, {"bar", "baz"}} {
+		pool := mock.SpotPool(
+			/*poolId=*/ uint64(poolId+1),
+			/*assets=*/ sdk.NewCoins(
+				sdk.NewInt64Coin(poolDenoms[0], 1_000_000),
+				sdk.NewInt64Coin(poolDenoms[1], 1_000_000),
+			),
+			/*shares=*/ 100,
+		)
+		pool.PoolParams.SwapFee = sdk.MustNewDecFromStr("0.01")
+		app.SpotKeeper.SetPool(ctx, pool)
+	}
+	queryServer := keeper.NewQuerier(app.SpotKeeper)
+	routes := []types.SwapRoute{
+		{PoolId: 1, TokenOutDenom: "bar"},
+		{PoolId: 2, TokenOutDenom: "baz"},
+	}
+	tokenIn := sdk.NewInt64Coin("foo", 10_000)
+
+	resp, err := queryServer.EstimateMultiHop(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryEstimateMultiHopRequest{Routes: routes, TokenIn: tokenIn},
+	)
+	require.NoError(t, err)
+
+	tokenOut, effectivePrice, feeRatio, err := app.SpotKeeper.EstimateMultiHop(ctx, routes, tokenIn)
+	require.NoError(t, err)
+	require.Equal(t, tokenOut, resp.TokenOut)
+	require.Equal(t, effectivePrice.String(), resp.EffectivePrice.String())
+	require.Equal(t, "0.019900000000000000", resp.CumulativeFeeRatio.String())
+	require.Equal(t, feeRatio.String(), resp.CumulativeFeeRatio.String())
+
+	_, err = queryServer.EstimateMultiHop(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryEstimateMultiHopRequest{TokenIn: tokenIn},
+	)
+	require.ErrorContains(t, err, "at least one route")
+}
+
 func TestQueryEstimateSwapExactAmountOut(t *testing.T) {
 	tests := []struct {
 		name            string
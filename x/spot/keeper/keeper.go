@@ -28,6 +28,11 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
+		oracleKeeper  types.OracleKeeper
+
+		// the address capable of executing governance operations such as
+		// MsgUpdatePoolParams. Typically, this is the x/gov module account.
+		authority string
 	}
 )
 
@@ -41,6 +46,9 @@ args
 	ps: the param subspace for this keeper
 	accountKeeper: the auth module\'s keeper for accounts
 	bankKeeper: the bank module\'s keeper for bank transfers
+	distrKeeper: the distribution module\'s keeper
+	oracleKeeper: the oracle module\'s keeper for asset prices
+	authority: the address allowed to run governance operations
 
 ret
 
@@ -53,6 +61,8 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
+	oracleKeeper types.OracleKeeper,
+	authority string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -66,9 +76,16 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
+		oracleKeeper:  oracleKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the x/spot module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -459,6 +476,10 @@ func (k Keeper) JoinPool(
 ) (pool types.Pool, numSharesOut sdk.Coin, remCoins sdk.Coins, err error) {
 	pool, _ = k.FetchPool(ctx, poolId)
 
+	if err = k.checkPoolAllowlist(ctx, poolId, joinerAddr); err != nil {
+		return pool, numSharesOut, remCoins, err
+	}
+
 	if len(tokensIn) != len(pool.PoolAssets) && !shouldSwap {
 		return pool, numSharesOut, remCoins, errors.New("too few assets to join this pool")
 	}
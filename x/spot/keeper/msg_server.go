@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/NibiruChain/nibiru/x/spot/types"
 )
@@ -164,3 +165,86 @@ func (k msgServer) SwapAssets(ctx context.Context, msg *types.MsgSwapAssets) (
 		TokenOut: tokenOut,
 	}, nil
 }
+
+/*
+UpdatePoolParams Handler for the MsgUpdatePoolParams governance transaction.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgUpdatePoolParams proto object
+
+ret
+
+	MsgUpdatePoolParamsResponse: the MsgUpdatePoolParamsResponse proto object response
+	error: an error if the signer is not the module authority or the update fails
+*/
+func (k msgServer) UpdatePoolParams(ctx context.Context, msg *types.MsgUpdatePoolParams) (
+	*types.MsgUpdatePoolParamsResponse, error,
+) {
+	if k.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+	if err := k.Keeper.UpdatePoolParams(sdkContext, msg.PoolId, msg.SwapFee, msg.ExitFee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdatePoolParamsResponse{}, nil
+}
+
+/*
+UpdatePoolAllowlist Handler for the MsgUpdatePoolAllowlist governance transaction.
+Addresses in "add" are inserted before the ones in "remove" are deleted.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgUpdatePoolAllowlist proto object
+
+ret
+
+	MsgUpdatePoolAllowlistResponse: the MsgUpdatePoolAllowlistResponse proto object response
+	error: an error if the signer is not the module authority or the pool does not exist
+*/
+func (k msgServer) UpdatePoolAllowlist(ctx context.Context, msg *types.MsgUpdatePoolAllowlist) (
+	*types.MsgUpdatePoolAllowlistResponse, error,
+) {
+	if k.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+	if _, err := k.FetchPool(sdkContext, msg.PoolId); err != nil {
+		return nil, err
+	}
+
+	toAdd, err := parseAddresses(msg.Add)
+	if err != nil {
+		return nil, err
+	}
+	toRemove, err := parseAddresses(msg.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.AddToPoolAllowlist(sdkContext, msg.PoolId, toAdd...); err != nil {
+		return nil, err
+	}
+	k.RemoveFromPoolAllowlist(sdkContext, msg.PoolId, toRemove...)
+
+	return &types.MsgUpdatePoolAllowlistResponse{}, nil
+}
+
+// parseAddresses converts bech32 strings into account addresses.
+func parseAddresses(bech32Addrs []string) (addrs []sdk.AccAddress, err error) {
+	for _, bech32Addr := range bech32Addrs {
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
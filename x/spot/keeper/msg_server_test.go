@@ -12,6 +12,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
@@ -1252,3 +1253,55 @@ func TestMsgServerSwapAssets(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgServerUpdatePoolParams(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		authority   func(k keeper.Keeper) string
+		expectedErr error
+	}{
+		{
+			name:      "governance authority",
+			authority: func(k keeper.Keeper) string { return k.GetAuthority() },
+		},
+		{
+			name:        "not the governance authority",
+			authority:   func(keeper.Keeper) string { return testutil.AccAddress().String() },
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			app.SpotKeeper.SetPool(ctx, mock.SpotPool(
+				/*poolId=*/ 1,
+				/*assets=*/ sdk.NewCoins(
+					sdk.NewInt64Coin("bar", 100),
+					sdk.NewInt64Coin("foo", 100),
+				),
+				/*shares=*/ 100,
+			))
+
+			msgServer := keeper.NewMsgServerImpl(app.SpotKeeper)
+			_, err := msgServer.UpdatePoolParams(
+				sdk.WrapSDKContext(ctx),
+				&types.MsgUpdatePoolParams{
+					Authority: tc.authority(app.SpotKeeper),
+					PoolId:    1,
+					SwapFee:   sdk.NewDecWithPrec(5, 2),
+					ExitFee:   sdk.NewDecWithPrec(1, 2),
+				},
+			)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			pool, err := app.SpotKeeper.FetchPool(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDecWithPrec(5, 2), pool.PoolParams.SwapFee)
+			require.Equal(t, sdk.NewDecWithPrec(1, 2), pool.PoolParams.ExitFee)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+/*
+UpdatePoolParams Updates the swap and exit fees of an existing pool. It is meant
+to be called by governance only, through MsgUpdatePoolParams.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool id number
+  - swapFee: the new swap fee, in [0, MaxSwapFee]
+  - exitFee: the new exit fee, in [0, 1)
+
+ret:
+  - err: error if the pool does not exist or a fee is out of range
+*/
+func (k Keeper) UpdatePoolParams(
+	ctx sdk.Context, poolId uint64, swapFee sdk.Dec, exitFee sdk.Dec,
+) error {
+	if swapFee.IsNil() || swapFee.IsNegative() || swapFee.GT(types.MaxSwapFee) {
+		return types.ErrInvalidSwapFee.Wrapf("swap fee must be in [0, %s], got %s", types.MaxSwapFee, swapFee)
+	}
+	if exitFee.IsNil() || exitFee.IsNegative() || exitFee.GTE(sdk.OneDec()) {
+		return types.ErrInvalidExitFee.Wrapf("exit fee must be in [0, 1), got %s", exitFee)
+	}
+
+	pool, err := k.FetchPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	pool.PoolParams.SwapFee = swapFee
+	pool.PoolParams.ExitFee = exitFee
+	k.SetPool(ctx, pool)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPoolParamsUpdated{
+		PoolId:  poolId,
+		SwapFee: swapFee,
+		ExitFee: exitFee,
+	})
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+func TestUpdatePoolParams(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		poolId      uint64
+		swapFee     sdk.Dec
+		exitFee     sdk.Dec
+		expectedErr error
+	}{
+		{
+			name:    "valid fees",
+			poolId:  1,
+			swapFee: sdk.NewDecWithPrec(5, 2),
+			exitFee: sdk.NewDecWithPrec(1, 2),
+		},
+		{
+			name:    "zero fees",
+			poolId:  1,
+			swapFee: sdk.ZeroDec(),
+			exitFee: sdk.ZeroDec(),
+		},
+		{
+			name:    "max swap fee",
+			poolId:  1,
+			swapFee: types.MaxSwapFee,
+			exitFee: sdk.ZeroDec(),
+		},
+		{
+			name:        "swap fee above max",
+			poolId:      1,
+			swapFee:     types.MaxSwapFee.Add(sdk.SmallestDec()),
+			exitFee:     sdk.ZeroDec(),
+			expectedErr: types.ErrInvalidSwapFee,
+		},
+		{
+			name:        "negative swap fee",
+			poolId:      1,
+			swapFee:     sdk.NewDecWithPrec(-1, 2),
+			exitFee:     sdk.ZeroDec(),
+			expectedErr: types.ErrInvalidSwapFee,
+		},
+		{
+			name:        "exit fee of one",
+			poolId:      1,
+			swapFee:     sdk.ZeroDec(),
+			exitFee:     sdk.OneDec(),
+			expectedErr: types.ErrInvalidExitFee,
+		},
+		{
+			name:        "pool not found",
+			poolId:      2,
+			swapFee:     sdk.ZeroDec(),
+			exitFee:     sdk.ZeroDec(),
+			expectedErr: types.ErrPoolNotFound,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			pool := mock.SpotPool(
+				/*poolId=*/ 1,
+				/*assets=*/ sdk.NewCoins(
+					sdk.NewInt64Coin("bar", 100),
+					sdk.NewInt64Coin("foo", 100),
+				),
+				/*shares=*/ 100,
+			)
+			app.SpotKeeper.SetPool(ctx, pool)
+
+			err := app.SpotKeeper.UpdatePoolParams(ctx, tc.poolId, tc.swapFee, tc.exitFee)
+
+			updatedPool, fetchErr := app.SpotKeeper.FetchPool(ctx, 1)
+			require.NoError(t, fetchErr)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, pool, updatedPool)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.swapFee, updatedPool.PoolParams.SwapFee)
+			require.Equal(t, tc.exitFee, updatedPool.PoolParams.ExitFee)
+			require.Equal(t, pool.PoolAssets, updatedPool.PoolAssets)
+
+			events := ctx.EventManager().Events()
+			require.NotEmpty(t, events)
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+			require.NoError(t, err)
+			event, ok := typedEvent.(*types.EventPoolParamsUpdated)
+			require.True(t, ok, "last event: %s", events[len(events)-1].Type)
+			require.Equal(t, tc.poolId, event.PoolId)
+			require.Equal(t, tc.swapFee.String(), event.SwapFee.String())
+			require.Equal(t, tc.exitFee.String(), event.ExitFee.String())
+		})
+	}
+}
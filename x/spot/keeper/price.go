@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+/*
+GetPoolValue Returns the total value locked in a pool, denominated in
+quoteDenom. Each pool asset is priced with the oracle exchange rate of the
+'asset:quoteDenom' pair. The quote asset itself is priced at one.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool id number
+  - quoteDenom: the denom the value is expressed in
+
+ret:
+  - value: the total value of the pool liquidity
+  - err: error if the pool does not exist or an asset has no oracle price
+*/
+func (k Keeper) GetPoolValue(ctx sdk.Context, poolId uint64, quoteDenom string) (
+	value sdk.Dec, err error,
+) {
+	pool, err := k.FetchPool(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	prices := make(map[string]sdk.Dec, len(pool.PoolAssets))
+	for _, poolAsset := range pool.PoolAssets {
+		denom := poolAsset.Token.Denom
+		if denom == quoteDenom {
+			prices[denom] = sdk.OneDec()
+			continue
+		}
+
+		price, err := k.oracleKeeper.GetExchangeRate(ctx, asset.NewPair(denom, quoteDenom))
+		if err != nil {
+			return sdk.Dec{}, types.ErrMissingAssetPrice.Wrapf(
+				"denom: %s, quote: %s: %s", denom, quoteDenom, err)
+		}
+		prices[denom] = price
+	}
+
+	return pool.TotalPoolValue(prices)
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+func TestGetPoolValue(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	pool := mock.SpotPool(1, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.BTC, 10),
+		sdk.NewInt64Coin(denoms.ETH, 200),
+	), 100)
+	app.SpotKeeper.SetPool(ctx, pool)
+
+	_, err := app.SpotKeeper.GetPoolValue(ctx, 1, denoms.USD)
+	require.ErrorIs(t, err, types.ErrMissingAssetPrice)
+
+	app.OracleKeeper.SetPrice(ctx, asset.NewPair(denoms.BTC, denoms.USD), sdk.NewDec(30_000))
+	app.OracleKeeper.SetPrice(ctx, asset.NewPair(denoms.ETH, denoms.USD), sdk.NewDec(2_000))
+
+	// 10 * 30_000 + 200 * 2_000
+	value, err := app.SpotKeeper.GetPoolValue(ctx, 1, denoms.USD)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(700_000), value)
+
+	// the quote asset of the pool is priced at one
+	_, err = app.SpotKeeper.GetPoolValue(ctx, 1, denoms.ETH)
+	require.ErrorIs(t, err, types.ErrMissingAssetPrice)
+	app.OracleKeeper.SetPrice(ctx, asset.NewPair(denoms.BTC, denoms.ETH), sdk.NewDec(15))
+	value, err = app.SpotKeeper.GetPoolValue(ctx, 1, denoms.ETH)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(350), value)
+
+	_, err = app.SpotKeeper.GetPoolValue(ctx, 2, denoms.USD)
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/spot/types"
@@ -82,6 +84,10 @@ func (k Keeper) SwapExactAmountIn(
 		return sdk.Coin{}, err
 	}
 
+	if err = k.checkPoolAllowlist(ctx, poolId, sender); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	// calculate tokenOut and validate
 	tokenOut, fee, err := pool.CalcOutAmtGivenIn(tokenIn, tokenOutDenom, false)
 	if err != nil {
@@ -119,3 +125,103 @@ func (k Keeper) SwapExactAmountIn(
 
 	return tokenOut, nil
 }
+
+/*
+EstimateMultiHop Estimates the result of swapping tokenIn through each pool of
+the routes in turn, deducting every pool's swap fee. No state is changed.
+
+args:
+  - ctx: the cosmos-sdk context
+  - routes: the hops of the swap, in order
+  - tokenIn: the amount of tokens given to the first pool
+
+ret:
+  - tokenOut: the amount of tokens taken out of the last pool
+  - effectivePrice: the amount of tokenIn paid per unit of tokenOut
+  - cumulativeFeeRatio: the share of the input lost to swap fees over all
+    hops, 1 - (1 - swapFee_1) * ... * (1 - swapFee_n)
+  - err: error if any
+*/
+func (k Keeper) EstimateMultiHop(
+	ctx sdk.Context,
+	routes []types.SwapRoute,
+	tokenIn sdk.Coin,
+) (tokenOut sdk.Coin, effectivePrice sdk.Dec, cumulativeFeeRatio sdk.Dec, err error) {
+	if len(routes) == 0 {
+		return sdk.Coin{}, sdk.Dec{}, sdk.Dec{}, errors.New("at least one route is required")
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return sdk.Coin{}, sdk.Dec{}, sdk.Dec{}, types.ErrInvalidTokenIn.Wrapf("token in must be positive: %s", tokenIn)
+	}
+
+	tokenOut = tokenIn
+	amountKeptAfterFees := sdk.OneDec()
+	for _, route := range routes {
+		if tokenOut.Denom == route.TokenOutDenom {
+			return sdk.Coin{}, sdk.Dec{}, sdk.Dec{}, types.ErrSameTokenDenom
+		}
+
+		pool, err := k.FetchPool(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, sdk.Dec{}, err
+		}
+
+		tokenOut, _, err = pool.CalcOutAmtGivenIn(tokenOut, route.TokenOutDenom, false)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, sdk.Dec{}, err
+		}
+		if !tokenOut.Amount.IsPositive() {
+			return sdk.Coin{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("tokenOut amount must be greater than zero in pool %d", route.PoolId)
+		}
+
+		amountKeptAfterFees = amountKeptAfterFees.Mul(sdk.OneDec().Sub(pool.PoolParams.SwapFee))
+	}
+
+	effectivePrice = sdk.NewDecFromInt(tokenIn.Amount).QuoInt(tokenOut.Amount)
+	return tokenOut, effectivePrice, sdk.OneDec().Sub(amountKeptAfterFees), nil
+}
+
+/*
+SwapExactAmountInRoute Swaps tokenIn through each pool of the routes in turn,
+the tokens out of a pool being the tokens in of the next one. Either every hop
+is executed or none is.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the address wishing to perform the swap
+  - routes: the hops of the swap, in order
+  - tokenIn: the amount of tokens given to the first pool
+  - minOut: the minimum amount of tokens to take out of the last pool
+
+ret:
+  - tokenOut: the amount of tokens taken out of the last pool
+  - err: error if any
+*/
+func (k Keeper) SwapExactAmountInRoute(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapRoute,
+	tokenIn sdk.Coin,
+	minOut sdkmath.Int,
+) (tokenOut sdk.Coin, err error) {
+	if len(routes) == 0 {
+		return sdk.Coin{}, errors.New("at least one route is required")
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+
+	tokenOut = tokenIn
+	for _, route := range routes {
+		tokenOut, err = k.SwapExactAmountIn(cacheCtx, sender, route.PoolId, tokenOut, route.TokenOutDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if tokenOut.Amount.LT(minOut) {
+		return sdk.Coin{}, types.ErrTokenOutBelowMin.Wrapf("token out %s is below the minimum %s", tokenOut, minOut)
+	}
+
+	commit()
+	return tokenOut, nil
+}
@@ -397,3 +397,193 @@ func TestDoubleSwapExactAmountIn(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimateMultiHop(t *testing.T) {
+	newPool := func(poolId uint64, denomA, denomB string) types.Pool {
+		pool := mock.SpotPool(
+			poolId,
+			sdk.NewCoins(
+				sdk.NewInt64Coin(denomA, 1_000_000),
+				sdk.NewInt64Coin(denomB, 1_000_000),
+			),
+			/*shares=*/ 100,
+		)
+		pool.PoolParams.SwapFee = sdk.MustNewDecFromStr("0.01")
+		return pool
+	}
+	pools := []types.Pool{
+		newPool(1, "foo", "bar"),
+		newPool(2, "bar", "baz"),
+		newPool(3, "baz", "qux"),
+	}
+
+	// chainHops swaps tokenIn through the pools directly as the expected result.
+	chainHops := func(tokenIn sdk.Coin, routes []types.SwapRoute) sdk.Coin {
+		tokenOut := tokenIn
+		for _, route := range routes {
+			var err error
+			tokenOut, _, err = pools[route.PoolId-1].CalcOutAmtGivenIn(tokenOut, route.TokenOutDenom, false)
+			require.NoError(t, err)
+		}
+		return tokenOut
+	}
+
+	tests := []struct {
+		name               string
+		routes             []types.SwapRoute
+		tokenIn            sdk.Coin
+		expectedFeeRatio   sdk.Dec
+		expectedErrContain string
+	}{
+		{
+			name: "two hops",
+			routes: []types.SwapRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 2, TokenOutDenom: "baz"},
+			},
+			tokenIn:          sdk.NewInt64Coin("foo", 10_000),
+			expectedFeeRatio: sdk.MustNewDecFromStr("0.0199"),
+		},
+		{
+			name: "three hops",
+			routes: []types.SwapRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 2, TokenOutDenom: "baz"},
+				{PoolId: 3, TokenOutDenom: "qux"},
+			},
+			tokenIn:          sdk.NewInt64Coin("foo", 10_000),
+			expectedFeeRatio: sdk.MustNewDecFromStr("0.029701"),
+		},
+		{
+			name:               "no routes",
+			routes:             []types.SwapRoute{},
+			tokenIn:            sdk.NewInt64Coin("foo", 10_000),
+			expectedErrContain: "at least one route",
+		},
+		{
+			name: "denom not in pool",
+			routes: []types.SwapRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 3, TokenOutDenom: "qux"},
+			},
+			tokenIn:            sdk.NewInt64Coin("foo", 10_000),
+			expectedErrContain: "could not find denom bar",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			for _, pool := range pools {
+				app.SpotKeeper.SetPool(ctx, pool)
+			}
+
+			tokenOut, effectivePrice, feeRatio, err := app.SpotKeeper.EstimateMultiHop(ctx, tc.routes, tc.tokenIn)
+			if tc.expectedErrContain != "" {
+				require.ErrorContains(t, err, tc.expectedErrContain)
+				return
+			}
+			require.NoError(t, err)
+
+			expectedTokenOut := chainHops(tc.tokenIn, tc.routes)
+			require.Equal(t, expectedTokenOut, tokenOut)
+			require.Equal(t,
+				sdk.NewDecFromInt(tc.tokenIn.Amount).QuoInt(expectedTokenOut.Amount).String(),
+				effectivePrice.String(),
+			)
+			require.Equal(t, tc.expectedFeeRatio.String(), feeRatio.String())
+
+			// fees compound, so the output shrinks with every extra hop
+			require.True(t, tokenOut.Amount.LT(tc.tokenIn.Amount))
+		})
+	}
+}
+
+func TestSwapExactAmountInRoute(t *testing.T) {
+	newPool := func(poolId uint64, denomA, denomB string) types.Pool {
+		return mock.SpotPool(
+			poolId,
+			sdk.NewCoins(
+				sdk.NewInt64Coin(denomA, 1_000_000),
+				sdk.NewInt64Coin(denomB, 1_000_000),
+			),
+			/*shares=*/ 100,
+		)
+	}
+	routes := []types.SwapRoute{
+		{PoolId: 1, TokenOutDenom: "bar"},
+		{PoolId: 2, TokenOutDenom: "baz"},
+	}
+	tokenIn := sdk.NewInt64Coin("foo", 10_000)
+
+	tests := []struct {
+		name          string
+		routes        []types.SwapRoute
+		minOutOffset  int64
+		expectedError error
+	}{
+		{
+			name:   "two hops",
+			routes: routes,
+		},
+		{
+			name:          "token out below min out",
+			routes:        routes,
+			minOutOffset:  1,
+			expectedError: types.ErrTokenOutBelowMin,
+		},
+		{
+			name: "intermediate denom not in the next pool",
+			routes: []types.SwapRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 3, TokenOutDenom: "qux"},
+			},
+			expectedError: types.ErrTokenDenomNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+
+			pools := []types.Pool{
+				newPool(1, "foo", "bar"),
+				newPool(2, "bar", "baz"),
+				newPool(3, "baz", "qux"),
+			}
+			for i := range pools {
+				poolAddr := testutil.AccAddress()
+				pools[i].Address = poolAddr.String()
+				require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, poolAddr, pools[i].PoolBalances()))
+				app.SpotKeeper.SetPool(ctx, pools[i])
+			}
+
+			sender := testutil.AccAddress()
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(tokenIn)))
+
+			estimatedOut, _, _, err := app.SpotKeeper.EstimateMultiHop(ctx, routes, tokenIn)
+			require.NoError(t, err)
+			minOut := estimatedOut.Amount.AddRaw(tc.minOutOffset)
+
+			tokenOut, err := app.SpotKeeper.SwapExactAmountInRoute(ctx, sender, tc.routes, tokenIn, minOut)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+
+				// no hop is executed
+				require.Equal(t, sdk.NewCoins(tokenIn), app.BankKeeper.GetAllBalances(ctx, sender))
+				for _, pool := range pools {
+					finalPool, err := app.SpotKeeper.FetchPool(ctx, pool.Id)
+					require.NoError(t, err)
+					require.Equal(t, pool, finalPool)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, estimatedOut, tokenOut)
+			require.Equal(t, sdk.NewCoins(tokenOut), app.BankKeeper.GetAllBalances(ctx, sender))
+		})
+	}
+}
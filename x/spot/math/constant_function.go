@@ -1,6 +1,9 @@
 package math
 
 import (
+	"fmt"
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,29 +15,113 @@ import (
 // deltaY = balanceY * (1 - (xPrior/xAfter)^(xWeight/yWeight))
 // deltaY is positive when y's balance liquidity decreases.
 // deltaY is negative when y's balance liquidity increases.
-// panics if yWeight is 0.
+// Returns an error if the weight ratio cannot be applied, see powWeightRatio.
 //
-// TODO(https://github.com/NibiruChain/nibiru/issues/141): Currently always calculates the invariant assuming constant weight (xy=k).
-// Once we figure out the floating point arithmetic conversions for exponentiation, we can
-// add unequal weights.
+// The weight ratio is reduced to a fraction p/q and the power is taken as the
+// q-th root of (xPrior/xAfter)^p, so equal weights give the exact xy=k curve.
 func SolveConstantProductInvariant(
 	xPrior,
 	xAfter,
-	/*unused*/ _xWeight,
+	xWeight,
 	yPrior,
-	/*unused*/ _yWeight sdk.Dec,
-) (deltaY sdk.Dec) {
-	// // weightRatio = (xWeight/yWeight)
-	// weightRatio := xWeight.Quo(yWeight)
-
+	yWeight sdk.Dec,
+) (deltaY sdk.Dec, err error) {
 	// r = xPrior/xAfter
 	r := xPrior.Quo(xAfter)
 
-	// TODO(https://github.com/NibiruChain/nibiru/issues/141): Figure out floating point arithmetic for exponentation.
-	// Naive calculation could lead to significant rounding errors with large numbers
 	// amountY = yPrior * (1 - (r ^ weightRatio))
-	// rToWeightRatio := sdk.MustNewDecFromStr(
-	// 	fmt.Sprintf("%f", math.Pow(r.MustFloat64(), weightRatio.MustFloat64())),
-	// )
-	return yPrior.Mul(sdk.OneDec().Sub(r))
+	rPow, err := powWeightRatio(r, xWeight, yWeight)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return yPrior.Mul(sdk.OneDec().Sub(rPow)), nil
+}
+
+// powWeightRatio returns base^(xWeight/yWeight), where the weight ratio is
+// reduced to a fraction p/q with p and q coprime. Returns an error if either
+// weight is not positive, if p or q does not fit in a uint64, or if the q-th
+// root of base does not converge.
+func powWeightRatio(base, xWeight, yWeight sdk.Dec) (sdk.Dec, error) {
+	if !xWeight.IsPositive() || !yWeight.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("weights must be positive, got %s and %s", xWeight, yWeight)
+	}
+
+	gcd := new(big.Int).GCD(nil, nil, xWeight.BigInt(), yWeight.BigInt())
+	p := new(big.Int).Quo(xWeight.BigInt(), gcd)
+	q := new(big.Int).Quo(yWeight.BigInt(), gcd)
+	if !p.IsUint64() || !q.IsUint64() {
+		return sdk.Dec{}, fmt.Errorf("weight ratio %s/%s is too precise", xWeight, yWeight)
+	}
+
+	if q.Uint64() == 1 {
+		return base.Power(p.Uint64()), nil
+	}
+
+	root, err := base.ApproxRoot(q.Uint64())
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to take the %d-th root of %s: %w", q.Uint64(), base, err)
+	}
+	return root.Power(p.Uint64()), nil
+}
+
+// SolvePoolSharesOutGivenSingleAssetIn computes the pool shares minted for
+// depositing a single asset into a weighted pool. The deposit is treated as a
+// swap of the (1 - normalizedWeight) portion of it into the other assets
+// followed by a balanced join, so the swap fee is only charged on that portion.
+//
+// normalizedWeight = tokenWeightIn / totalWeight
+// amountInAfterFee = tokenAmountIn * (1 - (1 - normalizedWeight) * swapFee)
+// sharesOut = totalShares * ((1 + amountInAfterFee / tokenBalanceIn)^normalizedWeight - 1)
+//
+// Returns an error if the weight ratio cannot be applied, see powWeightRatio.
+func SolvePoolSharesOutGivenSingleAssetIn(
+	tokenBalanceIn,
+	tokenWeightIn,
+	totalWeight,
+	totalShares,
+	tokenAmountIn,
+	swapFee sdk.Dec,
+) (sharesOut sdk.Dec, err error) {
+	// feeRatio = (1 - normalizedWeight) * swapFee
+	feeRatio := totalWeight.Sub(tokenWeightIn).Quo(totalWeight).Mul(swapFee)
+	tokenAmountInAfterFee := tokenAmountIn.Mul(sdk.OneDec().Sub(feeRatio))
+
+	balanceRatio := sdk.OneDec().Add(tokenAmountInAfterFee.Quo(tokenBalanceIn))
+	poolRatio, err := powWeightRatio(balanceRatio, tokenWeightIn, totalWeight)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return totalShares.Mul(poolRatio.Sub(sdk.OneDec())), nil
+}
+
+// SolveTokenOutGivenPoolSharesIn computes the amount of a single asset paid out
+// for burning pool shares of a weighted pool. It is the inverse of
+// SolvePoolSharesOutGivenSingleAssetIn: the exit is treated as a balanced exit
+// followed by a swap of the other assets into the out asset, so the fee is
+// only charged on the (1 - normalizedWeight) portion of the output.
+//
+// normalizedWeight = tokenWeightOut / totalWeight
+// amountOutBeforeFee = tokenBalanceOut * (1 - (1 - sharesIn / totalShares)^(1 / normalizedWeight))
+// tokenAmountOut = amountOutBeforeFee * (1 - (1 - normalizedWeight) * exitFee)
+//
+// Returns an error if the weight ratio cannot be applied, see powWeightRatio.
+func SolveTokenOutGivenPoolSharesIn(
+	tokenBalanceOut,
+	tokenWeightOut,
+	totalWeight,
+	totalShares,
+	sharesIn,
+	exitFee sdk.Dec,
+) (tokenAmountOut sdk.Dec, err error) {
+	sharesRatio := sdk.OneDec().Sub(sharesIn.Quo(totalShares))
+	balanceRatio, err := powWeightRatio(sharesRatio, totalWeight, tokenWeightOut)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	tokenAmountOutBeforeFee := tokenBalanceOut.Mul(sdk.OneDec().Sub(balanceRatio))
+
+	// feeRatio = (1 - normalizedWeight) * exitFee
+	feeRatio := totalWeight.Sub(tokenWeightOut).Quo(totalWeight).Mul(exitFee)
+	return tokenAmountOutBeforeFee.Mul(sdk.OneDec().Sub(feeRatio)), nil
 }
@@ -37,23 +37,65 @@ func TestSolveConstantProductInvariantHappyPath(t *testing.T) {
 			yWeight:        sdk.NewDecWithPrec(5, 1),
 			expectedDeltaY: sdk.NewDecWithPrec(1122, 2),
 		},
-		// TODO(https://github.com/NibiruChain/nibiru/issues/141): allow for uneven weights
-		// {
-		// 	// 44*(1-(86/35)^(.75/.25))
-		// 	name:           "difficult numbers - uneven weights",
-		// 	xPrior:         sdk.NewDec(86),
-		// 	xAfter:         sdk.NewDec(35),
-		// 	xWeight:        sdk.NewDecWithPrec(75, 2),
-		// 	yPrior:         sdk.NewDec(44),
-		// 	yWeight:        sdk.NewDecWithPrec(25, 2),
-		// 	expectedDeltaY: sdk.NewDecWithPrec(-60874551603, 8),
-		// },
+		{
+			// 44*(1-(86/35)^(.75/.25))
+			name:           "difficult numbers - uneven weights",
+			xPrior:         sdk.NewDec(86),
+			xAfter:         sdk.NewDec(35),
+			xWeight:        sdk.NewDecWithPrec(75, 2),
+			yPrior:         sdk.NewDec(44),
+			yWeight:        sdk.NewDecWithPrec(25, 2),
+			expectedDeltaY: sdk.NewDecWithPrec(-60874551603, 8),
+		},
+		{
+			// 100*(1-(100/200)^(.20/.80))
+			name:           "fractional weight ratio",
+			xPrior:         sdk.NewDec(100),
+			xAfter:         sdk.NewDec(200),
+			xWeight:        sdk.NewDecWithPrec(2, 1),
+			yPrior:         sdk.NewDec(100),
+			yWeight:        sdk.NewDecWithPrec(8, 1),
+			expectedDeltaY: sdk.MustNewDecFromStr("15.910358474628546"),
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			deltaY := SolveConstantProductInvariant(
+			deltaY, err := SolveConstantProductInvariant(
 				tc.xPrior, tc.xAfter, tc.xWeight, tc.yPrior, tc.yWeight)
+			require.NoError(t, err)
 			require.InDelta(t, tc.expectedDeltaY.MustFloat64(), deltaY.MustFloat64(), 0.0001)
 		})
 	}
 }
+
+func TestSolveConstantProductInvariantInvalidWeights(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		xWeight sdk.Dec
+		yWeight sdk.Dec
+	}{
+		{
+			name:    "zero weight",
+			xWeight: sdk.ZeroDec(),
+			yWeight: sdk.OneDec(),
+		},
+		{
+			name:    "negative weight",
+			xWeight: sdk.OneDec(),
+			yWeight: sdk.NewDec(-1),
+		},
+		{
+			// 1 / (2^64 + 1) cannot be reduced to a uint64 root
+			name:    "weight ratio too precise",
+			xWeight: sdk.OneDec(),
+			yWeight: sdk.MustNewDecFromStr("18446744073709551617"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := SolveConstantProductInvariant(
+				sdk.NewDec(100), sdk.NewDec(120), tc.xWeight, sdk.NewDec(100), tc.yWeight)
+			require.Error(t, err)
+		})
+	}
+}
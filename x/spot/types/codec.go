@@ -12,6 +12,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgJoinPool{}, "spot/JoinPool", nil)
 	cdc.RegisterConcrete(&MsgExitPool{}, "spot/ExitPool", nil)
 	cdc.RegisterConcrete(&MsgSwapAssets{}, "spot/SwapAssets", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolParams{}, "spot/UpdatePoolParams", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolAllowlist{}, "spot/UpdatePoolAllowlist", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,6 +24,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgJoinPool{},
 		&MsgExitPool{},
 		&MsgSwapAssets{},
+		&MsgUpdatePoolParams{},
+		&MsgUpdatePoolAllowlist{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -9,6 +11,9 @@ const (
 	MinPoolAssets = 2
 	// MaxPoolAssets maximum number of assets a pool may have
 	MaxPoolAssets = 2
+	// MaxPoolAssetsSupported maximum number of assets the pool math supports,
+	// MaxPoolAssets is the lower cap currently enforced on new pools
+	MaxPoolAssetsSupported = 8
 
 	// DisplayPoolShareExponent the exponent of a pool display share compared to a pool base share (one pool display share = 10^18 pool base shares)
 	DisplayPoolShareExponent = 18
@@ -34,4 +39,7 @@ var (
 	// This is done so that LBP's / smooth weight changes can actually happen smoothly,
 	// without complex precision loss / edge effects.
 	MaxUserSpecifiedWeight = sdkmath.NewIntFromUint64(1 << 20)
+
+	// MaxSwapFee is the highest swap fee governance can set on an existing pool.
+	MaxSwapFee = sdk.NewDecWithPrec(1, 1)
 )
@@ -39,4 +39,12 @@ var (
 	ErrSameTokenDenom     = sdkerrors.Register(ModuleName, 14, "cannot use same token denom to swap in and out")
 
 	ErrNotImplemented = sdkerrors.Register(ModuleName, 18, "not implemented")
+
+	ErrAddressNotAllowed = sdkerrors.Register(ModuleName, 24, "address is not on the pool allowlist")
+
+	ErrTokenOutBelowMin = sdkerrors.Register(ModuleName, 25, "token out is below the minimum amount")
+
+	ErrMissingAssetPrice = sdkerrors.Register(ModuleName, 26, "missing price for pool asset")
+
+	ErrInvalidTokenOut = sdkerrors.Register(ModuleName, 27, "invalid token out")
 )
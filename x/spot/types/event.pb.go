@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -357,50 +358,105 @@ func (m *EventAssetsSwapped) GetFinalPool() Pool {
 	return Pool{}
 }
 
+type EventPoolParamsUpdated struct {
+	// the id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// the new swap fee of the pool
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
+	// the new exit fee of the pool
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee"`
+}
+
+func (m *EventPoolParamsUpdated) Reset()         { *m = EventPoolParamsUpdated{} }
+func (m *EventPoolParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPoolParamsUpdated) ProtoMessage()    {}
+func (*EventPoolParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23fa99c8c3a21a65, []int{4}
+}
+func (m *EventPoolParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPoolParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolParamsUpdated.Merge(m, src)
+}
+func (m *EventPoolParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolParamsUpdated proto.InternalMessageInfo
+
+func (m *EventPoolParamsUpdated) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventPoolCreated)(nil), "nibiru.spot.v1.EventPoolCreated")
 	proto.RegisterType((*EventPoolJoined)(nil), "nibiru.spot.v1.EventPoolJoined")
 	proto.RegisterType((*EventPoolExited)(nil), "nibiru.spot.v1.EventPoolExited")
 	proto.RegisterType((*EventAssetsSwapped)(nil), "nibiru.spot.v1.EventAssetsSwapped")
+	proto.RegisterType((*EventPoolParamsUpdated)(nil), "nibiru.spot.v1.EventPoolParamsUpdated")
 }
 
 func init() { proto.RegisterFile("nibiru/spot/v1/event.proto", fileDescriptor_23fa99c8c3a21a65) }
 
 var fileDescriptor_23fa99c8c3a21a65 = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x13, 0xb7, 0x25, 0x57, 0x68, 0x91, 0xa9, 0x90, 0x9b, 0xc1, 0x44, 0x9d, 0x22, 0x86,
-	0xb3, 0xdc, 0x4e, 0x20, 0x84, 0x44, 0x43, 0x84, 0xc2, 0x00, 0xa8, 0x85, 0x85, 0xc5, 0xb2, 0x93,
-	0x97, 0xe4, 0x44, 0x72, 0xcf, 0xba, 0x3b, 0x87, 0x32, 0xf2, 0x0f, 0x98, 0xf8, 0x4d, 0x1d, 0x3b,
-	0x32, 0x21, 0x94, 0xfc, 0x03, 0x26, 0x46, 0x74, 0x77, 0x4e, 0x08, 0x1d, 0x5a, 0x97, 0x32, 0x74,
-	0xbb, 0xf3, 0x7b, 0xdf, 0x7d, 0xef, 0x7d, 0xdf, 0xf3, 0x23, 0x0d, 0xce, 0x52, 0x26, 0xf2, 0x50,
-	0x66, 0xa8, 0xc2, 0x69, 0x14, 0xc2, 0x14, 0xb8, 0xa2, 0x99, 0x40, 0x85, 0xde, 0x96, 0x8d, 0x51,
-	0x1d, 0xa3, 0xd3, 0xa8, 0xb1, 0x33, 0xc4, 0x21, 0x9a, 0x50, 0xa8, 0x4f, 0x36, 0xab, 0x11, 0xf4,
-	0x50, 0x4e, 0x50, 0x86, 0x69, 0x22, 0x21, 0x9c, 0x46, 0x29, 0xa8, 0x24, 0x0a, 0x7b, 0xc8, 0x78,
-	0x11, 0xdf, 0x3d, 0xc7, 0x90, 0x21, 0x8e, 0x6d, 0x68, 0xef, 0xa7, 0x43, 0xee, 0x76, 0x34, 0xe1,
-	0x1b, 0xc4, 0x71, 0x5b, 0x40, 0xa2, 0xa0, 0xef, 0xf9, 0x64, 0xa3, 0xa7, 0x8f, 0x28, 0x7c, 0xa7,
-	0xe9, 0xb4, 0xea, 0x47, 0x8b, 0xab, 0x77, 0x40, 0xdc, 0x01, 0x80, 0xf4, 0xab, 0xcd, 0x5a, 0x6b,
-	0x73, 0x7f, 0x97, 0x5a, 0x62, 0xaa, 0x89, 0x69, 0x41, 0x4c, 0xdb, 0xc8, 0xf8, 0xa1, 0x7b, 0xfa,
-	0xfd, 0x41, 0xe5, 0xc8, 0x24, 0x7b, 0x8f, 0x08, 0x19, 0x30, 0x9e, 0x8c, 0x63, 0xcd, 0xeb, 0xbb,
-	0x4d, 0xa7, 0xb5, 0xb9, 0xbf, 0x43, 0xff, 0xee, 0x8c, 0x6a, 0xfe, 0x02, 0x55, 0x37, 0xd9, 0xfa,
-	0x83, 0xf7, 0x96, 0xdc, 0xb7, 0xd0, 0x5c, 0x82, 0x30, 0xf8, 0x58, 0x8e, 0x12, 0x01, 0xd2, 0x5f,
-	0x33, 0xcf, 0x5c, 0x5a, 0xc1, 0x3d, 0x03, 0x7f, 0x27, 0x41, 0xe8, 0xf7, 0x8e, 0x0d, 0x76, 0xef,
-	0x73, 0x8d, 0x6c, 0x2f, 0x9b, 0x7e, 0x89, 0x8c, 0xdb, 0x9e, 0x93, 0x7e, 0x5f, 0x80, 0x94, 0x8b,
-	0x9e, 0x8b, 0xab, 0xf7, 0x84, 0xd4, 0x15, 0x7e, 0x00, 0x2e, 0x63, 0xc6, 0xcb, 0x36, 0x7e, 0xcb,
-	0x22, 0xba, 0xdc, 0x7b, 0x41, 0xb6, 0x57, 0xca, 0x8e, 0x31, 0x57, 0x7e, 0xad, 0x5c, 0xe9, 0x77,
-	0xb2, 0x65, 0xc5, 0xaf, 0x73, 0xa5, 0xcb, 0x10, 0x30, 0x89, 0xb5, 0xad, 0xd2, 0x77, 0x4b, 0x96,
-	0x21, 0x60, 0xa2, 0xaf, 0xe7, 0x3d, 0x58, 0xfb, 0x3f, 0x1e, 0xac, 0x5f, 0xc3, 0x83, 0x5f, 0xd5,
-	0x15, 0x0f, 0x3a, 0x27, 0x4c, 0x5d, 0xe8, 0x41, 0x87, 0x6c, 0xad, 0xaa, 0x68, 0x8c, 0x28, 0xc5,
-	0x7d, 0xfb, 0x8f, 0x88, 0x5d, 0xee, 0x3d, 0x25, 0xa4, 0xb0, 0xd2, 0xfa, 0x50, 0x4a, 0xc4, 0xc2,
-	0x7d, 0xed, 0xc1, 0x62, 0xfc, 0xdd, 0x7f, 0x1f, 0xff, 0x1b, 0x20, 0xfd, 0xd7, 0x2a, 0xf1, 0x8c,
-	0xf4, 0xcf, 0xa4, 0x04, 0x25, 0x8f, 0x3f, 0x26, 0x59, 0x76, 0xa1, 0xfa, 0x8f, 0x89, 0x9d, 0xe7,
-	0x2b, 0xe8, 0xbe, 0x61, 0x00, 0x5d, 0xbe, 0xfc, 0x7b, 0xae, 0x32, 0xf9, 0x96, 0x4d, 0x0b, 0x1e,
-	0x91, 0xda, 0x00, 0xa0, 0xd8, 0x19, 0x97, 0xe2, 0x74, 0xee, 0x35, 0xe4, 0x3e, 0x7c, 0x7e, 0x3a,
-	0x0b, 0x9c, 0xb3, 0x59, 0xe0, 0xfc, 0x98, 0x05, 0xce, 0x97, 0x79, 0x50, 0x39, 0x9b, 0x07, 0x95,
-	0x6f, 0xf3, 0xa0, 0xf2, 0xfe, 0xe1, 0x90, 0xa9, 0x51, 0x9e, 0xd2, 0x1e, 0x4e, 0xc2, 0x57, 0xe6,
-	0xa9, 0xf6, 0x28, 0x61, 0x3c, 0x2c, 0x16, 0xeb, 0x89, 0x5d, 0xad, 0xea, 0x53, 0x06, 0x32, 0x5d,
-	0x37, 0x9b, 0xf5, 0xe0, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xf6, 0x0e, 0x47, 0xd8, 0x05,
-	0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0x66, 0xcb, 0x16, 0xca, 0x54, 0x5b, 0xb3, 0x36, 0x75, 0xcb, 0x61, 0x4b, 0x38, 0x18, 0x62,
+	0xe2, 0x6c, 0x68, 0x4f, 0x1a, 0x63, 0x22, 0x94, 0x1a, 0x3c, 0x68, 0x43, 0xed, 0xc5, 0xcb, 0x66,
+	0x61, 0x1f, 0x30, 0x29, 0xcc, 0x6c, 0x66, 0x06, 0x8a, 0x47, 0xff, 0x81, 0x27, 0xff, 0x92, 0x3d,
+	0xf6, 0x68, 0x3c, 0x34, 0x06, 0xfe, 0x81, 0x27, 0x8f, 0x66, 0x66, 0x16, 0xc4, 0x1e, 0x9a, 0x6d,
+	0xeb, 0xc1, 0x13, 0x3b, 0xbc, 0xf7, 0xbd, 0xef, 0xbd, 0xef, 0x7b, 0xbb, 0x83, 0x8a, 0x94, 0xb4,
+	0x09, 0x1f, 0xf9, 0x22, 0x66, 0xd2, 0x1f, 0x57, 0x7d, 0x18, 0x03, 0x95, 0x38, 0xe6, 0x4c, 0x32,
+	0x67, 0xc3, 0xc4, 0xb0, 0x8a, 0xe1, 0x71, 0xb5, 0xb8, 0xd5, 0x63, 0x3d, 0xa6, 0x43, 0xbe, 0x7a,
+	0x32, 0x59, 0x45, 0xaf, 0xc3, 0xc4, 0x90, 0x09, 0xbf, 0x1d, 0x0a, 0xf0, 0xc7, 0xd5, 0x36, 0xc8,
+	0xb0, 0xea, 0x77, 0x18, 0xa1, 0x49, 0x7c, 0xe7, 0x0a, 0x43, 0xcc, 0xd8, 0xc0, 0x84, 0xca, 0x3f,
+	0x2d, 0xf4, 0xa0, 0xa1, 0x08, 0x8f, 0x18, 0x1b, 0xd4, 0x39, 0x84, 0x12, 0x22, 0xc7, 0x45, 0xf9,
+	0x8e, 0x7a, 0x64, 0xdc, 0xb5, 0x4a, 0x56, 0xa5, 0xd0, 0x9a, 0x1f, 0x9d, 0x7d, 0x64, 0x77, 0x01,
+	0x84, 0xbb, 0x52, 0xca, 0x56, 0xd6, 0xf7, 0x76, 0xb0, 0x21, 0xc6, 0x8a, 0x18, 0x27, 0xc4, 0xb8,
+	0xce, 0x08, 0xad, 0xd9, 0xe7, 0x97, 0xbb, 0x99, 0x96, 0x4e, 0x76, 0x9e, 0x21, 0xd4, 0x25, 0x34,
+	0x1c, 0x04, 0x8a, 0xd7, 0xb5, 0x4b, 0x56, 0x65, 0x7d, 0x6f, 0x0b, 0xff, 0x3d, 0x19, 0x56, 0xfc,
+	0x09, 0xaa, 0xa0, 0xb3, 0xd5, 0x1f, 0xce, 0x7b, 0xb4, 0x6d, 0xa0, 0x23, 0x01, 0x5c, 0xe3, 0x03,
+	0xd1, 0x0f, 0x39, 0x08, 0x77, 0xb5, 0x64, 0xa5, 0xe9, 0xe0, 0xa1, 0x86, 0x9f, 0x08, 0xe0, 0xaa,
+	0xde, 0xb1, 0xc6, 0x96, 0x3f, 0x65, 0xd1, 0xe6, 0x62, 0xe8, 0x37, 0x8c, 0x50, 0x33, 0x73, 0x18,
+	0x45, 0x1c, 0x84, 0x98, 0xcf, 0x9c, 0x1c, 0x9d, 0x17, 0xa8, 0x20, 0xd9, 0x29, 0x50, 0x11, 0x10,
+	0x9a, 0x76, 0xf0, 0x35, 0x83, 0x68, 0x52, 0xe7, 0x35, 0xda, 0x5c, 0x6a, 0x3b, 0x60, 0x23, 0xe9,
+	0x66, 0xd3, 0xb5, 0x7e, 0x3f, 0x5e, 0x74, 0xfc, 0x6e, 0x24, 0x55, 0x1b, 0x1c, 0x86, 0x81, 0xb2,
+	0x55, 0xb8, 0x76, 0xca, 0x36, 0x38, 0x0c, 0xd5, 0xf1, 0xaa, 0x07, 0xab, 0xff, 0xc6, 0x83, 0xdc,
+	0x1d, 0x3c, 0xf8, 0xb5, 0xb2, 0xe4, 0x41, 0x63, 0x42, 0xe4, 0xb5, 0x1e, 0x34, 0xd0, 0xc6, 0xb2,
+	0x8a, 0xda, 0x88, 0x54, 0xdc, 0xf7, 0xfe, 0x88, 0xd8, 0xa4, 0xce, 0x4b, 0x84, 0x12, 0x2b, 0x8d,
+	0x0f, 0xa9, 0x44, 0x4c, 0xdc, 0x57, 0x1e, 0xcc, 0xd7, 0xdf, 0xbe, 0xfd, 0xfa, 0xff, 0x07, 0xd2,
+	0x7f, 0x59, 0x41, 0x8e, 0x96, 0xfe, 0x95, 0x10, 0x20, 0xc5, 0xf1, 0x59, 0x18, 0xc7, 0xd7, 0xaa,
+	0xff, 0x1c, 0x99, 0x7d, 0xbe, 0x81, 0xee, 0x79, 0x0d, 0x68, 0xd2, 0xc5, 0xdb, 0x73, 0x93, 0xcd,
+	0x37, 0x6c, 0x4a, 0xf0, 0x2a, 0xca, 0x76, 0x01, 0x5c, 0x3b, 0x1d, 0x4e, 0xe5, 0xde, 0x41, 0xee,
+	0xf2, 0x57, 0x0b, 0x6d, 0x2f, 0x76, 0xf2, 0x28, 0xe4, 0xe1, 0x50, 0x9c, 0xc4, 0x91, 0xfe, 0x24,
+	0x3e, 0x42, 0x79, 0x2d, 0x3f, 0x89, 0xb4, 0x38, 0x76, 0x2b, 0xa7, 0x8e, 0xcd, 0xc8, 0x69, 0xa2,
+	0x35, 0x71, 0x16, 0xc6, 0x81, 0x6a, 0x53, 0x69, 0x53, 0xa8, 0x61, 0x55, 0xf6, 0xfb, 0xe5, 0xee,
+	0xe3, 0x1e, 0x91, 0xfd, 0x51, 0x1b, 0x77, 0xd8, 0xd0, 0x4f, 0x3e, 0xd0, 0xe6, 0xe7, 0xa9, 0x88,
+	0x4e, 0x7d, 0xf9, 0x31, 0x06, 0x81, 0x0f, 0xa0, 0xd3, 0xca, 0x2b, 0xfc, 0x21, 0x80, 0x2a, 0x05,
+	0x13, 0x22, 0x75, 0xa9, 0xec, 0xed, 0x4a, 0x29, 0xfc, 0x21, 0x40, 0xed, 0xe0, 0x7c, 0xea, 0x59,
+	0x17, 0x53, 0xcf, 0xfa, 0x31, 0xf5, 0xac, 0xcf, 0x33, 0x2f, 0x73, 0x31, 0xf3, 0x32, 0xdf, 0x66,
+	0x5e, 0xe6, 0xc3, 0x93, 0xa5, 0x52, 0x6f, 0xb5, 0x28, 0xf5, 0x7e, 0x48, 0xa8, 0x9f, 0x5c, 0x11,
+	0x13, 0x73, 0x49, 0xe8, 0x92, 0xed, 0x9c, 0xbe, 0x23, 0xf6, 0x7f, 0x0f, 0x00, 0x83, 0x07, 0x5b,
+	0xbe, 0xa2, 0x06, 0x00, 0x00,
 }
 
 func (m *EventPoolCreated) Marshal() (dAtA []byte, err error) {
@@ -713,6 +769,54 @@ func (m *EventAssetsSwapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPoolParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -830,6 +934,22 @@ func (m *EventAssetsSwapped) Size() (n int) {
 	return n
 }
 
+func (m *EventPoolParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvent(uint64(m.PoolId))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1730,6 +1850,143 @@ func (m *EventAssetsSwapped) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPoolParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -51,3 +53,8 @@ type BankKeeper interface {
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// OracleKeeper defines the contract needed to read asset prices from the oracle.
+type OracleKeeper interface {
+	GetExchangeRate(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
+}
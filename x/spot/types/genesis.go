@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -11,5 +17,30 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	poolIds := make(map[uint64]bool)
+	for _, pool := range gs.Pools {
+		if err := pool.ValidateTotalShares(); err != nil {
+			return err
+		}
+		poolIds[pool.Id] = true
+	}
+
+	allowlistPoolIds := make(map[uint64]bool)
+	for _, allowlist := range gs.PoolAllowlists {
+		if !poolIds[allowlist.PoolId] {
+			return fmt.Errorf("allowlist of unknown pool %d", allowlist.PoolId)
+		}
+		if allowlistPoolIds[allowlist.PoolId] {
+			return fmt.Errorf("duplicate allowlist of pool %d", allowlist.PoolId)
+		}
+		allowlistPoolIds[allowlist.PoolId] = true
+
+		for _, addr := range allowlist.Addresses {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return fmt.Errorf("invalid address %s in allowlist of pool %d: %w", addr, allowlist.PoolId, err)
+			}
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -29,6 +29,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// pools defines all the pools of the module.
 	Pools []Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools"`
+	// pool_allowlists defines the addresses allowed to swap with and join the
+	// pools that have an allowlist.
+	PoolAllowlists []PoolAllowlist `protobuf:"bytes,3,rep,name=pool_allowlists,json=poolAllowlists,proto3" json:"pool_allowlists"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,29 +81,95 @@ func (m *GenesisState) GetPools() []Pool {
 	return nil
 }
 
+func (m *GenesisState) GetPoolAllowlists() []PoolAllowlist {
+	if m != nil {
+		return m.PoolAllowlists
+	}
+	return nil
+}
+
+// PoolAllowlist is the allowlist of a pool, used in the spot module's genesis
+// state.
+type PoolAllowlist struct {
+	PoolId    uint64   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *PoolAllowlist) Reset()         { *m = PoolAllowlist{} }
+func (m *PoolAllowlist) String() string { return proto.CompactTextString(m) }
+func (*PoolAllowlist) ProtoMessage()    {}
+func (*PoolAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2772e1e838a47ec, []int{1}
+}
+func (m *PoolAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAllowlist.Merge(m, src)
+}
+func (m *PoolAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolAllowlist proto.InternalMessageInfo
+
+func (m *PoolAllowlist) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolAllowlist) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.spot.v1.GenesisState")
+	proto.RegisterType((*PoolAllowlist)(nil), "nibiru.spot.v1.PoolAllowlist")
 }
 
 func init() { proto.RegisterFile("nibiru/spot/v1/genesis.proto", fileDescriptor_f2772e1e838a47ec) }
 
 var fileDescriptor_f2772e1e838a47ec = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
+	// 301 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xcb, 0x4c, 0xca,
 	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0xc8, 0x2f, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
 	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xea, 0x81, 0x64, 0xf5,
 	0xca, 0x0c, 0xa5, 0xa4, 0xd1, 0x54, 0x17, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x15, 0x4b, 0x49, 0xa2,
 	0x4b, 0xe6, 0xe7, 0xe7, 0x40, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b,
-	0x22, 0xaa, 0x54, 0xc6, 0xc5, 0xe3, 0x0e, 0xb1, 0x2e, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x84,
-	0x8b, 0x0d, 0x62, 0xa0, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x98, 0x1e, 0xaa, 0xf5, 0x7a,
-	0x01, 0x60, 0x59, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x6a, 0x85, 0x0c, 0xb8, 0x58,
-	0x41, 0x36, 0x15, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0x60, 0x68, 0xca, 0xcf, 0xcf,
-	0x81, 0x6a, 0x81, 0x28, 0x74, 0x72, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xad, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x3f, 0xb0, 0x31,
-	0xce, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x50, 0x9f, 0x55, 0x40, 0xfc, 0x56, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0xf6, 0x84, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x16, 0xfb, 0x27, 0x7a, 0x42,
-	0x01, 0x00, 0x00,
+	0x22, 0xaa, 0x74, 0x84, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x5f, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90,
+	0x09, 0x17, 0x1b, 0xc4, 0x44, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x31, 0x3d, 0x54, 0xfb,
+	0xf5, 0x02, 0xc0, 0xb2, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xd5, 0x0a, 0x19, 0x70,
+	0xb1, 0x82, 0xac, 0x2a, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x12, 0xc1, 0xd0, 0x94, 0x9f,
+	0x9f, 0x03, 0xd5, 0x02, 0x51, 0x28, 0xe4, 0xc3, 0xc5, 0x0f, 0x62, 0xc4, 0x27, 0xe6, 0xe4, 0xe4,
+	0x97, 0xe7, 0x64, 0x16, 0x97, 0x14, 0x4b, 0x30, 0x83, 0xf5, 0xca, 0x62, 0xd3, 0xeb, 0x08, 0x53,
+	0x05, 0x35, 0x84, 0xaf, 0x00, 0x59, 0xb0, 0x58, 0xc9, 0x8d, 0x8b, 0x17, 0x45, 0x99, 0x90, 0x38,
+	0x17, 0x3b, 0xd8, 0xf8, 0xcc, 0x14, 0xb0, 0x3f, 0x58, 0x82, 0xd8, 0x40, 0x5c, 0xcf, 0x14, 0x21,
+	0x19, 0x2e, 0xce, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0x54, 0x88, 0x6b, 0x39, 0x83, 0x10,
+	0x02, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
+	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e,
+	0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x07, 0x76, 0xa0, 0x73, 0x46, 0x62,
+	0x66, 0x9e, 0x3e, 0x34, 0xc0, 0x2b, 0x20, 0x41, 0x5e, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
+	0x0e, 0x5b, 0x63, 0xc0, 0x00, 0xe9, 0x99, 0x21, 0x57, 0xd9, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +192,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolAllowlists) > 0 {
+		for iNdEx := len(m.PoolAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Pools) > 0 {
 		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -150,6 +233,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -175,6 +295,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolAllowlists) > 0 {
+		for _, e := range m.PoolAllowlists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -280,6 +424,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAllowlists = append(m.PoolAllowlists, PoolAllowlist{})
+			if err := m.PoolAllowlists[len(m.PoolAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,8 +3,10 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/spot/types"
 )
 
@@ -24,6 +26,66 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "pool with total shares in another denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Pools: []types.Pool{
+					{Id: 1, TotalShares: sdk.NewInt64Coin("nibiru/pool/2", 100)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pool allowlist",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Pools: []types.Pool{
+					{Id: 1, TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 100)},
+				},
+				PoolAllowlists: []types.PoolAllowlist{
+					{PoolId: 1, Addresses: []string{testutil.AccAddress().String()}},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "allowlist of unknown pool",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PoolAllowlists: []types.PoolAllowlist{
+					{PoolId: 1, Addresses: []string{testutil.AccAddress().String()}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate pool allowlist",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Pools: []types.Pool{
+					{Id: 1, TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 100)},
+				},
+				PoolAllowlists: []types.PoolAllowlist{
+					{PoolId: 1, Addresses: []string{testutil.AccAddress().String()}},
+					{PoolId: 1, Addresses: []string{testutil.AccAddress().String()}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid allowlist address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Pools: []types.Pool{
+					{Id: 1, TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 100)},
+				},
+				PoolAllowlists: []types.PoolAllowlist{
+					{PoolId: 1, Addresses: []string{"invalid"}},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -37,6 +37,9 @@ var (
 	KeyTotalLiquidity = []byte{0x03}
 	// KeyPrefixPoolIds defines prefix to store pool ids by denoms in the pool
 	KeyPrefixPoolIds = []byte{0x04}
+	// KeyPrefixPoolAllowlist defines prefix to store the addresses allowed to
+	// swap with and join a pool
+	KeyPrefixPoolAllowlist = []byte{0x05}
 )
 
 func GetDenomPrefixPoolIds(denoms ...string) []byte {
@@ -52,3 +55,11 @@ func GetKeyPrefixPools(poolId uint64) []byte {
 func GetDenomLiquidityPrefix(denom string) []byte {
 	return append(KeyTotalLiquidity, []byte(denom)...)
 }
+
+func GetPoolAllowlistPrefix(poolId uint64) []byte {
+	return append(append([]byte{}, KeyPrefixPoolAllowlist...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetPoolAllowlistKey(poolId uint64, addr sdk.AccAddress) []byte {
+	return append(GetPoolAllowlistPrefix(poolId), addr...)
+}
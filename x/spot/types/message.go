@@ -11,6 +11,9 @@ const (
 	TypeMsgJoinPool   = "join_pool"
 	TypeMsgSwapAssets = "swap_assets"
 	TypeMsgCreatePool = "create_pool"
+
+	TypeMsgUpdatePoolParams    = "update_pool_params"
+	TypeMsgUpdatePoolAllowlist = "update_pool_allowlist"
 )
 
 var (
@@ -18,6 +21,8 @@ var (
 	_ sdk.Msg = &MsgJoinPool{}
 	_ sdk.Msg = &MsgSwapAssets{}
 	_ sdk.Msg = &MsgCreatePool{}
+	_ sdk.Msg = &MsgUpdatePoolParams{}
+	_ sdk.Msg = &MsgUpdatePoolAllowlist{}
 )
 
 func NewMsgExitPool(sender string, poolId uint64, poolShares sdk.Coin) *MsgExitPool {
@@ -224,3 +229,81 @@ func (msg *MsgCreatePool) ValidateBasic() error {
 
 	return nil
 }
+
+func (msg *MsgUpdatePoolParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdatePoolParams) Type() string {
+	return TypeMsgUpdatePoolParams
+}
+
+func (msg *MsgUpdatePoolParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdatePoolParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdatePoolParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.SwapFee.IsNil() || msg.SwapFee.IsNegative() || msg.SwapFee.GT(MaxSwapFee) {
+		return ErrInvalidSwapFee.Wrapf("swap fee must be in [0, %s], got %s", MaxSwapFee, msg.SwapFee)
+	}
+
+	if msg.ExitFee.IsNil() || msg.ExitFee.IsNegative() || msg.ExitFee.GTE(sdk.OneDec()) {
+		return ErrInvalidExitFee.Wrapf("exit fee must be in [0, 1), got %s", msg.ExitFee)
+	}
+
+	return nil
+}
+
+func (msg *MsgUpdatePoolAllowlist) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdatePoolAllowlist) Type() string {
+	return TypeMsgUpdatePoolAllowlist
+}
+
+func (msg *MsgUpdatePoolAllowlist) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdatePoolAllowlist) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdatePoolAllowlist) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return errors.ErrInvalidRequest.Wrap("no addresses to add or remove")
+	}
+
+	for _, addr := range append(append([]string{}, msg.Add...), msg.Remove...) {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid allowlist address (%s)", err)
+		}
+	}
+
+	return nil
+}
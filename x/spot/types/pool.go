@@ -84,6 +84,18 @@ func NewPool(
 	return pool, nil
 }
 
+// ValidateTotalShares checks that the total LP shares of the pool are
+// denominated in the pool's share denom and are not negative.
+func (pool Pool) ValidateTotalShares() error {
+	if shareDenom := GetPoolShareBaseDenom(pool.Id); pool.TotalShares.Denom != shareDenom {
+		return fmt.Errorf("total shares of pool %d must be in %s, not: %s", pool.Id, shareDenom, pool.TotalShares.Denom)
+	}
+	if pool.TotalShares.Amount.IsNil() || pool.TotalShares.Amount.IsNegative() {
+		return fmt.Errorf("total shares of pool %d must not be negative: %s", pool.Id, pool.TotalShares)
+	}
+	return nil
+}
+
 /*
 Ensure the denoms of the tokens in are assets of the pool
 
@@ -119,16 +131,7 @@ ret:
 func (pool *Pool) AddTokensToPool(tokensIn sdk.Coins) (
 	numShares sdkmath.Int, remCoins sdk.Coins, err error,
 ) {
-	if pool.TotalShares.Amount.IsZero() {
-		// Mint the initial 100.000000000000000000 pool share tokens to the sender
-		numShares = InitPoolSharesSupply
-		remCoins = sdk.Coins{}
-	} else if pool.PoolParams.PoolType == PoolType_STABLESWAP {
-		numShares, err = pool.numSharesOutFromTokensInStableSwap(tokensIn)
-		remCoins = sdk.Coins{}
-	} else {
-		numShares, remCoins, err = pool.numSharesOutFromTokensIn(tokensIn)
-	}
+	numShares, remCoins, err = pool.CalcJoinPoolShares(tokensIn)
 	if err != nil {
 		return sdk.ZeroInt(), sdk.Coins{}, err
 	}
@@ -263,12 +266,30 @@ func (pool *Pool) updatePoolAssetBalances(tokens ...sdk.Coin) (err error) {
 // If the same denom's PoolAsset exists, will return error.
 // The list of PoolAssets must be sorted. This is done to enable fast searching for a PoolAsset by denomination.
 func (pool *Pool) setInitialPoolAssets(poolAssets []PoolAsset) (err error) {
+	if len(poolAssets) < MinPoolAssets {
+		return ErrTooFewPoolAssets.Wrapf("invalid number of assets (%d)", len(poolAssets))
+	}
+	if len(poolAssets) > MaxPoolAssetsSupported {
+		return ErrTooManyPoolAssets.Wrapf("invalid number of assets (%d), at most %d are supported", len(poolAssets), MaxPoolAssetsSupported)
+	}
+
 	exists := make(map[string]bool)
 
 	newTotalWeight := sdk.ZeroInt()
 	scaledPoolAssets := make([]PoolAsset, 0, len(poolAssets))
 
 	for _, asset := range poolAssets {
+		// weights are checked before they are scaled by GuaranteedWeightPrecision
+		if asset.Weight.IsNil() || !asset.Weight.IsPositive() {
+			return ErrInvalidTokenWeight.Wrapf("invalid token weight %s for denom %s", asset.Weight, asset.Token.Denom)
+		}
+		// bound the weight before scaling it, so that the scaled weight and the
+		// total weight stay far from the bit length limit of sdkmath.Int
+		if asset.Weight.GTE(MaxUserSpecifiedWeight) {
+			return ErrInvalidTokenWeight.Wrapf(
+				"token weight %s for denom %s must be less than %s", asset.Weight, asset.Token.Denom, MaxUserSpecifiedWeight)
+		}
+
 		if err = asset.Validate(); err != nil {
 			return err
 		}
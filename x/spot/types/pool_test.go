@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	fmt "fmt"
 	"log"
+	"math/big"
 	"os"
 	"strconv"
 	"testing"
@@ -136,6 +137,137 @@ func TestNewPool(t *testing.T) {
 	}, pool)
 }
 
+func TestNewPoolValidation(t *testing.T) {
+	newAssets := func(n int) []PoolAsset {
+		poolAssets := make([]PoolAsset, n)
+		for i := range poolAssets {
+			poolAssets[i] = PoolAsset{
+				Token:  sdk.NewInt64Coin(fmt.Sprintf("denom%d", i), 100),
+				Weight: sdk.OneInt(),
+			}
+		}
+		return poolAssets
+	}
+	withWeight := func(poolAssets []PoolAsset, weight sdkmath.Int) []PoolAsset {
+		poolAssets[0].Weight = weight
+		return poolAssets
+	}
+
+	for _, tc := range []struct {
+		name        string
+		poolAssets  []PoolAsset
+		expectedErr error
+	}{
+		{
+			name:        "no assets",
+			poolAssets:  newAssets(0),
+			expectedErr: ErrTooFewPoolAssets,
+		},
+		{
+			name:        "single asset",
+			poolAssets:  newAssets(1),
+			expectedErr: ErrTooFewPoolAssets,
+		},
+		{
+			name:       "min assets",
+			poolAssets: newAssets(MinPoolAssets),
+		},
+		{
+			name:       "max supported assets",
+			poolAssets: newAssets(MaxPoolAssetsSupported),
+		},
+		{
+			name:        "more than max supported assets",
+			poolAssets:  newAssets(MaxPoolAssetsSupported + 1),
+			expectedErr: ErrTooManyPoolAssets,
+		},
+		{
+			name:        "zero weight",
+			poolAssets:  withWeight(newAssets(2), sdk.ZeroInt()),
+			expectedErr: ErrInvalidTokenWeight,
+		},
+		{
+			name:        "negative weight",
+			poolAssets:  withWeight(newAssets(2), sdk.NewInt(-1)),
+			expectedErr: ErrInvalidTokenWeight,
+		},
+		{
+			name:        "nil weight",
+			poolAssets:  withWeight(newAssets(2), sdkmath.Int{}),
+			expectedErr: ErrInvalidTokenWeight,
+		},
+		{
+			name:       "largest weight",
+			poolAssets: withWeight(newAssets(2), MaxUserSpecifiedWeight.SubRaw(1)),
+		},
+		{
+			name:        "weight at the max user specified weight",
+			poolAssets:  withWeight(newAssets(2), MaxUserSpecifiedWeight),
+			expectedErr: ErrInvalidTokenWeight,
+		},
+		{
+			name:        "weight near the sdkmath.Int overflow threshold",
+			poolAssets:  withWeight(newAssets(2), sdkmath.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 250))),
+			expectedErr: ErrInvalidTokenWeight,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			poolParams := PoolParams{
+				PoolType: PoolType_BALANCER,
+				SwapFee:  sdk.NewDecWithPrec(3, 2),
+				ExitFee:  sdk.NewDecWithPrec(3, 2),
+			}
+			_, err := NewPool(1, testutil.AccAddress(), poolParams, tc.poolAssets)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPoolShareAccounting(t *testing.T) {
+	pool, err := NewPool(1, testutil.AccAddress(), PoolParams{
+		PoolType: PoolType_BALANCER,
+		SwapFee:  sdk.ZeroDec(),
+		ExitFee:  sdk.ZeroDec(),
+	}, []PoolAsset{
+		{Token: sdk.NewInt64Coin("bar", 1_000), Weight: sdk.OneInt()},
+		{Token: sdk.NewInt64Coin("foo", 1_000), Weight: sdk.OneInt()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("nibiru/pool/1", InitPoolSharesSupply), pool.TotalShares)
+	require.NoError(t, pool.ValidateTotalShares())
+
+	// joining mints shares
+	numShares, _, err := pool.AddTokensToPool(sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 100), sdk.NewInt64Coin("foo", 100)))
+	require.NoError(t, err)
+	require.True(t, numShares.IsPositive())
+	require.Equal(t, InitPoolSharesSupply.Add(numShares), pool.TotalShares.Amount)
+	require.NoError(t, pool.ValidateTotalShares())
+
+	// exiting burns them
+	_, _, err = pool.ExitPool(numShares)
+	require.NoError(t, err)
+	require.Equal(t, InitPoolSharesSupply, pool.TotalShares.Amount)
+	require.NoError(t, pool.ValidateTotalShares())
+
+	t.Run("negative total shares", func(t *testing.T) {
+		pool := pool
+		pool.TotalShares = sdk.Coin{Denom: "nibiru/pool/1", Amount: sdk.NewInt(-1)}
+		require.ErrorContains(t, pool.ValidateTotalShares(), "must not be negative")
+	})
+
+	t.Run("total shares in another denom", func(t *testing.T) {
+		pool := pool
+		pool.TotalShares = sdk.NewInt64Coin("nibiru/pool/2", 100)
+		require.ErrorContains(t, pool.ValidateTotalShares(), "must be in nibiru/pool/1")
+	})
+}
+
 func TestJoinPoolHappyPath(t *testing.T) {
 	for _, tc := range []struct {
 		name              string
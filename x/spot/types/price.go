@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // CalcSpotPrice calculates the spot price based on weight.
 // spotPrice = (BalanceIn / WeightIn) / (BalanceOut / WeightOut)
@@ -20,3 +24,57 @@ func (pool Pool) CalcSpotPrice(tokenIn, tokenOut string) (sdk.Dec, error) {
 
 	return weightedBalanceIn.Quo(weightedBalanceOut), nil
 }
+
+// SpotPrice returns the marginal price of 'baseDenom' in units of 'quoteDenom'
+// from the weighted balances of the pool.
+// spotPrice = (BalanceQuote / WeightQuote) / (BalanceBase / WeightBase)
+func (pool Pool) SpotPrice(baseDenom, quoteDenom string) (sdk.Dec, error) {
+	return pool.CalcSpotPrice(quoteDenom, baseDenom)
+}
+
+// SpotPriceWithSwapFee returns the marginal price of 'baseDenom' in units of
+// 'quoteDenom' that a trader pays when the swap fee is taken on the quote.
+// spotPriceWithFee = spotPrice / (1 - swapFee)
+func (pool Pool) SpotPriceWithSwapFee(baseDenom, quoteDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
+	if swapFee.IsNil() || swapFee.IsNegative() || swapFee.GTE(sdk.OneDec()) {
+		return sdk.Dec{}, fmt.Errorf("swap fee must be in [0, 1), got: %s", swapFee)
+	}
+
+	spotPrice, err := pool.SpotPrice(baseDenom, quoteDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return spotPrice.Quo(sdk.OneDec().Sub(swapFee)), nil
+}
+
+// TotalPoolValue returns the value of the pool liquidity in the reference asset
+// of 'prices', which maps each pool asset denom to its price in that asset.
+// totalValue = sum(BalanceAsset * PriceAsset)
+func (pool Pool) TotalPoolValue(prices map[string]sdk.Dec) (sdk.Dec, error) {
+	totalValue := sdk.ZeroDec()
+	for _, poolAsset := range pool.PoolAssets {
+		price, ok := prices[poolAsset.Token.Denom]
+		if !ok || price.IsNil() {
+			return sdk.Dec{}, ErrMissingAssetPrice.Wrapf("denom: %s", poolAsset.Token.Denom)
+		}
+		totalValue = totalValue.Add(price.MulInt(poolAsset.Token.Amount))
+	}
+	return totalValue, nil
+}
+
+// SharePrice returns the value of one LP share of the pool in the reference
+// asset of 'prices'. It is zero when the pool has no shares outstanding.
+// sharePrice = totalValue / totalShares
+func (pool Pool) SharePrice(prices map[string]sdk.Dec) (sdk.Dec, error) {
+	if pool.TotalShares.Amount.IsNil() || pool.TotalShares.Amount.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+
+	totalValue, err := pool.TotalPoolValue(prices)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return totalValue.QuoInt(pool.TotalShares.Amount), nil
+}
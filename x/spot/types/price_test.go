@@ -102,3 +102,133 @@ func TestCalSpotPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestSpotPrice(t *testing.T) {
+	// naive balance ratio foo/bar is 2, weighted it is (2 / 80) / (1 / 20)
+	pool, err := NewPool(1, testutil.AccAddress(), PoolParams{
+		SwapFee:  sdk.NewDecWithPrec(3, 2),
+		ExitFee:  sdk.NewDecWithPrec(3, 2),
+		PoolType: PoolType_BALANCER,
+	}, []PoolAsset{
+		{
+			Token:  sdk.NewInt64Coin("foo", 2*common.TO_MICRO),
+			Weight: sdk.NewInt(80),
+		},
+		{
+			Token:  sdk.NewInt64Coin("bar", 1*common.TO_MICRO),
+			Weight: sdk.NewInt(20),
+		},
+	})
+	require.NoError(t, err)
+
+	spotPrice, err := pool.SpotPrice("bar", "foo")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), spotPrice)
+
+	spotPrice, err = pool.SpotPrice("foo", "bar")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), spotPrice)
+
+	// 0.5 / (1 - 0.03)
+	spotPriceWithFee, err := pool.SpotPriceWithSwapFee("bar", "foo", sdk.NewDecWithPrec(3, 2))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.515463917525773196"), spotPriceWithFee)
+
+	spotPriceWithFee, err = pool.SpotPriceWithSwapFee("bar", "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), spotPriceWithFee)
+
+	_, err = pool.SpotPrice("baz", "foo")
+	require.ErrorIs(t, err, ErrTokenDenomNotFound)
+
+	_, err = pool.SpotPriceWithSwapFee("bar", "baz", sdk.ZeroDec())
+	require.ErrorIs(t, err, ErrTokenDenomNotFound)
+
+	_, err = pool.SpotPriceWithSwapFee("bar", "foo", sdk.OneDec())
+	require.Error(t, err)
+}
+
+func TestTotalPoolValue(t *testing.T) {
+	pool, err := NewPool(1, testutil.AccAddress(), PoolParams{
+		SwapFee:  sdk.NewDecWithPrec(3, 2),
+		ExitFee:  sdk.NewDecWithPrec(3, 2),
+		PoolType: PoolType_BALANCER,
+	}, []PoolAsset{
+		{
+			Token:  sdk.NewInt64Coin("foo", 2*common.TO_MICRO),
+			Weight: sdk.NewInt(50),
+		},
+		{
+			Token:  sdk.NewInt64Coin("bar", 1*common.TO_MICRO),
+			Weight: sdk.NewInt(50),
+		},
+	})
+	require.NoError(t, err)
+
+	// 2_000_000 * 1.5 + 1_000_000 * 4
+	value, err := pool.TotalPoolValue(map[string]sdk.Dec{
+		"foo": sdk.MustNewDecFromStr("1.5"),
+		"bar": sdk.NewDec(4),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(7*common.TO_MICRO), value)
+
+	_, err = pool.TotalPoolValue(map[string]sdk.Dec{
+		"foo": sdk.MustNewDecFromStr("1.5"),
+	})
+	require.ErrorIs(t, err, ErrMissingAssetPrice)
+}
+
+func TestSharePrice(t *testing.T) {
+	pool, err := NewPool(1, testutil.AccAddress(), PoolParams{
+		SwapFee:  sdk.NewDecWithPrec(3, 2),
+		ExitFee:  sdk.NewDecWithPrec(3, 2),
+		PoolType: PoolType_BALANCER,
+	}, []PoolAsset{
+		{
+			Token:  sdk.NewInt64Coin("foo", 2*common.TO_MICRO),
+			Weight: sdk.NewInt(50),
+		},
+		{
+			Token:  sdk.NewInt64Coin("bar", 1*common.TO_MICRO),
+			Weight: sdk.NewInt(50),
+		},
+	})
+	require.NoError(t, err)
+	prices := map[string]sdk.Dec{
+		"foo": sdk.MustNewDecFromStr("1.5"),
+		"bar": sdk.NewDec(4),
+	}
+
+	// 7_000_000 / 100 * 10^18
+	sharePrice, err := pool.SharePrice(prices)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(7*common.TO_MICRO).QuoInt(InitPoolSharesSupply), sharePrice)
+
+	// a balanced join doubling the pool liquidity doubles the TVL and the
+	// shares, so the share price is unchanged
+	numShares, remCoins, err := pool.AddTokensToPool(sdk.NewCoins(
+		sdk.NewInt64Coin("foo", 2*common.TO_MICRO),
+		sdk.NewInt64Coin("bar", 1*common.TO_MICRO),
+	))
+	require.NoError(t, err)
+	require.Empty(t, remCoins)
+	require.Equal(t, InitPoolSharesSupply, numShares)
+
+	totalValue, err := pool.TotalPoolValue(prices)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(14*common.TO_MICRO), totalValue)
+
+	sharePriceAfterJoin, err := pool.SharePrice(prices)
+	require.NoError(t, err)
+	require.Equal(t, sharePrice, sharePriceAfterJoin)
+	require.Equal(t, totalValue, sharePriceAfterJoin.MulInt(pool.TotalShares.Amount))
+
+	_, err = pool.SharePrice(map[string]sdk.Dec{"foo": sdk.OneDec()})
+	require.ErrorIs(t, err, ErrMissingAssetPrice)
+
+	pool.TotalShares.Amount = sdk.ZeroInt()
+	sharePrice, err = pool.SharePrice(prices)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroDec(), sharePrice)
+}
@@ -1033,6 +1033,162 @@ func (m *QuerySwapExactAmountInResponse) GetFee() types.Coin {
 	return types.Coin{}
 }
 
+// A single hop of a multi-hop swap: the tokens held after the previous hop are
+// swapped in pool pool_id for token_out_denom.
+type SwapRoute struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+}
+
+func (m *SwapRoute) Reset()         { *m = SwapRoute{} }
+func (m *SwapRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapRoute) ProtoMessage()    {}
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e32191d06b2665, []int{22}
+}
+func (m *SwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRoute.Merge(m, src)
+}
+func (m *SwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRoute proto.InternalMessageInfo
+
+func (m *SwapRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapRoute) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+// Given an exact amount of tokens in and the hops to swap them through,
+// calculates the expected amount of tokens out of the last hop.
+type QueryEstimateMultiHopRequest struct {
+	Routes  []SwapRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	TokenIn types.Coin  `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+}
+
+func (m *QueryEstimateMultiHopRequest) Reset()         { *m = QueryEstimateMultiHopRequest{} }
+func (m *QueryEstimateMultiHopRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateMultiHopRequest) ProtoMessage()    {}
+func (*QueryEstimateMultiHopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e32191d06b2665, []int{23}
+}
+func (m *QueryEstimateMultiHopRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateMultiHopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateMultiHopRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateMultiHopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateMultiHopRequest.Merge(m, src)
+}
+func (m *QueryEstimateMultiHopRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateMultiHopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateMultiHopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateMultiHopRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateMultiHopRequest) GetRoutes() []SwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QueryEstimateMultiHopRequest) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+type QueryEstimateMultiHopResponse struct {
+	TokenOut types.Coin `protobuf:"bytes,1,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// amount of token in paid per unit of token out
+	EffectivePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=effective_price,json=effectivePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"effective_price"`
+	// share of the input lost to swap fees over all hops
+	CumulativeFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=cumulative_fee_ratio,json=cumulativeFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_fee_ratio"`
+}
+
+func (m *QueryEstimateMultiHopResponse) Reset()         { *m = QueryEstimateMultiHopResponse{} }
+func (m *QueryEstimateMultiHopResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateMultiHopResponse) ProtoMessage()    {}
+func (*QueryEstimateMultiHopResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e32191d06b2665, []int{24}
+}
+func (m *QueryEstimateMultiHopResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateMultiHopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateMultiHopResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateMultiHopResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateMultiHopResponse.Merge(m, src)
+}
+func (m *QueryEstimateMultiHopResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateMultiHopResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateMultiHopResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateMultiHopResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateMultiHopResponse) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
 // Given an exact amount of tokens out and a target tokenInDenom, calculates
 // the expected amount of tokens in required to do the swap.
 type QuerySwapExactAmountOutRequest struct {
@@ -1045,7 +1201,7 @@ func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{22}
+	return fileDescriptor_15e32191d06b2665, []int{25}
 }
 func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1259,7 @@ func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactA
 func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{23}
+	return fileDescriptor_15e32191d06b2665, []int{26}
 }
 func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1148,7 +1304,7 @@ func (m *QueryJoinExactAmountInRequest) Reset()         { *m = QueryJoinExactAmo
 func (m *QueryJoinExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryJoinExactAmountInRequest) ProtoMessage()    {}
 func (*QueryJoinExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{24}
+	return fileDescriptor_15e32191d06b2665, []int{27}
 }
 func (m *QueryJoinExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1202,7 +1358,7 @@ func (m *QueryJoinExactAmountInResponse) Reset()         { *m = QueryJoinExactAm
 func (m *QueryJoinExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryJoinExactAmountInResponse) ProtoMessage()    {}
 func (*QueryJoinExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{25}
+	return fileDescriptor_15e32191d06b2665, []int{28}
 }
 func (m *QueryJoinExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,7 +1402,7 @@ func (m *QueryJoinExactAmountOutRequest) Reset()         { *m = QueryJoinExactAm
 func (m *QueryJoinExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryJoinExactAmountOutRequest) ProtoMessage()    {}
 func (*QueryJoinExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{26}
+	return fileDescriptor_15e32191d06b2665, []int{29}
 }
 func (m *QueryJoinExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1289,7 +1445,7 @@ func (m *QueryJoinExactAmountOutResponse) Reset()         { *m = QueryJoinExactA
 func (m *QueryJoinExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryJoinExactAmountOutResponse) ProtoMessage()    {}
 func (*QueryJoinExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{27}
+	return fileDescriptor_15e32191d06b2665, []int{30}
 }
 func (m *QueryJoinExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1328,7 +1484,7 @@ func (m *QueryExitExactAmountInRequest) Reset()         { *m = QueryExitExactAmo
 func (m *QueryExitExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExitExactAmountInRequest) ProtoMessage()    {}
 func (*QueryExitExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{28}
+	return fileDescriptor_15e32191d06b2665, []int{31}
 }
 func (m *QueryExitExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1374,7 +1530,7 @@ func (m *QueryExitExactAmountInResponse) Reset()         { *m = QueryExitExactAm
 func (m *QueryExitExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExitExactAmountInResponse) ProtoMessage()    {}
 func (*QueryExitExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{29}
+	return fileDescriptor_15e32191d06b2665, []int{32}
 }
 func (m *QueryExitExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1425,7 +1581,7 @@ func (m *QueryExitExactAmountOutRequest) Reset()         { *m = QueryExitExactAm
 func (m *QueryExitExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExitExactAmountOutRequest) ProtoMessage()    {}
 func (*QueryExitExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{30}
+	return fileDescriptor_15e32191d06b2665, []int{33}
 }
 func (m *QueryExitExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1468,7 +1624,7 @@ func (m *QueryExitExactAmountOutResponse) Reset()         { *m = QueryExitExactA
 func (m *QueryExitExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExitExactAmountOutResponse) ProtoMessage()    {}
 func (*QueryExitExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15e32191d06b2665, []int{31}
+	return fileDescriptor_15e32191d06b2665, []int{34}
 }
 func (m *QueryExitExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1497,6 +1653,94 @@ func (m *QueryExitExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryExitExactAmountOutResponse proto.InternalMessageInfo
 
+type QueryPoolAllowlistRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryPoolAllowlistRequest) Reset()         { *m = QueryPoolAllowlistRequest{} }
+func (m *QueryPoolAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAllowlistRequest) ProtoMessage()    {}
+func (*QueryPoolAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e32191d06b2665, []int{35}
+}
+func (m *QueryPoolAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAllowlistRequest.Merge(m, src)
+}
+func (m *QueryPoolAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolAllowlistRequest proto.InternalMessageInfo
+
+func (m *QueryPoolAllowlistRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryPoolAllowlistResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *QueryPoolAllowlistResponse) Reset()         { *m = QueryPoolAllowlistResponse{} }
+func (m *QueryPoolAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAllowlistResponse) ProtoMessage()    {}
+func (*QueryPoolAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e32191d06b2665, []int{36}
+}
+func (m *QueryPoolAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAllowlistResponse.Merge(m, src)
+}
+func (m *QueryPoolAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryPoolAllowlistResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.spot.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.spot.v1.QueryParamsResponse")
@@ -1520,6 +1764,9 @@ func init() {
 	proto.RegisterType((*QuerySpotPriceResponse)(nil), "nibiru.spot.v1.QuerySpotPriceResponse")
 	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "nibiru.spot.v1.QuerySwapExactAmountInRequest")
 	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "nibiru.spot.v1.QuerySwapExactAmountInResponse")
+	proto.RegisterType((*SwapRoute)(nil), "nibiru.spot.v1.SwapRoute")
+	proto.RegisterType((*QueryEstimateMultiHopRequest)(nil), "nibiru.spot.v1.QueryEstimateMultiHopRequest")
+	proto.RegisterType((*QueryEstimateMultiHopResponse)(nil), "nibiru.spot.v1.QueryEstimateMultiHopResponse")
 	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "nibiru.spot.v1.QuerySwapExactAmountOutRequest")
 	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "nibiru.spot.v1.QuerySwapExactAmountOutResponse")
 	proto.RegisterType((*QueryJoinExactAmountInRequest)(nil), "nibiru.spot.v1.QueryJoinExactAmountInRequest")
@@ -1530,108 +1777,123 @@ func init() {
 	proto.RegisterType((*QueryExitExactAmountInResponse)(nil), "nibiru.spot.v1.QueryExitExactAmountInResponse")
 	proto.RegisterType((*QueryExitExactAmountOutRequest)(nil), "nibiru.spot.v1.QueryExitExactAmountOutRequest")
 	proto.RegisterType((*QueryExitExactAmountOutResponse)(nil), "nibiru.spot.v1.QueryExitExactAmountOutResponse")
+	proto.RegisterType((*QueryPoolAllowlistRequest)(nil), "nibiru.spot.v1.QueryPoolAllowlistRequest")
+	proto.RegisterType((*QueryPoolAllowlistResponse)(nil), "nibiru.spot.v1.QueryPoolAllowlistResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/spot/v1/query.proto", fileDescriptor_15e32191d06b2665) }
 
 var fileDescriptor_15e32191d06b2665 = []byte{
-	// 1522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0x14, 0xc7,
-	0x16, 0x75, 0x63, 0x63, 0x3c, 0x65, 0x30, 0x50, 0x36, 0xc6, 0x6e, 0xc3, 0x18, 0x0a, 0xb0, 0xfd,
-	0x6c, 0x31, 0xad, 0x01, 0xde, 0x43, 0xbc, 0x04, 0xa1, 0x18, 0x1c, 0xe2, 0x7c, 0x80, 0x33, 0x44,
-	0x91, 0x92, 0x2c, 0x46, 0x6d, 0xbb, 0x3c, 0x34, 0xb8, 0xbb, 0x9a, 0xe9, 0x6a, 0xb0, 0x15, 0x48,
-	0xa4, 0x6c, 0xa2, 0x64, 0x13, 0x22, 0xb6, 0x59, 0x64, 0x13, 0x45, 0xca, 0x26, 0x89, 0x22, 0x45,
-	0x59, 0xe4, 0x07, 0xb0, 0x44, 0xca, 0x26, 0xca, 0xc2, 0x89, 0x20, 0xbf, 0x80, 0x5f, 0x10, 0x55,
-	0xd5, 0xed, 0x9e, 0xfe, 0x9c, 0xee, 0x91, 0x58, 0x64, 0xc5, 0xb8, 0xea, 0xde, 0x7b, 0xce, 0x3d,
-	0x75, 0xba, 0xfb, 0x16, 0x48, 0x77, 0xac, 0x55, 0xab, 0xed, 0x1b, 0x9e, 0xcb, 0xb8, 0x71, 0xb7,
-	0x6e, 0xdc, 0xf1, 0x69, 0x7b, 0xbb, 0xe6, 0xb6, 0x19, 0x67, 0x78, 0x44, 0xed, 0xd5, 0xc4, 0x5e,
-	0xed, 0x6e, 0x5d, 0x1f, 0x6b, 0xb1, 0x16, 0x93, 0x5b, 0x86, 0xf8, 0xa5, 0xa2, 0xf4, 0x23, 0x2d,
-	0xc6, 0x5a, 0x9b, 0xd4, 0x30, 0x5d, 0xcb, 0x30, 0x1d, 0x87, 0x71, 0x93, 0x5b, 0xcc, 0xf1, 0x60,
-	0x77, 0x7e, 0x8d, 0x79, 0x36, 0xf3, 0x8c, 0x55, 0xd3, 0xa3, 0xaa, 0xb8, 0x71, 0xb7, 0xbe, 0x4a,
-	0xb9, 0x59, 0x37, 0x5c, 0xb3, 0x65, 0x39, 0x32, 0x18, 0x62, 0xa7, 0x12, 0x5c, 0x5c, 0xb3, 0x6d,
-	0xda, 0x41, 0xa1, 0xc9, 0xe4, 0x26, 0x63, 0x9b, 0xb0, 0x55, 0x8d, 0x62, 0x04, 0xd5, 0xd7, 0x98,
-	0x05, 0x75, 0xc9, 0x18, 0xc2, 0x6f, 0x0b, 0xe4, 0x15, 0x59, 0xaf, 0x41, 0xef, 0xf8, 0xd4, 0xe3,
-	0xe4, 0x0d, 0x34, 0x1a, 0x5b, 0xf5, 0x5c, 0xe6, 0x78, 0x14, 0x9f, 0x43, 0x83, 0x0a, 0x77, 0x42,
-	0x3b, 0xa6, 0xcd, 0x0d, 0x9f, 0x19, 0xaf, 0xc5, 0x55, 0xa8, 0xa9, 0xf8, 0xc5, 0x81, 0xc7, 0x3b,
-	0xd3, 0x7d, 0x0d, 0x88, 0x25, 0x13, 0x68, 0x5c, 0x15, 0x63, 0x6c, 0xf3, 0x9a, 0x6f, 0xaf, 0xd2,
-	0x76, 0x00, 0x73, 0x06, 0x1d, 0x4e, 0xed, 0x00, 0xd4, 0x61, 0xb4, 0x47, 0x74, 0xd1, 0xb4, 0xd6,
-	0x25, 0xd6, 0x40, 0x63, 0x50, 0xfc, 0xb9, 0xbc, 0x4e, 0x16, 0xd0, 0x81, 0x30, 0x07, 0xea, 0xe4,
-	0x07, 0x5f, 0x44, 0x07, 0x23, 0xc1, 0x50, 0x7a, 0x0e, 0x0d, 0x88, 0x6d, 0xe8, 0x61, 0x2c, 0xd5,
-	0x83, 0x88, 0x95, 0x11, 0xe4, 0x83, 0x48, 0x7a, 0xa0, 0x0d, 0x7e, 0x15, 0xa1, 0xce, 0xe9, 0x40,
-	0x91, 0x99, 0x9a, 0x92, 0xb9, 0x26, 0x64, 0xae, 0x29, 0x9f, 0x80, 0xd8, 0xb5, 0x15, 0xb3, 0x45,
-	0x21, 0xb7, 0x11, 0xc9, 0x24, 0x9f, 0x69, 0x81, 0xf4, 0xaa, 0x3a, 0xb0, 0x9b, 0x47, 0xbb, 0x05,
-	0xb6, 0x90, 0xb8, 0x3f, 0x97, 0x9e, 0x0a, 0xc1, 0x57, 0x63, 0x54, 0x76, 0x49, 0x2a, 0xb3, 0x85,
-	0x54, 0x14, 0x50, 0x8c, 0x4b, 0x3d, 0x72, 0x44, 0x31, 0x27, 0xe4, 0x4b, 0xfb, 0x6e, 0xe4, 0xec,
-	0x12, 0x36, 0x79, 0x09, 0x0d, 0xcb, 0x9c, 0x98, 0x57, 0xf4, 0xac, 0x46, 0x20, 0x11, 0xb9, 0xe1,
-	0x6f, 0x32, 0x8e, 0xc6, 0x64, 0xdd, 0x6b, 0xbe, 0x1d, 0x95, 0x9d, 0x9c, 0x43, 0x87, 0x12, 0xeb,
-	0x80, 0x36, 0x85, 0x2a, 0x8e, 0x6f, 0x37, 0x03, 0xd1, 0x04, 0xc7, 0x21, 0x07, 0x82, 0xc8, 0x11,
-	0xa4, 0xcb, 0xac, 0x77, 0x18, 0x37, 0x37, 0xdf, 0xb4, 0xee, 0xf8, 0xd6, 0xba, 0xc5, 0xb7, 0x83,
-	0x9a, 0x5f, 0x69, 0x68, 0x2a, 0x73, 0x1b, 0x4a, 0x3f, 0x40, 0x95, 0xcd, 0x60, 0x11, 0xce, 0x63,
-	0x32, 0x26, 0x6f, 0x20, 0xec, 0x65, 0x66, 0x39, 0x8b, 0x57, 0x84, 0xeb, 0x9f, 0xef, 0x4c, 0x1f,
-	0xd8, 0x36, 0xed, 0xcd, 0xff, 0x93, 0x30, 0x93, 0x7c, 0xf7, 0xe7, 0xf4, 0x5c, 0xcb, 0xe2, 0x37,
-	0xfd, 0xd5, 0xda, 0x1a, 0xb3, 0x0d, 0x78, 0x22, 0xd5, 0x3f, 0xa7, 0xbd, 0xf5, 0xdb, 0x06, 0xdf,
-	0x76, 0xa9, 0x27, 0x8b, 0x78, 0x8d, 0x0e, 0x22, 0xb9, 0x80, 0xaa, 0x1d, 0x76, 0xa2, 0x9f, 0x64,
-	0x03, 0xf9, 0xa7, 0xf3, 0xb5, 0x86, 0xa6, 0x73, 0x73, 0xff, 0x1d, 0xdd, 0x05, 0x0f, 0xbf, 0x64,
-	0x78, 0xe3, 0xa6, 0xd9, 0xa6, 0xc5, 0xa6, 0xf3, 0xd1, 0x44, 0x3a, 0x07, 0xda, 0x79, 0x0f, 0xed,
-	0xe5, 0x62, 0xb9, 0xe9, 0xc9, 0x75, 0xb0, 0x5d, 0x97, 0x8e, 0xa6, 0xa0, 0xa3, 0x51, 0xd5, 0x51,
-	0x34, 0x99, 0x34, 0x86, 0x79, 0x07, 0x82, 0x7c, 0x04, 0xde, 0xbb, 0xe1, 0x32, 0xbe, 0xd2, 0xb6,
-	0xd6, 0x68, 0x11, 0x51, 0x7c, 0x12, 0x8d, 0x70, 0x76, 0x9b, 0x3a, 0x4d, 0xcb, 0x69, 0xae, 0x53,
-	0x87, 0xd9, 0xf2, 0xe9, 0xac, 0x34, 0xf6, 0xca, 0xd5, 0x65, 0xe7, 0x8a, 0x58, 0xc3, 0x33, 0x68,
-	0xbf, 0x8a, 0x62, 0x3e, 0x87, 0xb0, 0x7e, 0x19, 0xb6, 0x4f, 0x2e, 0x5f, 0xf7, 0xb9, 0x8c, 0x23,
-	0xe7, 0xe1, 0xf1, 0x8c, 0xe0, 0x43, 0xd3, 0x47, 0x11, 0x12, 0xcf, 0x53, 0xd3, 0x15, 0xab, 0x92,
-	0x43, 0xa5, 0x51, 0xf1, 0x82, 0x30, 0xf2, 0xbd, 0x86, 0x8e, 0xaa, 0xcc, 0x7b, 0xa6, 0xbb, 0xb4,
-	0x65, 0xae, 0xf1, 0x57, 0x6c, 0xe6, 0x3b, 0x7c, 0xd9, 0x29, 0xec, 0xe0, 0x2d, 0x34, 0x14, 0x74,
-	0x00, 0x6f, 0x96, 0x2e, 0x52, 0x1e, 0x06, 0x29, 0xf7, 0x07, 0x52, 0xaa, 0x44, 0xd2, 0xd8, 0x03,
-	0xfd, 0x96, 0x6e, 0xf5, 0x27, 0x0d, 0x4c, 0x9f, 0xc1, 0x18, 0x7a, 0x5e, 0x41, 0x95, 0xb0, 0x54,
-	0x31, 0xb5, 0x89, 0xb8, 0x6f, 0xc3, 0x4c, 0xd2, 0x18, 0x0a, 0x90, 0xf1, 0x25, 0xd4, 0xbf, 0x41,
-	0xa9, 0x24, 0xd4, 0xb5, 0x16, 0x86, 0x5a, 0x48, 0xd5, 0xda, 0xa0, 0x94, 0x34, 0x44, 0x26, 0xf9,
-	0x31, 0x87, 0xf5, 0x75, 0x9f, 0x17, 0x0a, 0xfd, 0xe2, 0xdb, 0x49, 0x9b, 0xaf, 0x3f, 0x6d, 0x3e,
-	0xe2, 0xc2, 0x1b, 0x22, 0x8b, 0x32, 0x28, 0xfd, 0x62, 0x3d, 0x40, 0x7e, 0x0e, 0xdc, 0xf8, 0x3a,
-	0xb3, 0x9c, 0xde, 0xdc, 0x78, 0x1f, 0x44, 0xf2, 0x14, 0x95, 0xde, 0xde, 0x55, 0x61, 0x66, 0x6f,
-	0xef, 0x2a, 0xd5, 0xbb, 0xb7, 0xec, 0x90, 0x87, 0xbb, 0xe0, 0x78, 0x33, 0x88, 0x83, 0x54, 0x2e,
-	0xda, 0x2f, 0x99, 0xab, 0xf7, 0x87, 0x3c, 0x4b, 0xf9, 0x34, 0x2e, 0xbe, 0x26, 0xb8, 0xfc, 0xb1,
-	0x33, 0x3d, 0x53, 0x02, 0x77, 0xd9, 0xe1, 0xcf, 0x77, 0xa6, 0xc7, 0x15, 0xeb, 0x44, 0x39, 0xd2,
-	0xd8, 0x27, 0x56, 0xd4, 0x1b, 0x49, 0x9c, 0xf2, 0x7d, 0x54, 0x69, 0x53, 0xbb, 0x29, 0x66, 0x39,
-	0xaf, 0x67, 0x49, 0xc2, 0xcc, 0x1e, 0x25, 0x69, 0x53, 0x5b, 0xfe, 0x0a, 0xbf, 0x4d, 0x09, 0x45,
-	0x4a, 0x18, 0x9e, 0x1c, 0x07, 0xe3, 0x65, 0xa5, 0x2a, 0x35, 0xc9, 0xb7, 0x81, 0x53, 0x96, 0xb6,
-	0x2c, 0xde, 0x9b, 0x53, 0x6c, 0x34, 0x12, 0x55, 0x0e, 0x9c, 0x5b, 0x59, 0xbc, 0xda, 0xf3, 0x39,
-	0x1c, 0x4a, 0x9f, 0x83, 0xb0, 0xf3, 0xde, 0xce, 0x31, 0x2c, 0x3b, 0xe4, 0xcb, 0xc0, 0x1a, 0x19,
-	0x4c, 0xc1, 0x1a, 0x1f, 0x23, 0x04, 0x0e, 0x54, 0xae, 0x28, 0x38, 0xa9, 0x25, 0x38, 0xa9, 0x83,
-	0x31, 0xf3, 0x0a, 0x07, 0xf4, 0xf6, 0xa5, 0x55, 0x89, 0xc2, 0x29, 0x0e, 0x1a, 0xd8, 0xa0, 0xb4,
-	0x84, 0x49, 0x2e, 0x01, 0xf4, 0x70, 0xf8, 0x7e, 0xeb, 0xd1, 0x1f, 0x12, 0x27, 0xf4, 0x46, 0x42,
-	0x92, 0x5e, 0xbc, 0x91, 0x95, 0xaa, 0xe4, 0x3c, 0xf3, 0xcd, 0x28, 0xda, 0x2d, 0x63, 0xb0, 0x83,
-	0x06, 0xd5, 0xd0, 0x88, 0x49, 0x72, 0xb8, 0x4c, 0xdf, 0x69, 0xf4, 0x13, 0x5d, 0x63, 0xc0, 0x78,
-	0x53, 0x9f, 0xfc, 0xf6, 0xf7, 0xa3, 0x5d, 0x87, 0xf0, 0xa8, 0x11, 0xbd, 0x52, 0xa9, 0x41, 0x56,
-	0x1c, 0x64, 0xe7, 0xa6, 0x82, 0x67, 0xb2, 0xeb, 0x25, 0x2f, 0x39, 0xfa, 0x6c, 0x61, 0x1c, 0x60,
-	0x1f, 0x93, 0xd8, 0x3a, 0x9e, 0x88, 0x63, 0x0b, 0x9d, 0x1c, 0x05, 0xb9, 0x81, 0x06, 0x44, 0x1e,
-	0x3e, 0x96, 0x5b, 0x32, 0x00, 0x3d, 0xde, 0x25, 0x02, 0xe0, 0x26, 0x25, 0xdc, 0x28, 0x3e, 0x98,
-	0x82, 0xc3, 0xb7, 0xd0, 0x6e, 0x39, 0x3e, 0xe3, 0xfc, 0x32, 0xa1, 0xac, 0xa4, 0x5b, 0x08, 0x40,
-	0xe9, 0x12, 0x6a, 0x0c, 0xe3, 0x14, 0x94, 0x87, 0x3f, 0xd7, 0x94, 0xaa, 0x70, 0x92, 0xf9, 0xaa,
-	0xc6, 0x4f, 0x73, 0xb6, 0x30, 0x0e, 0xb0, 0x17, 0x24, 0xf6, 0x29, 0x7c, 0x22, 0x8d, 0x6d, 0x7c,
-	0x08, 0x26, 0x7c, 0x10, 0x9c, 0xf0, 0x3d, 0x34, 0x14, 0xdc, 0x2f, 0xf0, 0xc9, 0x4c, 0x84, 0xc4,
-	0xb5, 0x44, 0x3f, 0x55, 0x10, 0x05, 0x2c, 0xaa, 0x92, 0xc5, 0x04, 0x1e, 0x8f, 0xb1, 0x08, 0xef,
-	0x2d, 0xf8, 0x0b, 0x0d, 0x8d, 0xc4, 0x2f, 0x21, 0x78, 0x3e, 0xb3, 0x72, 0xe6, 0x45, 0x46, 0x5f,
-	0x28, 0x15, 0x0b, 0x5c, 0x4e, 0x4a, 0x2e, 0x55, 0x7c, 0x24, 0xc6, 0x45, 0x8d, 0xbf, 0xe1, 0x78,
-	0x8e, 0x7f, 0xd0, 0x10, 0x4e, 0x5f, 0x1e, 0x70, 0x2d, 0x1f, 0x29, 0xeb, 0x86, 0xa2, 0x1b, 0xa5,
-	0xe3, 0x81, 0xdd, 0x05, 0xc9, 0xee, 0x2c, 0xae, 0x77, 0x3d, 0x2f, 0xc5, 0x56, 0xfe, 0xd9, 0xa1,
-	0xfc, 0x48, 0x43, 0xc3, 0x91, 0x9b, 0x01, 0x9e, 0xcd, 0xc7, 0x8e, 0xdd, 0x37, 0xf4, 0xb9, 0xe2,
-	0x40, 0x60, 0x57, 0x97, 0xec, 0x16, 0xf0, 0x7f, 0x4a, 0xb0, 0x53, 0xdf, 0x0c, 0xfc, 0xa9, 0x86,
-	0x2a, 0xe1, 0xe0, 0x8e, 0xb3, 0xfd, 0x92, 0xbc, 0x58, 0xe8, 0x33, 0x45, 0x61, 0xbd, 0xb9, 0x5b,
-	0xe4, 0x78, 0xf8, 0x17, 0x0d, 0x4d, 0x2e, 0x79, 0xdc, 0xb2, 0x4d, 0x4e, 0x53, 0xe3, 0x35, 0x3e,
-	0x9d, 0x0d, 0x99, 0x73, 0x71, 0xd0, 0x6b, 0x65, 0xc3, 0x81, 0xe9, 0xcb, 0x92, 0xe9, 0xff, 0xf0,
-	0xb9, 0x18, 0xd3, 0x0e, 0x47, 0x0a, 0xc4, 0x0c, 0xef, 0x9e, 0xe9, 0x36, 0xa9, 0xa8, 0xd1, 0x34,
-	0x65, 0x91, 0xa6, 0xe5, 0xe0, 0x5f, 0x35, 0xa4, 0xe7, 0x50, 0x17, 0x5f, 0xb8, 0x52, 0x64, 0x3a,
-	0xdf, 0x9f, 0x1c, 0x57, 0xe6, 0x4f, 0xc2, 0xe4, 0xa2, 0x64, 0x7f, 0x1e, 0xff, 0xb7, 0x77, 0xf6,
-	0xcc, 0xe7, 0x31, 0xe5, 0x53, 0x33, 0x64, 0x8e, 0xf2, 0x79, 0x43, 0x72, 0x8e, 0xf2, 0xb9, 0xa3,
-	0x69, 0x79, 0xe5, 0x6f, 0x31, 0xcb, 0xe9, 0xaa, 0x7c, 0x7a, 0x62, 0xc3, 0xa5, 0xc8, 0x14, 0x2a,
-	0xdf, 0x65, 0x14, 0x2c, 0xad, 0x7c, 0x9a, 0x7d, 0x52, 0xf9, 0xd4, 0x88, 0x96, 0xa3, 0x7c, 0xde,
-	0xd0, 0x99, 0xa3, 0x7c, 0xee, 0xe4, 0x57, 0x5e, 0x79, 0xba, 0x65, 0xf1, 0xae, 0xca, 0xa7, 0xe7,
-	0x21, 0x5c, 0x8a, 0x4c, 0xa1, 0xf2, 0xf9, 0x83, 0x56, 0x79, 0xe5, 0xd3, 0xec, 0x99, 0xcf, 0x17,
-	0xaf, 0x3c, 0x7e, 0x5a, 0xd5, 0x9e, 0x3c, 0xad, 0x6a, 0x7f, 0x3d, 0xad, 0x6a, 0x0f, 0x9f, 0x55,
-	0xfb, 0x9e, 0x3c, 0xab, 0xf6, 0xfd, 0xfe, 0xac, 0xda, 0xf7, 0xfe, 0x7c, 0x64, 0x9e, 0xbc, 0x26,
-	0x4b, 0x5f, 0xbe, 0x69, 0x5a, 0x4e, 0x00, 0xb3, 0x05, 0x1f, 0x24, 0x31, 0x57, 0xae, 0x0e, 0xca,
-	0xff, 0xa6, 0x3e, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x32, 0xfc, 0x09, 0x8c, 0x17,
-	0x00, 0x00,
+	// 1740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6f, 0xdc, 0x4e,
+	0x19, 0x8f, 0x93, 0x34, 0xff, 0xec, 0x93, 0x36, 0x69, 0x27, 0x6f, 0x1b, 0x27, 0xdd, 0x6d, 0xa7,
+	0x6d, 0x92, 0x26, 0xd4, 0x56, 0xda, 0x40, 0x95, 0x42, 0x55, 0x35, 0x4d, 0xda, 0x06, 0xfa, 0x12,
+	0x5c, 0x04, 0x02, 0x0e, 0x8b, 0xb3, 0x99, 0x24, 0x6e, 0x77, 0x3d, 0xee, 0xda, 0xce, 0x8b, 0x68,
+	0x41, 0xe2, 0x82, 0xe0, 0x42, 0x51, 0x25, 0xc4, 0x81, 0x03, 0x27, 0x90, 0x10, 0x12, 0x20, 0x24,
+	0xc4, 0x81, 0x0f, 0xd0, 0x63, 0x25, 0x2e, 0xc0, 0x21, 0xa0, 0x96, 0x4f, 0xd0, 0x4f, 0x80, 0xe6,
+	0xc5, 0xde, 0xf5, 0xda, 0x5e, 0x7b, 0x51, 0x0e, 0xff, 0x53, 0x37, 0x33, 0xcf, 0xcb, 0xef, 0xf9,
+	0x3d, 0xbf, 0xf1, 0xcc, 0xa3, 0x82, 0x6a, 0x5b, 0x5b, 0x56, 0xc3, 0xd7, 0x5d, 0x87, 0x7a, 0xfa,
+	0xfe, 0x92, 0xfe, 0xd2, 0x27, 0x8d, 0x23, 0xcd, 0x69, 0x50, 0x8f, 0xa2, 0x61, 0xb1, 0xa7, 0xb1,
+	0x3d, 0x6d, 0x7f, 0x49, 0x1d, 0xdb, 0xa5, 0xbb, 0x94, 0x6f, 0xe9, 0xec, 0x97, 0xb0, 0x52, 0x67,
+	0x76, 0x29, 0xdd, 0xad, 0x11, 0xdd, 0x74, 0x2c, 0xdd, 0xb4, 0x6d, 0xea, 0x99, 0x9e, 0x45, 0x6d,
+	0x57, 0xee, 0x2e, 0x54, 0xa9, 0x5b, 0xa7, 0xae, 0xbe, 0x65, 0xba, 0x44, 0x04, 0xd7, 0xf7, 0x97,
+	0xb6, 0x88, 0x67, 0x2e, 0xe9, 0x8e, 0xb9, 0x6b, 0xd9, 0xdc, 0x58, 0xda, 0x4e, 0xb7, 0x61, 0x71,
+	0xcc, 0x86, 0x59, 0x0f, 0x02, 0x4d, 0xb5, 0x6f, 0x52, 0x5a, 0x93, 0x5b, 0xa5, 0xd6, 0x1c, 0x41,
+	0xf4, 0x2a, 0xb5, 0x64, 0x5c, 0x3c, 0x06, 0xe8, 0xeb, 0x2c, 0xf3, 0x26, 0x8f, 0x67, 0x90, 0x97,
+	0x3e, 0x71, 0x3d, 0xfc, 0x35, 0x18, 0x8d, 0xac, 0xba, 0x0e, 0xb5, 0x5d, 0x82, 0x96, 0x61, 0x40,
+	0xe4, 0x2d, 0x2a, 0x17, 0x94, 0xf9, 0xa1, 0xeb, 0x13, 0x5a, 0x94, 0x05, 0x4d, 0xd8, 0xaf, 0xf6,
+	0xbf, 0x3b, 0x2e, 0xf7, 0x18, 0xd2, 0x16, 0x17, 0x61, 0x42, 0x04, 0xa3, 0xb4, 0xf6, 0xc4, 0xaf,
+	0x6f, 0x91, 0x46, 0x90, 0xe6, 0x3a, 0x4c, 0xc6, 0x76, 0x64, 0xaa, 0x49, 0xf8, 0x8c, 0x55, 0x51,
+	0xb1, 0xb6, 0x79, 0xae, 0x7e, 0x63, 0x80, 0xfd, 0xb9, 0xb1, 0x8d, 0x17, 0xe1, 0x6c, 0xe8, 0x23,
+	0xe3, 0xa4, 0x1b, 0xdf, 0x86, 0x73, 0x2d, 0xc6, 0x32, 0xf4, 0x3c, 0xf4, 0xb3, 0x6d, 0x59, 0xc3,
+	0x58, 0xac, 0x06, 0x66, 0xcb, 0x2d, 0xf0, 0x77, 0x5b, 0xdc, 0x03, 0x6e, 0xd0, 0x7d, 0x80, 0x66,
+	0x77, 0x64, 0x90, 0x59, 0x4d, 0xd0, 0xac, 0x31, 0x9a, 0x35, 0xa1, 0x13, 0x49, 0xb6, 0xb6, 0x69,
+	0xee, 0x12, 0xe9, 0x6b, 0xb4, 0x78, 0xe2, 0x9f, 0x28, 0x80, 0x5a, 0xa3, 0x4b, 0x74, 0x0b, 0x70,
+	0x8a, 0xe5, 0x66, 0x14, 0xf7, 0xa5, 0xc2, 0x13, 0x26, 0xe8, 0x41, 0x04, 0x4a, 0x2f, 0x87, 0x32,
+	0x97, 0x09, 0x45, 0x24, 0x8a, 0x60, 0x59, 0x6a, 0x69, 0x51, 0x44, 0x09, 0xe9, 0xd4, 0x7e, 0x13,
+	0x26, 0x63, 0x2e, 0xb2, 0x84, 0x2f, 0xc3, 0x10, 0xf7, 0x89, 0x68, 0x45, 0x4d, 0x2a, 0x44, 0x3a,
+	0x82, 0x13, 0xfe, 0xc6, 0x13, 0x30, 0xc6, 0xe3, 0x3e, 0xf1, 0xeb, 0xad, 0xb4, 0xe3, 0x65, 0x18,
+	0x6f, 0x5b, 0x97, 0xd9, 0xa6, 0xa1, 0x60, 0xfb, 0xf5, 0x4a, 0x40, 0x1a, 0xc3, 0x38, 0x68, 0x4b,
+	0x23, 0x3c, 0x03, 0x2a, 0xf7, 0xfa, 0x06, 0xf5, 0xcc, 0xda, 0x23, 0xeb, 0xa5, 0x6f, 0x6d, 0x5b,
+	0xde, 0x51, 0x10, 0xf3, 0x57, 0x0a, 0x4c, 0x27, 0x6e, 0xcb, 0xd0, 0xaf, 0xa1, 0x50, 0x0b, 0x16,
+	0x65, 0x3f, 0xa6, 0x22, 0xf4, 0x06, 0xc4, 0xde, 0xa3, 0x96, 0xbd, 0xba, 0xc6, 0x54, 0xff, 0xe9,
+	0xb8, 0x7c, 0xf6, 0xc8, 0xac, 0xd7, 0x6e, 0xe1, 0xd0, 0x13, 0xff, 0xee, 0xdf, 0xe5, 0xf9, 0x5d,
+	0xcb, 0xdb, 0xf3, 0xb7, 0xb4, 0x2a, 0xad, 0xeb, 0xf2, 0x44, 0x8a, 0x7f, 0xae, 0xb9, 0xdb, 0x2f,
+	0x74, 0xef, 0xc8, 0x21, 0x2e, 0x0f, 0xe2, 0x1a, 0xcd, 0x8c, 0x78, 0x05, 0x4a, 0x4d, 0x74, 0xac,
+	0x9e, 0xf6, 0x02, 0xd2, 0xbb, 0xf3, 0x6b, 0x05, 0xca, 0xa9, 0xbe, 0x9f, 0x8f, 0xea, 0x82, 0xc3,
+	0xcf, 0x11, 0x3e, 0xdb, 0x33, 0x1b, 0x24, 0x5b, 0x74, 0x3e, 0x14, 0xe3, 0x3e, 0xb2, 0x9c, 0x6f,
+	0xc3, 0x69, 0x8f, 0x2d, 0x57, 0x5c, 0xbe, 0x2e, 0x65, 0xd7, 0xa1, 0xa2, 0x69, 0x59, 0xd1, 0xa8,
+	0xa8, 0xa8, 0xd5, 0x19, 0x1b, 0x43, 0x5e, 0x33, 0x05, 0xfe, 0x81, 0xd4, 0xde, 0x33, 0x87, 0x7a,
+	0x9b, 0x0d, 0xab, 0x4a, 0xb2, 0x80, 0xa2, 0xcb, 0x30, 0xec, 0xd1, 0x17, 0xc4, 0xae, 0x58, 0x76,
+	0x65, 0x9b, 0xd8, 0xb4, 0xce, 0x4f, 0x67, 0xc1, 0x38, 0xcd, 0x57, 0x37, 0xec, 0x35, 0xb6, 0x86,
+	0x66, 0x61, 0x44, 0x58, 0x51, 0xdf, 0x93, 0x66, 0x7d, 0xdc, 0xec, 0x0c, 0x5f, 0x7e, 0xea, 0x7b,
+	0xdc, 0x0e, 0xdf, 0x84, 0x89, 0xf6, 0xfc, 0xb2, 0xe8, 0xf3, 0x00, 0xec, 0x3c, 0x55, 0x1c, 0xb6,
+	0xca, 0x31, 0x14, 0x8c, 0x82, 0x1b, 0x98, 0xe1, 0x3f, 0x28, 0x70, 0x5e, 0x78, 0x1e, 0x98, 0xce,
+	0xfa, 0xa1, 0x59, 0xf5, 0xee, 0xd6, 0xa9, 0x6f, 0x7b, 0x1b, 0x76, 0x66, 0x05, 0x8f, 0x61, 0x30,
+	0xa8, 0xa0, 0xd8, 0x9b, 0x45, 0xe5, 0xa4, 0xa4, 0x72, 0x24, 0xa0, 0x52, 0x38, 0x62, 0xe3, 0x33,
+	0x59, 0x6f, 0xee, 0x52, 0xff, 0xac, 0x40, 0x29, 0x0d, 0xb1, 0xac, 0x79, 0x13, 0x0a, 0x61, 0xa8,
+	0x6c, 0x68, 0xc5, 0xa8, 0x6e, 0x43, 0x4f, 0x6c, 0x0c, 0x06, 0x99, 0xd1, 0x1d, 0xe8, 0xdb, 0x21,
+	0xa4, 0xd8, 0x97, 0x15, 0x0b, 0xc9, 0x58, 0x20, 0x62, 0xed, 0x10, 0x82, 0x0d, 0xe6, 0x89, 0x1f,
+	0x41, 0x81, 0xe1, 0x35, 0xa8, 0xef, 0xa5, 0x5f, 0x5d, 0x49, 0x1c, 0xf4, 0x26, 0x71, 0xf0, 0x1b,
+	0x05, 0x66, 0x38, 0x07, 0xeb, 0xae, 0x67, 0xd5, 0x4d, 0x8f, 0x3c, 0xf6, 0x6b, 0x9e, 0xf5, 0x90,
+	0x3a, 0x41, 0xd3, 0x6e, 0xc2, 0x40, 0x83, 0xa5, 0x72, 0xc3, 0x63, 0xdb, 0xf6, 0x6d, 0x0d, 0xc1,
+	0x04, 0x57, 0xb1, 0x30, 0x3f, 0xe1, 0xa6, 0xe2, 0xdf, 0xf7, 0xc2, 0xf9, 0x14, 0xa0, 0x49, 0xbd,
+	0x52, 0x4e, 0xa2, 0x57, 0xdf, 0x82, 0x11, 0xb2, 0xb3, 0x43, 0xaa, 0x9e, 0xb5, 0x4f, 0xa4, 0xec,
+	0x39, 0x89, 0xab, 0x1a, 0x73, 0xfe, 0xd7, 0x71, 0x79, 0x36, 0xc7, 0xc7, 0x68, 0x8d, 0x54, 0x8d,
+	0xe1, 0x30, 0x0c, 0x3f, 0x2b, 0xe8, 0x7b, 0x30, 0x56, 0xf5, 0xeb, 0x7e, 0xcd, 0xe4, 0x91, 0x77,
+	0x08, 0xa9, 0x34, 0xd8, 0xe5, 0x58, 0xec, 0xfb, 0xbf, 0xa2, 0xa3, 0x66, 0xac, 0xfb, 0x84, 0x18,
+	0x2c, 0x12, 0xfe, 0x53, 0x8a, 0xb6, 0x9f, 0xfa, 0x5e, 0xe6, 0x71, 0x3c, 0x79, 0xd1, 0xc7, 0x3f,
+	0x51, 0x7d, 0xf1, 0x4f, 0x14, 0x76, 0xa0, 0x9c, 0x0a, 0x59, 0xf6, 0xf8, 0x84, 0x45, 0xf5, 0x97,
+	0xe0, 0x9b, 0xf5, 0x55, 0x6a, 0xd9, 0xdd, 0x7d, 0xb3, 0x5e, 0x49, 0x92, 0x5c, 0x01, 0xa5, 0xbb,
+	0x1b, 0x2d, 0xf4, 0xec, 0xee, 0x46, 0x13, 0xb5, 0xbb, 0x1b, 0x36, 0x7e, 0xd3, 0x0b, 0xa5, 0x34,
+	0xe0, 0x92, 0x2a, 0x07, 0x46, 0x38, 0x72, 0x71, 0xcb, 0x84, 0x87, 0xa2, 0xb0, 0xfa, 0xb0, 0x0b,
+	0x79, 0x6d, 0xd8, 0xde, 0xa7, 0xe3, 0xf2, 0x84, 0x40, 0xdd, 0x16, 0x0e, 0x1b, 0x67, 0xd8, 0x8a,
+	0xb8, 0xb7, 0x58, 0x97, 0x5f, 0x41, 0xa1, 0x41, 0xea, 0x15, 0xf6, 0xe2, 0x77, 0xbb, 0xa6, 0x24,
+	0xf4, 0xec, 0x92, 0x92, 0x06, 0xa9, 0xf3, 0x5f, 0x78, 0x25, 0x99, 0x91, 0x1c, 0x82, 0xc7, 0x17,
+	0xa1, 0x9c, 0xea, 0x2a, 0xd8, 0xc4, 0xbf, 0x0d, 0x94, 0xb2, 0x7e, 0x68, 0x79, 0xdd, 0x29, 0xa5,
+	0x0e, 0xc3, 0xad, 0xcc, 0x49, 0xe5, 0x16, 0x56, 0x1f, 0x74, 0xdd, 0x87, 0xf1, 0x78, 0x1f, 0x98,
+	0x9c, 0x4f, 0x37, 0xdb, 0xb0, 0x61, 0xe3, 0x9f, 0x07, 0xd2, 0x48, 0x40, 0x2a, 0xa5, 0xf1, 0x43,
+	0x00, 0xa9, 0x40, 0xa1, 0x8a, 0x8c, 0x4e, 0xad, 0xcb, 0x4e, 0x9d, 0x8b, 0x88, 0x97, 0x29, 0xa0,
+	0xbb, 0xf7, 0x98, 0x70, 0x64, 0x4a, 0xb1, 0xa1, 0x7f, 0x87, 0x90, 0x1c, 0x22, 0xb9, 0x23, 0x53,
+	0x0f, 0x85, 0xb7, 0x60, 0x97, 0xfa, 0xe0, 0x79, 0xf0, 0x4a, 0x32, 0x25, 0xdd, 0x68, 0x23, 0xc9,
+	0x55, 0x6a, 0x63, 0x19, 0xa6, 0xc2, 0xf1, 0xe4, 0x6e, 0xad, 0x46, 0x0f, 0x6a, 0x96, 0x9b, 0x1d,
+	0xf8, 0x16, 0xa8, 0x49, 0x5e, 0xb2, 0x45, 0x33, 0x50, 0x30, 0xb7, 0xb7, 0x1b, 0xc4, 0x75, 0xe5,
+	0xcd, 0x5b, 0x30, 0x9a, 0x0b, 0xd7, 0xff, 0x39, 0x0e, 0xa7, 0xb8, 0x33, 0xb2, 0x61, 0x40, 0x0c,
+	0x33, 0x08, 0xb7, 0x5f, 0xcc, 0xf1, 0x59, 0x5b, 0xbd, 0xd4, 0xd1, 0x46, 0x96, 0x33, 0xfd, 0xa3,
+	0xbf, 0xff, 0xf7, 0x6d, 0xef, 0x38, 0x1a, 0xd5, 0x5b, 0x47, 0x7d, 0x31, 0x60, 0x31, 0xe9, 0x34,
+	0x27, 0x68, 0x34, 0x9b, 0x1c, 0xaf, 0x7d, 0xf8, 0x56, 0xe7, 0x32, 0xed, 0x64, 0xee, 0x0b, 0x3c,
+	0xb7, 0x8a, 0x8a, 0xd1, 0xdc, 0x8c, 0x40, 0x5b, 0xa4, 0xdc, 0x81, 0x7e, 0xe6, 0x87, 0x2e, 0xa4,
+	0x86, 0x0c, 0x92, 0x5e, 0xec, 0x60, 0x21, 0xd3, 0x4d, 0xf1, 0x74, 0xa3, 0xe8, 0x5c, 0x2c, 0x1d,
+	0x7a, 0x0e, 0xa7, 0x36, 0xf9, 0xe0, 0x9b, 0x1e, 0x26, 0xa4, 0x15, 0x77, 0x32, 0x91, 0xa9, 0x54,
+	0x9e, 0x6a, 0x0c, 0xa1, 0x58, 0x2a, 0x17, 0xfd, 0x54, 0x11, 0xac, 0xca, 0x4e, 0xa6, 0xb3, 0x1a,
+	0xed, 0xe6, 0x5c, 0xa6, 0x9d, 0xcc, 0xbd, 0xc8, 0x73, 0x5f, 0x41, 0x97, 0xe2, 0xb9, 0xf5, 0xef,
+	0x4b, 0x75, 0xbe, 0x0e, 0x3a, 0x7c, 0x00, 0x83, 0xc1, 0xdc, 0x8b, 0x2e, 0x27, 0x66, 0x68, 0x1b,
+	0x97, 0xd5, 0x2b, 0x19, 0x56, 0x12, 0x45, 0x89, 0xa3, 0x28, 0xa2, 0x89, 0x08, 0x8a, 0x70, 0x9e,
+	0x46, 0x3f, 0x53, 0x60, 0x38, 0x3a, 0x1c, 0xa3, 0x85, 0xc4, 0xc8, 0x89, 0x03, 0xb6, 0xba, 0x98,
+	0xcb, 0x56, 0x62, 0xb9, 0xcc, 0xb1, 0x94, 0xd0, 0x4c, 0x04, 0x8b, 0x18, 0xcb, 0xc2, 0xb1, 0x11,
+	0xfd, 0x51, 0x01, 0x14, 0x1f, 0x6a, 0x91, 0x96, 0x9e, 0x29, 0x69, 0x72, 0x56, 0xf5, 0xdc, 0xf6,
+	0x12, 0xdd, 0x0a, 0x47, 0x77, 0x03, 0x2d, 0x75, 0xec, 0x97, 0x40, 0xcb, 0xff, 0x6c, 0x42, 0x7e,
+	0xab, 0xc0, 0x50, 0xcb, 0xc4, 0x8a, 0xe6, 0xd2, 0x73, 0x47, 0xe6, 0x60, 0x75, 0x3e, 0xdb, 0x50,
+	0xa2, 0x5b, 0xe2, 0xe8, 0x16, 0xd1, 0xd5, 0x1c, 0xe8, 0xc4, 0x2d, 0x85, 0x7e, 0xac, 0x40, 0x21,
+	0x1c, 0x28, 0x51, 0xb2, 0x5e, 0xda, 0x07, 0x5e, 0x75, 0x36, 0xcb, 0xac, 0x3b, 0x75, 0x33, 0x1f,
+	0x17, 0xfd, 0x55, 0x81, 0xa9, 0x60, 0x82, 0x88, 0x8d, 0x7d, 0xe8, 0x5a, 0x72, 0xca, 0x94, 0x81,
+	0x56, 0xd5, 0xf2, 0x9a, 0x4b, 0xa4, 0x5f, 0xe1, 0x48, 0xbf, 0x84, 0x96, 0x23, 0x48, 0x9b, 0x18,
+	0x89, 0x04, 0xa6, 0xbb, 0x07, 0xa6, 0x53, 0x21, 0x2c, 0x46, 0xc5, 0xe4, 0x41, 0x2a, 0x96, 0x8d,
+	0xfe, 0xa6, 0x80, 0x9a, 0x02, 0x9d, 0xdd, 0xa9, 0xb9, 0xc0, 0x34, 0x6f, 0x3c, 0x55, 0xcf, 0x6d,
+	0x2f, 0xd1, 0xdf, 0xe6, 0xe8, 0x6f, 0xa2, 0x2f, 0x76, 0x8f, 0x9e, 0xfa, 0x1e, 0xfa, 0xa5, 0x02,
+	0x67, 0xdb, 0x67, 0x37, 0xf4, 0x85, 0x44, 0x10, 0x29, 0xb3, 0xa8, 0x7a, 0x2d, 0xa7, 0xb5, 0x04,
+	0x3c, 0xc7, 0x01, 0x5f, 0x44, 0xe5, 0x08, 0xe0, 0x10, 0x66, 0x9d, 0xd9, 0x57, 0xf6, 0xa8, 0x13,
+	0x11, 0x45, 0xec, 0x41, 0x9d, 0x22, 0x8a, 0xb4, 0x89, 0x41, 0xd5, 0xf2, 0x9a, 0x77, 0x2b, 0x8a,
+	0xe7, 0xd4, 0xb2, 0x3b, 0x8a, 0x22, 0xfe, 0x7c, 0x45, 0xb9, 0xc0, 0x64, 0x8a, 0xa2, 0xc3, 0xbb,
+	0x38, 0xb7, 0x28, 0xe2, 0xe8, 0x99, 0x28, 0x5a, 0x99, 0x8f, 0xbd, 0x57, 0x53, 0x98, 0x4f, 0x7b,
+	0x81, 0xab, 0x5a, 0x5e, 0xf3, 0x6e, 0x99, 0x27, 0x87, 0x96, 0xd7, 0x91, 0xf9, 0xf8, 0xe3, 0x10,
+	0xe5, 0x02, 0x93, 0xc9, 0x7c, 0x87, 0x57, 0x67, 0x6e, 0xe6, 0xe3, 0xe8, 0x19, 0xf3, 0xbf, 0x50,
+	0xe0, 0x4c, 0xe4, 0xe9, 0x89, 0xae, 0xa6, 0x3e, 0x27, 0xda, 0x1f, 0xb5, 0xea, 0x42, 0x1e, 0x53,
+	0x89, 0x53, 0xe3, 0x38, 0xe7, 0xd1, 0x6c, 0xc7, 0xcf, 0xb3, 0x19, 0xf8, 0xad, 0xae, 0xbd, 0xfb,
+	0x50, 0x52, 0xde, 0x7f, 0x28, 0x29, 0xff, 0xf9, 0x50, 0x52, 0xde, 0x7c, 0x2c, 0xf5, 0xbc, 0xff,
+	0x58, 0xea, 0xf9, 0xc7, 0xc7, 0x52, 0xcf, 0x77, 0x16, 0x5a, 0x5e, 0xfd, 0x4f, 0x78, 0xac, 0x7b,
+	0x7b, 0xa6, 0x65, 0x07, 0x71, 0x0f, 0x45, 0x64, 0xfe, 0xfa, 0xdf, 0x1a, 0xe0, 0xff, 0xe5, 0x74,
+	0xe3, 0x7f, 0x03, 0x00, 0x51, 0xfd, 0x18, 0x26, 0x58, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1672,6 +1934,9 @@ type QueryClient interface {
 	// Estimates the amount of tokens required to return the exact amount of
 	// assets requested.
 	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+	// Estimates the amount of tokens returned given an exact amount of tokens to
+	// swap through several pools in turn.
+	EstimateMultiHop(ctx context.Context, in *QueryEstimateMultiHopRequest, opts ...grpc.CallOption) (*QueryEstimateMultiHopResponse, error)
 	// Estimates the amount of pool shares returned given an amount of tokens to
 	// join.
 	EstimateJoinExactAmountIn(ctx context.Context, in *QueryJoinExactAmountInRequest, opts ...grpc.CallOption) (*QueryJoinExactAmountInResponse, error)
@@ -1684,6 +1949,9 @@ type QueryClient interface {
 	// Estimates the amount of pool shares required to extract an exact amount of
 	// tokens from the pool.
 	EstimateExitExactAmountOut(ctx context.Context, in *QueryExitExactAmountOutRequest, opts ...grpc.CallOption) (*QueryExitExactAmountOutResponse, error)
+	// Addresses allowed to swap with and join a pool. An empty list means that
+	// the pool is open to everyone.
+	PoolAllowlist(ctx context.Context, in *QueryPoolAllowlistRequest, opts ...grpc.CallOption) (*QueryPoolAllowlistResponse, error)
 }
 
 type queryClient struct {
@@ -1802,6 +2070,15 @@ func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QueryS
 	return out, nil
 }
 
+func (c *queryClient) EstimateMultiHop(ctx context.Context, in *QueryEstimateMultiHopRequest, opts ...grpc.CallOption) (*QueryEstimateMultiHopResponse, error) {
+	out := new(QueryEstimateMultiHopResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.spot.v1.Query/EstimateMultiHop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EstimateJoinExactAmountIn(ctx context.Context, in *QueryJoinExactAmountInRequest, opts ...grpc.CallOption) (*QueryJoinExactAmountInResponse, error) {
 	out := new(QueryJoinExactAmountInResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.spot.v1.Query/EstimateJoinExactAmountIn", in, out, opts...)
@@ -1838,6 +2115,15 @@ func (c *queryClient) EstimateExitExactAmountOut(ctx context.Context, in *QueryE
 	return out, nil
 }
 
+func (c *queryClient) PoolAllowlist(ctx context.Context, in *QueryPoolAllowlistRequest, opts ...grpc.CallOption) (*QueryPoolAllowlistResponse, error) {
+	out := new(QueryPoolAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.spot.v1.Query/PoolAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters of the spot module.
@@ -1866,6 +2152,9 @@ type QueryServer interface {
 	// Estimates the amount of tokens required to return the exact amount of
 	// assets requested.
 	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+	// Estimates the amount of tokens returned given an exact amount of tokens to
+	// swap through several pools in turn.
+	EstimateMultiHop(context.Context, *QueryEstimateMultiHopRequest) (*QueryEstimateMultiHopResponse, error)
 	// Estimates the amount of pool shares returned given an amount of tokens to
 	// join.
 	EstimateJoinExactAmountIn(context.Context, *QueryJoinExactAmountInRequest) (*QueryJoinExactAmountInResponse, error)
@@ -1878,6 +2167,9 @@ type QueryServer interface {
 	// Estimates the amount of pool shares required to extract an exact amount of
 	// tokens from the pool.
 	EstimateExitExactAmountOut(context.Context, *QueryExitExactAmountOutRequest) (*QueryExitExactAmountOutResponse, error)
+	// Addresses allowed to swap with and join a pool. An empty list means that
+	// the pool is open to everyone.
+	PoolAllowlist(context.Context, *QueryPoolAllowlistRequest) (*QueryPoolAllowlistResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1920,6 +2212,9 @@ func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context,
 func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
 }
+func (*UnimplementedQueryServer) EstimateMultiHop(ctx context.Context, req *QueryEstimateMultiHopRequest) (*QueryEstimateMultiHopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateMultiHop not implemented")
+}
 func (*UnimplementedQueryServer) EstimateJoinExactAmountIn(ctx context.Context, req *QueryJoinExactAmountInRequest) (*QueryJoinExactAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateJoinExactAmountIn not implemented")
 }
@@ -1932,6 +2227,9 @@ func (*UnimplementedQueryServer) EstimateExitExactAmountIn(ctx context.Context,
 func (*UnimplementedQueryServer) EstimateExitExactAmountOut(ctx context.Context, req *QueryExitExactAmountOutRequest) (*QueryExitExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateExitExactAmountOut not implemented")
 }
+func (*UnimplementedQueryServer) PoolAllowlist(ctx context.Context, req *QueryPoolAllowlistRequest) (*QueryPoolAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAllowlist not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2153,6 +2451,24 @@ func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateMultiHop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateMultiHopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateMultiHop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.spot.v1.Query/EstimateMultiHop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateMultiHop(ctx, req.(*QueryEstimateMultiHopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EstimateJoinExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryJoinExactAmountInRequest)
 	if err := dec(in); err != nil {
@@ -2225,6 +2541,24 @@ func _Query_EstimateExitExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.spot.v1.Query/PoolAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAllowlist(ctx, req.(*QueryPoolAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.spot.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2277,6 +2611,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateSwapExactAmountOut",
 			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "EstimateMultiHop",
+			Handler:    _Query_EstimateMultiHop_Handler,
+		},
 		{
 			MethodName: "EstimateJoinExactAmountIn",
 			Handler:    _Query_EstimateJoinExactAmountIn_Handler,
@@ -2293,6 +2631,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateExitExactAmountOut",
 			Handler:    _Query_EstimateExitExactAmountOut_Handler,
 		},
+		{
+			MethodName: "PoolAllowlist",
+			Handler:    _Query_PoolAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/spot/v1/query.proto",
@@ -3012,7 +3354,7 @@ func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+func (m *SwapRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3022,33 +3364,23 @@ func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapRoute) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3057,7 +3389,7 @@ func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryEstimateMultiHopRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3067,12 +3399,12 @@ func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEstimateMultiHopRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEstimateMultiHopRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3087,10 +3419,24 @@ func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	}
 	i--
 	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryJoinExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryEstimateMultiHopResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3100,22 +3446,153 @@ func (m *QueryJoinExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryJoinExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEstimateMultiHopResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryJoinExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEstimateMultiHopResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokensIn) > 0 {
-		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	{
+		size := m.CumulativeFeeRatio.Size()
+		i -= size
+		if _, err := m.CumulativeFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EffectivePrice.Size()
+		i -= size
+		if _, err := m.EffectivePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJoinExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJoinExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJoinExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
 				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
@@ -3370,6 +3847,66 @@ func (m *QueryExitExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPoolAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3663,6 +4200,54 @@ func (m *QuerySwapExactAmountInResponse) Size() (n int) {
 	return n
 }
 
+func (m *SwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateMultiHopRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateMultiHopResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectivePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeFeeRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3804,6 +4389,33 @@ func (m *QueryExitExactAmountOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPoolAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5601,7 +6213,7 @@ func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *SwapRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5624,10 +6236,10 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5651,9 +6263,9 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5663,13 +6275,382 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateMultiHopRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateMultiHopRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateMultiHopRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateMultiHopResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateMultiHopResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateMultiHopResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectivePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectivePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -6498,6 +7479,157 @@ func (m *QueryExitExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPoolAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,11 +2,107 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/math"
 )
 
+/*
+CalcJoinPoolShares Calculates the number of LP shares minted for depositing
+tokensIn into the pool, and the coins left over that could not be added at the
+current ratio of the pool assets. The minted shares are in the
+GetPoolShareBaseDenom(pool.Id) denom.
+
+Note that this function is pure/read-only. The pool is not modified.
+
+args:
+  - tokensIn: the tokens to add to the pool, all of them pool assets
+
+ret:
+  - numShares: the number of LP shares minted for the deposit
+  - remCoins: the coins remaining after the deposit
+  - err: error if any
+*/
+func (pool Pool) CalcJoinPoolShares(tokensIn sdk.Coins) (
+	numShares sdkmath.Int, remCoins sdk.Coins, err error,
+) {
+	if tokensIn.Empty() || !tokensIn.IsAllPositive() {
+		return sdk.ZeroInt(), sdk.Coins{}, ErrInvalidTokenIn.Wrapf("tokens in must be positive: %s", tokensIn)
+	}
+	if !pool.AreTokensInDenomInPoolAssets(tokensIn) {
+		return sdk.ZeroInt(), sdk.Coins{}, ErrTokenDenomNotFound.Wrapf("tokens in: %s", tokensIn)
+	}
+
+	switch {
+	case pool.TotalShares.Amount.IsZero():
+		// Mint the initial 100.000000000000000000 pool share tokens to the sender
+		return InitPoolSharesSupply, sdk.Coins{}, nil
+	case pool.PoolParams.PoolType == PoolType_STABLESWAP:
+		numShares, err = pool.numSharesOutFromTokensInStableSwap(tokensIn)
+		return numShares, sdk.Coins{}, err
+	default:
+		return pool.numSharesOutFromTokensIn(tokensIn)
+	}
+}
+
+/*
+CalcJoinPoolSharesSingleAsset Calculates the number of LP shares minted for
+depositing a single asset into a balancer pool. The deposit is treated as a
+swap of part of tokenIn into the other pool assets followed by a balanced join,
+and the swap fee is charged on the implicitly swapped portion only.
+
+Note that this function is pure/read-only. The pool is not modified.
+
+args:
+  - tokenIn: the token to add to the pool, a pool asset
+  - swapFee: the fee charged on the swapped portion of tokenIn, in [0, 1)
+
+ret:
+  - numShares: the number of LP shares minted for the deposit
+  - err: error if any
+*/
+func (pool Pool) CalcJoinPoolSharesSingleAsset(tokenIn sdk.Coin, swapFee sdk.Dec) (
+	numShares sdkmath.Int, err error,
+) {
+	if pool.PoolParams.PoolType != PoolType_BALANCER {
+		return sdk.ZeroInt(), ErrInvalidPoolType
+	}
+	if tokenIn.Amount.IsNil() || !tokenIn.Amount.IsPositive() {
+		return sdk.ZeroInt(), ErrInvalidTokenIn.Wrapf("token in must be positive: %s", tokenIn)
+	}
+	if swapFee.IsNil() || swapFee.IsNegative() || swapFee.GTE(sdk.OneDec()) {
+		return sdk.ZeroInt(), ErrInvalidSwapFee.Wrapf("swap fee must be in [0, 1), got %s", swapFee)
+	}
+
+	_, poolAssetIn, err := pool.getPoolAssetAndIndex(tokenIn.Denom)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if !poolAssetIn.Token.Amount.IsPositive() {
+		return sdk.ZeroInt(), fmt.Errorf("pool has no %s liquidity", tokenIn.Denom)
+	}
+	if !pool.TotalShares.Amount.IsPositive() {
+		return sdk.ZeroInt(), errors.New("pool has no shares to join at")
+	}
+
+	sharesOut, err := math.SolvePoolSharesOutGivenSingleAssetIn(
+		/*tokenBalanceIn=*/ sdk.NewDecFromInt(poolAssetIn.Token.Amount),
+		/*tokenWeightIn=*/ sdk.NewDecFromInt(poolAssetIn.Weight),
+		/*totalWeight=*/ sdk.NewDecFromInt(pool.TotalWeight),
+		/*totalShares=*/ sdk.NewDecFromInt(pool.TotalShares.Amount),
+		/*tokenAmountIn=*/ sdk.NewDecFromInt(tokenIn.Amount),
+		/*swapFee=*/ swapFee,
+	)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return sharesOut.TruncateInt(), nil
+}
+
 /*
 Takes a pool and the amount of tokens desired to add to the pool,
 and calculates the number of pool shares and remaining coins after theoretically
@@ -171,17 +267,117 @@ ret:
 func (pool Pool) TokensOutFromPoolSharesIn(numSharesIn sdkmath.Int) (
 	tokensOut sdk.Coins, fees sdk.Coins, err error,
 ) {
-	if numSharesIn.IsZero() {
+	return pool.tokensOutFromPoolSharesIn(numSharesIn, pool.PoolParams.ExitFee)
+}
+
+/*
+CalcExitPoolShares Calculates the tokens owed for burning numSharesIn LP shares,
+net of an exit fee charged on the share of the pool being withdrawn.
+
+Note that this function is pure/read-only. The pool is not modified.
+
+args:
+  - numSharesIn: number of LP shares to burn, at most the pool's total shares
+  - exitFee: the fraction of the withdrawn tokens kept by the pool, in [0, 1)
+
+ret:
+  - tokensOut: the tokens withdrawn from the pool
+  - err: error if any
+*/
+func (pool Pool) CalcExitPoolShares(numSharesIn sdkmath.Int, exitFee sdk.Dec) (
+	tokensOut sdk.Coins, err error,
+) {
+	tokensOut, _, err = pool.tokensOutFromPoolSharesIn(numSharesIn, exitFee)
+	return tokensOut, err
+}
+
+/*
+CalcExitPoolSharesSingleAsset Calculates the amount of a single pool asset owed
+for burning numSharesIn LP shares of a balancer pool. The exit is treated as a
+balanced exit followed by a swap of the other pool assets into tokenOutDenom,
+and the exit fee is charged on the implicitly swapped portion only.
+
+Note that this function is pure/read-only. The pool is not modified.
+
+args:
+  - numSharesIn: number of LP shares to burn, less than the pool's total shares
+  - tokenOutDenom: the denom of the pool asset to withdraw
+  - exitFee: the fee charged on the swapped portion of the output, in [0, 1)
+
+ret:
+  - tokenOut: the token withdrawn from the pool
+  - err: error if any
+*/
+func (pool Pool) CalcExitPoolSharesSingleAsset(
+	numSharesIn sdkmath.Int, tokenOutDenom string, exitFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	if pool.PoolParams.PoolType != PoolType_BALANCER {
+		return sdk.Coin{}, ErrInvalidPoolType
+	}
+	if numSharesIn.IsNil() || !numSharesIn.IsPositive() {
+		return sdk.Coin{}, errors.New("num shares in must be greater than zero")
+	}
+	if numSharesIn.GTE(pool.TotalShares.Amount) {
+		return sdk.Coin{}, fmt.Errorf("num shares in %s must be less than the pool's total shares %s", numSharesIn, pool.TotalShares.Amount)
+	}
+	if exitFee.IsNil() || exitFee.IsNegative() || exitFee.GTE(sdk.OneDec()) {
+		return sdk.Coin{}, ErrInvalidExitFee.Wrapf("exit fee must be in [0, 1), got %s", exitFee)
+	}
+
+	_, poolAssetOut, err := pool.getPoolAssetAndIndex(tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOutDec, err := math.SolveTokenOutGivenPoolSharesIn(
+		/*tokenBalanceOut=*/ sdk.NewDecFromInt(poolAssetOut.Token.Amount),
+		/*tokenWeightOut=*/ sdk.NewDecFromInt(poolAssetOut.Weight),
+		/*totalWeight=*/ sdk.NewDecFromInt(pool.TotalWeight),
+		/*totalShares=*/ sdk.NewDecFromInt(pool.TotalShares.Amount),
+		/*sharesIn=*/ sdk.NewDecFromInt(numSharesIn),
+		/*exitFee=*/ exitFee,
+	)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	tokenOutAmt := tokenOutDec.TruncateInt()
+
+	if !tokenOutAmt.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("not enough pool shares to withdraw %s", tokenOutDenom)
+	}
+	if tokenOutAmt.GTE(poolAssetOut.Token.Amount) {
+		return sdk.Coin{}, fmt.Errorf("pool must retain positive %s reserves", tokenOutDenom)
+	}
+
+	return sdk.NewCoin(tokenOutDenom, tokenOutAmt), nil
+}
+
+func (pool Pool) tokensOutFromPoolSharesIn(numSharesIn sdkmath.Int, exitFee sdk.Dec) (
+	tokensOut sdk.Coins, fees sdk.Coins, err error,
+) {
+	if numSharesIn.IsNil() || !numSharesIn.IsPositive() {
 		return nil, nil, errors.New("num shares in must be greater than zero")
 	}
+	if numSharesIn.GT(pool.TotalShares.Amount) {
+		return nil, nil, fmt.Errorf("num shares in %s exceeds the pool's total shares %s", numSharesIn, pool.TotalShares.Amount)
+	}
+	if exitFee.IsNil() || exitFee.IsNegative() || exitFee.GTE(sdk.OneDec()) {
+		return nil, nil, fmt.Errorf("exit fee must be in [0, 1), got %s", exitFee)
+	}
+
+	if len(pool.PoolAssets) == 0 {
+		return nil, nil, errors.New("pool has no assets")
+	}
+	for _, poolAsset := range pool.PoolAssets {
+		if !poolAsset.Token.Amount.IsPositive() {
+			return nil, nil, fmt.Errorf("pool has no %s liquidity", poolAsset.Token.Denom)
+		}
+	}
 
 	shareRatio := sdk.NewDecFromInt(numSharesIn).QuoInt(pool.TotalShares.Amount)
 	if shareRatio.IsZero() {
 		return nil, nil, errors.New("share ratio must be greater than zero")
 	}
-	if shareRatio.GT(sdk.OneDec()) {
-		return nil, nil, errors.New("share ratio cannot be greater than one")
-	}
 
 	poolLiquidity := pool.PoolBalances()
 	tokensOut = make(sdk.Coins, len(poolLiquidity))
@@ -190,7 +386,7 @@ func (pool Pool) TokensOutFromPoolSharesIn(numSharesIn sdkmath.Int) (
 		// tokenOut = shareRatio * poolTokenAmt * (1 - exitFee)
 		tokenAmount := shareRatio.MulInt(coin.Amount)
 		tokenOutAmt := tokenAmount.Mul(
-			sdk.OneDec().Sub(pool.PoolParams.ExitFee),
+			sdk.OneDec().Sub(exitFee),
 		).TruncateInt()
 		tokensOut[i] = sdk.NewCoin(coin.Denom, tokenOutAmt)
 		fees[i] = sdk.NewCoin(coin.Denom, tokenAmount.TruncateInt().Sub(tokenOutAmt))
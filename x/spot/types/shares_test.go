@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
 )
 
 func TestMaximalSharesFromExactRatioJoin(t *testing.T) {
@@ -497,3 +499,409 @@ func TestNumSharesOutStableswap(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcJoinPoolShares(t *testing.T) {
+	newPool := func() Pool {
+		return Pool{
+			Id:      1,
+			Address: "some_address",
+			PoolParams: PoolParams{
+				PoolType: PoolType_BALANCER,
+				SwapFee:  sdk.ZeroDec(),
+			},
+			PoolAssets: []PoolAsset{
+				{
+					Token:  sdk.NewInt64Coin("aaa", 100),
+					Weight: sdk.OneInt(),
+				},
+				{
+					Token:  sdk.NewInt64Coin("bbb", 200),
+					Weight: sdk.OneInt(),
+				},
+			},
+			TotalWeight: sdk.NewInt(2),
+			TotalShares: sdk.NewInt64Coin(GetPoolShareBaseDenom(1), 100),
+		}
+	}
+
+	for _, tc := range []struct {
+		name              string
+		tokensIn          sdk.Coins
+		expectedNumShares sdkmath.Int
+		expectedRemCoins  sdk.Coins
+		expectedErr       error
+	}{
+		{
+			name: "balanced deposit",
+			tokensIn: sdk.NewCoins(
+				sdk.NewInt64Coin("aaa", 10),
+				sdk.NewInt64Coin("bbb", 20),
+			),
+			expectedNumShares: sdk.NewInt(10),
+			expectedRemCoins:  sdk.Coins{},
+		},
+		{
+			name: "imbalanced deposit",
+			tokensIn: sdk.NewCoins(
+				sdk.NewInt64Coin("aaa", 10),
+				sdk.NewInt64Coin("bbb", 50),
+			),
+			expectedNumShares: sdk.NewInt(10),
+			expectedRemCoins: sdk.NewCoins(
+				sdk.NewInt64Coin("bbb", 30),
+			),
+		},
+		{
+			name: "denom not in pool",
+			tokensIn: sdk.NewCoins(
+				sdk.NewInt64Coin("aaa", 10),
+				sdk.NewInt64Coin("ccc", 20),
+			),
+			expectedErr: ErrTokenDenomNotFound,
+		},
+		{
+			name:        "no tokens",
+			tokensIn:    sdk.NewCoins(),
+			expectedErr: ErrInvalidTokenIn,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := newPool()
+			numShares, remCoins, err := pool.CalcJoinPoolShares(tc.tokensIn)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNumShares, numShares)
+			require.Equal(t, tc.expectedRemCoins, remCoins)
+
+			// the pool is left untouched
+			require.Equal(t, newPool(), pool)
+		})
+	}
+}
+
+func TestCalcExitPoolShares(t *testing.T) {
+	newPool := func() Pool {
+		return Pool{
+			Id:      1,
+			Address: "some_address",
+			PoolParams: PoolParams{
+				PoolType: PoolType_BALANCER,
+				SwapFee:  sdk.ZeroDec(),
+				ExitFee:  sdk.ZeroDec(),
+			},
+			PoolAssets: []PoolAsset{
+				{
+					Token:  sdk.NewInt64Coin("aaa", 100*common.TO_MICRO),
+					Weight: sdk.OneInt(),
+				},
+				{
+					Token:  sdk.NewInt64Coin("bbb", 200*common.TO_MICRO),
+					Weight: sdk.OneInt(),
+				},
+			},
+			TotalWeight: sdk.NewInt(2),
+			TotalShares: sdk.NewInt64Coin(GetPoolShareBaseDenom(1), 100*common.TO_MICRO),
+		}
+	}
+	// a quarter of the pool, so that the exiting shares are exactly a fifth of
+	// the total and the share ratio does not truncate
+	deposit := sdk.NewCoins(
+		sdk.NewInt64Coin("aaa", 25*common.TO_MICRO),
+		sdk.NewInt64Coin("bbb", 50*common.TO_MICRO),
+	)
+
+	t.Run("join then exit round-trips minus the exit fee", func(t *testing.T) {
+		for _, tc := range []struct {
+			exitFee           sdk.Dec
+			expectedTokensOut sdk.Coins
+		}{
+			{
+				exitFee:           sdk.ZeroDec(),
+				expectedTokensOut: deposit,
+			},
+			{
+				exitFee: sdk.MustNewDecFromStr("0.01"),
+				expectedTokensOut: sdk.NewCoins(
+					sdk.NewInt64Coin("aaa", 24_750_000),
+					sdk.NewInt64Coin("bbb", 49_500_000),
+				),
+			},
+		} {
+			pool := newPool()
+			numShares, remCoins, err := pool.AddTokensToPool(deposit)
+			require.NoError(t, err)
+			require.Empty(t, remCoins)
+
+			tokensOut, err := pool.CalcExitPoolShares(numShares, tc.exitFee)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokensOut, tokensOut)
+		}
+	})
+
+	for _, tc := range []struct {
+		name        string
+		modifyPool  func(pool *Pool)
+		numSharesIn sdkmath.Int
+		exitFee     sdk.Dec
+	}{
+		{
+			name:        "more shares than the pool has",
+			numSharesIn: sdk.NewInt(100*common.TO_MICRO + 1),
+			exitFee:     sdk.ZeroDec(),
+		},
+		{
+			name:        "zero shares",
+			numSharesIn: sdk.ZeroInt(),
+			exitFee:     sdk.ZeroDec(),
+		},
+		{
+			name:        "exit fee of one",
+			numSharesIn: sdk.NewInt(1 * common.TO_MICRO),
+			exitFee:     sdk.OneDec(),
+		},
+		{
+			name: "pool without liquidity",
+			modifyPool: func(pool *Pool) {
+				pool.PoolAssets[0].Token.Amount = sdk.ZeroInt()
+			},
+			numSharesIn: sdk.NewInt(1 * common.TO_MICRO),
+			exitFee:     sdk.ZeroDec(),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := newPool()
+			if tc.modifyPool != nil {
+				tc.modifyPool(&pool)
+			}
+			_, err := pool.CalcExitPoolShares(tc.numSharesIn, tc.exitFee)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCalcJoinPoolSharesSingleAsset(t *testing.T) {
+	newPool := func() Pool {
+		return Pool{
+			Id:      1,
+			Address: "some_address",
+			PoolParams: PoolParams{
+				PoolType: PoolType_BALANCER,
+				SwapFee:  sdk.ZeroDec(),
+				ExitFee:  sdk.ZeroDec(),
+			},
+			PoolAssets: []PoolAsset{
+				{
+					Token:  sdk.NewInt64Coin("aaa", 100*common.TO_MICRO),
+					Weight: sdk.OneInt(),
+				},
+				{
+					Token:  sdk.NewInt64Coin("bbb", 200*common.TO_MICRO),
+					Weight: sdk.OneInt(),
+				},
+			},
+			TotalWeight: sdk.NewInt(2),
+			TotalShares: sdk.NewInt64Coin(GetPoolShareBaseDenom(1), 100*common.TO_MICRO),
+		}
+	}
+
+	t.Run("single asset join mints fewer shares than a balanced join of the same value", func(t *testing.T) {
+		pool := newPool()
+
+		// 1 aaa is worth 2 bbb, so both deposits are worth 4 bbb
+		balancedShares, remCoins, err := pool.CalcJoinPoolShares(sdk.NewCoins(
+			sdk.NewInt64Coin("aaa", 1*common.TO_MICRO),
+			sdk.NewInt64Coin("bbb", 2*common.TO_MICRO),
+		))
+		require.NoError(t, err)
+		require.Empty(t, remCoins)
+		require.Equal(t, sdk.NewInt(1*common.TO_MICRO), balancedShares)
+
+		// 100 * (sqrt(1 + 2 / 100) - 1)
+		singleShares, err := pool.CalcJoinPoolSharesSingleAsset(
+			sdk.NewInt64Coin("aaa", 2*common.TO_MICRO), sdk.ZeroDec())
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt(995_049), singleShares)
+		require.True(t, singleShares.LT(balancedShares))
+
+		// 100 * (sqrt(1 + 2 * (1 - 0.5 * 0.03) / 100) - 1)
+		singleSharesWithFee, err := pool.CalcJoinPoolSharesSingleAsset(
+			sdk.NewInt64Coin("aaa", 2*common.TO_MICRO), sdk.NewDecWithPrec(3, 2))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt(980_196), singleSharesWithFee)
+		require.True(t, singleSharesWithFee.LT(singleShares))
+
+		// the pool is left untouched
+		require.Equal(t, newPool(), pool)
+	})
+
+	for _, tc := range []struct {
+		name        string
+		modifyPool  func(pool *Pool)
+		tokenIn     sdk.Coin
+		swapFee     sdk.Dec
+		expectedErr error
+	}{
+		{
+			name:        "denom not in pool",
+			tokenIn:     sdk.NewInt64Coin("ccc", 1*common.TO_MICRO),
+			swapFee:     sdk.ZeroDec(),
+			expectedErr: ErrTokenDenomNotFound,
+		},
+		{
+			name:        "zero token in",
+			tokenIn:     sdk.NewInt64Coin("aaa", 0),
+			swapFee:     sdk.ZeroDec(),
+			expectedErr: ErrInvalidTokenIn,
+		},
+		{
+			name:        "swap fee of one",
+			tokenIn:     sdk.NewInt64Coin("aaa", 1*common.TO_MICRO),
+			swapFee:     sdk.OneDec(),
+			expectedErr: ErrInvalidSwapFee,
+		},
+		{
+			name: "stableswap pool",
+			modifyPool: func(pool *Pool) {
+				pool.PoolParams.PoolType = PoolType_STABLESWAP
+			},
+			tokenIn:     sdk.NewInt64Coin("aaa", 1*common.TO_MICRO),
+			swapFee:     sdk.ZeroDec(),
+			expectedErr: ErrInvalidPoolType,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := newPool()
+			if tc.modifyPool != nil {
+				tc.modifyPool(&pool)
+			}
+			_, err := pool.CalcJoinPoolSharesSingleAsset(tc.tokenIn, tc.swapFee)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestCalcExitPoolSharesSingleAsset(t *testing.T) {
+	newPool := func() Pool {
+		return Pool{
+			Id:      1,
+			Address: "some_address",
+			PoolParams: PoolParams{
+				PoolType: PoolType_BALANCER,
+				SwapFee:  sdk.ZeroDec(),
+				ExitFee:  sdk.ZeroDec(),
+			},
+			PoolAssets: []PoolAsset{
+				{
+					Token:  sdk.NewInt64Coin("aaa", 100*common.TO_MICRO),
+					Weight: sdk.OneInt(),
+				},
+				{
+					Token:  sdk.NewInt64Coin("bbb", 200*common.TO_MICRO),
+					Weight: sdk.OneInt(),
+				},
+			},
+			TotalWeight: sdk.NewInt(2),
+			TotalShares: sdk.NewInt64Coin(GetPoolShareBaseDenom(1), 100*common.TO_MICRO),
+		}
+	}
+
+	t.Run("single asset join then exit round-trips minus the exit fee", func(t *testing.T) {
+		tokenIn := sdk.NewInt64Coin("aaa", 2*common.TO_MICRO)
+		for _, tc := range []struct {
+			exitFee          sdk.Dec
+			expectedTokenOut sdk.Coin
+		}{
+			{
+				// rounding only, in favor of the pool
+				exitFee:          sdk.ZeroDec(),
+				expectedTokenOut: sdk.NewInt64Coin("aaa", 1_999_999),
+			},
+			{
+				exitFee:          sdk.NewDecWithPrec(3, 2),
+				expectedTokenOut: sdk.NewInt64Coin("aaa", 1_969_999),
+			},
+		} {
+			pool := newPool()
+			numShares, err := pool.CalcJoinPoolSharesSingleAsset(tokenIn, sdk.ZeroDec())
+			require.NoError(t, err)
+			require.NoError(t, pool.incrementBalances(numShares, sdk.NewCoins(tokenIn)))
+
+			tokenOut, err := pool.CalcExitPoolSharesSingleAsset(numShares, "aaa", tc.exitFee)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenOut, tokenOut)
+		}
+	})
+
+	t.Run("balanced exit value", func(t *testing.T) {
+		// 100 * (1 - 0.99^2)
+		tokenOut, err := newPool().CalcExitPoolSharesSingleAsset(
+			sdk.NewInt(1*common.TO_MICRO), "aaa", sdk.ZeroDec())
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("aaa", 1_990_000), tokenOut)
+	})
+
+	for _, tc := range []struct {
+		name        string
+		modifyPool  func(pool *Pool)
+		numSharesIn sdkmath.Int
+		denomOut    string
+		exitFee     sdk.Dec
+		expectedErr error
+	}{
+		{
+			name:        "denom not in pool",
+			numSharesIn: sdk.NewInt(1 * common.TO_MICRO),
+			denomOut:    "ccc",
+			exitFee:     sdk.ZeroDec(),
+			expectedErr: ErrTokenDenomNotFound,
+		},
+		{
+			name:        "zero shares",
+			numSharesIn: sdk.ZeroInt(),
+			denomOut:    "aaa",
+			exitFee:     sdk.ZeroDec(),
+		},
+		{
+			name:        "all of the pool's shares",
+			numSharesIn: sdk.NewInt(100 * common.TO_MICRO),
+			denomOut:    "aaa",
+			exitFee:     sdk.ZeroDec(),
+		},
+		{
+			name:        "exit fee of one",
+			numSharesIn: sdk.NewInt(1 * common.TO_MICRO),
+			denomOut:    "aaa",
+			exitFee:     sdk.OneDec(),
+			expectedErr: ErrInvalidExitFee,
+		},
+		{
+			name: "stableswap pool",
+			modifyPool: func(pool *Pool) {
+				pool.PoolParams.PoolType = PoolType_STABLESWAP
+			},
+			numSharesIn: sdk.NewInt(1 * common.TO_MICRO),
+			denomOut:    "aaa",
+			exitFee:     sdk.ZeroDec(),
+			expectedErr: ErrInvalidPoolType,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := newPool()
+			if tc.modifyPool != nil {
+				tc.modifyPool(&pool)
+			}
+			_, err := pool.CalcExitPoolSharesSingleAsset(tc.numSharesIn, tc.denomOut, tc.exitFee)
+			require.Error(t, err)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -12,7 +12,8 @@ import (
 
 /*
 CalcOutAmtGivenIn Calculates the amount of tokenOut given tokenIn, deducting the swap fee.
-Solved using the SolveConstantProductInvariant AMM curve.
+Solved using the SolveConstantProductInvariant AMM curve, weighted by the
+PoolAsset weights of the two tokens for balancer pools.
 Only supports single asset swaps.
 
 args:
@@ -28,6 +29,10 @@ ret:
 func (pool Pool) CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee bool) (
 	tokenOut sdk.Coin, fee sdk.Coin, err error,
 ) {
+	if !tokenIn.Amount.IsPositive() {
+		return tokenOut, fee, ErrInvalidTokenIn.Wrapf("tokenIn amount must be positive: %s", tokenIn)
+	}
+
 	_, poolAssetIn, err := pool.getPoolAssetAndIndex(tokenIn.Denom)
 	if err != nil {
 		return tokenOut, fee, err
@@ -54,19 +59,32 @@ func (pool Pool) CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee
 	// delta balanceOut is positive(tokens inside the pool decreases)
 	var tokenAmountOut sdkmath.Int
 	if pool.PoolParams.PoolType == PoolType_STABLESWAP {
+		if pool.PoolParams.A.IsNil() {
+			return tokenOut, fee, ErrAmplificationMissing
+		}
+		if !pool.PoolParams.A.IsPositive() {
+			return tokenOut, fee, ErrAmplificationTooLow
+		}
+
 		tokenAmountOut, err = pool.Exchange(sdk.NewCoin(tokenIn.Denom, tokenAmountInAfterFee.TruncateInt()), tokenOutDenom)
 
 		if err != nil {
 			return
 		}
 	} else if pool.PoolParams.PoolType == PoolType_BALANCER {
-		tokenAmountOut = math.SolveConstantProductInvariant(
+		deltaOut, err := math.SolveConstantProductInvariant(
 			/*xPrior=*/ poolTokenInBalance,
 			/*xAfter=*/ poolTokenInBalancePostSwap,
 			/*xWeight=*/ sdk.NewDecFromInt(poolAssetIn.Weight),
 			/*yPrior=*/ sdk.NewDecFromInt(poolAssetOut.Token.Amount),
 			/*yWeight=*/ sdk.NewDecFromInt(poolAssetOut.Weight),
-		).TruncateInt()
+		)
+		if err != nil {
+			return tokenOut, fee, err
+		}
+		tokenAmountOut = deltaOut.TruncateInt()
+	} else {
+		return tokenOut, fee, ErrInvalidPoolType
 	}
 
 	if tokenAmountOut.IsZero() {
@@ -118,7 +136,8 @@ Only supports single asset swaps.
 This function is the inverse of CalcOutAmtGivenIn.
 
 args:
-  - tokenOut: the amount of tokens to swap
+  - tokenOut: the amount of tokens to swap, must be positive and less than
+    the pool balance of its denom
   - tokenInDenom: the target token denom
 
 ret:
@@ -128,10 +147,21 @@ ret:
 func (pool Pool) CalcInAmtGivenOutBalancer(tokenOut sdk.Coin, tokenInDenom string) (
 	tokenIn sdk.Coin, err error,
 ) {
+	if !tokenOut.Amount.IsPositive() {
+		return tokenIn, ErrInvalidTokenOut.Wrapf("tokenOut amount must be positive: %s", tokenOut)
+	}
+	if tokenOut.Denom == tokenInDenom {
+		return tokenIn, ErrSameTokenDenom
+	}
+
 	_, poolAssetOut, err := pool.getPoolAssetAndIndex(tokenOut.Denom)
 	if err != nil {
 		return tokenIn, err
 	}
+	if tokenOut.Amount.GTE(poolAssetOut.Token.Amount) {
+		return tokenIn, ErrInvalidTokenOut.Wrapf(
+			"tokenOut %s must be less than the pool balance of %s", tokenOut, poolAssetOut.Token)
+	}
 
 	_, poolAssetIn, err := pool.getPoolAssetAndIndex(tokenInDenom)
 	if err != nil {
@@ -142,13 +172,17 @@ func (pool Pool) CalcInAmtGivenOutBalancer(tokenOut sdk.Coin, tokenInDenom strin
 	poolTokenOutBalance := sdk.NewDecFromInt(poolAssetOut.Token.Amount)
 	poolTokenOutBalancePostSwap := poolTokenOutBalance.Sub(sdk.NewDecFromInt(tokenOut.Amount))
 	// (x_0)(y_0) = (x_0 + in)(y_0 - out)
-	tokenAmountIn := math.SolveConstantProductInvariant(
+	deltaIn, err := math.SolveConstantProductInvariant(
 		/*xPrior=*/ poolTokenOutBalance,
 		/*xAfter=*/ poolTokenOutBalancePostSwap,
 		/*xWeight=*/ sdk.NewDecFromInt(poolAssetOut.Weight),
 		/*yPrior=*/ sdk.NewDecFromInt(poolAssetIn.Token.Amount),
 		/*yWeight=*/ sdk.NewDecFromInt(poolAssetIn.Weight),
-	).Neg()
+	)
+	if err != nil {
+		return tokenIn, err
+	}
+	tokenAmountIn := deltaIn.Neg()
 
 	// We deduct a swap fee on the input asset. The swap happens by following the invariant curve on the input * (1 - swap fee)
 	// and then the swap fee is added to the pool.
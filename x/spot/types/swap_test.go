@@ -139,6 +139,127 @@ func TestCalcOutAmtGivenIn(t *testing.T) {
 			tokenOutDenom: "bbb",
 			shouldError:   true,
 		},
+		{
+			name: "equal weights (50/50)",
+			pool: Pool{
+				PoolParams: PoolParams{
+					PoolType: PoolType_BALANCER,
+					SwapFee:  sdk.ZeroDec(),
+				},
+				PoolAssets: []PoolAsset{
+					{
+						Token:  sdk.NewInt64Coin("aaa", 100*common.TO_MICRO),
+						Weight: sdk.NewInt(GuaranteedWeightPrecision),
+					},
+					{
+						Token:  sdk.NewInt64Coin("bbb", 100*common.TO_MICRO),
+						Weight: sdk.NewInt(GuaranteedWeightPrecision),
+					},
+				},
+				TotalWeight: sdk.NewInt(2 * GuaranteedWeightPrecision),
+			},
+			tokenIn:       sdk.NewInt64Coin("aaa", 1*common.TO_MICRO),
+			tokenOutDenom: "bbb",
+			// 100e6 * (1 - (100/101)^(1/1))
+			expectedTokenOut: sdk.NewInt64Coin("bbb", 990099),
+			expectedFee:      sdk.NewInt64Coin("aaa", 0),
+		},
+		{
+			name: "unequal weights (80/20), into the heavy asset",
+			pool: Pool{
+				PoolParams: PoolParams{
+					PoolType: PoolType_BALANCER,
+					SwapFee:  sdk.ZeroDec(),
+				},
+				PoolAssets: []PoolAsset{
+					{
+						Token:  sdk.NewInt64Coin("aaa", 100*common.TO_MICRO),
+						Weight: sdk.NewInt(4 * GuaranteedWeightPrecision),
+					},
+					{
+						Token:  sdk.NewInt64Coin("bbb", 100*common.TO_MICRO),
+						Weight: sdk.NewInt(1 * GuaranteedWeightPrecision),
+					},
+				},
+				TotalWeight: sdk.NewInt(5 * GuaranteedWeightPrecision),
+			},
+			tokenIn:       sdk.NewInt64Coin("aaa", 1*common.TO_MICRO),
+			tokenOutDenom: "bbb",
+			// 100e6 * (1 - (100/101)^(80/20)) = 3901965.55
+			expectedTokenOut: sdk.NewInt64Coin("bbb", 3901965),
+			expectedFee:      sdk.NewInt64Coin("aaa", 0),
+		},
+		{
+			name: "unequal weights (80/20), into the light asset",
+			pool: Pool{
+				PoolParams: PoolParams{
+					PoolType: PoolType_BALANCER,
+					SwapFee:  sdk.ZeroDec(),
+				},
+				PoolAssets: []PoolAsset{
+					{
+						Token:  sdk.NewInt64Coin("aaa", 100*common.TO_MICRO),
+						Weight: sdk.NewInt(4 * GuaranteedWeightPrecision),
+					},
+					{
+						Token:  sdk.NewInt64Coin("bbb", 100*common.TO_MICRO),
+						Weight: sdk.NewInt(1 * GuaranteedWeightPrecision),
+					},
+				},
+				TotalWeight: sdk.NewInt(5 * GuaranteedWeightPrecision),
+			},
+			tokenIn:       sdk.NewInt64Coin("bbb", 1*common.TO_MICRO),
+			tokenOutDenom: "aaa",
+			// 100e6 * (1 - (100/101)^(20/80)) = 248449.12
+			expectedTokenOut: sdk.NewInt64Coin("aaa", 248449),
+			expectedFee:      sdk.NewInt64Coin("bbb", 0),
+		},
+		{
+			name: "zero token in",
+			pool: Pool{
+				PoolParams: PoolParams{
+					PoolType: PoolType_BALANCER,
+					SwapFee:  sdk.MustNewDecFromStr("0.0003"),
+				},
+				PoolAssets: []PoolAsset{
+					{
+						Token:  sdk.NewInt64Coin("aaa", 100),
+						Weight: sdk.OneInt(),
+					},
+					{
+						Token:  sdk.NewInt64Coin("bbb", 100),
+						Weight: sdk.OneInt(),
+					},
+				},
+				TotalWeight: sdk.NewInt(2),
+			},
+			tokenIn:       sdk.NewInt64Coin("aaa", 0),
+			tokenOutDenom: "bbb",
+			shouldError:   true,
+		},
+		{
+			name: "token out denom not in pool",
+			pool: Pool{
+				PoolParams: PoolParams{
+					PoolType: PoolType_BALANCER,
+					SwapFee:  sdk.MustNewDecFromStr("0.0003"),
+				},
+				PoolAssets: []PoolAsset{
+					{
+						Token:  sdk.NewInt64Coin("aaa", 100),
+						Weight: sdk.OneInt(),
+					},
+					{
+						Token:  sdk.NewInt64Coin("bbb", 100),
+						Weight: sdk.OneInt(),
+					},
+				},
+				TotalWeight: sdk.NewInt(2),
+			},
+			tokenIn:       sdk.NewInt64Coin("aaa", 10),
+			tokenOutDenom: "ccc",
+			shouldError:   true,
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
@@ -154,6 +275,49 @@ func TestCalcOutAmtGivenIn(t *testing.T) {
 	}
 }
 
+func TestCalcOutAmtGivenInStableswapSlippage(t *testing.T) {
+	newPool := func(poolType PoolType) Pool {
+		return Pool{
+			PoolParams: PoolParams{
+				PoolType: poolType,
+				SwapFee:  sdk.ZeroDec(),
+				A:        sdk.NewInt(100),
+			},
+			PoolAssets: []PoolAsset{
+				{
+					Token:  sdk.NewInt64Coin("unusd", 1*common.TO_MICRO),
+					Weight: sdk.OneInt(),
+				},
+				{
+					Token:  sdk.NewInt64Coin("uusdc", 1*common.TO_MICRO),
+					Weight: sdk.OneInt(),
+				},
+			},
+			TotalWeight: sdk.NewInt(2),
+		}
+	}
+	tokenIn := sdk.NewInt64Coin("unusd", 100_000)
+
+	// 1_000_000 - 1_000_000 * 1_000_000 / 1_100_000 = 90_909.09...
+	balancerOut, _, err := newPool(PoolType_BALANCER).CalcOutAmtGivenIn(tokenIn, "uusdc", false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 90_909), balancerOut)
+
+	stableswapOut, _, err := newPool(PoolType_STABLESWAP).CalcOutAmtGivenIn(tokenIn, "uusdc", false)
+	require.NoError(t, err)
+	require.True(t, stableswapOut.Amount.GT(balancerOut.Amount))
+	// less than 1% slippage on a trade worth 10% of the pool
+	require.True(t, stableswapOut.Amount.GT(sdk.NewInt(99_000)))
+	require.True(t, stableswapOut.Amount.LTE(tokenIn.Amount))
+
+	t.Run("stableswap pool without a positive amplification", func(t *testing.T) {
+		pool := newPool(PoolType_STABLESWAP)
+		pool.PoolParams.A = sdk.ZeroInt()
+		_, _, err := pool.CalcOutAmtGivenIn(tokenIn, "uusdc", false)
+		require.ErrorIs(t, err, ErrAmplificationTooLow)
+	})
+}
+
 func TestCalcInAmtGivenOut(t *testing.T) {
 	for _, tc := range []struct {
 		name            string
@@ -266,6 +430,65 @@ func TestCalcInAmtGivenOut(t *testing.T) {
 	}
 }
 
+func TestCalcInAmtGivenOutRoundTrip(t *testing.T) {
+	newPool := func(weightA, weightB int64) Pool {
+		return Pool{
+			PoolParams: PoolParams{
+				PoolType: PoolType_BALANCER,
+				SwapFee:  sdk.MustNewDecFromStr("0.003"),
+			},
+			PoolAssets: []PoolAsset{
+				{Token: sdk.NewInt64Coin("aaa", 5_000*common.TO_MICRO), Weight: sdk.NewInt(weightA)},
+				{Token: sdk.NewInt64Coin("bbb", 2_000*common.TO_MICRO), Weight: sdk.NewInt(weightB)},
+			},
+			TotalWeight: sdk.NewInt(weightA + weightB),
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		pool     Pool
+		tokenOut sdk.Coin
+	}{
+		{name: "equal weights", pool: newPool(1, 1), tokenOut: sdk.NewInt64Coin("bbb", 123_456_789)},
+		{name: "unequal weights", pool: newPool(1, 3), tokenOut: sdk.NewInt64Coin("bbb", 123_456_789)},
+		{name: "reverse direction", pool: newPool(1, 1), tokenOut: sdk.NewInt64Coin("aaa", 987_654)},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tokenInDenom := "aaa"
+			if tc.tokenOut.Denom == "aaa" {
+				tokenInDenom = "bbb"
+			}
+
+			tokenIn, err := tc.pool.CalcInAmtGivenOut(tc.tokenOut, tokenInDenom)
+			require.NoError(t, err)
+
+			// swapping the required input gives at least the requested output,
+			// and no more than a rounding unit above it
+			tokenOut, _, err := tc.pool.CalcOutAmtGivenIn(tokenIn, tc.tokenOut.Denom, false)
+			require.NoError(t, err)
+			require.True(t, tokenOut.Amount.GTE(tc.tokenOut.Amount), "%s < %s", tokenOut, tc.tokenOut)
+			require.True(t, tokenOut.Amount.Sub(tc.tokenOut.Amount).LTE(sdk.NewInt(2)), "%s >> %s", tokenOut, tc.tokenOut)
+		})
+	}
+
+	t.Run("invalid token out", func(t *testing.T) {
+		pool := newPool(1, 1)
+		for _, tokenOut := range []sdk.Coin{
+			sdk.NewInt64Coin("bbb", 0),
+			sdk.NewInt64Coin("bbb", 2_000*common.TO_MICRO),
+			sdk.NewInt64Coin("bbb", 3_000*common.TO_MICRO),
+		} {
+			_, err := pool.CalcInAmtGivenOut(tokenOut, "aaa")
+			require.ErrorIs(t, err, ErrInvalidTokenOut)
+		}
+
+		_, err := pool.CalcInAmtGivenOut(sdk.NewInt64Coin("bbb", 1), "bbb")
+		require.ErrorIs(t, err, ErrSameTokenDenom)
+	})
+}
+
 func TestApplySwap(t *testing.T) {
 	for _, tc := range []struct {
 		name               string
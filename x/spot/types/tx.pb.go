@@ -6,7 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -483,6 +486,212 @@ func (m *MsgSwapAssetsResponse) GetTokenOut() types.Coin {
 	return types.Coin{}
 }
 
+// MsgUpdatePoolParams: sdk.Msg for updating the swap and exit fees of a pool.
+type MsgUpdatePoolParams struct {
+	// Authority: Address of the governance module account.
+	Authority string                                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PoolId    uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SwapFee   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+}
+
+func (m *MsgUpdatePoolParams) Reset()         { *m = MsgUpdatePoolParams{} }
+func (m *MsgUpdatePoolParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolParams) ProtoMessage()    {}
+func (*MsgUpdatePoolParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ac7099e2729ab26, []int{8}
+}
+func (m *MsgUpdatePoolParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolParams.Merge(m, src)
+}
+func (m *MsgUpdatePoolParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolParams proto.InternalMessageInfo
+
+func (m *MsgUpdatePoolParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdatePoolParams) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// MsgUpdatePoolParamsResponse is the gRPC response for the
+// MsgUpdatePoolParams TxMsg.
+type MsgUpdatePoolParamsResponse struct {
+}
+
+func (m *MsgUpdatePoolParamsResponse) Reset()         { *m = MsgUpdatePoolParamsResponse{} }
+func (m *MsgUpdatePoolParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolParamsResponse) ProtoMessage()    {}
+func (*MsgUpdatePoolParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ac7099e2729ab26, []int{9}
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolParamsResponse proto.InternalMessageInfo
+
+// MsgUpdatePoolAllowlist: sdk.Msg for updating the allowlist of a pool. Once a
+// pool has a non-empty allowlist, only the addresses on it can swap with or
+// join the pool.
+type MsgUpdatePoolAllowlist struct {
+	// Authority: Address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PoolId    uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// add: addresses to add to the allowlist.
+	Add []string `protobuf:"bytes,3,rep,name=add,proto3" json:"add,omitempty" yaml:"add"`
+	// remove: addresses to remove from the allowlist.
+	Remove []string `protobuf:"bytes,4,rep,name=remove,proto3" json:"remove,omitempty" yaml:"remove"`
+}
+
+func (m *MsgUpdatePoolAllowlist) Reset()         { *m = MsgUpdatePoolAllowlist{} }
+func (m *MsgUpdatePoolAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolAllowlist) ProtoMessage()    {}
+func (*MsgUpdatePoolAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ac7099e2729ab26, []int{10}
+}
+func (m *MsgUpdatePoolAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolAllowlist.Merge(m, src)
+}
+func (m *MsgUpdatePoolAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolAllowlist proto.InternalMessageInfo
+
+func (m *MsgUpdatePoolAllowlist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdatePoolAllowlist) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgUpdatePoolAllowlist) GetAdd() []string {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *MsgUpdatePoolAllowlist) GetRemove() []string {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
+// MsgUpdatePoolAllowlistResponse is the gRPC response for the
+// MsgUpdatePoolAllowlist TxMsg.
+type MsgUpdatePoolAllowlistResponse struct {
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) Reset()         { *m = MsgUpdatePoolAllowlistResponse{} }
+func (m *MsgUpdatePoolAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdatePoolAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ac7099e2729ab26, []int{11}
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolAllowlistResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreatePool)(nil), "nibiru.spot.v1.MsgCreatePool")
 	proto.RegisterType((*MsgCreatePoolResponse)(nil), "nibiru.spot.v1.MsgCreatePoolResponse")
@@ -492,64 +701,84 @@ func init() {
 	proto.RegisterType((*MsgExitPoolResponse)(nil), "nibiru.spot.v1.MsgExitPoolResponse")
 	proto.RegisterType((*MsgSwapAssets)(nil), "nibiru.spot.v1.MsgSwapAssets")
 	proto.RegisterType((*MsgSwapAssetsResponse)(nil), "nibiru.spot.v1.MsgSwapAssetsResponse")
+	proto.RegisterType((*MsgUpdatePoolParams)(nil), "nibiru.spot.v1.MsgUpdatePoolParams")
+	proto.RegisterType((*MsgUpdatePoolParamsResponse)(nil), "nibiru.spot.v1.MsgUpdatePoolParamsResponse")
+	proto.RegisterType((*MsgUpdatePoolAllowlist)(nil), "nibiru.spot.v1.MsgUpdatePoolAllowlist")
+	proto.RegisterType((*MsgUpdatePoolAllowlistResponse)(nil), "nibiru.spot.v1.MsgUpdatePoolAllowlistResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/spot/v1/tx.proto", fileDescriptor_2ac7099e2729ab26) }
 
 var fileDescriptor_2ac7099e2729ab26 = []byte{
-	// 832 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9b, 0xa8, 0x4d, 0x27, 0x4a, 0xbb, 0x75, 0xcb, 0xd6, 0xf1, 0xb2, 0x49, 0x34, 0x2b,
-	0xa4, 0x00, 0x92, 0x4d, 0xc2, 0x0d, 0x71, 0x60, 0x9d, 0xe5, 0x50, 0xa4, 0xd0, 0xca, 0x91, 0x38,
-	0x20, 0xa4, 0x68, 0x92, 0x8c, 0xdc, 0x59, 0xec, 0x19, 0x93, 0x19, 0xb7, 0x59, 0x21, 0x2e, 0x5c,
-	0xb9, 0x20, 0xf1, 0x7b, 0x90, 0x38, 0xee, 0x71, 0xa5, 0xbd, 0x70, 0x8a, 0x50, 0xcb, 0x2f, 0x88,
-	0xc4, 0x85, 0x13, 0xf2, 0xcc, 0xd8, 0x75, 0x8a, 0xd5, 0x08, 0xa1, 0xde, 0x3c, 0xef, 0xbd, 0xf9,
-	0xde, 0xfb, 0xbe, 0xf7, 0xde, 0x18, 0x9c, 0x50, 0x32, 0x21, 0xf3, 0xc4, 0xe5, 0x31, 0x13, 0xee,
-	0x65, 0xcf, 0x15, 0x0b, 0x27, 0x9e, 0x33, 0xc1, 0xcc, 0x7d, 0xe5, 0x70, 0x52, 0x87, 0x73, 0xd9,
-	0xb3, 0x9b, 0x77, 0x02, 0x63, 0xc6, 0x42, 0x15, 0x6a, 0x1f, 0x07, 0x2c, 0x60, 0xf2, 0xd3, 0x4d,
-	0xbf, 0xb4, 0xb5, 0x35, 0x65, 0x3c, 0x62, 0xdc, 0x9d, 0x20, 0x8e, 0xdd, 0xcb, 0xde, 0x04, 0x0b,
-	0xd4, 0x73, 0xa7, 0x8c, 0x50, 0xed, 0x7f, 0x37, 0x60, 0x2c, 0x08, 0xb1, 0x8b, 0x62, 0xe2, 0x22,
-	0x4a, 0x99, 0x40, 0x82, 0x30, 0xca, 0x95, 0x17, 0xfe, 0x66, 0x80, 0xc6, 0x90, 0x07, 0x83, 0x39,
-	0x46, 0x02, 0x9f, 0x33, 0x16, 0x9a, 0x16, 0xd8, 0x9d, 0xa6, 0x27, 0x36, 0xb7, 0x8c, 0x8e, 0xd1,
-	0xdd, 0xf3, 0xb3, 0xa3, 0x39, 0x02, 0xf5, 0xb4, 0x9a, 0x71, 0x8c, 0xe6, 0x28, 0xe2, 0xd6, 0x76,
-	0xc7, 0xe8, 0xd6, 0xfb, 0xb6, 0xb3, 0x4e, 0xc0, 0x49, 0x41, 0xce, 0x65, 0x84, 0xf7, 0x78, 0xb5,
-	0x6c, 0x9b, 0xaf, 0x50, 0x14, 0x7e, 0x02, 0x0b, 0x17, 0xa1, 0x0f, 0xe2, 0x3c, 0xc6, 0xfc, 0x4c,
-	0x83, 0x22, 0xce, 0xb1, 0xe0, 0x56, 0xa5, 0x53, 0xe9, 0xd6, 0xfb, 0xcd, 0x32, 0xd0, 0xe7, 0x69,
-	0x84, 0x57, 0x7d, 0xbd, 0x6c, 0x6f, 0x29, 0x04, 0x69, 0xe0, 0xf0, 0x23, 0xf0, 0xce, 0x1a, 0x03,
-	0x1f, 0xf3, 0x98, 0x51, 0x8e, 0xcd, 0x13, 0xb0, 0x2b, 0xa1, 0xc9, 0x4c, 0x32, 0xa9, 0xfa, 0x3b,
-	0xe9, 0xf1, 0x74, 0x06, 0xff, 0x32, 0x40, 0x7d, 0xc8, 0x83, 0x2f, 0x18, 0xa1, 0x92, 0xf2, 0xfb,
-	0x60, 0x87, 0x63, 0x3a, 0xc3, 0x9a, 0xb1, 0x77, 0xb8, 0x5a, 0xb6, 0x1b, 0xaa, 0x6e, 0x65, 0x87,
-	0xbe, 0x0e, 0x30, 0x3f, 0xbc, 0xc5, 0x4c, 0xf9, 0x57, 0x3d, 0x73, 0xb5, 0x6c, 0xef, 0x17, 0x38,
-	0x92, 0x19, 0xcc, 0xf2, 0x98, 0xe7, 0x60, 0x4f, 0xb0, 0x6f, 0x31, 0xe5, 0x63, 0x42, 0x73, 0x66,
-	0xaa, 0x5d, 0x4e, 0xda, 0x2e, 0x47, 0xb7, 0xcb, 0x19, 0x30, 0x42, 0x3d, 0x2b, 0x65, 0xb6, 0x5a,
-	0xb6, 0x1f, 0x29, 0xb4, 0xfc, 0x26, 0xf4, 0x6b, 0xea, 0xfb, 0x94, 0x9a, 0x9f, 0x82, 0x46, 0xc2,
-	0xf1, 0x18, 0x85, 0xe1, 0x38, 0x6d, 0x31, 0xb7, 0xaa, 0x1d, 0xa3, 0x5b, 0xf3, 0xac, 0xd5, 0xb2,
-	0x7d, 0xac, 0xae, 0xad, 0xb9, 0xa1, 0x5f, 0x4f, 0x38, 0x7e, 0x1e, 0x86, 0x03, 0x79, 0xfa, 0x69,
-	0x1b, 0x1c, 0x15, 0x78, 0xe7, 0x42, 0x75, 0x41, 0x35, 0xad, 0x58, 0xb2, 0xaf, 0xf7, 0x8f, 0xcb,
-	0xc4, 0xf7, 0x65, 0x84, 0x19, 0x82, 0x23, 0x9a, 0x44, 0x63, 0xc9, 0x94, 0x5f, 0xa0, 0x39, 0xe6,
-	0x63, 0x96, 0x08, 0x3d, 0x0a, 0xf7, 0x70, 0x83, 0x9a, 0x9b, 0xad, 0x8a, 0x2c, 0xc1, 0x80, 0xfe,
-	0x23, 0x9a, 0x44, 0x69, 0xaa, 0x91, 0xb4, 0x9d, 0x25, 0xc2, 0xfc, 0x06, 0x1c, 0xcc, 0x71, 0x84,
-	0x08, 0x25, 0x34, 0xd0, 0x7c, 0xff, 0x87, 0x8a, 0xfb, 0x39, 0x96, 0x52, 0xe3, 0x57, 0x35, 0x05,
-	0x9f, 0x2f, 0x88, 0x78, 0xd0, 0x29, 0xf8, 0x4a, 0x4f, 0xb8, 0xe2, 0x6a, 0x55, 0x36, 0x69, 0x65,
-	0x6b, 0x06, 0xc5, 0xcd, 0x51, 0x77, 0xf5, 0xe6, 0x28, 0x81, 0xe0, 0x4b, 0xd9, 0xcc, 0xac, 0xfc,
-	0xbc, 0x99, 0x23, 0x00, 0x34, 0xe9, 0xb4, 0x33, 0x1b, 0xf5, 0x6a, 0xea, 0x6c, 0x87, 0x6b, 0x7a,
-	0xc9, 0x86, 0xe8, 0xe1, 0x3d, 0x4b, 0x04, 0xfc, 0x5b, 0x3d, 0x13, 0xa3, 0x2b, 0x14, 0xab, 0xad,
-	0x7b, 0x30, 0xb5, 0x86, 0x40, 0x4d, 0xbb, 0x5a, 0x99, 0x0d, 0x52, 0x9d, 0xe8, 0xe2, 0x0f, 0x0a,
-	0xc5, 0xcb, 0x5e, 0xef, 0xca, 0xcf, 0x53, 0x6a, 0x7a, 0xe0, 0x40, 0x59, 0x59, 0x22, 0xc6, 0x33,
-	0x4c, 0x59, 0x24, 0x57, 0x66, 0xcf, 0xb3, 0x57, 0xcb, 0xf6, 0xe3, 0xe2, 0xb5, 0x3c, 0x00, 0xfa,
-	0x0d, 0x69, 0x39, 0x4b, 0xc4, 0x0b, 0x79, 0x26, 0xf2, 0x81, 0xb9, 0xe5, 0x9e, 0x4b, 0x9d, 0xed,
-	0xb7, 0x56, 0xda, 0xf8, 0xef, 0x93, 0xa9, 0x84, 0xae, 0x65, 0xf9, 0xfa, 0x6f, 0x2b, 0xa0, 0x32,
-	0xe4, 0x81, 0x19, 0x01, 0x50, 0x78, 0x92, 0x9f, 0xde, 0xdd, 0xc8, 0xb5, 0xf7, 0xce, 0x7e, 0xef,
-	0x5e, 0x77, 0x56, 0x2d, 0x6c, 0xfe, 0xf8, 0xf6, 0xcf, 0x5f, 0xb6, 0x8f, 0xe0, 0xa1, 0x5b, 0xfc,
-	0xc5, 0xc8, 0xb5, 0xfe, 0x0e, 0xd4, 0xf2, 0xc7, 0xf0, 0x49, 0x09, 0x5a, 0xe6, 0xb4, 0x9f, 0xdd,
-	0xe3, 0xcc, 0x13, 0x3d, 0x93, 0x89, 0x9e, 0xc2, 0x27, 0x6b, 0x89, 0xbe, 0xd7, 0xad, 0xfe, 0xc1,
-	0x7d, 0xc9, 0x08, 0x4d, 0x53, 0xe6, 0x9b, 0x57, 0x96, 0x32, 0x73, 0x96, 0xa6, 0xbc, 0x3b, 0xf4,
-	0x1b, 0x53, 0xe2, 0x05, 0x11, 0xe6, 0x15, 0x00, 0x85, 0x01, 0x2e, 0x13, 0xf5, 0xd6, 0x5d, 0x2a,
-	0xea, 0xbf, 0x47, 0x60, 0x63, 0x62, 0x7e, 0x85, 0x62, 0xef, 0xc5, 0xeb, 0xeb, 0x96, 0xf1, 0xe6,
-	0xba, 0x65, 0xfc, 0x71, 0xdd, 0x32, 0x7e, 0xbe, 0x69, 0x6d, 0xbd, 0xb9, 0x69, 0x6d, 0xfd, 0x7e,
-	0xd3, 0xda, 0xfa, 0xfa, 0x83, 0x80, 0x88, 0x8b, 0x64, 0xe2, 0x4c, 0x59, 0xe4, 0x7e, 0x29, 0x01,
-	0x06, 0x17, 0x88, 0xd0, 0x0c, 0x6c, 0xa1, 0xe0, 0xc4, 0xab, 0x18, 0xf3, 0xc9, 0x8e, 0xfc, 0x63,
-	0x7f, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0xa8, 0xd2, 0xbd, 0x4b, 0x08, 0x00, 0x00,
+	// 1073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0x1b, 0x47,
+	0x14, 0xf6, 0x5a, 0xaa, 0x6d, 0x8d, 0xf0, 0xaf, 0xb5, 0x63, 0xcb, 0xeb, 0x5a, 0x12, 0x63, 0x12,
+	0xdc, 0x84, 0x68, 0x6b, 0x17, 0x7a, 0x08, 0x3d, 0xd4, 0x6b, 0x37, 0xe0, 0x82, 0x6b, 0xb3, 0xa6,
+	0x3d, 0x94, 0x80, 0x58, 0x7b, 0xa7, 0xeb, 0x49, 0x76, 0x67, 0xb6, 0x3b, 0xb3, 0xfe, 0x41, 0xe9,
+	0x25, 0xd7, 0x5e, 0x0a, 0xfd, 0x27, 0x7a, 0xec, 0xa1, 0xd7, 0x42, 0x4f, 0x25, 0xc7, 0xd0, 0x5e,
+	0x4a, 0x0e, 0xa2, 0xd8, 0x85, 0xde, 0x05, 0xbd, 0xf4, 0x54, 0xe6, 0xc7, 0xae, 0x56, 0x8e, 0x90,
+	0x1d, 0x42, 0x72, 0xd2, 0xcc, 0x7c, 0x6f, 0xbe, 0x37, 0xdf, 0x37, 0xf3, 0xde, 0x0a, 0x2c, 0x12,
+	0x7c, 0x88, 0x93, 0xd4, 0x66, 0x31, 0xe5, 0xf6, 0xc9, 0xba, 0xcd, 0xcf, 0x5a, 0x71, 0x42, 0x39,
+	0x35, 0xa7, 0x14, 0xd0, 0x12, 0x40, 0xeb, 0x64, 0xdd, 0x5a, 0xba, 0x12, 0x18, 0x53, 0x1a, 0xaa,
+	0x50, 0x6b, 0x3e, 0xa0, 0x01, 0x95, 0x43, 0x5b, 0x8c, 0xf4, 0x6a, 0xfd, 0x88, 0xb2, 0x88, 0x32,
+	0xfb, 0xd0, 0x63, 0xc8, 0x3e, 0x59, 0x3f, 0x44, 0xdc, 0x5b, 0xb7, 0x8f, 0x28, 0x26, 0x1a, 0x7f,
+	0x37, 0xa0, 0x34, 0x08, 0x91, 0xed, 0xc5, 0xd8, 0xf6, 0x08, 0xa1, 0xdc, 0xe3, 0x98, 0x12, 0xa6,
+	0xd1, 0x45, 0xbd, 0x3b, 0x62, 0x81, 0xc8, 0x16, 0xb1, 0x40, 0x03, 0x4b, 0x0a, 0x68, 0xab, 0x7c,
+	0x6a, 0xa2, 0x20, 0xf8, 0xab, 0x01, 0x26, 0x77, 0x59, 0xb0, 0x95, 0x20, 0x8f, 0xa3, 0x7d, 0x4a,
+	0x43, 0xb3, 0x06, 0xc6, 0x8f, 0xc4, 0x8c, 0x26, 0x35, 0xa3, 0x69, 0xac, 0x55, 0xdc, 0x6c, 0x6a,
+	0x1e, 0x80, 0xaa, 0x50, 0xd0, 0x8e, 0xbd, 0xc4, 0x8b, 0x58, 0x6d, 0xb4, 0x69, 0xac, 0x55, 0x37,
+	0xac, 0x56, 0xbf, 0xe8, 0x96, 0x20, 0xd9, 0x97, 0x11, 0xce, 0x42, 0xb7, 0xd3, 0x30, 0xcf, 0xbd,
+	0x28, 0x7c, 0x00, 0x0b, 0x1b, 0xa1, 0x0b, 0xe2, 0x3c, 0xc6, 0xfc, 0x58, 0x93, 0x7a, 0x8c, 0x21,
+	0xce, 0x6a, 0xa5, 0x66, 0x69, 0xad, 0xba, 0xb1, 0x34, 0x88, 0x74, 0x53, 0x44, 0x38, 0xe5, 0x67,
+	0x9d, 0xc6, 0x88, 0x62, 0x90, 0x0b, 0x0c, 0xbe, 0x0f, 0x6e, 0xf5, 0x29, 0x70, 0x11, 0x8b, 0x29,
+	0x61, 0xc8, 0x5c, 0x04, 0xe3, 0x92, 0x1a, 0xfb, 0x52, 0x49, 0xd9, 0x1d, 0x13, 0xd3, 0x1d, 0x1f,
+	0xfe, 0x6b, 0x80, 0xea, 0x2e, 0x0b, 0x3e, 0xa5, 0x98, 0x48, 0xc9, 0xef, 0x81, 0x31, 0x86, 0x88,
+	0x8f, 0xb4, 0x62, 0x67, 0xb6, 0xdb, 0x69, 0x4c, 0xaa, 0x73, 0xab, 0x75, 0xe8, 0xea, 0x00, 0xf3,
+	0x5e, 0x8f, 0x53, 0xe8, 0x2f, 0x3b, 0x66, 0xb7, 0xd3, 0x98, 0x2a, 0x68, 0xc4, 0x3e, 0xcc, 0xf2,
+	0x98, 0xfb, 0xa0, 0xc2, 0xe9, 0x13, 0x44, 0x58, 0x1b, 0x93, 0x5c, 0x99, 0xb6, 0x5f, 0x5c, 0x71,
+	0x4b, 0x5f, 0x71, 0x6b, 0x8b, 0x62, 0xe2, 0xd4, 0x84, 0xb2, 0x6e, 0xa7, 0x31, 0xa3, 0xd8, 0xf2,
+	0x9d, 0xd0, 0x9d, 0x50, 0xe3, 0x1d, 0x62, 0x7e, 0x04, 0x26, 0x53, 0x86, 0xda, 0x5e, 0x18, 0xb6,
+	0xc5, 0xb3, 0x60, 0xb5, 0x72, 0xd3, 0x58, 0x9b, 0x70, 0x6a, 0xdd, 0x4e, 0x63, 0x5e, 0x6d, 0xeb,
+	0x83, 0xa1, 0x5b, 0x4d, 0x19, 0xda, 0x0c, 0xc3, 0x2d, 0x39, 0xfb, 0x6e, 0x14, 0xcc, 0x15, 0x74,
+	0xe7, 0x46, 0xad, 0x81, 0xb2, 0x38, 0xb1, 0x54, 0x5f, 0xdd, 0x98, 0x1f, 0x64, 0xbe, 0x2b, 0x23,
+	0xcc, 0x10, 0xcc, 0x91, 0x34, 0x6a, 0x4b, 0xa5, 0xec, 0xd8, 0x4b, 0x10, 0x6b, 0xd3, 0x94, 0xeb,
+	0xa7, 0x30, 0x44, 0x1b, 0xd4, 0xda, 0x2c, 0x75, 0xc8, 0x01, 0x1c, 0xd0, 0x9d, 0x21, 0x69, 0x24,
+	0x52, 0x1d, 0xc8, 0xb5, 0xbd, 0x94, 0x9b, 0x8f, 0xc0, 0x74, 0x82, 0x22, 0x0f, 0x13, 0x4c, 0x02,
+	0xad, 0xf7, 0x35, 0x5c, 0x9c, 0xca, 0xb9, 0x94, 0x1b, 0xbf, 0xa8, 0x57, 0xf0, 0xc9, 0x19, 0xe6,
+	0x6f, 0xf4, 0x15, 0x7c, 0x01, 0xaa, 0x05, 0xad, 0xb5, 0xd2, 0x75, 0x5e, 0x59, 0x5a, 0x41, 0xb1,
+	0x72, 0xd4, 0x5e, 0x5d, 0x39, 0xca, 0x20, 0xf8, 0x18, 0xcc, 0x15, 0x8e, 0x9f, 0x5f, 0xe6, 0x01,
+	0x00, 0x5a, 0xb4, 0xb8, 0x99, 0x6b, 0xfd, 0x5a, 0xd2, 0xd9, 0x66, 0xfb, 0xfc, 0x92, 0x17, 0xa2,
+	0x1f, 0xef, 0x5e, 0xca, 0xe1, 0x7f, 0xaa, 0x4d, 0x1c, 0x9c, 0x7a, 0xb1, 0xaa, 0xba, 0x37, 0xe6,
+	0xd6, 0x2e, 0x50, 0xaf, 0x5d, 0x95, 0xcc, 0x35, 0x56, 0x2d, 0xea, 0xc3, 0x4f, 0x17, 0x0e, 0x2f,
+	0xef, 0x7a, 0x5c, 0x0e, 0x77, 0x88, 0xe9, 0x80, 0x69, 0xb5, 0x4a, 0x53, 0xde, 0xf6, 0x11, 0xa1,
+	0x91, 0x2c, 0x99, 0x8a, 0x63, 0x75, 0x3b, 0x8d, 0x85, 0xe2, 0xb6, 0x3c, 0x00, 0xba, 0x93, 0x72,
+	0x65, 0x2f, 0xe5, 0xdb, 0x72, 0x8e, 0xc1, 0xad, 0x3e, 0xed, 0xb9, 0xd5, 0x59, 0x7d, 0x6b, 0xa7,
+	0x8d, 0x57, 0x7f, 0x99, 0xca, 0xe8, 0x89, 0x2c, 0x1f, 0xfc, 0x4d, 0x55, 0xe8, 0xe7, 0xb1, 0xaf,
+	0x9b, 0x99, 0xee, 0x92, 0x1f, 0x82, 0x8a, 0x97, 0xf2, 0x63, 0x9a, 0x60, 0x7e, 0xae, 0x0d, 0xaf,
+	0xfd, 0xfe, 0xf3, 0xfd, 0x79, 0x9d, 0x6c, 0xd3, 0xf7, 0x13, 0xc4, 0xd8, 0x01, 0x4f, 0x30, 0x09,
+	0xdc, 0x5e, 0xe8, 0xab, 0x59, 0xff, 0x08, 0x4c, 0xb0, 0x53, 0x2f, 0x6e, 0x7f, 0x85, 0x90, 0x54,
+	0x53, 0x71, 0x36, 0xc5, 0x91, 0x5f, 0x74, 0x1a, 0x77, 0x02, 0xcc, 0x8f, 0xd3, 0xc3, 0xd6, 0x11,
+	0x8d, 0xf4, 0xe7, 0x43, 0xff, 0xdc, 0x67, 0xfe, 0x13, 0x9b, 0x9f, 0xc7, 0x88, 0xb5, 0xb6, 0xd1,
+	0x51, 0xef, 0x26, 0x32, 0x1e, 0xe8, 0x8e, 0x8b, 0xe1, 0x43, 0x84, 0x04, 0x3b, 0x3a, 0xc3, 0x5c,
+	0xb2, 0x97, 0x5f, 0x8f, 0x3d, 0xe3, 0x81, 0xee, 0xb8, 0x18, 0x3e, 0x44, 0xe8, 0xc1, 0xd4, 0xd3,
+	0x7f, 0x7e, 0xba, 0xdb, 0x13, 0x0e, 0x57, 0xc0, 0xf2, 0x00, 0x1f, 0xb3, 0x9b, 0x83, 0x2f, 0x0c,
+	0xb0, 0xd0, 0x87, 0x6f, 0x86, 0x21, 0x3d, 0x0d, 0x31, 0xe3, 0x6f, 0xc7, 0xea, 0x26, 0x28, 0x79,
+	0xbe, 0x2f, 0xab, 0xb3, 0xe2, 0x4c, 0x75, 0x3b, 0x0d, 0xa0, 0x02, 0x3d, 0xdf, 0x87, 0xae, 0x80,
+	0x44, 0x7d, 0x25, 0x28, 0xa2, 0x27, 0xc2, 0xac, 0x52, 0x7f, 0x7d, 0xa9, 0x75, 0xe8, 0xea, 0x80,
+	0x97, 0xb4, 0x37, 0x41, 0x7d, 0xb0, 0xb6, 0x4c, 0xfe, 0xc6, 0x8f, 0xef, 0x80, 0xd2, 0x2e, 0x0b,
+	0xcc, 0x08, 0x80, 0xc2, 0x97, 0x7f, 0xe5, 0x6a, 0xe3, 0xef, 0xfb, 0xac, 0x5a, 0xb7, 0x87, 0xc2,
+	0xb9, 0xb5, 0x4b, 0x4f, 0xff, 0xf8, 0xfb, 0x87, 0xd1, 0x39, 0x38, 0x6b, 0x17, 0xff, 0xfd, 0xc8,
+	0xaf, 0xc7, 0xd7, 0x60, 0x22, 0xff, 0xe6, 0x2e, 0x0f, 0x60, 0xcb, 0x40, 0x6b, 0x75, 0x08, 0x98,
+	0x27, 0x5a, 0x95, 0x89, 0x56, 0xe0, 0x72, 0x5f, 0xa2, 0x6f, 0xb4, 0xd7, 0xdf, 0xda, 0x8f, 0x29,
+	0x26, 0x22, 0x65, 0xde, 0xe0, 0x07, 0xa5, 0xcc, 0x40, 0x6b, 0x75, 0x08, 0x78, 0xe3, 0x94, 0xe2,
+	0x35, 0x9a, 0xa7, 0x00, 0x14, 0xfa, 0xe4, 0x20, 0x53, 0x7b, 0xb0, 0x75, 0x7b, 0x28, 0x7c, 0xe3,
+	0xc4, 0xa2, 0xc8, 0x4c, 0x1f, 0xcc, 0xbc, 0xd4, 0x38, 0x06, 0xc9, 0xba, 0x1a, 0x64, 0xdd, 0xbb,
+	0x41, 0x50, 0xde, 0xf4, 0x22, 0x30, 0x37, 0xa8, 0x6c, 0xee, 0x0c, 0xe5, 0xc8, 0xe3, 0xac, 0xd6,
+	0xcd, 0xe2, 0xb2, 0x74, 0xce, 0xf6, 0xb3, 0x8b, 0xba, 0xf1, 0xfc, 0xa2, 0x6e, 0xfc, 0x75, 0x51,
+	0x37, 0xbe, 0xbf, 0xac, 0x8f, 0x3c, 0xbf, 0xac, 0x8f, 0xfc, 0x79, 0x59, 0x1f, 0xf9, 0xf2, 0x6e,
+	0xa1, 0x6d, 0x7c, 0x26, 0x39, 0xb7, 0x8e, 0x3d, 0x4c, 0x32, 0x87, 0xce, 0x94, 0x47, 0xb2, 0x7d,
+	0x1c, 0x8e, 0xc9, 0x7f, 0xbb, 0x1f, 0xfc, 0x3f, 0x00, 0xa6, 0x1c, 0x6f, 0x4a, 0xbb, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -572,6 +801,12 @@ type MsgClient interface {
 	ExitPool(ctx context.Context, in *MsgExitPool, opts ...grpc.CallOption) (*MsgExitPoolResponse, error)
 	// Swap assets in a pool
 	SwapAssets(ctx context.Context, in *MsgSwapAssets, opts ...grpc.CallOption) (*MsgSwapAssetsResponse, error)
+	// UpdatePoolParams: A governance operation for updating the swap and exit
+	// fees of an existing pool.
+	UpdatePoolParams(ctx context.Context, in *MsgUpdatePoolParams, opts ...grpc.CallOption) (*MsgUpdatePoolParamsResponse, error)
+	// UpdatePoolAllowlist: A governance operation for adding addresses to and
+	// removing addresses from the allowlist of a pool.
+	UpdatePoolAllowlist(ctx context.Context, in *MsgUpdatePoolAllowlist, opts ...grpc.CallOption) (*MsgUpdatePoolAllowlistResponse, error)
 }
 
 type msgClient struct {
@@ -618,6 +853,24 @@ func (c *msgClient) SwapAssets(ctx context.Context, in *MsgSwapAssets, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdatePoolParams(ctx context.Context, in *MsgUpdatePoolParams, opts ...grpc.CallOption) (*MsgUpdatePoolParamsResponse, error) {
+	out := new(MsgUpdatePoolParamsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.spot.v1.Msg/UpdatePoolParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePoolAllowlist(ctx context.Context, in *MsgUpdatePoolAllowlist, opts ...grpc.CallOption) (*MsgUpdatePoolAllowlistResponse, error) {
+	out := new(MsgUpdatePoolAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.spot.v1.Msg/UpdatePoolAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Used to create a pool.
@@ -628,6 +881,12 @@ type MsgServer interface {
 	ExitPool(context.Context, *MsgExitPool) (*MsgExitPoolResponse, error)
 	// Swap assets in a pool
 	SwapAssets(context.Context, *MsgSwapAssets) (*MsgSwapAssetsResponse, error)
+	// UpdatePoolParams: A governance operation for updating the swap and exit
+	// fees of an existing pool.
+	UpdatePoolParams(context.Context, *MsgUpdatePoolParams) (*MsgUpdatePoolParamsResponse, error)
+	// UpdatePoolAllowlist: A governance operation for adding addresses to and
+	// removing addresses from the allowlist of a pool.
+	UpdatePoolAllowlist(context.Context, *MsgUpdatePoolAllowlist) (*MsgUpdatePoolAllowlistResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -646,6 +905,12 @@ func (*UnimplementedMsgServer) ExitPool(ctx context.Context, req *MsgExitPool) (
 func (*UnimplementedMsgServer) SwapAssets(ctx context.Context, req *MsgSwapAssets) (*MsgSwapAssetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapAssets not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePoolParams(ctx context.Context, req *MsgUpdatePoolParams) (*MsgUpdatePoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePoolAllowlist(ctx context.Context, req *MsgUpdatePoolAllowlist) (*MsgUpdatePoolAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolAllowlist not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -723,6 +988,42 @@ func _Msg_SwapAssets_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.spot.v1.Msg/UpdatePoolParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolParams(ctx, req.(*MsgUpdatePoolParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePoolAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.spot.v1.Msg/UpdatePoolAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolAllowlist(ctx, req.(*MsgUpdatePoolAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.spot.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -743,6 +1044,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapAssets",
 			Handler:    _Msg_SwapAssets_Handler,
 		},
+		{
+			MethodName: "UpdatePoolParams",
+			Handler:    _Msg_UpdatePoolParams_Handler,
+		},
+		{
+			MethodName: "UpdatePoolAllowlist",
+			Handler:    _Msg_UpdatePoolAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/spot/v1/tx.proto",
@@ -1117,63 +1426,217 @@ func (m *MsgSwapAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdatePoolParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdatePoolParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolParams != nil {
-		l = m.PoolParams.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.PoolAssets) > 0 {
-		for _, e := range m.PoolAssets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MsgCreatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x1a
 	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdatePoolParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolParams != nil {
+		l = m.PoolParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolAssets) > 0 {
+		for _, e := range m.PoolAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgJoinPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
 		n += 1 + sovTx(uint64(m.PoolId))
 	}
 	if len(m.TokensIn) > 0 {
@@ -1275,6 +1738,72 @@ func (m *MsgSwapAssetsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdatePoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePoolParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePoolAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2277,6 +2806,440 @@ func (m *MsgSwapAssetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdatePoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePoolParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePoolAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePoolAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0